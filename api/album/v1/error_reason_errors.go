@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Error 辅助函数
+
+func ErrorAlbumNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_ALBUM_NOT_FOUND.String(), format)
+}
+
+func ErrorAlbumNoAuth(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_ALBUM_NO_AUTH.String(), format)
+}
+
+func ErrorParamsError(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_PARAMS_ERROR.String(), format)
+}
+
+func ErrorUnauthorized(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_UNAUTHORIZED.String(), format)
+}
+
+func ErrorSystemError(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_SYSTEM_ERROR.String(), format)
+}
+
+// Is 辅助函数
+
+func IsAlbumNotFound(err error) bool {
+	return errors.Reason(err) == ErrorReason_ALBUM_NOT_FOUND.String()
+}
+
+func IsAlbumNoAuth(err error) bool {
+	return errors.Reason(err) == ErrorReason_ALBUM_NO_AUTH.String()
+}
+
+func IsParamsError(err error) bool {
+	return errors.Reason(err) == ErrorReason_PARAMS_ERROR.String()
+}
+
+func IsUnauthorized(err error) bool {
+	return errors.Reason(err) == ErrorReason_UNAUTHORIZED.String()
+}
+
+func IsSystemError(err error) bool {
+	return errors.Reason(err) == ErrorReason_SYSTEM_ERROR.String()
+}
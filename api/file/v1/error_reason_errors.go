@@ -59,3 +59,39 @@ func IsFileTooLarge(err error) bool {
 	e := errors.FromError(err)
 	return e.Reason == "FILE_TOO_LARGE" && e.Code == 40004
 }
+
+func ErrorUnauthorized(format string, args ...interface{}) *errors.Error {
+	return errors.New(40100, "UNAUTHORIZED", fmt.Sprintf(format, args...))
+}
+
+func IsUnauthorized(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == "UNAUTHORIZED" && e.Code == 40100
+}
+
+func ErrorUploadTokenInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(40005, "UPLOAD_TOKEN_INVALID", fmt.Sprintf(format, args...))
+}
+
+func IsUploadTokenInvalid(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == "UPLOAD_TOKEN_INVALID" && e.Code == 40005
+}
+
+func ErrorUploadNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(40400, "UPLOAD_NOT_FOUND", fmt.Sprintf(format, args...))
+}
+
+func IsUploadNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == "UPLOAD_NOT_FOUND" && e.Code == 40400
+}
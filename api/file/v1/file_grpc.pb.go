@@ -19,7 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	File_GetUploadPresignedUrl_FullMethodName = "/api.file.v1.File/GetUploadPresignedUrl"
+	File_GetUploadPresignedUrl_FullMethodName      = "/api.file.v1.File/GetUploadPresignedUrl"
+	File_CompleteUpload_FullMethodName             = "/api.file.v1.File/CompleteUpload"
+	File_InitiateMultipartUpload_FullMethodName    = "/api.file.v1.File/InitiateMultipartUpload"
+	File_GetUploadPartPresignedUrls_FullMethodName = "/api.file.v1.File/GetUploadPartPresignedUrls"
+	File_ListUploadedParts_FullMethodName          = "/api.file.v1.File/ListUploadedParts"
+	File_CompleteMultipartUpload_FullMethodName    = "/api.file.v1.File/CompleteMultipartUpload"
+	File_AbortMultipartUpload_FullMethodName       = "/api.file.v1.File/AbortMultipartUpload"
 )
 
 // FileClient is the client API for File service.
@@ -28,6 +34,18 @@ const (
 type FileClient interface {
 	// 获取上传预签名 URL
 	GetUploadPresignedUrl(ctx context.Context, in *GetUploadPresignedUrlRequest, opts ...grpc.CallOption) (*GetUploadPresignedUrlReply, error)
+	// 完成上传：校验已上传的文件并标记上传完成，图片只能引用已完成的上传
+	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadReply, error)
+	// 初始化分片上传（大文件断点续传）
+	InitiateMultipartUpload(ctx context.Context, in *InitiateMultipartUploadRequest, opts ...grpc.CallOption) (*InitiateMultipartUploadReply, error)
+	// 获取分片上传预签名 URL
+	GetUploadPartPresignedUrls(ctx context.Context, in *GetUploadPartPresignedUrlsRequest, opts ...grpc.CallOption) (*GetUploadPartPresignedUrlsReply, error)
+	// 查询已上传的分片（用于中断后续传）
+	ListUploadedParts(ctx context.Context, in *ListUploadedPartsRequest, opts ...grpc.CallOption) (*ListUploadedPartsReply, error)
+	// 完成分片上传
+	CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*CompleteMultipartUploadReply, error)
+	// 取消分片上传
+	AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadReply, error)
 }
 
 type fileClient struct {
@@ -47,12 +65,78 @@ func (c *fileClient) GetUploadPresignedUrl(ctx context.Context, in *GetUploadPre
 	return out, nil
 }
 
+func (c *fileClient) CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadReply, error) {
+	out := new(CompleteUploadReply)
+	err := c.cc.Invoke(ctx, File_CompleteUpload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileClient) InitiateMultipartUpload(ctx context.Context, in *InitiateMultipartUploadRequest, opts ...grpc.CallOption) (*InitiateMultipartUploadReply, error) {
+	out := new(InitiateMultipartUploadReply)
+	err := c.cc.Invoke(ctx, File_InitiateMultipartUpload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileClient) GetUploadPartPresignedUrls(ctx context.Context, in *GetUploadPartPresignedUrlsRequest, opts ...grpc.CallOption) (*GetUploadPartPresignedUrlsReply, error) {
+	out := new(GetUploadPartPresignedUrlsReply)
+	err := c.cc.Invoke(ctx, File_GetUploadPartPresignedUrls_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileClient) ListUploadedParts(ctx context.Context, in *ListUploadedPartsRequest, opts ...grpc.CallOption) (*ListUploadedPartsReply, error) {
+	out := new(ListUploadedPartsReply)
+	err := c.cc.Invoke(ctx, File_ListUploadedParts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileClient) CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*CompleteMultipartUploadReply, error) {
+	out := new(CompleteMultipartUploadReply)
+	err := c.cc.Invoke(ctx, File_CompleteMultipartUpload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileClient) AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadReply, error) {
+	out := new(AbortMultipartUploadReply)
+	err := c.cc.Invoke(ctx, File_AbortMultipartUpload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileServer is the server API for File service.
 // All implementations must embed UnimplementedFileServer
 // for forward compatibility
 type FileServer interface {
 	// 获取上传预签名 URL
 	GetUploadPresignedUrl(context.Context, *GetUploadPresignedUrlRequest) (*GetUploadPresignedUrlReply, error)
+	// 完成上传：校验已上传的文件并标记上传完成，图片只能引用已完成的上传
+	CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadReply, error)
+	// 初始化分片上传（大文件断点续传）
+	InitiateMultipartUpload(context.Context, *InitiateMultipartUploadRequest) (*InitiateMultipartUploadReply, error)
+	// 获取分片上传预签名 URL
+	GetUploadPartPresignedUrls(context.Context, *GetUploadPartPresignedUrlsRequest) (*GetUploadPartPresignedUrlsReply, error)
+	// 查询已上传的分片（用于中断后续传）
+	ListUploadedParts(context.Context, *ListUploadedPartsRequest) (*ListUploadedPartsReply, error)
+	// 完成分片上传
+	CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*CompleteMultipartUploadReply, error)
+	// 取消分片上传
+	AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadReply, error)
 	mustEmbedUnimplementedFileServer()
 }
 
@@ -63,6 +147,24 @@ type UnimplementedFileServer struct {
 func (UnimplementedFileServer) GetUploadPresignedUrl(context.Context, *GetUploadPresignedUrlRequest) (*GetUploadPresignedUrlReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUploadPresignedUrl not implemented")
 }
+func (UnimplementedFileServer) CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteUpload not implemented")
+}
+func (UnimplementedFileServer) InitiateMultipartUpload(context.Context, *InitiateMultipartUploadRequest) (*InitiateMultipartUploadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateMultipartUpload not implemented")
+}
+func (UnimplementedFileServer) GetUploadPartPresignedUrls(context.Context, *GetUploadPartPresignedUrlsRequest) (*GetUploadPartPresignedUrlsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadPartPresignedUrls not implemented")
+}
+func (UnimplementedFileServer) ListUploadedParts(context.Context, *ListUploadedPartsRequest) (*ListUploadedPartsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUploadedParts not implemented")
+}
+func (UnimplementedFileServer) CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*CompleteMultipartUploadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMultipartUpload not implemented")
+}
+func (UnimplementedFileServer) AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortMultipartUpload not implemented")
+}
 func (UnimplementedFileServer) mustEmbedUnimplementedFileServer() {}
 
 // UnsafeFileServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +196,114 @@ func _File_GetUploadPresignedUrl_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _File_CompleteUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServer).CompleteUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: File_CompleteUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServer).CompleteUpload(ctx, req.(*CompleteUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _File_InitiateMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServer).InitiateMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: File_InitiateMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServer).InitiateMultipartUpload(ctx, req.(*InitiateMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _File_GetUploadPartPresignedUrls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadPartPresignedUrlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServer).GetUploadPartPresignedUrls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: File_GetUploadPartPresignedUrls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServer).GetUploadPartPresignedUrls(ctx, req.(*GetUploadPartPresignedUrlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _File_ListUploadedParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUploadedPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServer).ListUploadedParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: File_ListUploadedParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServer).ListUploadedParts(ctx, req.(*ListUploadedPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _File_CompleteMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServer).CompleteMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: File_CompleteMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServer).CompleteMultipartUpload(ctx, req.(*CompleteMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _File_AbortMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileServer).AbortMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: File_AbortMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileServer).AbortMultipartUpload(ctx, req.(*AbortMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // File_ServiceDesc is the grpc.ServiceDesc for File service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +315,30 @@ var File_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUploadPresignedUrl",
 			Handler:    _File_GetUploadPresignedUrl_Handler,
 		},
+		{
+			MethodName: "CompleteUpload",
+			Handler:    _File_CompleteUpload_Handler,
+		},
+		{
+			MethodName: "InitiateMultipartUpload",
+			Handler:    _File_InitiateMultipartUpload_Handler,
+		},
+		{
+			MethodName: "GetUploadPartPresignedUrls",
+			Handler:    _File_GetUploadPartPresignedUrls_Handler,
+		},
+		{
+			MethodName: "ListUploadedParts",
+			Handler:    _File_ListUploadedParts_Handler,
+		},
+		{
+			MethodName: "CompleteMultipartUpload",
+			Handler:    _File_CompleteMultipartUpload_Handler,
+		},
+		{
+			MethodName: "AbortMultipartUpload",
+			Handler:    _File_AbortMultipartUpload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "file/v1/file.proto",
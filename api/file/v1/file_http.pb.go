@@ -19,16 +19,40 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationFileAbortMultipartUpload = "/api.file.v1.File/AbortMultipartUpload"
+const OperationFileCompleteMultipartUpload = "/api.file.v1.File/CompleteMultipartUpload"
+const OperationFileCompleteUpload = "/api.file.v1.File/CompleteUpload"
+const OperationFileGetUploadPartPresignedUrls = "/api.file.v1.File/GetUploadPartPresignedUrls"
 const OperationFileGetUploadPresignedUrl = "/api.file.v1.File/GetUploadPresignedUrl"
+const OperationFileInitiateMultipartUpload = "/api.file.v1.File/InitiateMultipartUpload"
+const OperationFileListUploadedParts = "/api.file.v1.File/ListUploadedParts"
 
 type FileHTTPServer interface {
+	// AbortMultipartUpload 取消分片上传
+	AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadReply, error)
+	// CompleteMultipartUpload 完成分片上传
+	CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*CompleteMultipartUploadReply, error)
+	// CompleteUpload 完成上传：校验已上传的文件并标记上传完成，图片只能引用已完成的上传
+	CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadReply, error)
+	// GetUploadPartPresignedUrls 获取分片上传预签名 URL
+	GetUploadPartPresignedUrls(context.Context, *GetUploadPartPresignedUrlsRequest) (*GetUploadPartPresignedUrlsReply, error)
 	// GetUploadPresignedUrl 获取上传预签名 URL
 	GetUploadPresignedUrl(context.Context, *GetUploadPresignedUrlRequest) (*GetUploadPresignedUrlReply, error)
+	// InitiateMultipartUpload 初始化分片上传（大文件断点续传）
+	InitiateMultipartUpload(context.Context, *InitiateMultipartUploadRequest) (*InitiateMultipartUploadReply, error)
+	// ListUploadedParts 查询已上传的分片（用于中断后续传）
+	ListUploadedParts(context.Context, *ListUploadedPartsRequest) (*ListUploadedPartsReply, error)
 }
 
 func RegisterFileHTTPServer(s *http.Server, srv FileHTTPServer) {
 	r := s.Route("/")
 	r.POST("/api/file/upload/presigned", _File_GetUploadPresignedUrl0_HTTP_Handler(srv))
+	r.POST("/api/file/upload/complete", _File_CompleteUpload0_HTTP_Handler(srv))
+	r.POST("/api/file/multipart/initiate", _File_InitiateMultipartUpload0_HTTP_Handler(srv))
+	r.POST("/api/file/multipart/parts/presigned", _File_GetUploadPartPresignedUrls0_HTTP_Handler(srv))
+	r.POST("/api/file/multipart/parts/list", _File_ListUploadedParts0_HTTP_Handler(srv))
+	r.POST("/api/file/multipart/complete", _File_CompleteMultipartUpload0_HTTP_Handler(srv))
+	r.POST("/api/file/multipart/abort", _File_AbortMultipartUpload0_HTTP_Handler(srv))
 }
 
 func _File_GetUploadPresignedUrl0_HTTP_Handler(srv FileHTTPServer) func(ctx http.Context) error {
@@ -53,9 +77,153 @@ func _File_GetUploadPresignedUrl0_HTTP_Handler(srv FileHTTPServer) func(ctx http
 	}
 }
 
+func _File_CompleteUpload0_HTTP_Handler(srv FileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompleteUploadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileCompleteUpload)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CompleteUpload(ctx, req.(*CompleteUploadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompleteUploadReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _File_InitiateMultipartUpload0_HTTP_Handler(srv FileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in InitiateMultipartUploadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileInitiateMultipartUpload)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.InitiateMultipartUpload(ctx, req.(*InitiateMultipartUploadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*InitiateMultipartUploadReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _File_GetUploadPartPresignedUrls0_HTTP_Handler(srv FileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUploadPartPresignedUrlsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileGetUploadPartPresignedUrls)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUploadPartPresignedUrls(ctx, req.(*GetUploadPartPresignedUrlsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUploadPartPresignedUrlsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _File_ListUploadedParts0_HTTP_Handler(srv FileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUploadedPartsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileListUploadedParts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUploadedParts(ctx, req.(*ListUploadedPartsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUploadedPartsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _File_CompleteMultipartUpload0_HTTP_Handler(srv FileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompleteMultipartUploadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileCompleteMultipartUpload)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CompleteMultipartUpload(ctx, req.(*CompleteMultipartUploadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompleteMultipartUploadReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _File_AbortMultipartUpload0_HTTP_Handler(srv FileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AbortMultipartUploadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationFileAbortMultipartUpload)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AbortMultipartUpload(ctx, req.(*AbortMultipartUploadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AbortMultipartUploadReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type FileHTTPClient interface {
+	// AbortMultipartUpload 取消分片上传
+	AbortMultipartUpload(ctx context.Context, req *AbortMultipartUploadRequest, opts ...http.CallOption) (rsp *AbortMultipartUploadReply, err error)
+	// CompleteMultipartUpload 完成分片上传
+	CompleteMultipartUpload(ctx context.Context, req *CompleteMultipartUploadRequest, opts ...http.CallOption) (rsp *CompleteMultipartUploadReply, err error)
+	// CompleteUpload 完成上传：校验已上传的文件并标记上传完成，图片只能引用已完成的上传
+	CompleteUpload(ctx context.Context, req *CompleteUploadRequest, opts ...http.CallOption) (rsp *CompleteUploadReply, err error)
+	// GetUploadPartPresignedUrls 获取分片上传预签名 URL
+	GetUploadPartPresignedUrls(ctx context.Context, req *GetUploadPartPresignedUrlsRequest, opts ...http.CallOption) (rsp *GetUploadPartPresignedUrlsReply, err error)
 	// GetUploadPresignedUrl 获取上传预签名 URL
 	GetUploadPresignedUrl(ctx context.Context, req *GetUploadPresignedUrlRequest, opts ...http.CallOption) (rsp *GetUploadPresignedUrlReply, err error)
+	// InitiateMultipartUpload 初始化分片上传（大文件断点续传）
+	InitiateMultipartUpload(ctx context.Context, req *InitiateMultipartUploadRequest, opts ...http.CallOption) (rsp *InitiateMultipartUploadReply, err error)
+	// ListUploadedParts 查询已上传的分片（用于中断后续传）
+	ListUploadedParts(ctx context.Context, req *ListUploadedPartsRequest, opts ...http.CallOption) (rsp *ListUploadedPartsReply, err error)
 }
 
 type FileHTTPClientImpl struct {
@@ -66,6 +234,62 @@ func NewFileHTTPClient(client *http.Client) FileHTTPClient {
 	return &FileHTTPClientImpl{client}
 }
 
+// AbortMultipartUpload 取消分片上传
+func (c *FileHTTPClientImpl) AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...http.CallOption) (*AbortMultipartUploadReply, error) {
+	var out AbortMultipartUploadReply
+	pattern := "/api/file/multipart/abort"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFileAbortMultipartUpload))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CompleteMultipartUpload 完成分片上传
+func (c *FileHTTPClientImpl) CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...http.CallOption) (*CompleteMultipartUploadReply, error) {
+	var out CompleteMultipartUploadReply
+	pattern := "/api/file/multipart/complete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFileCompleteMultipartUpload))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CompleteUpload 完成上传：校验已上传的文件并标记上传完成，图片只能引用已完成的上传
+func (c *FileHTTPClientImpl) CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...http.CallOption) (*CompleteUploadReply, error) {
+	var out CompleteUploadReply
+	pattern := "/api/file/upload/complete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFileCompleteUpload))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUploadPartPresignedUrls 获取分片上传预签名 URL
+func (c *FileHTTPClientImpl) GetUploadPartPresignedUrls(ctx context.Context, in *GetUploadPartPresignedUrlsRequest, opts ...http.CallOption) (*GetUploadPartPresignedUrlsReply, error) {
+	var out GetUploadPartPresignedUrlsReply
+	pattern := "/api/file/multipart/parts/presigned"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFileGetUploadPartPresignedUrls))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetUploadPresignedUrl 获取上传预签名 URL
 func (c *FileHTTPClientImpl) GetUploadPresignedUrl(ctx context.Context, in *GetUploadPresignedUrlRequest, opts ...http.CallOption) (*GetUploadPresignedUrlReply, error) {
 	var out GetUploadPresignedUrlReply
@@ -79,3 +303,31 @@ func (c *FileHTTPClientImpl) GetUploadPresignedUrl(ctx context.Context, in *GetU
 	}
 	return &out, nil
 }
+
+// InitiateMultipartUpload 初始化分片上传（大文件断点续传）
+func (c *FileHTTPClientImpl) InitiateMultipartUpload(ctx context.Context, in *InitiateMultipartUploadRequest, opts ...http.CallOption) (*InitiateMultipartUploadReply, error) {
+	var out InitiateMultipartUploadReply
+	pattern := "/api/file/multipart/initiate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFileInitiateMultipartUpload))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListUploadedParts 查询已上传的分片（用于中断后续传）
+func (c *FileHTTPClientImpl) ListUploadedParts(ctx context.Context, in *ListUploadedPartsRequest, opts ...http.CallOption) (*ListUploadedPartsReply, error) {
+	var out ListUploadedPartsReply
+	pattern := "/api/file/multipart/parts/list"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationFileListUploadedParts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Error 辅助函数
+
+func ErrorParamsError(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_PARAMS_ERROR.String(), format)
+}
+
+func ErrorUnauthorized(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_UNAUTHORIZED.String(), format)
+}
+
+func ErrorSystemError(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_SYSTEM_ERROR.String(), format)
+}
+
+func ErrorTooManyStreams(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_TOO_MANY_STREAMS.String(), format)
+}
+
+// Is 辅助函数
+
+func IsParamsError(err error) bool {
+	return errors.Reason(err) == ErrorReason_PARAMS_ERROR.String()
+}
+
+func IsUnauthorized(err error) bool {
+	return errors.Reason(err) == ErrorReason_UNAUTHORIZED.String()
+}
+
+func IsSystemError(err error) bool {
+	return errors.Reason(err) == ErrorReason_SYSTEM_ERROR.String()
+}
+
+func IsTooManyStreams(err error) bool {
+	return errors.Reason(err) == ErrorReason_TOO_MANY_STREAMS.String()
+}
@@ -25,43 +25,52 @@ type ErrorReason int32
 
 const (
 	// 图片相关错误
-	ErrorReason_PICTURE_NOT_FOUND      ErrorReason = 0
-	ErrorReason_PICTURE_UPLOAD_FAILED  ErrorReason = 1
-	ErrorReason_PICTURE_DELETE_FAILED  ErrorReason = 2
-	ErrorReason_PICTURE_UPDATE_FAILED  ErrorReason = 3
-	ErrorReason_PICTURE_NO_AUTH        ErrorReason = 4
-	ErrorReason_PICTURE_FILE_TOO_LARGE ErrorReason = 5
-	ErrorReason_PICTURE_FORMAT_ERROR   ErrorReason = 6
-	ErrorReason_PARAMS_ERROR           ErrorReason = 7
-	ErrorReason_INVALID_ARGUMENT       ErrorReason = 8
-	ErrorReason_UNAUTHORIZED           ErrorReason = 9
+	ErrorReason_PICTURE_NOT_FOUND         ErrorReason = 0
+	ErrorReason_PICTURE_UPLOAD_FAILED     ErrorReason = 1
+	ErrorReason_PICTURE_DELETE_FAILED     ErrorReason = 2
+	ErrorReason_PICTURE_UPDATE_FAILED     ErrorReason = 3
+	ErrorReason_PICTURE_NO_AUTH           ErrorReason = 4
+	ErrorReason_PICTURE_FILE_TOO_LARGE    ErrorReason = 5
+	ErrorReason_PICTURE_FORMAT_ERROR      ErrorReason = 6
+	ErrorReason_PARAMS_ERROR              ErrorReason = 7
+	ErrorReason_INVALID_ARGUMENT          ErrorReason = 8
+	ErrorReason_UNAUTHORIZED              ErrorReason = 9
+	ErrorReason_SYSTEM_ERROR              ErrorReason = 10
+	ErrorReason_PICTURE_DUPLICATE         ErrorReason = 11
+	ErrorReason_PICTURE_VERSION_NOT_FOUND ErrorReason = 12
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "PICTURE_NOT_FOUND",
-		1: "PICTURE_UPLOAD_FAILED",
-		2: "PICTURE_DELETE_FAILED",
-		3: "PICTURE_UPDATE_FAILED",
-		4: "PICTURE_NO_AUTH",
-		5: "PICTURE_FILE_TOO_LARGE",
-		6: "PICTURE_FORMAT_ERROR",
-		7: "PARAMS_ERROR",
-		8: "INVALID_ARGUMENT",
-		9: "UNAUTHORIZED",
+		0:  "PICTURE_NOT_FOUND",
+		1:  "PICTURE_UPLOAD_FAILED",
+		2:  "PICTURE_DELETE_FAILED",
+		3:  "PICTURE_UPDATE_FAILED",
+		4:  "PICTURE_NO_AUTH",
+		5:  "PICTURE_FILE_TOO_LARGE",
+		6:  "PICTURE_FORMAT_ERROR",
+		7:  "PARAMS_ERROR",
+		8:  "INVALID_ARGUMENT",
+		9:  "UNAUTHORIZED",
+		10: "SYSTEM_ERROR",
+		11: "PICTURE_DUPLICATE",
+		12: "PICTURE_VERSION_NOT_FOUND",
 	}
 	ErrorReason_value = map[string]int32{
-		"PICTURE_NOT_FOUND":      0,
-		"PICTURE_UPLOAD_FAILED":  1,
-		"PICTURE_DELETE_FAILED":  2,
-		"PICTURE_UPDATE_FAILED":  3,
-		"PICTURE_NO_AUTH":        4,
-		"PICTURE_FILE_TOO_LARGE": 5,
-		"PICTURE_FORMAT_ERROR":   6,
-		"PARAMS_ERROR":           7,
-		"INVALID_ARGUMENT":       8,
-		"UNAUTHORIZED":           9,
+		"PICTURE_NOT_FOUND":         0,
+		"PICTURE_UPLOAD_FAILED":     1,
+		"PICTURE_DELETE_FAILED":     2,
+		"PICTURE_UPDATE_FAILED":     3,
+		"PICTURE_NO_AUTH":           4,
+		"PICTURE_FILE_TOO_LARGE":    5,
+		"PICTURE_FORMAT_ERROR":      6,
+		"PARAMS_ERROR":              7,
+		"INVALID_ARGUMENT":          8,
+		"UNAUTHORIZED":              9,
+		"SYSTEM_ERROR":              10,
+		"PICTURE_DUPLICATE":         11,
+		"PICTURE_VERSION_NOT_FOUND": 12,
 	}
 )
 
@@ -99,7 +108,7 @@ var file_picture_v1_error_reason_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x0e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a,
 	0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xbc, 0x02, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
+	0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x96, 0x03, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
 	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x11, 0x50, 0x49, 0x43, 0x54, 0x55, 0x52, 0x45, 0x5f,
 	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x94,
 	0x03, 0x12, 0x1f, 0x0a, 0x15, 0x50, 0x49, 0x43, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x55, 0x50, 0x4c,
@@ -118,12 +127,18 @@ var file_picture_v1_error_reason_proto_rawDesc = []byte{
 	0x52, 0x10, 0x07, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1a, 0x0a, 0x10, 0x49, 0x4e, 0x56,
 	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x08, 0x1a,
 	0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x16, 0x0a, 0x0c, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f,
-	0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x09, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x1a, 0x04, 0xa0,
-	0x45, 0xf4, 0x03, 0x42, 0x41, 0x0a, 0x0e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x2d, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x2d, 0x63,
-	0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x2d, 0x67, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x79, 0x2d, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x09, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03, 0x12, 0x16, 0x0a,
+	0x0c, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0a, 0x1a,
+	0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x50, 0x49, 0x43, 0x54, 0x55, 0x52, 0x45,
+	0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x0b, 0x1a, 0x04, 0xa8, 0x45,
+	0x99, 0x03, 0x12, 0x23, 0x0a, 0x19, 0x50, 0x49, 0x43, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x56, 0x45,
+	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x0c, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x41, 0x0a,
+	0x0e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x50,
+	0x01, 0x5a, 0x2d, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x2d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x2d,
+	0x67, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
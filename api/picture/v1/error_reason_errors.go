@@ -46,6 +46,18 @@ func ErrorUnauthorized(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, ErrorReason_UNAUTHORIZED.String(), format)
 }
 
+func ErrorSystemError(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_SYSTEM_ERROR.String(), format)
+}
+
+func ErrorPictureDuplicate(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_PICTURE_DUPLICATE.String(), format)
+}
+
+func ErrorPictureVersionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_PICTURE_VERSION_NOT_FOUND.String(), format)
+}
+
 // Is 辅助函数
 
 func IsPictureNotFound(err error) bool {
@@ -87,3 +99,15 @@ func IsInvalidArgument(err error) bool {
 func IsUnauthorized(err error) bool {
 	return errors.Reason(err) == ErrorReason_UNAUTHORIZED.String()
 }
+
+func IsSystemError(err error) bool {
+	return errors.Reason(err) == ErrorReason_SYSTEM_ERROR.String()
+}
+
+func IsPictureDuplicate(err error) bool {
+	return errors.Reason(err) == ErrorReason_PICTURE_DUPLICATE.String()
+}
+
+func IsPictureVersionNotFound(err error) bool {
+	return errors.Reason(err) == ErrorReason_PICTURE_VERSION_NOT_FOUND.String()
+}
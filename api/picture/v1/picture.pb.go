@@ -31,12 +31,13 @@ type UploadPictureRequest struct {
 	Url          string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                               // COS 图片 URL
 	Name         string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                             // 图片名称
 	Introduction string   `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction,omitempty"`             // 简介
-	Category     string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`                     // 分类
-	Tags         []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                             // 标签数组
+	Category     string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`                     // 分类，需为已有分类或其别名，可为空
+	Tags         []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                             // 标签数组，最多 10 个，别名会归一为对应的标签
 	PicSize      int64    `protobuf:"varint,7,opt,name=pic_size,json=picSize,proto3" json:"pic_size,omitempty"`       // 图片体积（字节）
-	PicWidth     int32    `protobuf:"varint,8,opt,name=pic_width,json=picWidth,proto3" json:"pic_width,omitempty"`    // 图片宽度
-	PicHeight    int32    `protobuf:"varint,9,opt,name=pic_height,json=picHeight,proto3" json:"pic_height,omitempty"` // 图片高度
-	PicFormat    string   `protobuf:"bytes,10,opt,name=pic_format,json=picFormat,proto3" json:"pic_format,omitempty"` // 图片格式
+	PicWidth     int32    `protobuf:"varint,8,opt,name=pic_width,json=picWidth,proto3" json:"pic_width,omitempty"`    // 图片宽度（服务端能解析图片时以实际宽度为准）
+	PicHeight    int32    `protobuf:"varint,9,opt,name=pic_height,json=picHeight,proto3" json:"pic_height,omitempty"` // 图片高度（服务端能解析图片时以实际高度为准）
+	PicFormat    string   `protobuf:"bytes,10,opt,name=pic_format,json=picFormat,proto3" json:"pic_format,omitempty"` // 图片格式（服务端能解析图片时以实际格式为准）
+	SpaceId      int64    `protobuf:"varint,11,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`      // 所属空间 id（需为自己创建的空间），0 表示公共图库，仅创建图片时有效
 }
 
 func (x *UploadPictureRequest) Reset() {
@@ -141,12 +142,20 @@ func (x *UploadPictureRequest) GetPicFormat() string {
 	return ""
 }
 
+func (x *UploadPictureRequest) GetSpaceId() int64 {
+	if x != nil {
+		return x.SpaceId
+	}
+	return 0
+}
+
 type UploadPictureReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Picture *PictureVO `protobuf:"bytes,1,opt,name=picture,proto3" json:"picture,omitempty"`
+	Picture         *PictureVO          `protobuf:"bytes,1,opt,name=picture,proto3" json:"picture,omitempty"`
+	SimilarPictures []*SimilarPictureVO `protobuf:"bytes,2,rep,name=similar_pictures,json=similarPictures,proto3" json:"similar_pictures,omitempty"` // 已存在的相似图片（查重模式为 warn 时返回）
 }
 
 func (x *UploadPictureReply) Reset() {
@@ -188,6 +197,13 @@ func (x *UploadPictureReply) GetPicture() *PictureVO {
 	return nil
 }
 
+func (x *UploadPictureReply) GetSimilarPictures() []*SimilarPictureVO {
+	if x != nil {
+		return x.SimilarPictures
+	}
+	return nil
+}
+
 type GetPictureByIdRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -287,15 +303,19 @@ type ListPictureByPageRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Current      int64    `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`                     // 当前页
-	PageSize     int64    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // 每页大小
-	Name         string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                            // 图片名称（模糊搜索）
-	Introduction string   `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction,omitempty"`            // 简介（模糊搜索）
-	Category     string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`                    // 分类
-	Tags         []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                            // 标签
-	UserId       int64    `protobuf:"varint,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // 用户 ID
-	SortField    string   `protobuf:"bytes,8,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"` // 排序字段
-	SortOrder    string   `protobuf:"bytes,9,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // 排序顺序（ascend/descend）
+	Current      int64    `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`                          // 当前页
+	PageSize     int64    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`        // 每页大小
+	Name         string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                 // 图片名称（模糊搜索）
+	Introduction string   `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction,omitempty"`                 // 简介（模糊搜索）
+	Category     string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`                         // 分类
+	Tags         []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                 // 标签
+	UserId       int64    `protobuf:"varint,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 用户 ID
+	SortField    string   `protobuf:"bytes,8,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"`      // 排序字段
+	SortOrder    string   `protobuf:"bytes,9,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`      // 排序顺序（ascend/descend）
+	CursorMode   bool     `protobuf:"varint,10,opt,name=cursor_mode,json=cursorMode,proto3" json:"cursor_mode,omitempty"` // 是否使用游标分页（忽略 current，按 cursor 定位）
+	Cursor       string   `protobuf:"bytes,11,opt,name=cursor,proto3" json:"cursor,omitempty"`                            // 游标（上一页返回的 next_cursor，首页传空），排序条件需与首页一致
+	WithTotal    bool     `protobuf:"varint,12,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`    // 游标分页时是否统计总数（偏移量分页始终统计）
+	SpaceId      int64    `protobuf:"varint,13,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`          // 空间 ID，0 表示不限
 }
 
 func (x *ListPictureByPageRequest) Reset() {
@@ -393,13 +413,42 @@ func (x *ListPictureByPageRequest) GetSortOrder() string {
 	return ""
 }
 
+func (x *ListPictureByPageRequest) GetCursorMode() bool {
+	if x != nil {
+		return x.CursorMode
+	}
+	return false
+}
+
+func (x *ListPictureByPageRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPictureByPageRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+func (x *ListPictureByPageRequest) GetSpaceId() int64 {
+	if x != nil {
+		return x.SpaceId
+	}
+	return 0
+}
+
 type ListPictureByPageReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total int64        `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
-	List  []*PictureVO `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`    // 列表
+	Total      int64        `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`                            // 总数（游标分页且未设置 with_total 时为 0）
+	List       []*PictureVO `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`                               // 列表
+	NextCursor string       `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 游标分页的下一页游标，没有更多数据时为空
 }
 
 func (x *ListPictureByPageReply) Reset() {
@@ -448,6 +497,13 @@ func (x *ListPictureByPageReply) GetList() []*PictureVO {
 	return nil
 }
 
+func (x *ListPictureByPageReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type DeletePictureRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -550,8 +606,8 @@ type UpdatePictureRequest struct {
 	Id           int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                    // 图片 id
 	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                 // 图片名称
 	Introduction string   `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"` // 简介
-	Category     string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`         // 分类
-	Tags         []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                 // 标签数组
+	Category     string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`         // 分类，需为已有分类或其别名，可为空
+	Tags         []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                 // 标签数组，最多 10 个，别名会归一为对应的标签
 }
 
 func (x *UpdatePictureRequest) Reset() {
@@ -676,8 +732,8 @@ type EditPictureRequest struct {
 	Id           int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                    // 图片 id
 	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                 // 图片名称
 	Introduction string   `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"` // 简介
-	Category     string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`         // 分类
-	Tags         []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                 // 标签数组
+	Category     string   `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`         // 分类，需为已有分类或其别名，可为空
+	Tags         []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                 // 标签数组，最多 10 个，别名会归一为对应的标签
 }
 
 func (x *EditPictureRequest) Reset() {
@@ -893,16 +949,28 @@ type ListPictureVOByPageRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Current      int64    `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`                         // 当前页
-	PageSize     int64    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页大小
-	Name         string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                // 图片名称（模糊搜索）
-	Introduction string   `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction,omitempty"`                // 简介（模糊搜索）
-	Category     string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`                        // 分类
-	Tags         []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                // 标签
-	UserId       int64    `protobuf:"varint,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 用户 ID
-	SortField    string   `protobuf:"bytes,8,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"`     // 排序字段
-	SortOrder    string   `protobuf:"bytes,9,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`     // 排序顺序（ascend/descend）
-	SearchText   string   `protobuf:"bytes,10,opt,name=search_text,json=searchText,proto3" json:"search_text,omitempty"` // 搜索词（同时搜名称、简介等）
+	Current          int64    `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`                                               // 当前页
+	PageSize         int64    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                             // 每页大小
+	Name             string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                      // 图片名称（模糊搜索）
+	Introduction     string   `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction,omitempty"`                                      // 简介（模糊搜索）
+	Category         string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`                                              // 分类
+	Tags             []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`                                                      // 标签
+	UserId           int64    `protobuf:"varint,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                   // 用户 ID
+	SortField        string   `protobuf:"bytes,8,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"`                           // 排序字段（支持 likeCount/favoriteCount/viewCount 等）
+	SortOrder        string   `protobuf:"bytes,9,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`                           // 排序顺序（ascend/descend）
+	SearchText       string   `protobuf:"bytes,10,opt,name=search_text,json=searchText,proto3" json:"search_text,omitempty"`                       // 搜索词（全文检索名称、简介、标签、分类和作者昵称），指定后按相关度排序，忽略排序字段和颜色排序
+	PicColor         string   `protobuf:"bytes,11,opt,name=pic_color,json=picColor,proto3" json:"pic_color,omitempty"`                             // 按颜色搜索（十六进制，如 #3366FF），指定后按与图片主色调的色差从小到大排序，忽略排序字段
+	MaxColorDistance float64  `protobuf:"fixed64,12,opt,name=max_color_distance,json=maxColorDistance,proto3" json:"max_color_distance,omitempty"` // 按颜色搜索时的最大色差（CIE76 ΔE），默认 30
+	PicFormat        string   `protobuf:"bytes,13,opt,name=pic_format,json=picFormat,proto3" json:"pic_format,omitempty"`                          // 图片格式（如 jpeg、png）
+	SizeRange        string   `protobuf:"bytes,14,opt,name=size_range,json=sizeRange,proto3" json:"size_range,omitempty"`                          // 体积区间：small（1MB 以下）、medium（1-5MB）、large（5-20MB）、huge（20MB 以上）
+	ScaleRange       string   `protobuf:"bytes,15,opt,name=scale_range,json=scaleRange,proto3" json:"scale_range,omitempty"`                       // 宽高比区间：portrait（0.9 以下）、square（0.9-1.1）、landscape（1.1-2）、panorama（2 以上）
+	WithFacets       bool     `protobuf:"varint,16,opt,name=with_facets,json=withFacets,proto3" json:"with_facets,omitempty"`                      // 是否同时返回分面统计
+	CursorMode       bool     `protobuf:"varint,17,opt,name=cursor_mode,json=cursorMode,proto3" json:"cursor_mode,omitempty"`                      // 是否使用游标分页（忽略 current，按 cursor 定位）
+	Cursor           string   `protobuf:"bytes,18,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                 // 游标（上一页返回的 next_cursor，首页传空），排序条件需与首页一致
+	WithTotal        bool     `protobuf:"varint,19,opt,name=with_total,json=withTotal,proto3" json:"with_total,omitempty"`                         // 游标分页时是否统计总数（偏移量分页始终统计）
+	SpaceId          int64    `protobuf:"varint,20,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`                               // 空间 ID，0 表示不限
+	FilterReview     bool     `protobuf:"varint,21,opt,name=filter_review,json=filterReview,proto3" json:"filter_review,omitempty"`                // 是否按审核状态过滤（非管理员只能查询到审核通过的图片和自己的图片）
+	ReviewStatus     int32    `protobuf:"varint,22,opt,name=review_status,json=reviewStatus,proto3" json:"review_status,omitempty"`                // 审核状态（filter_review 为 true 时生效）：0-待审核，1-通过，2-拒绝
 }
 
 func (x *ListPictureVOByPageRequest) Reset() {
@@ -1007,13 +1075,99 @@ func (x *ListPictureVOByPageRequest) GetSearchText() string {
 	return ""
 }
 
+func (x *ListPictureVOByPageRequest) GetPicColor() string {
+	if x != nil {
+		return x.PicColor
+	}
+	return ""
+}
+
+func (x *ListPictureVOByPageRequest) GetMaxColorDistance() float64 {
+	if x != nil {
+		return x.MaxColorDistance
+	}
+	return 0
+}
+
+func (x *ListPictureVOByPageRequest) GetPicFormat() string {
+	if x != nil {
+		return x.PicFormat
+	}
+	return ""
+}
+
+func (x *ListPictureVOByPageRequest) GetSizeRange() string {
+	if x != nil {
+		return x.SizeRange
+	}
+	return ""
+}
+
+func (x *ListPictureVOByPageRequest) GetScaleRange() string {
+	if x != nil {
+		return x.ScaleRange
+	}
+	return ""
+}
+
+func (x *ListPictureVOByPageRequest) GetWithFacets() bool {
+	if x != nil {
+		return x.WithFacets
+	}
+	return false
+}
+
+func (x *ListPictureVOByPageRequest) GetCursorMode() bool {
+	if x != nil {
+		return x.CursorMode
+	}
+	return false
+}
+
+func (x *ListPictureVOByPageRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPictureVOByPageRequest) GetWithTotal() bool {
+	if x != nil {
+		return x.WithTotal
+	}
+	return false
+}
+
+func (x *ListPictureVOByPageRequest) GetSpaceId() int64 {
+	if x != nil {
+		return x.SpaceId
+	}
+	return 0
+}
+
+func (x *ListPictureVOByPageRequest) GetFilterReview() bool {
+	if x != nil {
+		return x.FilterReview
+	}
+	return false
+}
+
+func (x *ListPictureVOByPageRequest) GetReviewStatus() int32 {
+	if x != nil {
+		return x.ReviewStatus
+	}
+	return 0
+}
+
 type ListPictureVOByPageReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total int64        `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
-	List  []*PictureVO `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`    // 列表
+	Total      int64          `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`                            // 总数（游标分页且未设置 with_total 时为 0）
+	List       []*PictureVO   `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`                               // 列表
+	Facets     *PictureFacets `protobuf:"bytes,3,opt,name=facets,proto3" json:"facets,omitempty"`                           // 分面统计（with_facets 为 true 时返回）
+	NextCursor string         `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 游标分页的下一页游标，没有更多数据时为空
 }
 
 func (x *ListPictureVOByPageReply) Reset() {
@@ -1062,14 +1216,32 @@ func (x *ListPictureVOByPageReply) GetList() []*PictureVO {
 	return nil
 }
 
-type GetPictureTagCategoryRequest struct {
+func (x *ListPictureVOByPageReply) GetFacets() *PictureFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *ListPictureVOByPageReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 分面取值及满足条件的图片数
+type FacetCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`  // 取值
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // 图片数
 }
 
-func (x *GetPictureTagCategoryRequest) Reset() {
-	*x = GetPictureTagCategoryRequest{}
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_picture_v1_picture_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1077,13 +1249,13 @@ func (x *GetPictureTagCategoryRequest) Reset() {
 	}
 }
 
-func (x *GetPictureTagCategoryRequest) String() string {
+func (x *FacetCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPictureTagCategoryRequest) ProtoMessage() {}
+func (*FacetCount) ProtoMessage() {}
 
-func (x *GetPictureTagCategoryRequest) ProtoReflect() protoreflect.Message {
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
 	mi := &file_picture_v1_picture_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1095,22 +1267,40 @@ func (x *GetPictureTagCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPictureTagCategoryRequest.ProtoReflect.Descriptor instead.
-func (*GetPictureTagCategoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
 	return file_picture_v1_picture_proto_rawDescGZIP(), []int{16}
 }
 
-type GetPictureTagCategoryReply struct {
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 图片列表的分面统计，单选条件（分类、格式、体积、宽高比）统计时不应用自身的过滤条件，标签统计时应用全部条件
+type PictureFacets struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TagList      []string `protobuf:"bytes,1,rep,name=tag_list,json=tagList,proto3" json:"tag_list,omitempty"`                // 标签列表
-	CategoryList []string `protobuf:"bytes,2,rep,name=category_list,json=categoryList,proto3" json:"category_list,omitempty"` // 分类列表
+	Categories  []*FacetCount `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`                      // 分类（按图片数从多到少，最多 30 个）
+	Tags        []*FacetCount `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`                                  // 标签（按图片数从多到少，最多 30 个）
+	Formats     []*FacetCount `protobuf:"bytes,3,rep,name=formats,proto3" json:"formats,omitempty"`                            // 图片格式（按图片数从多到少，最多 30 个）
+	SizeRanges  []*FacetCount `protobuf:"bytes,4,rep,name=size_ranges,json=sizeRanges,proto3" json:"size_ranges,omitempty"`    // 体积区间（按区间从小到大，包含图片数为 0 的区间）
+	ScaleRanges []*FacetCount `protobuf:"bytes,5,rep,name=scale_ranges,json=scaleRanges,proto3" json:"scale_ranges,omitempty"` // 宽高比区间（按区间从小到大，包含图片数为 0 的区间）
 }
 
-func (x *GetPictureTagCategoryReply) Reset() {
-	*x = GetPictureTagCategoryReply{}
+func (x *PictureFacets) Reset() {
+	*x = PictureFacets{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_picture_v1_picture_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1118,13 +1308,13 @@ func (x *GetPictureTagCategoryReply) Reset() {
 	}
 }
 
-func (x *GetPictureTagCategoryReply) String() string {
+func (x *PictureFacets) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPictureTagCategoryReply) ProtoMessage() {}
+func (*PictureFacets) ProtoMessage() {}
 
-func (x *GetPictureTagCategoryReply) ProtoReflect() protoreflect.Message {
+func (x *PictureFacets) ProtoReflect() protoreflect.Message {
 	mi := &file_picture_v1_picture_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1136,51 +1326,56 @@ func (x *GetPictureTagCategoryReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPictureTagCategoryReply.ProtoReflect.Descriptor instead.
-func (*GetPictureTagCategoryReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use PictureFacets.ProtoReflect.Descriptor instead.
+func (*PictureFacets) Descriptor() ([]byte, []int) {
 	return file_picture_v1_picture_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetPictureTagCategoryReply) GetTagList() []string {
+func (x *PictureFacets) GetCategories() []*FacetCount {
 	if x != nil {
-		return x.TagList
+		return x.Categories
 	}
 	return nil
 }
 
-func (x *GetPictureTagCategoryReply) GetCategoryList() []string {
+func (x *PictureFacets) GetTags() []*FacetCount {
 	if x != nil {
-		return x.CategoryList
+		return x.Tags
 	}
 	return nil
 }
 
-// PictureVO 图片视图对象
-type PictureVO struct {
+func (x *PictureFacets) GetFormats() []*FacetCount {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+func (x *PictureFacets) GetSizeRanges() []*FacetCount {
+	if x != nil {
+		return x.SizeRanges
+	}
+	return nil
+}
+
+func (x *PictureFacets) GetScaleRanges() []*FacetCount {
+	if x != nil {
+		return x.ScaleRanges
+	}
+	return nil
+}
+
+type GetPictureTagCategoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                   // id
-	Url          string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                  // 图片 url
-	Name         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                // 图片名称
-	Introduction string                 `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction,omitempty"`                // 简介
-	Tags         []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                                // 标签
-	Category     string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`                        // 分类
-	PicSize      int64                  `protobuf:"varint,7,opt,name=pic_size,json=picSize,proto3" json:"pic_size,omitempty"`          // 文件体积
-	PicWidth     int32                  `protobuf:"varint,8,opt,name=pic_width,json=picWidth,proto3" json:"pic_width,omitempty"`       // 图片宽度
-	PicHeight    int32                  `protobuf:"varint,9,opt,name=pic_height,json=picHeight,proto3" json:"pic_height,omitempty"`    // 图片高度
-	PicScale     float64                `protobuf:"fixed64,10,opt,name=pic_scale,json=picScale,proto3" json:"pic_scale,omitempty"`     // 图片比例
-	PicFormat    string                 `protobuf:"bytes,11,opt,name=pic_format,json=picFormat,proto3" json:"pic_format,omitempty"`    // 图片格式
-	UserId       int64                  `protobuf:"varint,12,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`            // 用户 id
-	CreateTime   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 创建时间
-	EditTime     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=edit_time,json=editTime,proto3" json:"edit_time,omitempty"`       // 编辑时间
-	UpdateTime   *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"` // 更新时间
-	User         *UserVO                `protobuf:"bytes,16,opt,name=user,proto3" json:"user,omitempty"`                               // 创建用户信息
+	TagLimit int32 `protobuf:"varint,1,opt,name=tag_limit,json=tagLimit,proto3" json:"tag_limit,omitempty"` // 返回的标签数，默认 20，最多 100
 }
 
-func (x *PictureVO) Reset() {
-	*x = PictureVO{}
+func (x *GetPictureTagCategoryRequest) Reset() {
+	*x = GetPictureTagCategoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_picture_v1_picture_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1188,13 +1383,13 @@ func (x *PictureVO) Reset() {
 	}
 }
 
-func (x *PictureVO) String() string {
+func (x *GetPictureTagCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PictureVO) ProtoMessage() {}
+func (*GetPictureTagCategoryRequest) ProtoMessage() {}
 
-func (x *PictureVO) ProtoReflect() protoreflect.Message {
+func (x *GetPictureTagCategoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_picture_v1_picture_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1206,549 +1401,4738 @@ func (x *PictureVO) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PictureVO.ProtoReflect.Descriptor instead.
-func (*PictureVO) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPictureTagCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPictureTagCategoryRequest) Descriptor() ([]byte, []int) {
 	return file_picture_v1_picture_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *PictureVO) GetId() int64 {
+func (x *GetPictureTagCategoryRequest) GetTagLimit() int32 {
 	if x != nil {
-		return x.Id
+		return x.TagLimit
 	}
 	return 0
 }
 
-func (x *PictureVO) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
+type GetPictureTagCategoryReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *PictureVO) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+	TagList        []string      `protobuf:"bytes,1,rep,name=tag_list,json=tagList,proto3" json:"tag_list,omitempty"`                      // 标签列表（按使用次数从多到少）
+	CategoryList   []string      `protobuf:"bytes,2,rep,name=category_list,json=categoryList,proto3" json:"category_list,omitempty"`       // 分类列表（按展示顺序）
+	TagCounts      []*FacetCount `protobuf:"bytes,3,rep,name=tag_counts,json=tagCounts,proto3" json:"tag_counts,omitempty"`                // 标签及使用次数，与 tag_list 顺序一致
+	CategoryCounts []*FacetCount `protobuf:"bytes,4,rep,name=category_counts,json=categoryCounts,proto3" json:"category_counts,omitempty"` // 分类及使用次数，与 category_list 顺序一致
 }
 
-func (x *PictureVO) GetIntroduction() string {
-	if x != nil {
-		return x.Introduction
+func (x *GetPictureTagCategoryReply) Reset() {
+	*x = GetPictureTagCategoryReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *PictureVO) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+func (x *GetPictureTagCategoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PictureVO) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
-}
+func (*GetPictureTagCategoryReply) ProtoMessage() {}
 
-func (x *PictureVO) GetPicSize() int64 {
-	if x != nil {
-		return x.PicSize
+func (x *GetPictureTagCategoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PictureVO) GetPicWidth() int32 {
-	if x != nil {
-		return x.PicWidth
-	}
-	return 0
+// Deprecated: Use GetPictureTagCategoryReply.ProtoReflect.Descriptor instead.
+func (*GetPictureTagCategoryReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *PictureVO) GetPicHeight() int32 {
+func (x *GetPictureTagCategoryReply) GetTagList() []string {
 	if x != nil {
-		return x.PicHeight
+		return x.TagList
 	}
-	return 0
+	return nil
 }
 
-func (x *PictureVO) GetPicScale() float64 {
+func (x *GetPictureTagCategoryReply) GetCategoryList() []string {
 	if x != nil {
-		return x.PicScale
+		return x.CategoryList
 	}
-	return 0
+	return nil
 }
 
-func (x *PictureVO) GetPicFormat() string {
+func (x *GetPictureTagCategoryReply) GetTagCounts() []*FacetCount {
 	if x != nil {
-		return x.PicFormat
+		return x.TagCounts
 	}
-	return ""
+	return nil
 }
 
-func (x *PictureVO) GetUserId() int64 {
+func (x *GetPictureTagCategoryReply) GetCategoryCounts() []*FacetCount {
 	if x != nil {
-		return x.UserId
+		return x.CategoryCounts
 	}
-	return 0
+	return nil
 }
 
-func (x *PictureVO) GetCreateTime() *timestamppb.Timestamp {
+type SuggestTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix       string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`                                 // 已输入的前缀（不区分大小写，匹配名称或别名），为空时按已选标签和使用习惯推荐
+	SelectedTags []string `protobuf:"bytes,2,rep,name=selected_tags,json=selectedTags,proto3" json:"selected_tags,omitempty"` // 图片上已选的标签，用于计算共现，已选的标签不会再次返回
+	Limit        int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                  // 返回数量，默认 10，最多 50
+}
+
+func (x *SuggestTagsRequest) Reset() {
+	*x = SuggestTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagsRequest) ProtoMessage() {}
+
+func (x *SuggestTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestTagsRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SuggestTagsRequest) GetPrefix() string {
 	if x != nil {
-		return x.CreateTime
+		return x.Prefix
 	}
-	return nil
+	return ""
 }
 
-func (x *PictureVO) GetEditTime() *timestamppb.Timestamp {
+func (x *SuggestTagsRequest) GetSelectedTags() []string {
 	if x != nil {
-		return x.EditTime
+		return x.SelectedTags
 	}
 	return nil
 }
 
-func (x *PictureVO) GetUpdateTime() *timestamppb.Timestamp {
+func (x *SuggestTagsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.UpdateTime
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestTagsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*TagSuggestion `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"` // 按得分从高到低排列
+}
+
+func (x *SuggestTagsReply) Reset() {
+	*x = SuggestTagsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestTagsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagsReply) ProtoMessage() {}
+
+func (x *SuggestTagsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagsReply.ProtoReflect.Descriptor instead.
+func (*SuggestTagsReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SuggestTagsReply) GetList() []*TagSuggestion {
+	if x != nil {
+		return x.List
 	}
 	return nil
 }
 
-func (x *PictureVO) GetUser() *UserVO {
+// 标签建议
+type TagSuggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                      // 标签名称（通过别名匹配时返回正式名称）
+	PictureCount int64   `protobuf:"varint,2,opt,name=picture_count,json=pictureCount,proto3" json:"picture_count,omitempty"` // 全站使用次数
+	UserCount    int64   `protobuf:"varint,3,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`          // 当前用户使用次数
+	Score        float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`                                  // 排序得分
+}
+
+func (x *TagSuggestion) Reset() {
+	*x = TagSuggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagSuggestion) ProtoMessage() {}
+
+func (x *TagSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagSuggestion.ProtoReflect.Descriptor instead.
+func (*TagSuggestion) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TagSuggestion) GetName() string {
 	if x != nil {
-		return x.User
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagSuggestion) GetPictureCount() int64 {
+	if x != nil {
+		return x.PictureCount
+	}
+	return 0
+}
+
+func (x *TagSuggestion) GetUserCount() int64 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *TagSuggestion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor   int64 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`                     // 游标（上一页返回的 next_cursor，首次请求传 0）
+	PageSize int64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页大小，最多 20
+}
+
+func (x *GetFeedRequest) Reset() {
+	*x = GetFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedRequest) ProtoMessage() {}
+
+func (x *GetFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetFeedRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *GetFeedRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetFeedReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List       []*PictureVO `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`                                // 列表（按发布时间倒序）
+	NextCursor int64        `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 下一页游标
+	HasMore    bool         `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`          // 是否还有更多
+}
+
+func (x *GetFeedReply) Reset() {
+	*x = GetFeedReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFeedReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedReply) ProtoMessage() {}
+
+func (x *GetFeedReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedReply.ProtoReflect.Descriptor instead.
+func (*GetFeedReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetFeedReply) GetList() []*PictureVO {
+	if x != nil {
+		return x.List
 	}
 	return nil
 }
 
-// UserVO 用户视图对象（简化版）
-type UserVO struct {
+func (x *GetFeedReply) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetFeedReply) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type LikePictureRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserAccount string `protobuf:"bytes,2,opt,name=user_account,json=userAccount,proto3" json:"user_account,omitempty"`
-	UserName    string `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserAvatar  string `protobuf:"bytes,4,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
-	UserProfile string `protobuf:"bytes,5,opt,name=user_profile,json=userProfile,proto3" json:"user_profile,omitempty"`
-	UserRole    string `protobuf:"bytes,6,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
+	Id   int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`     // 图片 id
+	Like bool  `protobuf:"varint,2,opt,name=like,proto3" json:"like,omitempty"` // true 点赞，false 取消点赞
 }
 
-func (x *UserVO) Reset() {
-	*x = UserVO{}
+func (x *LikePictureRequest) Reset() {
+	*x = LikePictureRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_picture_v1_picture_proto_msgTypes[19]
+		mi := &file_picture_v1_picture_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserVO) String() string {
+func (x *LikePictureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserVO) ProtoMessage() {}
+func (*LikePictureRequest) ProtoMessage() {}
 
-func (x *UserVO) ProtoReflect() protoreflect.Message {
-	mi := &file_picture_v1_picture_proto_msgTypes[19]
+func (x *LikePictureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePictureRequest.ProtoReflect.Descriptor instead.
+func (*LikePictureRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LikePictureRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LikePictureRequest) GetLike() bool {
+	if x != nil {
+		return x.Like
+	}
+	return false
+}
+
+type LikePictureReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Liked     bool  `protobuf:"varint,1,opt,name=liked,proto3" json:"liked,omitempty"`                          // 当前是否已点赞
+	LikeCount int64 `protobuf:"varint,2,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"` // 点赞数
+}
+
+func (x *LikePictureReply) Reset() {
+	*x = LikePictureReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePictureReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePictureReply) ProtoMessage() {}
+
+func (x *LikePictureReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UserVO.ProtoReflect.Descriptor instead.
-func (*UserVO) Descriptor() ([]byte, []int) {
-	return file_picture_v1_picture_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *UserVO) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UserVO) GetUserAccount() string {
-	if x != nil {
-		return x.UserAccount
-	}
-	return ""
-}
-
-func (x *UserVO) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
-}
-
-func (x *UserVO) GetUserAvatar() string {
-	if x != nil {
-		return x.UserAvatar
-	}
-	return ""
-}
-
-func (x *UserVO) GetUserProfile() string {
-	if x != nil {
-		return x.UserProfile
-	}
-	return ""
-}
-
-func (x *UserVO) GetUserRole() string {
-	if x != nil {
-		return x.UserRole
-	}
-	return ""
-}
-
-var File_picture_v1_picture_proto protoreflect.FileDescriptor
-
-var file_picture_v1_picture_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x61, 0x70, 0x69, 0x2e,
-	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x02, 0x0a, 0x14, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x08,
-	0x70, 0x69, 0x63, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x70, 0x69, 0x63, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x63, 0x5f, 0x77,
-	0x69, 0x64, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x69, 0x63, 0x57,
-	0x69, 0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x69, 0x63, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x63, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x22, 0x49, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x56, 0x4f, 0x52, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x27, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a,
-	0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0x90, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e,
-	0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
-	0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72,
-	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x04,
-	0x6c, 0x69, 0x73, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x12,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x8e, 0x01, 0x0a,
-	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x2e, 0x0a,
-	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x8c, 0x01,
-	0x0a, 0x12, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x10,
-	0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x56, 0x4f, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33,
-	0x0a, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x07, 0x70, 0x69, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x22, 0xb3, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x56, 0x4f, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f,
-	0x72, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72,
-	0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x65, 0x78, 0x74, 0x22, 0x5f, 0x0a, 0x18, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x04, 0x6c,
-	0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x56, 0x4f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65,
-	0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x54, 0x61, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x54, 0x61, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x67, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x67, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xa0, 0x04, 0x0a, 0x09, 0x50, 0x69, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
-	0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x12, 0x19, 0x0a, 0x08, 0x70, 0x69, 0x63, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x70, 0x69, 0x63, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
-	0x69, 0x63, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x70, 0x69, 0x63, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63, 0x5f,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x69,
-	0x63, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x63, 0x5f, 0x73,
-	0x63, 0x61, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x69, 0x63, 0x53,
-	0x63, 0x61, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63, 0x5f, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x63, 0x46, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x64, 0x69,
-	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x2a, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x56, 0x4f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0xb9, 0x01, 0x0a, 0x06,
-	0x55, 0x73, 0x65, 0x72, 0x56, 0x4f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73,
-	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61,
-	0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65,
-	0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75,
-	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x32, 0xa1, 0x09, 0x0a, 0x07, 0x50, 0x69, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x12, 0x79, 0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x7b,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x49, 0x64,
-	0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x11,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x50, 0x61, 0x67,
-	0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x6c, 0x69, 0x73,
-	0x74, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x12, 0x79, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
-	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x12, 0x79, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
-	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x71, 0x0a, 0x0b,
-	0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69,
-	0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x12,
-	0x7f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x42,
-	0x79, 0x49, 0x64, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56,
-	0x4f, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x76, 0x6f,
-	0x12, 0x91, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x56, 0x4f, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
-	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69,
-	0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x56, 0x4f, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x70, 0x61, 0x67,
-	0x65, 0x2f, 0x76, 0x6f, 0x12, 0x94, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x54, 0x61, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2c,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x54, 0x61, 0x67, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x54, 0x61, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
-	0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x74,
-	0x61, 0x67, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x42, 0x2f, 0x5a, 0x2d, 0x73,
-	0x6d, 0x61, 0x72, 0x74, 0x2d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x2d, 0x67, 0x61, 0x6c, 0x6c,
-	0x65, 0x72, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_picture_v1_picture_proto_rawDescOnce sync.Once
-	file_picture_v1_picture_proto_rawDescData = file_picture_v1_picture_proto_rawDesc
-)
-
-func file_picture_v1_picture_proto_rawDescGZIP() []byte {
-	file_picture_v1_picture_proto_rawDescOnce.Do(func() {
-		file_picture_v1_picture_proto_rawDescData = protoimpl.X.CompressGZIP(file_picture_v1_picture_proto_rawDescData)
-	})
-	return file_picture_v1_picture_proto_rawDescData
-}
-
-var file_picture_v1_picture_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
-var file_picture_v1_picture_proto_goTypes = []interface{}{
-	(*UploadPictureRequest)(nil),         // 0: api.picture.v1.UploadPictureRequest
-	(*UploadPictureReply)(nil),           // 1: api.picture.v1.UploadPictureReply
-	(*GetPictureByIdRequest)(nil),        // 2: api.picture.v1.GetPictureByIdRequest
-	(*GetPictureByIdReply)(nil),          // 3: api.picture.v1.GetPictureByIdReply
-	(*ListPictureByPageRequest)(nil),     // 4: api.picture.v1.ListPictureByPageRequest
-	(*ListPictureByPageReply)(nil),       // 5: api.picture.v1.ListPictureByPageReply
-	(*DeletePictureRequest)(nil),         // 6: api.picture.v1.DeletePictureRequest
-	(*DeletePictureReply)(nil),           // 7: api.picture.v1.DeletePictureReply
-	(*UpdatePictureRequest)(nil),         // 8: api.picture.v1.UpdatePictureRequest
-	(*UpdatePictureReply)(nil),           // 9: api.picture.v1.UpdatePictureReply
-	(*EditPictureRequest)(nil),           // 10: api.picture.v1.EditPictureRequest
-	(*EditPictureReply)(nil),             // 11: api.picture.v1.EditPictureReply
-	(*GetPictureVOByIdRequest)(nil),      // 12: api.picture.v1.GetPictureVOByIdRequest
-	(*GetPictureVOByIdReply)(nil),        // 13: api.picture.v1.GetPictureVOByIdReply
-	(*ListPictureVOByPageRequest)(nil),   // 14: api.picture.v1.ListPictureVOByPageRequest
-	(*ListPictureVOByPageReply)(nil),     // 15: api.picture.v1.ListPictureVOByPageReply
-	(*GetPictureTagCategoryRequest)(nil), // 16: api.picture.v1.GetPictureTagCategoryRequest
-	(*GetPictureTagCategoryReply)(nil),   // 17: api.picture.v1.GetPictureTagCategoryReply
-	(*PictureVO)(nil),                    // 18: api.picture.v1.PictureVO
-	(*UserVO)(nil),                       // 19: api.picture.v1.UserVO
-	(*timestamppb.Timestamp)(nil),        // 20: google.protobuf.Timestamp
-}
-var file_picture_v1_picture_proto_depIdxs = []int32{
-	18, // 0: api.picture.v1.UploadPictureReply.picture:type_name -> api.picture.v1.PictureVO
-	18, // 1: api.picture.v1.GetPictureByIdReply.picture:type_name -> api.picture.v1.PictureVO
-	18, // 2: api.picture.v1.ListPictureByPageReply.list:type_name -> api.picture.v1.PictureVO
-	18, // 3: api.picture.v1.GetPictureVOByIdReply.picture:type_name -> api.picture.v1.PictureVO
-	18, // 4: api.picture.v1.ListPictureVOByPageReply.list:type_name -> api.picture.v1.PictureVO
-	20, // 5: api.picture.v1.PictureVO.create_time:type_name -> google.protobuf.Timestamp
-	20, // 6: api.picture.v1.PictureVO.edit_time:type_name -> google.protobuf.Timestamp
-	20, // 7: api.picture.v1.PictureVO.update_time:type_name -> google.protobuf.Timestamp
-	19, // 8: api.picture.v1.PictureVO.user:type_name -> api.picture.v1.UserVO
-	0,  // 9: api.picture.v1.Picture.UploadPicture:input_type -> api.picture.v1.UploadPictureRequest
-	2,  // 10: api.picture.v1.Picture.GetPictureById:input_type -> api.picture.v1.GetPictureByIdRequest
-	4,  // 11: api.picture.v1.Picture.ListPictureByPage:input_type -> api.picture.v1.ListPictureByPageRequest
-	6,  // 12: api.picture.v1.Picture.DeletePicture:input_type -> api.picture.v1.DeletePictureRequest
-	8,  // 13: api.picture.v1.Picture.UpdatePicture:input_type -> api.picture.v1.UpdatePictureRequest
-	10, // 14: api.picture.v1.Picture.EditPicture:input_type -> api.picture.v1.EditPictureRequest
-	12, // 15: api.picture.v1.Picture.GetPictureVOById:input_type -> api.picture.v1.GetPictureVOByIdRequest
-	14, // 16: api.picture.v1.Picture.ListPictureVOByPage:input_type -> api.picture.v1.ListPictureVOByPageRequest
-	16, // 17: api.picture.v1.Picture.GetPictureTagCategory:input_type -> api.picture.v1.GetPictureTagCategoryRequest
-	1,  // 18: api.picture.v1.Picture.UploadPicture:output_type -> api.picture.v1.UploadPictureReply
-	3,  // 19: api.picture.v1.Picture.GetPictureById:output_type -> api.picture.v1.GetPictureByIdReply
-	5,  // 20: api.picture.v1.Picture.ListPictureByPage:output_type -> api.picture.v1.ListPictureByPageReply
-	7,  // 21: api.picture.v1.Picture.DeletePicture:output_type -> api.picture.v1.DeletePictureReply
-	9,  // 22: api.picture.v1.Picture.UpdatePicture:output_type -> api.picture.v1.UpdatePictureReply
-	11, // 23: api.picture.v1.Picture.EditPicture:output_type -> api.picture.v1.EditPictureReply
-	13, // 24: api.picture.v1.Picture.GetPictureVOById:output_type -> api.picture.v1.GetPictureVOByIdReply
-	15, // 25: api.picture.v1.Picture.ListPictureVOByPage:output_type -> api.picture.v1.ListPictureVOByPageReply
-	17, // 26: api.picture.v1.Picture.GetPictureTagCategory:output_type -> api.picture.v1.GetPictureTagCategoryReply
-	18, // [18:27] is the sub-list for method output_type
-	9,  // [9:18] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
-}
-
-func init() { file_picture_v1_picture_proto_init() }
-func file_picture_v1_picture_proto_init() {
-	if File_picture_v1_picture_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_picture_v1_picture_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadPictureRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePictureReply.ProtoReflect.Descriptor instead.
+func (*LikePictureReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LikePictureReply) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+func (x *LikePictureReply) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+type FavoritePictureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`             // 图片 id
+	Favorite bool  `protobuf:"varint,2,opt,name=favorite,proto3" json:"favorite,omitempty"` // true 收藏，false 取消收藏
+}
+
+func (x *FavoritePictureRequest) Reset() {
+	*x = FavoritePictureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoritePictureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoritePictureRequest) ProtoMessage() {}
+
+func (x *FavoritePictureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoritePictureRequest.ProtoReflect.Descriptor instead.
+func (*FavoritePictureRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *FavoritePictureRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FavoritePictureRequest) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+type FavoritePictureReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Favorited     bool  `protobuf:"varint,1,opt,name=favorited,proto3" json:"favorited,omitempty"`                              // 当前是否已收藏
+	FavoriteCount int64 `protobuf:"varint,2,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"` // 收藏数
+}
+
+func (x *FavoritePictureReply) Reset() {
+	*x = FavoritePictureReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoritePictureReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoritePictureReply) ProtoMessage() {}
+
+func (x *FavoritePictureReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoritePictureReply.ProtoReflect.Descriptor instead.
+func (*FavoritePictureReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FavoritePictureReply) GetFavorited() bool {
+	if x != nil {
+		return x.Favorited
+	}
+	return false
+}
+
+func (x *FavoritePictureReply) GetFavoriteCount() int64 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+type ListMyFavoritePicturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Current  int64 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`                   // 当前页
+	PageSize int64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页大小
+}
+
+func (x *ListMyFavoritePicturesRequest) Reset() {
+	*x = ListMyFavoritePicturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyFavoritePicturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyFavoritePicturesRequest) ProtoMessage() {}
+
+func (x *ListMyFavoritePicturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyFavoritePicturesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyFavoritePicturesRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListMyFavoritePicturesRequest) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *ListMyFavoritePicturesRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListMyFavoritePicturesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64        `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	List  []*PictureVO `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`    // 列表（按收藏时间倒序）
+}
+
+func (x *ListMyFavoritePicturesReply) Reset() {
+	*x = ListMyFavoritePicturesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMyFavoritePicturesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyFavoritePicturesReply) ProtoMessage() {}
+
+func (x *ListMyFavoritePicturesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyFavoritePicturesReply.ProtoReflect.Descriptor instead.
+func (*ListMyFavoritePicturesReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListMyFavoritePicturesReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListMyFavoritePicturesReply) GetList() []*PictureVO {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type FindSimilarPicturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                      // 图片 id
+	MaxDistance int32 `protobuf:"varint,2,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"` // 最大汉明距离（1-32），不传时使用配置的查重阈值
+	Limit       int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                // 返回数量，默认 20，最多 50
+}
+
+func (x *FindSimilarPicturesRequest) Reset() {
+	*x = FindSimilarPicturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindSimilarPicturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarPicturesRequest) ProtoMessage() {}
+
+func (x *FindSimilarPicturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarPicturesRequest.ProtoReflect.Descriptor instead.
+func (*FindSimilarPicturesRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FindSimilarPicturesRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FindSimilarPicturesRequest) GetMaxDistance() int32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+func (x *FindSimilarPicturesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FindSimilarPicturesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*SimilarPictureVO `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"` // 相似图片（按距离从近到远）
+}
+
+func (x *FindSimilarPicturesReply) Reset() {
+	*x = FindSimilarPicturesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindSimilarPicturesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarPicturesReply) ProtoMessage() {}
+
+func (x *FindSimilarPicturesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarPicturesReply.ProtoReflect.Descriptor instead.
+func (*FindSimilarPicturesReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FindSimilarPicturesReply) GetList() []*SimilarPictureVO {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type ListDuplicateClustersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxDistance int32 `protobuf:"varint,1,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"` // 最大汉明距离（1-32），不传时使用配置的查重阈值
+	ScanLimit   int32 `protobuf:"varint,2,opt,name=scan_limit,json=scanLimit,proto3" json:"scan_limit,omitempty"`       // 扫描最近上传的图片数量，默认 2000，最多 10000
+}
+
+func (x *ListDuplicateClustersRequest) Reset() {
+	*x = ListDuplicateClustersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDuplicateClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateClustersRequest) ProtoMessage() {}
+
+func (x *ListDuplicateClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateClustersRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateClustersRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListDuplicateClustersRequest) GetMaxDistance() int32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+func (x *ListDuplicateClustersRequest) GetScanLimit() int32 {
+	if x != nil {
+		return x.ScanLimit
+	}
+	return 0
+}
+
+type ListDuplicateClustersReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clusters []*DuplicateCluster `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"` // 重复图片分组（按图片数量从多到少）
+	Scanned  int64               `protobuf:"varint,2,opt,name=scanned,proto3" json:"scanned,omitempty"`  // 实际扫描的图片数量
+}
+
+func (x *ListDuplicateClustersReply) Reset() {
+	*x = ListDuplicateClustersReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDuplicateClustersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateClustersReply) ProtoMessage() {}
+
+func (x *ListDuplicateClustersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateClustersReply.ProtoReflect.Descriptor instead.
+func (*ListDuplicateClustersReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListDuplicateClustersReply) GetClusters() []*DuplicateCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *ListDuplicateClustersReply) GetScanned() int64 {
+	if x != nil {
+		return x.Scanned
+	}
+	return 0
+}
+
+// SimilarPictureVO 相似图片
+type SimilarPictureVO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Picture  *PictureVO `protobuf:"bytes,1,opt,name=picture,proto3" json:"picture,omitempty"`
+	Distance int32      `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"` // 与目标图片感知哈希的汉明距离，越小越相似
+}
+
+func (x *SimilarPictureVO) Reset() {
+	*x = SimilarPictureVO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimilarPictureVO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarPictureVO) ProtoMessage() {}
+
+func (x *SimilarPictureVO) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarPictureVO.ProtoReflect.Descriptor instead.
+func (*SimilarPictureVO) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SimilarPictureVO) GetPicture() *PictureVO {
+	if x != nil {
+		return x.Picture
+	}
+	return nil
+}
+
+func (x *SimilarPictureVO) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+// DuplicateCluster 一组重复图片，与组内任意一张图片相似即归入该组
+type DuplicateCluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pictures []*PictureVO `protobuf:"bytes,1,rep,name=pictures,proto3" json:"pictures,omitempty"` // 组内图片（按 id 升序，第一张为最早上传的图片）
+}
+
+func (x *DuplicateCluster) Reset() {
+	*x = DuplicateCluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DuplicateCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCluster) ProtoMessage() {}
+
+func (x *DuplicateCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCluster.ProtoReflect.Descriptor instead.
+func (*DuplicateCluster) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DuplicateCluster) GetPictures() []*PictureVO {
+	if x != nil {
+		return x.Pictures
+	}
+	return nil
+}
+
+// PictureVO 图片视图对象
+type PictureVO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                                         // id
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                                                                                        // 图片 url
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                                                                      // 图片名称
+	Introduction  string                 `protobuf:"bytes,4,opt,name=introduction,proto3" json:"introduction,omitempty"`                                                                                      // 简介
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                                                                                                      // 标签
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`                                                                                              // 分类
+	PicSize       int64                  `protobuf:"varint,7,opt,name=pic_size,json=picSize,proto3" json:"pic_size,omitempty"`                                                                                // 文件体积
+	PicWidth      int32                  `protobuf:"varint,8,opt,name=pic_width,json=picWidth,proto3" json:"pic_width,omitempty"`                                                                             // 图片宽度
+	PicHeight     int32                  `protobuf:"varint,9,opt,name=pic_height,json=picHeight,proto3" json:"pic_height,omitempty"`                                                                          // 图片高度
+	PicScale      float64                `protobuf:"fixed64,10,opt,name=pic_scale,json=picScale,proto3" json:"pic_scale,omitempty"`                                                                           // 图片比例
+	PicFormat     string                 `protobuf:"bytes,11,opt,name=pic_format,json=picFormat,proto3" json:"pic_format,omitempty"`                                                                          // 图片格式
+	UserId        int64                  `protobuf:"varint,12,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                                                  // 用户 id
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                                                                       // 创建时间
+	EditTime      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=edit_time,json=editTime,proto3" json:"edit_time,omitempty"`                                                                             // 编辑时间
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`                                                                       // 更新时间
+	User          *UserVO                `protobuf:"bytes,16,opt,name=user,proto3" json:"user,omitempty"`                                                                                                     // 创建用户信息
+	LikeCount     int64                  `protobuf:"varint,17,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`                                                                         // 点赞数
+	FavoriteCount int64                  `protobuf:"varint,18,opt,name=favorite_count,json=favoriteCount,proto3" json:"favorite_count,omitempty"`                                                             // 收藏数
+	ViewCount     int64                  `protobuf:"varint,19,opt,name=view_count,json=viewCount,proto3" json:"view_count,omitempty"`                                                                         // 浏览数
+	Liked         bool                   `protobuf:"varint,20,opt,name=liked,proto3" json:"liked,omitempty"`                                                                                                  // 当前登录用户是否已点赞
+	Favorited     bool                   `protobuf:"varint,21,opt,name=favorited,proto3" json:"favorited,omitempty"`                                                                                          // 当前登录用户是否已收藏
+	CommentCount  int64                  `protobuf:"varint,22,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`                                                                // 评论数（仅统计审核通过的评论）
+	PicColor      string                 `protobuf:"bytes,23,opt,name=pic_color,json=picColor,proto3" json:"pic_color,omitempty"`                                                                             // 主色调（#RRGGBB）
+	PicPalette    []string               `protobuf:"bytes,24,rep,name=pic_palette,json=picPalette,proto3" json:"pic_palette,omitempty"`                                                                       // 调色板（按占比从高到低）
+	Metadata      *PictureMetadata       `protobuf:"bytes,25,opt,name=metadata,proto3" json:"metadata,omitempty"`                                                                                             // 拍摄信息（来自 EXIF）
+	SearchScore   float64                `protobuf:"fixed64,26,opt,name=search_score,json=searchScore,proto3" json:"search_score,omitempty"`                                                                  // 按搜索词查询时的相关度
+	Highlights    map[string]string      `protobuf:"bytes,27,rep,name=highlights,proto3" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 按搜索词查询时命中字段的高亮片段（name、introduction、category、tags、userName），匹配部分以 <em> 标记
+	SpaceId       int64                  `protobuf:"varint,28,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`                                                                               // 所属空间 id，0 表示公共图库
+	ReviewStatus  int32                  `protobuf:"varint,29,opt,name=review_status,json=reviewStatus,proto3" json:"review_status,omitempty"`                                                                // 审核状态：0-待审核，1-通过，2-拒绝，未通过审核的图片只对作者和管理员可见
+	ReviewMessage string                 `protobuf:"bytes,30,opt,name=review_message,json=reviewMessage,proto3" json:"review_message,omitempty"`                                                              // 审核信息
+}
+
+func (x *PictureVO) Reset() {
+	*x = PictureVO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PictureVO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PictureVO) ProtoMessage() {}
+
+func (x *PictureVO) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PictureVO.ProtoReflect.Descriptor instead.
+func (*PictureVO) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PictureVO) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PictureVO) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PictureVO) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PictureVO) GetIntroduction() string {
+	if x != nil {
+		return x.Introduction
+	}
+	return ""
+}
+
+func (x *PictureVO) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PictureVO) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PictureVO) GetPicSize() int64 {
+	if x != nil {
+		return x.PicSize
+	}
+	return 0
+}
+
+func (x *PictureVO) GetPicWidth() int32 {
+	if x != nil {
+		return x.PicWidth
+	}
+	return 0
+}
+
+func (x *PictureVO) GetPicHeight() int32 {
+	if x != nil {
+		return x.PicHeight
+	}
+	return 0
+}
+
+func (x *PictureVO) GetPicScale() float64 {
+	if x != nil {
+		return x.PicScale
+	}
+	return 0
+}
+
+func (x *PictureVO) GetPicFormat() string {
+	if x != nil {
+		return x.PicFormat
+	}
+	return ""
+}
+
+func (x *PictureVO) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PictureVO) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *PictureVO) GetEditTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditTime
+	}
+	return nil
+}
+
+func (x *PictureVO) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *PictureVO) GetUser() *UserVO {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *PictureVO) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
+	}
+	return 0
+}
+
+func (x *PictureVO) GetFavoriteCount() int64 {
+	if x != nil {
+		return x.FavoriteCount
+	}
+	return 0
+}
+
+func (x *PictureVO) GetViewCount() int64 {
+	if x != nil {
+		return x.ViewCount
+	}
+	return 0
+}
+
+func (x *PictureVO) GetLiked() bool {
+	if x != nil {
+		return x.Liked
+	}
+	return false
+}
+
+func (x *PictureVO) GetFavorited() bool {
+	if x != nil {
+		return x.Favorited
+	}
+	return false
+}
+
+func (x *PictureVO) GetCommentCount() int64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *PictureVO) GetPicColor() string {
+	if x != nil {
+		return x.PicColor
+	}
+	return ""
+}
+
+func (x *PictureVO) GetPicPalette() []string {
+	if x != nil {
+		return x.PicPalette
+	}
+	return nil
+}
+
+func (x *PictureVO) GetMetadata() *PictureMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PictureVO) GetSearchScore() float64 {
+	if x != nil {
+		return x.SearchScore
+	}
+	return 0
+}
+
+func (x *PictureVO) GetHighlights() map[string]string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *PictureVO) GetSpaceId() int64 {
+	if x != nil {
+		return x.SpaceId
+	}
+	return 0
+}
+
+func (x *PictureVO) GetReviewStatus() int32 {
+	if x != nil {
+		return x.ReviewStatus
+	}
+	return 0
+}
+
+func (x *PictureVO) GetReviewMessage() string {
+	if x != nil {
+		return x.ReviewMessage
+	}
+	return ""
+}
+
+// PictureMetadata 图片拍摄信息
+type PictureMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CameraMake   string                 `protobuf:"bytes,1,opt,name=camera_make,json=cameraMake,proto3" json:"camera_make,omitempty"`       // 相机厂商
+	CameraModel  string                 `protobuf:"bytes,2,opt,name=camera_model,json=cameraModel,proto3" json:"camera_model,omitempty"`    // 相机型号
+	LensModel    string                 `protobuf:"bytes,3,opt,name=lens_model,json=lensModel,proto3" json:"lens_model,omitempty"`          // 镜头型号
+	ShootTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=shoot_time,json=shootTime,proto3" json:"shoot_time,omitempty"`          // 拍摄时间
+	Orientation  int32                  `protobuf:"varint,5,opt,name=orientation,proto3" json:"orientation,omitempty"`                      // EXIF 方向（1-8），宽高已按方向校正
+	FNumber      float64                `protobuf:"fixed64,6,opt,name=f_number,json=fNumber,proto3" json:"f_number,omitempty"`              // 光圈值
+	ExposureTime string                 `protobuf:"bytes,7,opt,name=exposure_time,json=exposureTime,proto3" json:"exposure_time,omitempty"` // 曝光时间（如 1/125）
+	Iso          int32                  `protobuf:"varint,8,opt,name=iso,proto3" json:"iso,omitempty"`                                      // 感光度
+	FocalLength  float64                `protobuf:"fixed64,9,opt,name=focal_length,json=focalLength,proto3" json:"focal_length,omitempty"`  // 焦距（毫米）
+	Gps          *GPSLocation           `protobuf:"bytes,10,opt,name=gps,proto3" json:"gps,omitempty"`                                      // 拍摄位置（仅作者选择保留位置信息时返回）
+}
+
+func (x *PictureMetadata) Reset() {
+	*x = PictureMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PictureMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PictureMetadata) ProtoMessage() {}
+
+func (x *PictureMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PictureMetadata.ProtoReflect.Descriptor instead.
+func (*PictureMetadata) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PictureMetadata) GetCameraMake() string {
+	if x != nil {
+		return x.CameraMake
+	}
+	return ""
+}
+
+func (x *PictureMetadata) GetCameraModel() string {
+	if x != nil {
+		return x.CameraModel
+	}
+	return ""
+}
+
+func (x *PictureMetadata) GetLensModel() string {
+	if x != nil {
+		return x.LensModel
+	}
+	return ""
+}
+
+func (x *PictureMetadata) GetShootTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShootTime
+	}
+	return nil
+}
+
+func (x *PictureMetadata) GetOrientation() int32 {
+	if x != nil {
+		return x.Orientation
+	}
+	return 0
+}
+
+func (x *PictureMetadata) GetFNumber() float64 {
+	if x != nil {
+		return x.FNumber
+	}
+	return 0
+}
+
+func (x *PictureMetadata) GetExposureTime() string {
+	if x != nil {
+		return x.ExposureTime
+	}
+	return ""
+}
+
+func (x *PictureMetadata) GetIso() int32 {
+	if x != nil {
+		return x.Iso
+	}
+	return 0
+}
+
+func (x *PictureMetadata) GetFocalLength() float64 {
+	if x != nil {
+		return x.FocalLength
+	}
+	return 0
+}
+
+func (x *PictureMetadata) GetGps() *GPSLocation {
+	if x != nil {
+		return x.Gps
+	}
+	return nil
+}
+
+// GPSLocation 拍摄位置
+type GPSLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`   // 纬度
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"` // 经度
+	Altitude  float64 `protobuf:"fixed64,3,opt,name=altitude,proto3" json:"altitude,omitempty"`   // 海拔（米）
+}
+
+func (x *GPSLocation) Reset() {
+	*x = GPSLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GPSLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPSLocation) ProtoMessage() {}
+
+func (x *GPSLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPSLocation.ProtoReflect.Descriptor instead.
+func (*GPSLocation) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GPSLocation) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GPSLocation) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GPSLocation) GetAltitude() float64 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+// UserVO 用户视图对象（简化版）
+type UserVO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAccount string `protobuf:"bytes,2,opt,name=user_account,json=userAccount,proto3" json:"user_account,omitempty"`
+	UserName    string `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserAvatar  string `protobuf:"bytes,4,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
+	UserProfile string `protobuf:"bytes,5,opt,name=user_profile,json=userProfile,proto3" json:"user_profile,omitempty"`
+	UserRole    string `protobuf:"bytes,6,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`
+}
+
+func (x *UserVO) Reset() {
+	*x = UserVO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserVO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserVO) ProtoMessage() {}
+
+func (x *UserVO) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserVO.ProtoReflect.Descriptor instead.
+func (*UserVO) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UserVO) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserVO) GetUserAccount() string {
+	if x != nil {
+		return x.UserAccount
+	}
+	return ""
+}
+
+func (x *UserVO) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UserVO) GetUserAvatar() string {
+	if x != nil {
+		return x.UserAvatar
+	}
+	return ""
+}
+
+func (x *UserVO) GetUserProfile() string {
+	if x != nil {
+		return x.UserProfile
+	}
+	return ""
+}
+
+func (x *UserVO) GetUserRole() string {
+	if x != nil {
+		return x.UserRole
+	}
+	return ""
+}
+
+// BatchPictureResult 单张图片的操作结果
+type BatchPictureResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`   // 失败原因（错误码，如 PICTURE_NOT_FOUND、PICTURE_NO_AUTH）
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // 失败说明
+}
+
+func (x *BatchPictureResult) Reset() {
+	*x = BatchPictureResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchPictureResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPictureResult) ProtoMessage() {}
+
+func (x *BatchPictureResult) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPictureResult.ProtoReflect.Descriptor instead.
+func (*BatchPictureResult) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BatchPictureResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchPictureResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchPictureResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BatchPictureResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BatchEditPicturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids           []int64  `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`                                   // 图片 id，最多 200 个
+	Category      string   `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`                                 // 新分类，需为已有分类或其别名，为空时不修改
+	ClearCategory bool     `protobuf:"varint,3,opt,name=clear_category,json=clearCategory,proto3" json:"clear_category,omitempty"` // 清空分类（忽略 category）
+	TagMode       string   `protobuf:"bytes,4,opt,name=tag_mode,json=tagMode,proto3" json:"tag_mode,omitempty"`                    // 标签修改方式：add 添加、remove 移除、replace 替换，为空时不修改标签
+	Tags          []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                                         // 标签，别名会归一为对应的标签，修改后每张图片最多 10 个
+}
+
+func (x *BatchEditPicturesRequest) Reset() {
+	*x = BatchEditPicturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchEditPicturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchEditPicturesRequest) ProtoMessage() {}
+
+func (x *BatchEditPicturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchEditPicturesRequest.ProtoReflect.Descriptor instead.
+func (*BatchEditPicturesRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BatchEditPicturesRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchEditPicturesRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BatchEditPicturesRequest) GetClearCategory() bool {
+	if x != nil {
+		return x.ClearCategory
+	}
+	return false
+}
+
+func (x *BatchEditPicturesRequest) GetTagMode() string {
+	if x != nil {
+		return x.TagMode
+	}
+	return ""
+}
+
+func (x *BatchEditPicturesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type BatchEditPicturesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SuccessCount int32                 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount int32                 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	Results      []*BatchPictureResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchEditPicturesReply) Reset() {
+	*x = BatchEditPicturesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchEditPicturesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchEditPicturesReply) ProtoMessage() {}
+
+func (x *BatchEditPicturesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchEditPicturesReply.ProtoReflect.Descriptor instead.
+func (*BatchEditPicturesReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BatchEditPicturesReply) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BatchEditPicturesReply) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *BatchEditPicturesReply) GetResults() []*BatchPictureResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchRenamePicturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids        []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`                          // 图片 id，最多 200 个，按顺序编号
+	Pattern    string  `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`                          // 名称模板，如 "Trip-{index}"，{index} 为序号，{name} 为原名称
+	StartIndex int32   `protobuf:"varint,3,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"` // 起始序号，默认 1
+}
+
+func (x *BatchRenamePicturesRequest) Reset() {
+	*x = BatchRenamePicturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchRenamePicturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRenamePicturesRequest) ProtoMessage() {}
+
+func (x *BatchRenamePicturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRenamePicturesRequest.ProtoReflect.Descriptor instead.
+func (*BatchRenamePicturesRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BatchRenamePicturesRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchRenamePicturesRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *BatchRenamePicturesRequest) GetStartIndex() int32 {
+	if x != nil {
+		return x.StartIndex
+	}
+	return 0
+}
+
+type BatchRenamePicturesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SuccessCount int32                 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount int32                 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	Results      []*BatchPictureResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchRenamePicturesReply) Reset() {
+	*x = BatchRenamePicturesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchRenamePicturesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRenamePicturesReply) ProtoMessage() {}
+
+func (x *BatchRenamePicturesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRenamePicturesReply.ProtoReflect.Descriptor instead.
+func (*BatchRenamePicturesReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BatchRenamePicturesReply) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BatchRenamePicturesReply) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *BatchRenamePicturesReply) GetResults() []*BatchPictureResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchDeletePicturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"` // 图片 id，最多 200 个
+}
+
+func (x *BatchDeletePicturesRequest) Reset() {
+	*x = BatchDeletePicturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeletePicturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeletePicturesRequest) ProtoMessage() {}
+
+func (x *BatchDeletePicturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeletePicturesRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeletePicturesRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BatchDeletePicturesRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchDeletePicturesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SuccessCount int32                 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount int32                 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	Results      []*BatchPictureResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchDeletePicturesReply) Reset() {
+	*x = BatchDeletePicturesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeletePicturesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeletePicturesReply) ProtoMessage() {}
+
+func (x *BatchDeletePicturesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeletePicturesReply.ProtoReflect.Descriptor instead.
+func (*BatchDeletePicturesReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BatchDeletePicturesReply) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BatchDeletePicturesReply) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *BatchDeletePicturesReply) GetResults() []*BatchPictureResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchMovePicturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids         []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`                               // 图片 id，最多 200 个
+	AlbumId     int64   `protobuf:"varint,2,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`               // 目标相册（需为自己创建的相册）
+	FromAlbumId int64   `protobuf:"varint,3,opt,name=from_album_id,json=fromAlbumId,proto3" json:"from_album_id,omitempty"` // 原相册，不为 0 时同时从原相册移除
+}
+
+func (x *BatchMovePicturesRequest) Reset() {
+	*x = BatchMovePicturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchMovePicturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMovePicturesRequest) ProtoMessage() {}
+
+func (x *BatchMovePicturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMovePicturesRequest.ProtoReflect.Descriptor instead.
+func (*BatchMovePicturesRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BatchMovePicturesRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchMovePicturesRequest) GetAlbumId() int64 {
+	if x != nil {
+		return x.AlbumId
+	}
+	return 0
+}
+
+func (x *BatchMovePicturesRequest) GetFromAlbumId() int64 {
+	if x != nil {
+		return x.FromAlbumId
+	}
+	return 0
+}
+
+type BatchMovePicturesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SuccessCount int32                 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount int32                 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	Results      []*BatchPictureResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchMovePicturesReply) Reset() {
+	*x = BatchMovePicturesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchMovePicturesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMovePicturesReply) ProtoMessage() {}
+
+func (x *BatchMovePicturesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMovePicturesReply.ProtoReflect.Descriptor instead.
+func (*BatchMovePicturesReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BatchMovePicturesReply) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BatchMovePicturesReply) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *BatchMovePicturesReply) GetResults() []*BatchPictureResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchMovePicturesToSpaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids     []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`                 // 图片 id，最多 200 个
+	SpaceId int64   `protobuf:"varint,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"` // 目标空间（需为图片作者创建的空间），0 表示移回公共图库
+}
+
+func (x *BatchMovePicturesToSpaceRequest) Reset() {
+	*x = BatchMovePicturesToSpaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchMovePicturesToSpaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMovePicturesToSpaceRequest) ProtoMessage() {}
+
+func (x *BatchMovePicturesToSpaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMovePicturesToSpaceRequest.ProtoReflect.Descriptor instead.
+func (*BatchMovePicturesToSpaceRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchMovePicturesToSpaceRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchMovePicturesToSpaceRequest) GetSpaceId() int64 {
+	if x != nil {
+		return x.SpaceId
+	}
+	return 0
+}
+
+type BatchMovePicturesToSpaceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SuccessCount int32                 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount int32                 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	Results      []*BatchPictureResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchMovePicturesToSpaceReply) Reset() {
+	*x = BatchMovePicturesToSpaceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchMovePicturesToSpaceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchMovePicturesToSpaceReply) ProtoMessage() {}
+
+func (x *BatchMovePicturesToSpaceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchMovePicturesToSpaceReply.ProtoReflect.Descriptor instead.
+func (*BatchMovePicturesToSpaceReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BatchMovePicturesToSpaceReply) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BatchMovePicturesToSpaceReply) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *BatchMovePicturesToSpaceReply) GetResults() []*BatchPictureResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchReviewPicturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids           []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`                                  // 图片 id，最多 200 个
+	ReviewStatus  int32   `protobuf:"varint,2,opt,name=review_status,json=reviewStatus,proto3" json:"review_status,omitempty"`   // 审核状态：1-通过，2-拒绝
+	ReviewMessage string  `protobuf:"bytes,3,opt,name=review_message,json=reviewMessage,proto3" json:"review_message,omitempty"` // 审核信息
+}
+
+func (x *BatchReviewPicturesRequest) Reset() {
+	*x = BatchReviewPicturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReviewPicturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReviewPicturesRequest) ProtoMessage() {}
+
+func (x *BatchReviewPicturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReviewPicturesRequest.ProtoReflect.Descriptor instead.
+func (*BatchReviewPicturesRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BatchReviewPicturesRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchReviewPicturesRequest) GetReviewStatus() int32 {
+	if x != nil {
+		return x.ReviewStatus
+	}
+	return 0
+}
+
+func (x *BatchReviewPicturesRequest) GetReviewMessage() string {
+	if x != nil {
+		return x.ReviewMessage
+	}
+	return ""
+}
+
+type BatchReviewPicturesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SuccessCount int32                 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount int32                 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	Results      []*BatchPictureResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchReviewPicturesReply) Reset() {
+	*x = BatchReviewPicturesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReviewPicturesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReviewPicturesReply) ProtoMessage() {}
+
+func (x *BatchReviewPicturesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReviewPicturesReply.ProtoReflect.Descriptor instead.
+func (*BatchReviewPicturesReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BatchReviewPicturesReply) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BatchReviewPicturesReply) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *BatchReviewPicturesReply) GetResults() []*BatchPictureResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// PictureFieldChange 字段修改
+type PictureFieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`                       // 字段：url、name、introduction、category、tags、picSize、picWidth、picHeight、picFormat
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // 修改前的值（tags 为 JSON 数组）
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` // 修改后的值
+}
+
+func (x *PictureFieldChange) Reset() {
+	*x = PictureFieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PictureFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PictureFieldChange) ProtoMessage() {}
+
+func (x *PictureFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PictureFieldChange.ProtoReflect.Descriptor instead.
+func (*PictureFieldChange) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PictureFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *PictureFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *PictureFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// PictureVersionVO 历史版本，图片信息为本次修改前的状态
+type PictureVersionVO struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PictureId    int64                  `protobuf:"varint,2,opt,name=picture_id,json=pictureId,proto3" json:"picture_id,omitempty"`
+	Version      int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`                         // 版本号，同一图片内递增
+	Operation    string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`                      // 修改方式：upload 重新上传、update 更新、edit 编辑、batch_edit 批量修改、batch_rename 批量重命名、restore 回滚、image_edit 编辑图片文件
+	OperatorId   int64                  `protobuf:"varint,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 修改人
+	Operator     *UserVO                `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+	Url          string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"` // 修改前的图片地址
+	Name         string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	Introduction string                 `protobuf:"bytes,9,opt,name=introduction,proto3" json:"introduction,omitempty"`
+	Category     string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	Tags         []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	PicSize      int64                  `protobuf:"varint,12,opt,name=pic_size,json=picSize,proto3" json:"pic_size,omitempty"`
+	PicWidth     int32                  `protobuf:"varint,13,opt,name=pic_width,json=picWidth,proto3" json:"pic_width,omitempty"`
+	PicHeight    int32                  `protobuf:"varint,14,opt,name=pic_height,json=picHeight,proto3" json:"pic_height,omitempty"`
+	PicFormat    string                 `protobuf:"bytes,15,opt,name=pic_format,json=picFormat,proto3" json:"pic_format,omitempty"`
+	Changes      []*PictureFieldChange  `protobuf:"bytes,16,rep,name=changes,proto3" json:"changes,omitempty"`                         // 本次修改的字段
+	CreateTime   *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"` // 修改时间
+}
+
+func (x *PictureVersionVO) Reset() {
+	*x = PictureVersionVO{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PictureVersionVO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PictureVersionVO) ProtoMessage() {}
+
+func (x *PictureVersionVO) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PictureVersionVO.ProtoReflect.Descriptor instead.
+func (*PictureVersionVO) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PictureVersionVO) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PictureVersionVO) GetPictureId() int64 {
+	if x != nil {
+		return x.PictureId
+	}
+	return 0
+}
+
+func (x *PictureVersionVO) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PictureVersionVO) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *PictureVersionVO) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *PictureVersionVO) GetOperator() *UserVO {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+func (x *PictureVersionVO) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PictureVersionVO) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PictureVersionVO) GetIntroduction() string {
+	if x != nil {
+		return x.Introduction
+	}
+	return ""
+}
+
+func (x *PictureVersionVO) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PictureVersionVO) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PictureVersionVO) GetPicSize() int64 {
+	if x != nil {
+		return x.PicSize
+	}
+	return 0
+}
+
+func (x *PictureVersionVO) GetPicWidth() int32 {
+	if x != nil {
+		return x.PicWidth
+	}
+	return 0
+}
+
+func (x *PictureVersionVO) GetPicHeight() int32 {
+	if x != nil {
+		return x.PicHeight
+	}
+	return 0
+}
+
+func (x *PictureVersionVO) GetPicFormat() string {
+	if x != nil {
+		return x.PicFormat
+	}
+	return ""
+}
+
+func (x *PictureVersionVO) GetChanges() []*PictureFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *PictureVersionVO) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListPictureVersionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PictureId int64 `protobuf:"varint,1,opt,name=picture_id,json=pictureId,proto3" json:"picture_id,omitempty"`
+	Current   int64 `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	PageSize  int64 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 最大 50
+}
+
+func (x *ListPictureVersionsRequest) Reset() {
+	*x = ListPictureVersionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPictureVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPictureVersionsRequest) ProtoMessage() {}
+
+func (x *ListPictureVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPictureVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPictureVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListPictureVersionsRequest) GetPictureId() int64 {
+	if x != nil {
+		return x.PictureId
+	}
+	return 0
+}
+
+func (x *ListPictureVersionsRequest) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *ListPictureVersionsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPictureVersionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total int64               `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	List  []*PictureVersionVO `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *ListPictureVersionsReply) Reset() {
+	*x = ListPictureVersionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPictureVersionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPictureVersionsReply) ProtoMessage() {}
+
+func (x *ListPictureVersionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPictureVersionsReply.ProtoReflect.Descriptor instead.
+func (*ListPictureVersionsReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListPictureVersionsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListPictureVersionsReply) GetList() []*PictureVersionVO {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type RestorePictureVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PictureId int64 `protobuf:"varint,1,opt,name=picture_id,json=pictureId,proto3" json:"picture_id,omitempty"`
+	VersionId int64 `protobuf:"varint,2,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"` // 历史版本 id，图片恢复为该版本记录的修改前的状态
+}
+
+func (x *RestorePictureVersionRequest) Reset() {
+	*x = RestorePictureVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestorePictureVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePictureVersionRequest) ProtoMessage() {}
+
+func (x *RestorePictureVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePictureVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestorePictureVersionRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RestorePictureVersionRequest) GetPictureId() int64 {
+	if x != nil {
+		return x.PictureId
+	}
+	return 0
+}
+
+func (x *RestorePictureVersionRequest) GetVersionId() int64 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
+}
+
+type RestorePictureVersionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Picture *PictureVO `protobuf:"bytes,1,opt,name=picture,proto3" json:"picture,omitempty"`
+}
+
+func (x *RestorePictureVersionReply) Reset() {
+	*x = RestorePictureVersionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestorePictureVersionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePictureVersionReply) ProtoMessage() {}
+
+func (x *RestorePictureVersionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePictureVersionReply.ProtoReflect.Descriptor instead.
+func (*RestorePictureVersionReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RestorePictureVersionReply) GetPicture() *PictureVO {
+	if x != nil {
+		return x.Picture
+	}
+	return nil
+}
+
+// ImageOperation 单个编辑操作，坐标和尺寸以上一步操作的结果为准（原图先按 EXIF 方向校正）
+type ImageOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type               string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                                                          // 操作类型：crop 裁剪、rotate 旋转、flip 翻转、resize 缩放、text_watermark 文字水印、image_watermark 图片水印
+	X                  int32   `protobuf:"varint,2,opt,name=x,proto3" json:"x,omitempty"`                                                               // crop：裁剪区域左上角横坐标
+	Y                  int32   `protobuf:"varint,3,opt,name=y,proto3" json:"y,omitempty"`                                                               // crop：裁剪区域左上角纵坐标
+	Width              int32   `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`                                                       // crop：裁剪宽度；resize：目标宽度，为 0 时按高度等比缩放
+	Height             int32   `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`                                                     // crop：裁剪高度；resize：目标高度，为 0 时按宽度等比缩放
+	Angle              int32   `protobuf:"varint,6,opt,name=angle,proto3" json:"angle,omitempty"`                                                       // rotate：顺时针旋转角度，90、180 或 270
+	Direction          string  `protobuf:"bytes,7,opt,name=direction,proto3" json:"direction,omitempty"`                                                // flip：horizontal 水平翻转、vertical 垂直翻转
+	Text               string  `protobuf:"bytes,8,opt,name=text,proto3" json:"text,omitempty"`                                                          // text_watermark：水印文字，仅支持 ASCII 可打印字符，最多 64 个
+	WatermarkPictureId int64   `protobuf:"varint,9,opt,name=watermark_picture_id,json=watermarkPictureId,proto3" json:"watermark_picture_id,omitempty"` // image_watermark：作为水印的图片（需为自己的图片）
+	Position           string  `protobuf:"bytes,10,opt,name=position,proto3" json:"position,omitempty"`                                                 // 水印位置：top_left、top、top_right、left、center、right、bottom_left、bottom、bottom_right（默认）
+	Opacity            float64 `protobuf:"fixed64,11,opt,name=opacity,proto3" json:"opacity,omitempty"`                                                 // 水印不透明度（0, 1]，默认 0.5
+	Scale              float64 `protobuf:"fixed64,12,opt,name=scale,proto3" json:"scale,omitempty"`                                                     // 文字水印：文字高度占图片短边的比例，默认 0.05；图片水印：水印宽度占图片宽度的比例，默认 0.2
+	Color              string  `protobuf:"bytes,13,opt,name=color,proto3" json:"color,omitempty"`                                                       // 文字水印颜色（#RRGGBB），默认 #FFFFFF
+}
+
+func (x *ImageOperation) Reset() {
+	*x = ImageOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageOperation) ProtoMessage() {}
+
+func (x *ImageOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageOperation.ProtoReflect.Descriptor instead.
+func (*ImageOperation) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ImageOperation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ImageOperation) GetX() int32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ImageOperation) GetY() int32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ImageOperation) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ImageOperation) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ImageOperation) GetAngle() int32 {
+	if x != nil {
+		return x.Angle
+	}
+	return 0
+}
+
+func (x *ImageOperation) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ImageOperation) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ImageOperation) GetWatermarkPictureId() int64 {
+	if x != nil {
+		return x.WatermarkPictureId
+	}
+	return 0
+}
+
+func (x *ImageOperation) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *ImageOperation) GetOpacity() float64 {
+	if x != nil {
+		return x.Opacity
+	}
+	return 0
+}
+
+func (x *ImageOperation) GetScale() float64 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *ImageOperation) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type EditPictureImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operations []*ImageOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"` // 编辑操作，按顺序执行，最多 20 个
+	Format     string            `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`         // 输出格式：jpeg、png，为空时与原图相同（GIF 原图输出为 PNG），需为图片所在存储桶允许的格式
+}
+
+func (x *EditPictureImageRequest) Reset() {
+	*x = EditPictureImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPictureImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPictureImageRequest) ProtoMessage() {}
+
+func (x *EditPictureImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPictureImageRequest.ProtoReflect.Descriptor instead.
+func (*EditPictureImageRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *EditPictureImageRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EditPictureImageRequest) GetOperations() []*ImageOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *EditPictureImageRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type EditPictureImageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Picture *PictureVO `protobuf:"bytes,1,opt,name=picture,proto3" json:"picture,omitempty"`
+}
+
+func (x *EditPictureImageReply) Reset() {
+	*x = EditPictureImageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPictureImageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPictureImageReply) ProtoMessage() {}
+
+func (x *EditPictureImageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPictureImageReply.ProtoReflect.Descriptor instead.
+func (*EditPictureImageReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *EditPictureImageReply) GetPicture() *PictureVO {
+	if x != nil {
+		return x.Picture
+	}
+	return nil
+}
+
+type GetImageTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetImageTokenRequest) Reset() {
+	*x = GetImageTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetImageTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImageTokenRequest) ProtoMessage() {}
+
+func (x *GetImageTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImageTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetImageTokenRequest) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{63}
+}
+
+type GetImageTokenReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token      string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                             // 只能用于 GET /img/{id}?token= 的短期令牌
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"` // 令牌过期时间
+}
+
+func (x *GetImageTokenReply) Reset() {
+	*x = GetImageTokenReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_picture_v1_picture_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetImageTokenReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImageTokenReply) ProtoMessage() {}
+
+func (x *GetImageTokenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_picture_v1_picture_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImageTokenReply.ProtoReflect.Descriptor instead.
+func (*GetImageTokenReply) Descriptor() ([]byte, []int) {
+	return file_picture_v1_picture_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetImageTokenReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetImageTokenReply) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+var File_picture_v1_picture_proto protoreflect.FileDescriptor
+
+var file_picture_v1_picture_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x02, 0x0a, 0x14, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x70, 0x69, 0x63, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x70, 0x69, 0x63, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x63, 0x5f, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x69, 0x63, 0x57,
+	0x69, 0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x69, 0x63, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x63, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0x96, 0x01,
+	0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f,
+	0x52, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x73, 0x69, 0x6d,
+	0x69, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x0f, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x4a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x56, 0x4f, 0x52, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x83, 0x03, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74,
+	0x68, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0x7e, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e,
+	0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x2e, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x12, 0x45,
+	0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x10, 0x45, 0x64, 0x69,
+	0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x4c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x56, 0x4f, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x70,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0xbb, 0x05, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x56, 0x4f, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e,
+	0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x63, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x63, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x10, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x63, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69,
+	0x74, 0x68, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x77, 0x69, 0x74, 0x68, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb7,
+	0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f,
+	0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x35, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x52,
+	0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x0a, 0x46, 0x61, 0x63, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x0d, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x46, 0x61,
+	0x63, 0x65, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x34, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x22, 0x3b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x54, 0x61, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x67, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x61, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xdc, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x54, 0x61,
+	0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x61, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x74, 0x61, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09,
+	0x74, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0e,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x67,
+	0x0a, 0x12, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x45, 0x0a, 0x10, 0x53, 0x75, 0x67, 0x67, 0x65,
+	0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x67, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x7d,
+	0x0a, 0x0d, 0x54, 0x61, 0x67, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x45, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x79, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22,
+	0x38, 0x0a, 0x12, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x6b, 0x65, 0x22, 0x47, 0x0a, 0x10, 0x4c, 0x69, 0x6b,
+	0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69,
+	0x6b, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x44, 0x0a, 0x16, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x50, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x22, 0x5b, 0x0a, 0x14, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x46,
+	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x62, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x50,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x22, 0x65, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x50, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64,
+	0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x56, 0x4f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x1c, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x73, 0x63, 0x61, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x74, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x08, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x08,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x22, 0x63, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x56, 0x4f, 0x52, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x49, 0x0a, 0x10, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x70,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x08, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x22, 0xed, 0x08, 0x0a, 0x09, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e,
+	0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x69,
+	0x63, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x69,
+	0x63, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x63, 0x5f, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x69, 0x63, 0x57, 0x69, 0x64,
+	0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x69, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x63, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x69, 0x63, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x69, 0x63, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x63, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x4f, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6b, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x64, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x69, 0x63, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x69, 0x63, 0x5f, 0x70, 0x61, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x18,
+	0x18, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x69, 0x63, 0x50, 0x61, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x12, 0x49, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18,
+	0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f,
+	0x2e, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xf5, 0x02, 0x0a, 0x0f, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61,
+	0x5f, 0x6d, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d,
+	0x65, 0x72, 0x61, 0x4d, 0x61, 0x6b, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6d, 0x65, 0x72,
+	0x61, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x61, 0x6d, 0x65, 0x72, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x65,
+	0x6e, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x65, 0x6e, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x68, 0x6f,
+	0x6f, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x6f, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x75,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x73, 0x6f, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x69, 0x73, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x66, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x03, 0x67,
+	0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x50, 0x53, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x67, 0x70, 0x73, 0x22, 0x63, 0x0a, 0x0b, 0x47, 0x50,
+	0x53, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22,
+	0xb9, 0x01, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x56, 0x4f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x70, 0x0a, 0x12, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9e, 0x01,
+	0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x61, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x61, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xa0,
+	0x01, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x69, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xa2, 0x01, 0x0a,
+	0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x2e, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x22, 0xa2, 0x01, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x6f, 0x76, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12,
+	0x22, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x62, 0x75,
+	0x6d, 0x49, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x76,
+	0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x1f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x6f, 0x76, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x54, 0x6f, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0xa7, 0x01, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4d, 0x6f, 0x76, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x54, 0x6f, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0x7a, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa2, 0x01, 0x0a,
+	0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x64, 0x0a, 0x12, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65,
+	0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb9, 0x04, 0x0a, 0x10, 0x50, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x4f, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x4f, 0x52, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x69, 0x63, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x69, 0x63, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x69, 0x63, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x69, 0x63, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69,
+	0x63, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x69, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63,
+	0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x69, 0x63, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x66, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x4f, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22,
+	0x5c, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x51, 0x0a,
+	0x1a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x70,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0xca, 0x02, 0x0a, 0x0e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x61, 0x74,
+	0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61,
+	0x72, 0x6b, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x61, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6f, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x81, 0x01,
+	0x0a, 0x17, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x22, 0x4c, 0x0a, 0x15, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x52, 0x07, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x32, 0xf4, 0x1b, 0x0a, 0x07, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x79, 0x0a, 0x0d,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x24, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a,
+	0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x7b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x49, 0x64,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x88, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x79, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x79, 0x0a, 0x0d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a,
+	0x22, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x71, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x12, 0x7f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x42, 0x79, 0x49, 0x64, 0x12, 0x27, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x56, 0x4f, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x2f, 0x76, 0x6f, 0x12, 0x91, 0x01, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x42, 0x79, 0x50, 0x61, 0x67,
+	0x65, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f,
+	0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x4f, 0x42, 0x79, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a,
+	0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x6f, 0x12, 0x94, 0x01,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x54, 0x61, 0x67, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x54, 0x61, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x54, 0x61, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x74, 0x61, 0x67, 0x5f, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x75, 0x0a, 0x0b, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f,
+	0x74, 0x61, 0x67, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x12,
+	0x71, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x22,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x6b, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22,
+	0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x6c, 0x69,
+	0x6b, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x0f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x50,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22,
+	0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x79, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x2f,
+	0x6c, 0x69, 0x73, 0x74, 0x2f, 0x70, 0x61, 0x67, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x13, 0x46, 0x69,
+	0x6e, 0x64, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x53, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12,
+	0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x73, 0x69,
+	0x6d, 0x69, 0x6c, 0x61, 0x72, 0x12, 0x9d, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x2c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x2f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x64, 0x69, 0x74,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x65, 0x64, 0x69,
+	0x74, 0x12, 0x91, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x72,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2a, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x11, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x4d, 0x6f, 0x76, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x9f, 0x01, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x6f, 0x76, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x54, 0x6f, 0x53, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x54, 0x6f, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x54, 0x6f, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x2f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a,
+	0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x96, 0x01, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2f,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
+	0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x12, 0x86, 0x01, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01,
+	0x2a, 0x22, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x12, 0x7b, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x2f, 0x5a, 0x2d, 0x73, 0x6d, 0x61, 0x72, 0x74,
+	0x2d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x2d, 0x67, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x79, 0x2d,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x69, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_picture_v1_picture_proto_rawDescOnce sync.Once
+	file_picture_v1_picture_proto_rawDescData = file_picture_v1_picture_proto_rawDesc
+)
+
+func file_picture_v1_picture_proto_rawDescGZIP() []byte {
+	file_picture_v1_picture_proto_rawDescOnce.Do(func() {
+		file_picture_v1_picture_proto_rawDescData = protoimpl.X.CompressGZIP(file_picture_v1_picture_proto_rawDescData)
+	})
+	return file_picture_v1_picture_proto_rawDescData
+}
+
+var file_picture_v1_picture_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_picture_v1_picture_proto_goTypes = []interface{}{
+	(*UploadPictureRequest)(nil),            // 0: api.picture.v1.UploadPictureRequest
+	(*UploadPictureReply)(nil),              // 1: api.picture.v1.UploadPictureReply
+	(*GetPictureByIdRequest)(nil),           // 2: api.picture.v1.GetPictureByIdRequest
+	(*GetPictureByIdReply)(nil),             // 3: api.picture.v1.GetPictureByIdReply
+	(*ListPictureByPageRequest)(nil),        // 4: api.picture.v1.ListPictureByPageRequest
+	(*ListPictureByPageReply)(nil),          // 5: api.picture.v1.ListPictureByPageReply
+	(*DeletePictureRequest)(nil),            // 6: api.picture.v1.DeletePictureRequest
+	(*DeletePictureReply)(nil),              // 7: api.picture.v1.DeletePictureReply
+	(*UpdatePictureRequest)(nil),            // 8: api.picture.v1.UpdatePictureRequest
+	(*UpdatePictureReply)(nil),              // 9: api.picture.v1.UpdatePictureReply
+	(*EditPictureRequest)(nil),              // 10: api.picture.v1.EditPictureRequest
+	(*EditPictureReply)(nil),                // 11: api.picture.v1.EditPictureReply
+	(*GetPictureVOByIdRequest)(nil),         // 12: api.picture.v1.GetPictureVOByIdRequest
+	(*GetPictureVOByIdReply)(nil),           // 13: api.picture.v1.GetPictureVOByIdReply
+	(*ListPictureVOByPageRequest)(nil),      // 14: api.picture.v1.ListPictureVOByPageRequest
+	(*ListPictureVOByPageReply)(nil),        // 15: api.picture.v1.ListPictureVOByPageReply
+	(*FacetCount)(nil),                      // 16: api.picture.v1.FacetCount
+	(*PictureFacets)(nil),                   // 17: api.picture.v1.PictureFacets
+	(*GetPictureTagCategoryRequest)(nil),    // 18: api.picture.v1.GetPictureTagCategoryRequest
+	(*GetPictureTagCategoryReply)(nil),      // 19: api.picture.v1.GetPictureTagCategoryReply
+	(*SuggestTagsRequest)(nil),              // 20: api.picture.v1.SuggestTagsRequest
+	(*SuggestTagsReply)(nil),                // 21: api.picture.v1.SuggestTagsReply
+	(*TagSuggestion)(nil),                   // 22: api.picture.v1.TagSuggestion
+	(*GetFeedRequest)(nil),                  // 23: api.picture.v1.GetFeedRequest
+	(*GetFeedReply)(nil),                    // 24: api.picture.v1.GetFeedReply
+	(*LikePictureRequest)(nil),              // 25: api.picture.v1.LikePictureRequest
+	(*LikePictureReply)(nil),                // 26: api.picture.v1.LikePictureReply
+	(*FavoritePictureRequest)(nil),          // 27: api.picture.v1.FavoritePictureRequest
+	(*FavoritePictureReply)(nil),            // 28: api.picture.v1.FavoritePictureReply
+	(*ListMyFavoritePicturesRequest)(nil),   // 29: api.picture.v1.ListMyFavoritePicturesRequest
+	(*ListMyFavoritePicturesReply)(nil),     // 30: api.picture.v1.ListMyFavoritePicturesReply
+	(*FindSimilarPicturesRequest)(nil),      // 31: api.picture.v1.FindSimilarPicturesRequest
+	(*FindSimilarPicturesReply)(nil),        // 32: api.picture.v1.FindSimilarPicturesReply
+	(*ListDuplicateClustersRequest)(nil),    // 33: api.picture.v1.ListDuplicateClustersRequest
+	(*ListDuplicateClustersReply)(nil),      // 34: api.picture.v1.ListDuplicateClustersReply
+	(*SimilarPictureVO)(nil),                // 35: api.picture.v1.SimilarPictureVO
+	(*DuplicateCluster)(nil),                // 36: api.picture.v1.DuplicateCluster
+	(*PictureVO)(nil),                       // 37: api.picture.v1.PictureVO
+	(*PictureMetadata)(nil),                 // 38: api.picture.v1.PictureMetadata
+	(*GPSLocation)(nil),                     // 39: api.picture.v1.GPSLocation
+	(*UserVO)(nil),                          // 40: api.picture.v1.UserVO
+	(*BatchPictureResult)(nil),              // 41: api.picture.v1.BatchPictureResult
+	(*BatchEditPicturesRequest)(nil),        // 42: api.picture.v1.BatchEditPicturesRequest
+	(*BatchEditPicturesReply)(nil),          // 43: api.picture.v1.BatchEditPicturesReply
+	(*BatchRenamePicturesRequest)(nil),      // 44: api.picture.v1.BatchRenamePicturesRequest
+	(*BatchRenamePicturesReply)(nil),        // 45: api.picture.v1.BatchRenamePicturesReply
+	(*BatchDeletePicturesRequest)(nil),      // 46: api.picture.v1.BatchDeletePicturesRequest
+	(*BatchDeletePicturesReply)(nil),        // 47: api.picture.v1.BatchDeletePicturesReply
+	(*BatchMovePicturesRequest)(nil),        // 48: api.picture.v1.BatchMovePicturesRequest
+	(*BatchMovePicturesReply)(nil),          // 49: api.picture.v1.BatchMovePicturesReply
+	(*BatchMovePicturesToSpaceRequest)(nil), // 50: api.picture.v1.BatchMovePicturesToSpaceRequest
+	(*BatchMovePicturesToSpaceReply)(nil),   // 51: api.picture.v1.BatchMovePicturesToSpaceReply
+	(*BatchReviewPicturesRequest)(nil),      // 52: api.picture.v1.BatchReviewPicturesRequest
+	(*BatchReviewPicturesReply)(nil),        // 53: api.picture.v1.BatchReviewPicturesReply
+	(*PictureFieldChange)(nil),              // 54: api.picture.v1.PictureFieldChange
+	(*PictureVersionVO)(nil),                // 55: api.picture.v1.PictureVersionVO
+	(*ListPictureVersionsRequest)(nil),      // 56: api.picture.v1.ListPictureVersionsRequest
+	(*ListPictureVersionsReply)(nil),        // 57: api.picture.v1.ListPictureVersionsReply
+	(*RestorePictureVersionRequest)(nil),    // 58: api.picture.v1.RestorePictureVersionRequest
+	(*RestorePictureVersionReply)(nil),      // 59: api.picture.v1.RestorePictureVersionReply
+	(*ImageOperation)(nil),                  // 60: api.picture.v1.ImageOperation
+	(*EditPictureImageRequest)(nil),         // 61: api.picture.v1.EditPictureImageRequest
+	(*EditPictureImageReply)(nil),           // 62: api.picture.v1.EditPictureImageReply
+	(*GetImageTokenRequest)(nil),            // 63: api.picture.v1.GetImageTokenRequest
+	(*GetImageTokenReply)(nil),              // 64: api.picture.v1.GetImageTokenReply
+	nil,                                     // 65: api.picture.v1.PictureVO.HighlightsEntry
+	(*timestamppb.Timestamp)(nil),           // 66: google.protobuf.Timestamp
+}
+var file_picture_v1_picture_proto_depIdxs = []int32{
+	37, // 0: api.picture.v1.UploadPictureReply.picture:type_name -> api.picture.v1.PictureVO
+	35, // 1: api.picture.v1.UploadPictureReply.similar_pictures:type_name -> api.picture.v1.SimilarPictureVO
+	37, // 2: api.picture.v1.GetPictureByIdReply.picture:type_name -> api.picture.v1.PictureVO
+	37, // 3: api.picture.v1.ListPictureByPageReply.list:type_name -> api.picture.v1.PictureVO
+	37, // 4: api.picture.v1.GetPictureVOByIdReply.picture:type_name -> api.picture.v1.PictureVO
+	37, // 5: api.picture.v1.ListPictureVOByPageReply.list:type_name -> api.picture.v1.PictureVO
+	17, // 6: api.picture.v1.ListPictureVOByPageReply.facets:type_name -> api.picture.v1.PictureFacets
+	16, // 7: api.picture.v1.PictureFacets.categories:type_name -> api.picture.v1.FacetCount
+	16, // 8: api.picture.v1.PictureFacets.tags:type_name -> api.picture.v1.FacetCount
+	16, // 9: api.picture.v1.PictureFacets.formats:type_name -> api.picture.v1.FacetCount
+	16, // 10: api.picture.v1.PictureFacets.size_ranges:type_name -> api.picture.v1.FacetCount
+	16, // 11: api.picture.v1.PictureFacets.scale_ranges:type_name -> api.picture.v1.FacetCount
+	16, // 12: api.picture.v1.GetPictureTagCategoryReply.tag_counts:type_name -> api.picture.v1.FacetCount
+	16, // 13: api.picture.v1.GetPictureTagCategoryReply.category_counts:type_name -> api.picture.v1.FacetCount
+	22, // 14: api.picture.v1.SuggestTagsReply.list:type_name -> api.picture.v1.TagSuggestion
+	37, // 15: api.picture.v1.GetFeedReply.list:type_name -> api.picture.v1.PictureVO
+	37, // 16: api.picture.v1.ListMyFavoritePicturesReply.list:type_name -> api.picture.v1.PictureVO
+	35, // 17: api.picture.v1.FindSimilarPicturesReply.list:type_name -> api.picture.v1.SimilarPictureVO
+	36, // 18: api.picture.v1.ListDuplicateClustersReply.clusters:type_name -> api.picture.v1.DuplicateCluster
+	37, // 19: api.picture.v1.SimilarPictureVO.picture:type_name -> api.picture.v1.PictureVO
+	37, // 20: api.picture.v1.DuplicateCluster.pictures:type_name -> api.picture.v1.PictureVO
+	66, // 21: api.picture.v1.PictureVO.create_time:type_name -> google.protobuf.Timestamp
+	66, // 22: api.picture.v1.PictureVO.edit_time:type_name -> google.protobuf.Timestamp
+	66, // 23: api.picture.v1.PictureVO.update_time:type_name -> google.protobuf.Timestamp
+	40, // 24: api.picture.v1.PictureVO.user:type_name -> api.picture.v1.UserVO
+	38, // 25: api.picture.v1.PictureVO.metadata:type_name -> api.picture.v1.PictureMetadata
+	65, // 26: api.picture.v1.PictureVO.highlights:type_name -> api.picture.v1.PictureVO.HighlightsEntry
+	66, // 27: api.picture.v1.PictureMetadata.shoot_time:type_name -> google.protobuf.Timestamp
+	39, // 28: api.picture.v1.PictureMetadata.gps:type_name -> api.picture.v1.GPSLocation
+	41, // 29: api.picture.v1.BatchEditPicturesReply.results:type_name -> api.picture.v1.BatchPictureResult
+	41, // 30: api.picture.v1.BatchRenamePicturesReply.results:type_name -> api.picture.v1.BatchPictureResult
+	41, // 31: api.picture.v1.BatchDeletePicturesReply.results:type_name -> api.picture.v1.BatchPictureResult
+	41, // 32: api.picture.v1.BatchMovePicturesReply.results:type_name -> api.picture.v1.BatchPictureResult
+	41, // 33: api.picture.v1.BatchMovePicturesToSpaceReply.results:type_name -> api.picture.v1.BatchPictureResult
+	41, // 34: api.picture.v1.BatchReviewPicturesReply.results:type_name -> api.picture.v1.BatchPictureResult
+	40, // 35: api.picture.v1.PictureVersionVO.operator:type_name -> api.picture.v1.UserVO
+	54, // 36: api.picture.v1.PictureVersionVO.changes:type_name -> api.picture.v1.PictureFieldChange
+	66, // 37: api.picture.v1.PictureVersionVO.create_time:type_name -> google.protobuf.Timestamp
+	55, // 38: api.picture.v1.ListPictureVersionsReply.list:type_name -> api.picture.v1.PictureVersionVO
+	37, // 39: api.picture.v1.RestorePictureVersionReply.picture:type_name -> api.picture.v1.PictureVO
+	60, // 40: api.picture.v1.EditPictureImageRequest.operations:type_name -> api.picture.v1.ImageOperation
+	37, // 41: api.picture.v1.EditPictureImageReply.picture:type_name -> api.picture.v1.PictureVO
+	66, // 42: api.picture.v1.GetImageTokenReply.expire_time:type_name -> google.protobuf.Timestamp
+	0,  // 43: api.picture.v1.Picture.UploadPicture:input_type -> api.picture.v1.UploadPictureRequest
+	2,  // 44: api.picture.v1.Picture.GetPictureById:input_type -> api.picture.v1.GetPictureByIdRequest
+	4,  // 45: api.picture.v1.Picture.ListPictureByPage:input_type -> api.picture.v1.ListPictureByPageRequest
+	6,  // 46: api.picture.v1.Picture.DeletePicture:input_type -> api.picture.v1.DeletePictureRequest
+	8,  // 47: api.picture.v1.Picture.UpdatePicture:input_type -> api.picture.v1.UpdatePictureRequest
+	10, // 48: api.picture.v1.Picture.EditPicture:input_type -> api.picture.v1.EditPictureRequest
+	12, // 49: api.picture.v1.Picture.GetPictureVOById:input_type -> api.picture.v1.GetPictureVOByIdRequest
+	14, // 50: api.picture.v1.Picture.ListPictureVOByPage:input_type -> api.picture.v1.ListPictureVOByPageRequest
+	18, // 51: api.picture.v1.Picture.GetPictureTagCategory:input_type -> api.picture.v1.GetPictureTagCategoryRequest
+	20, // 52: api.picture.v1.Picture.SuggestTags:input_type -> api.picture.v1.SuggestTagsRequest
+	23, // 53: api.picture.v1.Picture.GetFeed:input_type -> api.picture.v1.GetFeedRequest
+	25, // 54: api.picture.v1.Picture.LikePicture:input_type -> api.picture.v1.LikePictureRequest
+	27, // 55: api.picture.v1.Picture.FavoritePicture:input_type -> api.picture.v1.FavoritePictureRequest
+	29, // 56: api.picture.v1.Picture.ListMyFavoritePictures:input_type -> api.picture.v1.ListMyFavoritePicturesRequest
+	31, // 57: api.picture.v1.Picture.FindSimilarPictures:input_type -> api.picture.v1.FindSimilarPicturesRequest
+	33, // 58: api.picture.v1.Picture.ListDuplicateClusters:input_type -> api.picture.v1.ListDuplicateClustersRequest
+	42, // 59: api.picture.v1.Picture.BatchEditPictures:input_type -> api.picture.v1.BatchEditPicturesRequest
+	44, // 60: api.picture.v1.Picture.BatchRenamePictures:input_type -> api.picture.v1.BatchRenamePicturesRequest
+	46, // 61: api.picture.v1.Picture.BatchDeletePictures:input_type -> api.picture.v1.BatchDeletePicturesRequest
+	48, // 62: api.picture.v1.Picture.BatchMovePictures:input_type -> api.picture.v1.BatchMovePicturesRequest
+	50, // 63: api.picture.v1.Picture.BatchMovePicturesToSpace:input_type -> api.picture.v1.BatchMovePicturesToSpaceRequest
+	52, // 64: api.picture.v1.Picture.BatchReviewPictures:input_type -> api.picture.v1.BatchReviewPicturesRequest
+	56, // 65: api.picture.v1.Picture.ListPictureVersions:input_type -> api.picture.v1.ListPictureVersionsRequest
+	58, // 66: api.picture.v1.Picture.RestorePictureVersion:input_type -> api.picture.v1.RestorePictureVersionRequest
+	61, // 67: api.picture.v1.Picture.EditPictureImage:input_type -> api.picture.v1.EditPictureImageRequest
+	63, // 68: api.picture.v1.Picture.GetImageToken:input_type -> api.picture.v1.GetImageTokenRequest
+	1,  // 69: api.picture.v1.Picture.UploadPicture:output_type -> api.picture.v1.UploadPictureReply
+	3,  // 70: api.picture.v1.Picture.GetPictureById:output_type -> api.picture.v1.GetPictureByIdReply
+	5,  // 71: api.picture.v1.Picture.ListPictureByPage:output_type -> api.picture.v1.ListPictureByPageReply
+	7,  // 72: api.picture.v1.Picture.DeletePicture:output_type -> api.picture.v1.DeletePictureReply
+	9,  // 73: api.picture.v1.Picture.UpdatePicture:output_type -> api.picture.v1.UpdatePictureReply
+	11, // 74: api.picture.v1.Picture.EditPicture:output_type -> api.picture.v1.EditPictureReply
+	13, // 75: api.picture.v1.Picture.GetPictureVOById:output_type -> api.picture.v1.GetPictureVOByIdReply
+	15, // 76: api.picture.v1.Picture.ListPictureVOByPage:output_type -> api.picture.v1.ListPictureVOByPageReply
+	19, // 77: api.picture.v1.Picture.GetPictureTagCategory:output_type -> api.picture.v1.GetPictureTagCategoryReply
+	21, // 78: api.picture.v1.Picture.SuggestTags:output_type -> api.picture.v1.SuggestTagsReply
+	24, // 79: api.picture.v1.Picture.GetFeed:output_type -> api.picture.v1.GetFeedReply
+	26, // 80: api.picture.v1.Picture.LikePicture:output_type -> api.picture.v1.LikePictureReply
+	28, // 81: api.picture.v1.Picture.FavoritePicture:output_type -> api.picture.v1.FavoritePictureReply
+	30, // 82: api.picture.v1.Picture.ListMyFavoritePictures:output_type -> api.picture.v1.ListMyFavoritePicturesReply
+	32, // 83: api.picture.v1.Picture.FindSimilarPictures:output_type -> api.picture.v1.FindSimilarPicturesReply
+	34, // 84: api.picture.v1.Picture.ListDuplicateClusters:output_type -> api.picture.v1.ListDuplicateClustersReply
+	43, // 85: api.picture.v1.Picture.BatchEditPictures:output_type -> api.picture.v1.BatchEditPicturesReply
+	45, // 86: api.picture.v1.Picture.BatchRenamePictures:output_type -> api.picture.v1.BatchRenamePicturesReply
+	47, // 87: api.picture.v1.Picture.BatchDeletePictures:output_type -> api.picture.v1.BatchDeletePicturesReply
+	49, // 88: api.picture.v1.Picture.BatchMovePictures:output_type -> api.picture.v1.BatchMovePicturesReply
+	51, // 89: api.picture.v1.Picture.BatchMovePicturesToSpace:output_type -> api.picture.v1.BatchMovePicturesToSpaceReply
+	53, // 90: api.picture.v1.Picture.BatchReviewPictures:output_type -> api.picture.v1.BatchReviewPicturesReply
+	57, // 91: api.picture.v1.Picture.ListPictureVersions:output_type -> api.picture.v1.ListPictureVersionsReply
+	59, // 92: api.picture.v1.Picture.RestorePictureVersion:output_type -> api.picture.v1.RestorePictureVersionReply
+	62, // 93: api.picture.v1.Picture.EditPictureImage:output_type -> api.picture.v1.EditPictureImageReply
+	64, // 94: api.picture.v1.Picture.GetImageToken:output_type -> api.picture.v1.GetImageTokenReply
+	69, // [69:95] is the sub-list for method output_type
+	43, // [43:69] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
+}
+
+func init() { file_picture_v1_picture_proto_init() }
+func file_picture_v1_picture_proto_init() {
+	if File_picture_v1_picture_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_picture_v1_picture_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadPictureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadPictureReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPictureByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPictureByIdReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPictureByPageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPictureByPageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePictureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePictureReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePictureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePictureReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditPictureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditPictureReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPictureVOByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPictureVOByIdReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPictureVOByPageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPictureVOByPageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FacetCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PictureFacets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPictureTagCategoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPictureTagCategoryReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestTagsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagSuggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeedReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikePictureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LikePictureReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FavoritePictureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FavoritePictureReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyFavoritePicturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyFavoritePicturesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindSimilarPicturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindSimilarPicturesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDuplicateClustersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDuplicateClustersReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimilarPictureVO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateCluster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PictureVO); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PictureMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1759,8 +6143,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadPictureReply); i {
+		file_picture_v1_picture_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GPSLocation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1771,8 +6155,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPictureByIdRequest); i {
+		file_picture_v1_picture_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserVO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1783,8 +6167,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPictureByIdReply); i {
+		file_picture_v1_picture_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchPictureResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1795,8 +6179,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPictureByPageRequest); i {
+		file_picture_v1_picture_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchEditPicturesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1807,8 +6191,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPictureByPageReply); i {
+		file_picture_v1_picture_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchEditPicturesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1819,8 +6203,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePictureRequest); i {
+		file_picture_v1_picture_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRenamePicturesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1831,8 +6215,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeletePictureReply); i {
+		file_picture_v1_picture_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRenamePicturesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1843,8 +6227,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePictureRequest); i {
+		file_picture_v1_picture_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeletePicturesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1855,8 +6239,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdatePictureReply); i {
+		file_picture_v1_picture_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeletePicturesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1867,8 +6251,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditPictureRequest); i {
+		file_picture_v1_picture_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchMovePicturesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1879,8 +6263,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditPictureReply); i {
+		file_picture_v1_picture_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchMovePicturesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1891,8 +6275,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPictureVOByIdRequest); i {
+		file_picture_v1_picture_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchMovePicturesToSpaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1903,8 +6287,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPictureVOByIdReply); i {
+		file_picture_v1_picture_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchMovePicturesToSpaceReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1915,8 +6299,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPictureVOByPageRequest); i {
+		file_picture_v1_picture_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReviewPicturesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1927,8 +6311,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPictureVOByPageReply); i {
+		file_picture_v1_picture_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReviewPicturesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1939,8 +6323,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPictureTagCategoryRequest); i {
+		file_picture_v1_picture_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PictureFieldChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1951,8 +6335,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPictureTagCategoryReply); i {
+		file_picture_v1_picture_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PictureVersionVO); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1963,8 +6347,8 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PictureVO); i {
+		file_picture_v1_picture_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPictureVersionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1975,8 +6359,92 @@ func file_picture_v1_picture_proto_init() {
 				return nil
 			}
 		}
-		file_picture_v1_picture_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserVO); i {
+		file_picture_v1_picture_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPictureVersionsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePictureVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePictureVersionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImageOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditPictureImageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditPictureImageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImageTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_picture_v1_picture_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImageTokenReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1994,7 +6462,7 @@ func file_picture_v1_picture_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_picture_v1_picture_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,15 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Picture_UploadPicture_FullMethodName         = "/api.picture.v1.Picture/UploadPicture"
-	Picture_GetPictureById_FullMethodName        = "/api.picture.v1.Picture/GetPictureById"
-	Picture_ListPictureByPage_FullMethodName     = "/api.picture.v1.Picture/ListPictureByPage"
-	Picture_DeletePicture_FullMethodName         = "/api.picture.v1.Picture/DeletePicture"
-	Picture_UpdatePicture_FullMethodName         = "/api.picture.v1.Picture/UpdatePicture"
-	Picture_EditPicture_FullMethodName           = "/api.picture.v1.Picture/EditPicture"
-	Picture_GetPictureVOById_FullMethodName      = "/api.picture.v1.Picture/GetPictureVOById"
-	Picture_ListPictureVOByPage_FullMethodName   = "/api.picture.v1.Picture/ListPictureVOByPage"
-	Picture_GetPictureTagCategory_FullMethodName = "/api.picture.v1.Picture/GetPictureTagCategory"
+	Picture_UploadPicture_FullMethodName            = "/api.picture.v1.Picture/UploadPicture"
+	Picture_GetPictureById_FullMethodName           = "/api.picture.v1.Picture/GetPictureById"
+	Picture_ListPictureByPage_FullMethodName        = "/api.picture.v1.Picture/ListPictureByPage"
+	Picture_DeletePicture_FullMethodName            = "/api.picture.v1.Picture/DeletePicture"
+	Picture_UpdatePicture_FullMethodName            = "/api.picture.v1.Picture/UpdatePicture"
+	Picture_EditPicture_FullMethodName              = "/api.picture.v1.Picture/EditPicture"
+	Picture_GetPictureVOById_FullMethodName         = "/api.picture.v1.Picture/GetPictureVOById"
+	Picture_ListPictureVOByPage_FullMethodName      = "/api.picture.v1.Picture/ListPictureVOByPage"
+	Picture_GetPictureTagCategory_FullMethodName    = "/api.picture.v1.Picture/GetPictureTagCategory"
+	Picture_SuggestTags_FullMethodName              = "/api.picture.v1.Picture/SuggestTags"
+	Picture_GetFeed_FullMethodName                  = "/api.picture.v1.Picture/GetFeed"
+	Picture_LikePicture_FullMethodName              = "/api.picture.v1.Picture/LikePicture"
+	Picture_FavoritePicture_FullMethodName          = "/api.picture.v1.Picture/FavoritePicture"
+	Picture_ListMyFavoritePictures_FullMethodName   = "/api.picture.v1.Picture/ListMyFavoritePictures"
+	Picture_FindSimilarPictures_FullMethodName      = "/api.picture.v1.Picture/FindSimilarPictures"
+	Picture_ListDuplicateClusters_FullMethodName    = "/api.picture.v1.Picture/ListDuplicateClusters"
+	Picture_BatchEditPictures_FullMethodName        = "/api.picture.v1.Picture/BatchEditPictures"
+	Picture_BatchRenamePictures_FullMethodName      = "/api.picture.v1.Picture/BatchRenamePictures"
+	Picture_BatchDeletePictures_FullMethodName      = "/api.picture.v1.Picture/BatchDeletePictures"
+	Picture_BatchMovePictures_FullMethodName        = "/api.picture.v1.Picture/BatchMovePictures"
+	Picture_BatchMovePicturesToSpace_FullMethodName = "/api.picture.v1.Picture/BatchMovePicturesToSpace"
+	Picture_BatchReviewPictures_FullMethodName      = "/api.picture.v1.Picture/BatchReviewPictures"
+	Picture_ListPictureVersions_FullMethodName      = "/api.picture.v1.Picture/ListPictureVersions"
+	Picture_RestorePictureVersion_FullMethodName    = "/api.picture.v1.Picture/RestorePictureVersion"
+	Picture_EditPictureImage_FullMethodName         = "/api.picture.v1.Picture/EditPictureImage"
+	Picture_GetImageToken_FullMethodName            = "/api.picture.v1.Picture/GetImageToken"
 )
 
 // PictureClient is the client API for Picture service.
@@ -52,6 +69,40 @@ type PictureClient interface {
 	ListPictureVOByPage(ctx context.Context, in *ListPictureVOByPageRequest, opts ...grpc.CallOption) (*ListPictureVOByPageReply, error)
 	// 获取标签和分类
 	GetPictureTagCategory(ctx context.Context, in *GetPictureTagCategoryRequest, opts ...grpc.CallOption) (*GetPictureTagCategoryReply, error)
+	// 标签输入建议：按前缀匹配标签名称和别名，结合全站使用次数、当前用户的使用次数和与已选标签的共现排序
+	SuggestTags(ctx context.Context, in *SuggestTagsRequest, opts ...grpc.CallOption) (*SuggestTagsReply, error)
+	// 获取关注动态（关注用户最近发布的图片）
+	GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedReply, error)
+	// 点赞/取消点赞（幂等，重复请求结果不变）
+	LikePicture(ctx context.Context, in *LikePictureRequest, opts ...grpc.CallOption) (*LikePictureReply, error)
+	// 收藏/取消收藏（幂等，重复请求结果不变）
+	FavoritePicture(ctx context.Context, in *FavoritePictureRequest, opts ...grpc.CallOption) (*FavoritePictureReply, error)
+	// 分页获取我的收藏
+	ListMyFavoritePictures(ctx context.Context, in *ListMyFavoritePicturesRequest, opts ...grpc.CallOption) (*ListMyFavoritePicturesReply, error)
+	// 查找相似图片（按感知哈希的汉明距离）
+	FindSimilarPictures(ctx context.Context, in *FindSimilarPicturesRequest, opts ...grpc.CallOption) (*FindSimilarPicturesReply, error)
+	// 重复图片分组报告（管理员）
+	ListDuplicateClusters(ctx context.Context, in *ListDuplicateClustersRequest, opts ...grpc.CallOption) (*ListDuplicateClustersReply, error)
+	// 批量修改分类和标签
+	BatchEditPictures(ctx context.Context, in *BatchEditPicturesRequest, opts ...grpc.CallOption) (*BatchEditPicturesReply, error)
+	// 按模板批量重命名
+	BatchRenamePictures(ctx context.Context, in *BatchRenamePicturesRequest, opts ...grpc.CallOption) (*BatchRenamePicturesReply, error)
+	// 批量删除
+	BatchDeletePictures(ctx context.Context, in *BatchDeletePicturesRequest, opts ...grpc.CallOption) (*BatchDeletePicturesReply, error)
+	// 批量移动到相册
+	BatchMovePictures(ctx context.Context, in *BatchMovePicturesRequest, opts ...grpc.CallOption) (*BatchMovePicturesReply, error)
+	// 批量移动到空间
+	BatchMovePicturesToSpace(ctx context.Context, in *BatchMovePicturesToSpaceRequest, opts ...grpc.CallOption) (*BatchMovePicturesToSpaceReply, error)
+	// 批量审核（管理员）
+	BatchReviewPictures(ctx context.Context, in *BatchReviewPicturesRequest, opts ...grpc.CallOption) (*BatchReviewPicturesReply, error)
+	// 分页查询图片的历史版本
+	ListPictureVersions(ctx context.Context, in *ListPictureVersionsRequest, opts ...grpc.CallOption) (*ListPictureVersionsReply, error)
+	// 回滚到历史版本
+	RestorePictureVersion(ctx context.Context, in *RestorePictureVersionRequest, opts ...grpc.CallOption) (*RestorePictureVersionReply, error)
+	// 编辑图片文件（裁剪、旋转、翻转、缩放、水印）
+	EditPictureImage(ctx context.Context, in *EditPictureImageRequest, opts ...grpc.CallOption) (*EditPictureImageReply, error)
+	// 获取访问图片缩放接口用的短期令牌
+	GetImageToken(ctx context.Context, in *GetImageTokenRequest, opts ...grpc.CallOption) (*GetImageTokenReply, error)
 }
 
 type pictureClient struct {
@@ -143,6 +194,159 @@ func (c *pictureClient) GetPictureTagCategory(ctx context.Context, in *GetPictur
 	return out, nil
 }
 
+func (c *pictureClient) SuggestTags(ctx context.Context, in *SuggestTagsRequest, opts ...grpc.CallOption) (*SuggestTagsReply, error) {
+	out := new(SuggestTagsReply)
+	err := c.cc.Invoke(ctx, Picture_SuggestTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedReply, error) {
+	out := new(GetFeedReply)
+	err := c.cc.Invoke(ctx, Picture_GetFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) LikePicture(ctx context.Context, in *LikePictureRequest, opts ...grpc.CallOption) (*LikePictureReply, error) {
+	out := new(LikePictureReply)
+	err := c.cc.Invoke(ctx, Picture_LikePicture_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) FavoritePicture(ctx context.Context, in *FavoritePictureRequest, opts ...grpc.CallOption) (*FavoritePictureReply, error) {
+	out := new(FavoritePictureReply)
+	err := c.cc.Invoke(ctx, Picture_FavoritePicture_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) ListMyFavoritePictures(ctx context.Context, in *ListMyFavoritePicturesRequest, opts ...grpc.CallOption) (*ListMyFavoritePicturesReply, error) {
+	out := new(ListMyFavoritePicturesReply)
+	err := c.cc.Invoke(ctx, Picture_ListMyFavoritePictures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) FindSimilarPictures(ctx context.Context, in *FindSimilarPicturesRequest, opts ...grpc.CallOption) (*FindSimilarPicturesReply, error) {
+	out := new(FindSimilarPicturesReply)
+	err := c.cc.Invoke(ctx, Picture_FindSimilarPictures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) ListDuplicateClusters(ctx context.Context, in *ListDuplicateClustersRequest, opts ...grpc.CallOption) (*ListDuplicateClustersReply, error) {
+	out := new(ListDuplicateClustersReply)
+	err := c.cc.Invoke(ctx, Picture_ListDuplicateClusters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) BatchEditPictures(ctx context.Context, in *BatchEditPicturesRequest, opts ...grpc.CallOption) (*BatchEditPicturesReply, error) {
+	out := new(BatchEditPicturesReply)
+	err := c.cc.Invoke(ctx, Picture_BatchEditPictures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) BatchRenamePictures(ctx context.Context, in *BatchRenamePicturesRequest, opts ...grpc.CallOption) (*BatchRenamePicturesReply, error) {
+	out := new(BatchRenamePicturesReply)
+	err := c.cc.Invoke(ctx, Picture_BatchRenamePictures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) BatchDeletePictures(ctx context.Context, in *BatchDeletePicturesRequest, opts ...grpc.CallOption) (*BatchDeletePicturesReply, error) {
+	out := new(BatchDeletePicturesReply)
+	err := c.cc.Invoke(ctx, Picture_BatchDeletePictures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) BatchMovePictures(ctx context.Context, in *BatchMovePicturesRequest, opts ...grpc.CallOption) (*BatchMovePicturesReply, error) {
+	out := new(BatchMovePicturesReply)
+	err := c.cc.Invoke(ctx, Picture_BatchMovePictures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) BatchMovePicturesToSpace(ctx context.Context, in *BatchMovePicturesToSpaceRequest, opts ...grpc.CallOption) (*BatchMovePicturesToSpaceReply, error) {
+	out := new(BatchMovePicturesToSpaceReply)
+	err := c.cc.Invoke(ctx, Picture_BatchMovePicturesToSpace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) BatchReviewPictures(ctx context.Context, in *BatchReviewPicturesRequest, opts ...grpc.CallOption) (*BatchReviewPicturesReply, error) {
+	out := new(BatchReviewPicturesReply)
+	err := c.cc.Invoke(ctx, Picture_BatchReviewPictures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) ListPictureVersions(ctx context.Context, in *ListPictureVersionsRequest, opts ...grpc.CallOption) (*ListPictureVersionsReply, error) {
+	out := new(ListPictureVersionsReply)
+	err := c.cc.Invoke(ctx, Picture_ListPictureVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) RestorePictureVersion(ctx context.Context, in *RestorePictureVersionRequest, opts ...grpc.CallOption) (*RestorePictureVersionReply, error) {
+	out := new(RestorePictureVersionReply)
+	err := c.cc.Invoke(ctx, Picture_RestorePictureVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) EditPictureImage(ctx context.Context, in *EditPictureImageRequest, opts ...grpc.CallOption) (*EditPictureImageReply, error) {
+	out := new(EditPictureImageReply)
+	err := c.cc.Invoke(ctx, Picture_EditPictureImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pictureClient) GetImageToken(ctx context.Context, in *GetImageTokenRequest, opts ...grpc.CallOption) (*GetImageTokenReply, error) {
+	out := new(GetImageTokenReply)
+	err := c.cc.Invoke(ctx, Picture_GetImageToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PictureServer is the server API for Picture service.
 // All implementations must embed UnimplementedPictureServer
 // for forward compatibility
@@ -165,6 +369,40 @@ type PictureServer interface {
 	ListPictureVOByPage(context.Context, *ListPictureVOByPageRequest) (*ListPictureVOByPageReply, error)
 	// 获取标签和分类
 	GetPictureTagCategory(context.Context, *GetPictureTagCategoryRequest) (*GetPictureTagCategoryReply, error)
+	// 标签输入建议：按前缀匹配标签名称和别名，结合全站使用次数、当前用户的使用次数和与已选标签的共现排序
+	SuggestTags(context.Context, *SuggestTagsRequest) (*SuggestTagsReply, error)
+	// 获取关注动态（关注用户最近发布的图片）
+	GetFeed(context.Context, *GetFeedRequest) (*GetFeedReply, error)
+	// 点赞/取消点赞（幂等，重复请求结果不变）
+	LikePicture(context.Context, *LikePictureRequest) (*LikePictureReply, error)
+	// 收藏/取消收藏（幂等，重复请求结果不变）
+	FavoritePicture(context.Context, *FavoritePictureRequest) (*FavoritePictureReply, error)
+	// 分页获取我的收藏
+	ListMyFavoritePictures(context.Context, *ListMyFavoritePicturesRequest) (*ListMyFavoritePicturesReply, error)
+	// 查找相似图片（按感知哈希的汉明距离）
+	FindSimilarPictures(context.Context, *FindSimilarPicturesRequest) (*FindSimilarPicturesReply, error)
+	// 重复图片分组报告（管理员）
+	ListDuplicateClusters(context.Context, *ListDuplicateClustersRequest) (*ListDuplicateClustersReply, error)
+	// 批量修改分类和标签
+	BatchEditPictures(context.Context, *BatchEditPicturesRequest) (*BatchEditPicturesReply, error)
+	// 按模板批量重命名
+	BatchRenamePictures(context.Context, *BatchRenamePicturesRequest) (*BatchRenamePicturesReply, error)
+	// 批量删除
+	BatchDeletePictures(context.Context, *BatchDeletePicturesRequest) (*BatchDeletePicturesReply, error)
+	// 批量移动到相册
+	BatchMovePictures(context.Context, *BatchMovePicturesRequest) (*BatchMovePicturesReply, error)
+	// 批量移动到空间
+	BatchMovePicturesToSpace(context.Context, *BatchMovePicturesToSpaceRequest) (*BatchMovePicturesToSpaceReply, error)
+	// 批量审核（管理员）
+	BatchReviewPictures(context.Context, *BatchReviewPicturesRequest) (*BatchReviewPicturesReply, error)
+	// 分页查询图片的历史版本
+	ListPictureVersions(context.Context, *ListPictureVersionsRequest) (*ListPictureVersionsReply, error)
+	// 回滚到历史版本
+	RestorePictureVersion(context.Context, *RestorePictureVersionRequest) (*RestorePictureVersionReply, error)
+	// 编辑图片文件（裁剪、旋转、翻转、缩放、水印）
+	EditPictureImage(context.Context, *EditPictureImageRequest) (*EditPictureImageReply, error)
+	// 获取访问图片缩放接口用的短期令牌
+	GetImageToken(context.Context, *GetImageTokenRequest) (*GetImageTokenReply, error)
 	mustEmbedUnimplementedPictureServer()
 }
 
@@ -199,6 +437,57 @@ func (UnimplementedPictureServer) ListPictureVOByPage(context.Context, *ListPict
 func (UnimplementedPictureServer) GetPictureTagCategory(context.Context, *GetPictureTagCategoryRequest) (*GetPictureTagCategoryReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPictureTagCategory not implemented")
 }
+func (UnimplementedPictureServer) SuggestTags(context.Context, *SuggestTagsRequest) (*SuggestTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestTags not implemented")
+}
+func (UnimplementedPictureServer) GetFeed(context.Context, *GetFeedRequest) (*GetFeedReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
+func (UnimplementedPictureServer) LikePicture(context.Context, *LikePictureRequest) (*LikePictureReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikePicture not implemented")
+}
+func (UnimplementedPictureServer) FavoritePicture(context.Context, *FavoritePictureRequest) (*FavoritePictureReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FavoritePicture not implemented")
+}
+func (UnimplementedPictureServer) ListMyFavoritePictures(context.Context, *ListMyFavoritePicturesRequest) (*ListMyFavoritePicturesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyFavoritePictures not implemented")
+}
+func (UnimplementedPictureServer) FindSimilarPictures(context.Context, *FindSimilarPicturesRequest) (*FindSimilarPicturesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSimilarPictures not implemented")
+}
+func (UnimplementedPictureServer) ListDuplicateClusters(context.Context, *ListDuplicateClustersRequest) (*ListDuplicateClustersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDuplicateClusters not implemented")
+}
+func (UnimplementedPictureServer) BatchEditPictures(context.Context, *BatchEditPicturesRequest) (*BatchEditPicturesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchEditPictures not implemented")
+}
+func (UnimplementedPictureServer) BatchRenamePictures(context.Context, *BatchRenamePicturesRequest) (*BatchRenamePicturesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRenamePictures not implemented")
+}
+func (UnimplementedPictureServer) BatchDeletePictures(context.Context, *BatchDeletePicturesRequest) (*BatchDeletePicturesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeletePictures not implemented")
+}
+func (UnimplementedPictureServer) BatchMovePictures(context.Context, *BatchMovePicturesRequest) (*BatchMovePicturesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMovePictures not implemented")
+}
+func (UnimplementedPictureServer) BatchMovePicturesToSpace(context.Context, *BatchMovePicturesToSpaceRequest) (*BatchMovePicturesToSpaceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMovePicturesToSpace not implemented")
+}
+func (UnimplementedPictureServer) BatchReviewPictures(context.Context, *BatchReviewPicturesRequest) (*BatchReviewPicturesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchReviewPictures not implemented")
+}
+func (UnimplementedPictureServer) ListPictureVersions(context.Context, *ListPictureVersionsRequest) (*ListPictureVersionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPictureVersions not implemented")
+}
+func (UnimplementedPictureServer) RestorePictureVersion(context.Context, *RestorePictureVersionRequest) (*RestorePictureVersionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePictureVersion not implemented")
+}
+func (UnimplementedPictureServer) EditPictureImage(context.Context, *EditPictureImageRequest) (*EditPictureImageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPictureImage not implemented")
+}
+func (UnimplementedPictureServer) GetImageToken(context.Context, *GetImageTokenRequest) (*GetImageTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImageToken not implemented")
+}
 func (UnimplementedPictureServer) mustEmbedUnimplementedPictureServer() {}
 
 // UnsafePictureServer may be embedded to opt out of forward compatibility for this service.
@@ -374,6 +663,312 @@ func _Picture_GetPictureTagCategory_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Picture_SuggestTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).SuggestTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_SuggestTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).SuggestTags(ctx, req.(*SuggestTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_GetFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).GetFeed(ctx, req.(*GetFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_LikePicture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikePictureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).LikePicture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_LikePicture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).LikePicture(ctx, req.(*LikePictureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_FavoritePicture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoritePictureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).FavoritePicture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_FavoritePicture_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).FavoritePicture(ctx, req.(*FavoritePictureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_ListMyFavoritePictures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyFavoritePicturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).ListMyFavoritePictures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_ListMyFavoritePictures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).ListMyFavoritePictures(ctx, req.(*ListMyFavoritePicturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_FindSimilarPictures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSimilarPicturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).FindSimilarPictures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_FindSimilarPictures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).FindSimilarPictures(ctx, req.(*FindSimilarPicturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_ListDuplicateClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).ListDuplicateClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_ListDuplicateClusters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).ListDuplicateClusters(ctx, req.(*ListDuplicateClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_BatchEditPictures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchEditPicturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).BatchEditPictures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_BatchEditPictures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).BatchEditPictures(ctx, req.(*BatchEditPicturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_BatchRenamePictures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRenamePicturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).BatchRenamePictures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_BatchRenamePictures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).BatchRenamePictures(ctx, req.(*BatchRenamePicturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_BatchDeletePictures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeletePicturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).BatchDeletePictures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_BatchDeletePictures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).BatchDeletePictures(ctx, req.(*BatchDeletePicturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_BatchMovePictures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchMovePicturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).BatchMovePictures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_BatchMovePictures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).BatchMovePictures(ctx, req.(*BatchMovePicturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_BatchMovePicturesToSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchMovePicturesToSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).BatchMovePicturesToSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_BatchMovePicturesToSpace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).BatchMovePicturesToSpace(ctx, req.(*BatchMovePicturesToSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_BatchReviewPictures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReviewPicturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).BatchReviewPictures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_BatchReviewPictures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).BatchReviewPictures(ctx, req.(*BatchReviewPicturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_ListPictureVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPictureVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).ListPictureVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_ListPictureVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).ListPictureVersions(ctx, req.(*ListPictureVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_RestorePictureVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePictureVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).RestorePictureVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_RestorePictureVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).RestorePictureVersion(ctx, req.(*RestorePictureVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_EditPictureImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditPictureImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).EditPictureImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_EditPictureImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).EditPictureImage(ctx, req.(*EditPictureImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Picture_GetImageToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImageTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PictureServer).GetImageToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Picture_GetImageToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PictureServer).GetImageToken(ctx, req.(*GetImageTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Picture_ServiceDesc is the grpc.ServiceDesc for Picture service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -417,6 +1012,74 @@ var Picture_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPictureTagCategory",
 			Handler:    _Picture_GetPictureTagCategory_Handler,
 		},
+		{
+			MethodName: "SuggestTags",
+			Handler:    _Picture_SuggestTags_Handler,
+		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _Picture_GetFeed_Handler,
+		},
+		{
+			MethodName: "LikePicture",
+			Handler:    _Picture_LikePicture_Handler,
+		},
+		{
+			MethodName: "FavoritePicture",
+			Handler:    _Picture_FavoritePicture_Handler,
+		},
+		{
+			MethodName: "ListMyFavoritePictures",
+			Handler:    _Picture_ListMyFavoritePictures_Handler,
+		},
+		{
+			MethodName: "FindSimilarPictures",
+			Handler:    _Picture_FindSimilarPictures_Handler,
+		},
+		{
+			MethodName: "ListDuplicateClusters",
+			Handler:    _Picture_ListDuplicateClusters_Handler,
+		},
+		{
+			MethodName: "BatchEditPictures",
+			Handler:    _Picture_BatchEditPictures_Handler,
+		},
+		{
+			MethodName: "BatchRenamePictures",
+			Handler:    _Picture_BatchRenamePictures_Handler,
+		},
+		{
+			MethodName: "BatchDeletePictures",
+			Handler:    _Picture_BatchDeletePictures_Handler,
+		},
+		{
+			MethodName: "BatchMovePictures",
+			Handler:    _Picture_BatchMovePictures_Handler,
+		},
+		{
+			MethodName: "BatchMovePicturesToSpace",
+			Handler:    _Picture_BatchMovePicturesToSpace_Handler,
+		},
+		{
+			MethodName: "BatchReviewPictures",
+			Handler:    _Picture_BatchReviewPictures_Handler,
+		},
+		{
+			MethodName: "ListPictureVersions",
+			Handler:    _Picture_ListPictureVersions_Handler,
+		},
+		{
+			MethodName: "RestorePictureVersion",
+			Handler:    _Picture_RestorePictureVersion_Handler,
+		},
+		{
+			MethodName: "EditPictureImage",
+			Handler:    _Picture_EditPictureImage_Handler,
+		},
+		{
+			MethodName: "GetImageToken",
+			Handler:    _Picture_GetImageToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "picture/v1/picture.proto",
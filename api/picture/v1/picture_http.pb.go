@@ -19,31 +19,82 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationPictureBatchDeletePictures = "/api.picture.v1.Picture/BatchDeletePictures"
+const OperationPictureBatchEditPictures = "/api.picture.v1.Picture/BatchEditPictures"
+const OperationPictureBatchMovePictures = "/api.picture.v1.Picture/BatchMovePictures"
+const OperationPictureBatchMovePicturesToSpace = "/api.picture.v1.Picture/BatchMovePicturesToSpace"
+const OperationPictureBatchRenamePictures = "/api.picture.v1.Picture/BatchRenamePictures"
+const OperationPictureBatchReviewPictures = "/api.picture.v1.Picture/BatchReviewPictures"
 const OperationPictureDeletePicture = "/api.picture.v1.Picture/DeletePicture"
 const OperationPictureEditPicture = "/api.picture.v1.Picture/EditPicture"
+const OperationPictureEditPictureImage = "/api.picture.v1.Picture/EditPictureImage"
+const OperationPictureFavoritePicture = "/api.picture.v1.Picture/FavoritePicture"
+const OperationPictureFindSimilarPictures = "/api.picture.v1.Picture/FindSimilarPictures"
+const OperationPictureGetFeed = "/api.picture.v1.Picture/GetFeed"
+const OperationPictureGetImageToken = "/api.picture.v1.Picture/GetImageToken"
 const OperationPictureGetPictureById = "/api.picture.v1.Picture/GetPictureById"
 const OperationPictureGetPictureTagCategory = "/api.picture.v1.Picture/GetPictureTagCategory"
 const OperationPictureGetPictureVOById = "/api.picture.v1.Picture/GetPictureVOById"
+const OperationPictureLikePicture = "/api.picture.v1.Picture/LikePicture"
+const OperationPictureListDuplicateClusters = "/api.picture.v1.Picture/ListDuplicateClusters"
+const OperationPictureListMyFavoritePictures = "/api.picture.v1.Picture/ListMyFavoritePictures"
 const OperationPictureListPictureByPage = "/api.picture.v1.Picture/ListPictureByPage"
 const OperationPictureListPictureVOByPage = "/api.picture.v1.Picture/ListPictureVOByPage"
+const OperationPictureListPictureVersions = "/api.picture.v1.Picture/ListPictureVersions"
+const OperationPictureRestorePictureVersion = "/api.picture.v1.Picture/RestorePictureVersion"
+const OperationPictureSuggestTags = "/api.picture.v1.Picture/SuggestTags"
 const OperationPictureUpdatePicture = "/api.picture.v1.Picture/UpdatePicture"
 const OperationPictureUploadPicture = "/api.picture.v1.Picture/UploadPicture"
 
 type PictureHTTPServer interface {
+	// BatchDeletePictures 批量删除
+	BatchDeletePictures(context.Context, *BatchDeletePicturesRequest) (*BatchDeletePicturesReply, error)
+	// BatchEditPictures 批量修改分类和标签
+	BatchEditPictures(context.Context, *BatchEditPicturesRequest) (*BatchEditPicturesReply, error)
+	// BatchMovePictures 批量移动到相册
+	BatchMovePictures(context.Context, *BatchMovePicturesRequest) (*BatchMovePicturesReply, error)
+	// BatchMovePicturesToSpace 批量移动到空间
+	BatchMovePicturesToSpace(context.Context, *BatchMovePicturesToSpaceRequest) (*BatchMovePicturesToSpaceReply, error)
+	// BatchRenamePictures 按模板批量重命名
+	BatchRenamePictures(context.Context, *BatchRenamePicturesRequest) (*BatchRenamePicturesReply, error)
+	// BatchReviewPictures 批量审核（管理员）
+	BatchReviewPictures(context.Context, *BatchReviewPicturesRequest) (*BatchReviewPicturesReply, error)
 	// DeletePicture 删除图片
 	DeletePicture(context.Context, *DeletePictureRequest) (*DeletePictureReply, error)
 	// EditPicture 编辑图片（用户）
 	EditPicture(context.Context, *EditPictureRequest) (*EditPictureReply, error)
+	// EditPictureImage 编辑图片文件（裁剪、旋转、翻转、缩放、水印）
+	EditPictureImage(context.Context, *EditPictureImageRequest) (*EditPictureImageReply, error)
+	// FavoritePicture 收藏/取消收藏（幂等，重复请求结果不变）
+	FavoritePicture(context.Context, *FavoritePictureRequest) (*FavoritePictureReply, error)
+	// FindSimilarPictures 查找相似图片（按感知哈希的汉明距离）
+	FindSimilarPictures(context.Context, *FindSimilarPicturesRequest) (*FindSimilarPicturesReply, error)
+	// GetFeed 获取关注动态（关注用户最近发布的图片）
+	GetFeed(context.Context, *GetFeedRequest) (*GetFeedReply, error)
+	// GetImageToken 获取访问图片缩放接口用的短期令牌
+	GetImageToken(context.Context, *GetImageTokenRequest) (*GetImageTokenReply, error)
 	// GetPictureById 根据 ID 获取图片
 	GetPictureById(context.Context, *GetPictureByIdRequest) (*GetPictureByIdReply, error)
 	// GetPictureTagCategory 获取标签和分类
 	GetPictureTagCategory(context.Context, *GetPictureTagCategoryRequest) (*GetPictureTagCategoryReply, error)
 	// GetPictureVOById 获取图片 VO（脱敏）
 	GetPictureVOById(context.Context, *GetPictureVOByIdRequest) (*GetPictureVOByIdReply, error)
+	// LikePicture 点赞/取消点赞（幂等，重复请求结果不变）
+	LikePicture(context.Context, *LikePictureRequest) (*LikePictureReply, error)
+	// ListDuplicateClusters 重复图片分组报告（管理员）
+	ListDuplicateClusters(context.Context, *ListDuplicateClustersRequest) (*ListDuplicateClustersReply, error)
+	// ListMyFavoritePictures 分页获取我的收藏
+	ListMyFavoritePictures(context.Context, *ListMyFavoritePicturesRequest) (*ListMyFavoritePicturesReply, error)
 	// ListPictureByPage 分页查询图片列表
 	ListPictureByPage(context.Context, *ListPictureByPageRequest) (*ListPictureByPageReply, error)
 	// ListPictureVOByPage 分页获取图片列表 VO（脱敏）
 	ListPictureVOByPage(context.Context, *ListPictureVOByPageRequest) (*ListPictureVOByPageReply, error)
+	// ListPictureVersions 分页查询图片的历史版本
+	ListPictureVersions(context.Context, *ListPictureVersionsRequest) (*ListPictureVersionsReply, error)
+	// RestorePictureVersion 回滚到历史版本
+	RestorePictureVersion(context.Context, *RestorePictureVersionRequest) (*RestorePictureVersionReply, error)
+	// SuggestTags 标签输入建议：按前缀匹配标签名称和别名，结合全站使用次数、当前用户的使用次数和与已选标签的共现排序
+	SuggestTags(context.Context, *SuggestTagsRequest) (*SuggestTagsReply, error)
 	// UpdatePicture 更新图片信息（管理员）
 	UpdatePicture(context.Context, *UpdatePictureRequest) (*UpdatePictureReply, error)
 	// UploadPicture 上传图片
@@ -61,6 +112,23 @@ func RegisterPictureHTTPServer(s *http.Server, srv PictureHTTPServer) {
 	r.GET("/api/picture/get/vo", _Picture_GetPictureVOById0_HTTP_Handler(srv))
 	r.POST("/api/picture/list/page/vo", _Picture_ListPictureVOByPage0_HTTP_Handler(srv))
 	r.GET("/api/picture/tag_category", _Picture_GetPictureTagCategory0_HTTP_Handler(srv))
+	r.GET("/api/picture/tag/suggest", _Picture_SuggestTags0_HTTP_Handler(srv))
+	r.GET("/api/picture/feed", _Picture_GetFeed0_HTTP_Handler(srv))
+	r.POST("/api/picture/like", _Picture_LikePicture0_HTTP_Handler(srv))
+	r.POST("/api/picture/favorite", _Picture_FavoritePicture0_HTTP_Handler(srv))
+	r.POST("/api/picture/favorite/list/page", _Picture_ListMyFavoritePictures0_HTTP_Handler(srv))
+	r.GET("/api/picture/similar", _Picture_FindSimilarPictures0_HTTP_Handler(srv))
+	r.POST("/api/picture/duplicate/clusters", _Picture_ListDuplicateClusters0_HTTP_Handler(srv))
+	r.POST("/api/picture/batch/edit", _Picture_BatchEditPictures0_HTTP_Handler(srv))
+	r.POST("/api/picture/batch/rename", _Picture_BatchRenamePictures0_HTTP_Handler(srv))
+	r.POST("/api/picture/batch/delete", _Picture_BatchDeletePictures0_HTTP_Handler(srv))
+	r.POST("/api/picture/batch/move", _Picture_BatchMovePictures0_HTTP_Handler(srv))
+	r.POST("/api/picture/batch/space", _Picture_BatchMovePicturesToSpace0_HTTP_Handler(srv))
+	r.POST("/api/picture/batch/review", _Picture_BatchReviewPictures0_HTTP_Handler(srv))
+	r.POST("/api/picture/version/list/page", _Picture_ListPictureVersions0_HTTP_Handler(srv))
+	r.POST("/api/picture/version/restore", _Picture_RestorePictureVersion0_HTTP_Handler(srv))
+	r.POST("/api/picture/image/edit", _Picture_EditPictureImage0_HTTP_Handler(srv))
+	r.GET("/api/picture/image/token", _Picture_GetImageToken0_HTTP_Handler(srv))
 }
 
 func _Picture_UploadPicture0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
@@ -255,21 +323,417 @@ func _Picture_GetPictureTagCategory0_HTTP_Handler(srv PictureHTTPServer) func(ct
 	}
 }
 
+func _Picture_SuggestTags0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SuggestTagsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureSuggestTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SuggestTags(ctx, req.(*SuggestTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SuggestTagsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_GetFeed0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetFeedRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureGetFeed)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetFeed(ctx, req.(*GetFeedRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetFeedReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_LikePicture0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LikePictureRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureLikePicture)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LikePicture(ctx, req.(*LikePictureRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LikePictureReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_FavoritePicture0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FavoritePictureRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureFavoritePicture)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FavoritePicture(ctx, req.(*FavoritePictureRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FavoritePictureReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_ListMyFavoritePictures0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyFavoritePicturesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureListMyFavoritePictures)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyFavoritePictures(ctx, req.(*ListMyFavoritePicturesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMyFavoritePicturesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_FindSimilarPictures0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FindSimilarPicturesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureFindSimilarPictures)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FindSimilarPictures(ctx, req.(*FindSimilarPicturesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FindSimilarPicturesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_ListDuplicateClusters0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDuplicateClustersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureListDuplicateClusters)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDuplicateClusters(ctx, req.(*ListDuplicateClustersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDuplicateClustersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_BatchEditPictures0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchEditPicturesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureBatchEditPictures)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchEditPictures(ctx, req.(*BatchEditPicturesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchEditPicturesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_BatchRenamePictures0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchRenamePicturesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureBatchRenamePictures)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchRenamePictures(ctx, req.(*BatchRenamePicturesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchRenamePicturesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_BatchDeletePictures0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchDeletePicturesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureBatchDeletePictures)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchDeletePictures(ctx, req.(*BatchDeletePicturesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchDeletePicturesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_BatchMovePictures0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchMovePicturesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureBatchMovePictures)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchMovePictures(ctx, req.(*BatchMovePicturesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchMovePicturesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_BatchMovePicturesToSpace0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchMovePicturesToSpaceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureBatchMovePicturesToSpace)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchMovePicturesToSpace(ctx, req.(*BatchMovePicturesToSpaceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchMovePicturesToSpaceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_BatchReviewPictures0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchReviewPicturesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureBatchReviewPictures)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchReviewPictures(ctx, req.(*BatchReviewPicturesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchReviewPicturesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_ListPictureVersions0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPictureVersionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureListPictureVersions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPictureVersions(ctx, req.(*ListPictureVersionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPictureVersionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_RestorePictureVersion0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestorePictureVersionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureRestorePictureVersion)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestorePictureVersion(ctx, req.(*RestorePictureVersionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestorePictureVersionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_EditPictureImage0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EditPictureImageRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureEditPictureImage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EditPictureImage(ctx, req.(*EditPictureImageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EditPictureImageReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Picture_GetImageToken0_HTTP_Handler(srv PictureHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetImageTokenRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPictureGetImageToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetImageToken(ctx, req.(*GetImageTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetImageTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type PictureHTTPClient interface {
+	// BatchDeletePictures 批量删除
+	BatchDeletePictures(ctx context.Context, req *BatchDeletePicturesRequest, opts ...http.CallOption) (rsp *BatchDeletePicturesReply, err error)
+	// BatchEditPictures 批量修改分类和标签
+	BatchEditPictures(ctx context.Context, req *BatchEditPicturesRequest, opts ...http.CallOption) (rsp *BatchEditPicturesReply, err error)
+	// BatchMovePictures 批量移动到相册
+	BatchMovePictures(ctx context.Context, req *BatchMovePicturesRequest, opts ...http.CallOption) (rsp *BatchMovePicturesReply, err error)
+	// BatchMovePicturesToSpace 批量移动到空间
+	BatchMovePicturesToSpace(ctx context.Context, req *BatchMovePicturesToSpaceRequest, opts ...http.CallOption) (rsp *BatchMovePicturesToSpaceReply, err error)
+	// BatchRenamePictures 按模板批量重命名
+	BatchRenamePictures(ctx context.Context, req *BatchRenamePicturesRequest, opts ...http.CallOption) (rsp *BatchRenamePicturesReply, err error)
+	// BatchReviewPictures 批量审核（管理员）
+	BatchReviewPictures(ctx context.Context, req *BatchReviewPicturesRequest, opts ...http.CallOption) (rsp *BatchReviewPicturesReply, err error)
 	// DeletePicture 删除图片
 	DeletePicture(ctx context.Context, req *DeletePictureRequest, opts ...http.CallOption) (rsp *DeletePictureReply, err error)
 	// EditPicture 编辑图片（用户）
 	EditPicture(ctx context.Context, req *EditPictureRequest, opts ...http.CallOption) (rsp *EditPictureReply, err error)
+	// EditPictureImage 编辑图片文件（裁剪、旋转、翻转、缩放、水印）
+	EditPictureImage(ctx context.Context, req *EditPictureImageRequest, opts ...http.CallOption) (rsp *EditPictureImageReply, err error)
+	// FavoritePicture 收藏/取消收藏（幂等，重复请求结果不变）
+	FavoritePicture(ctx context.Context, req *FavoritePictureRequest, opts ...http.CallOption) (rsp *FavoritePictureReply, err error)
+	// FindSimilarPictures 查找相似图片（按感知哈希的汉明距离）
+	FindSimilarPictures(ctx context.Context, req *FindSimilarPicturesRequest, opts ...http.CallOption) (rsp *FindSimilarPicturesReply, err error)
+	// GetFeed 获取关注动态（关注用户最近发布的图片）
+	GetFeed(ctx context.Context, req *GetFeedRequest, opts ...http.CallOption) (rsp *GetFeedReply, err error)
+	// GetImageToken 获取访问图片缩放接口用的短期令牌
+	GetImageToken(ctx context.Context, req *GetImageTokenRequest, opts ...http.CallOption) (rsp *GetImageTokenReply, err error)
 	// GetPictureById 根据 ID 获取图片
 	GetPictureById(ctx context.Context, req *GetPictureByIdRequest, opts ...http.CallOption) (rsp *GetPictureByIdReply, err error)
 	// GetPictureTagCategory 获取标签和分类
 	GetPictureTagCategory(ctx context.Context, req *GetPictureTagCategoryRequest, opts ...http.CallOption) (rsp *GetPictureTagCategoryReply, err error)
 	// GetPictureVOById 获取图片 VO（脱敏）
 	GetPictureVOById(ctx context.Context, req *GetPictureVOByIdRequest, opts ...http.CallOption) (rsp *GetPictureVOByIdReply, err error)
+	// LikePicture 点赞/取消点赞（幂等，重复请求结果不变）
+	LikePicture(ctx context.Context, req *LikePictureRequest, opts ...http.CallOption) (rsp *LikePictureReply, err error)
+	// ListDuplicateClusters 重复图片分组报告（管理员）
+	ListDuplicateClusters(ctx context.Context, req *ListDuplicateClustersRequest, opts ...http.CallOption) (rsp *ListDuplicateClustersReply, err error)
+	// ListMyFavoritePictures 分页获取我的收藏
+	ListMyFavoritePictures(ctx context.Context, req *ListMyFavoritePicturesRequest, opts ...http.CallOption) (rsp *ListMyFavoritePicturesReply, err error)
 	// ListPictureByPage 分页查询图片列表
 	ListPictureByPage(ctx context.Context, req *ListPictureByPageRequest, opts ...http.CallOption) (rsp *ListPictureByPageReply, err error)
 	// ListPictureVOByPage 分页获取图片列表 VO（脱敏）
 	ListPictureVOByPage(ctx context.Context, req *ListPictureVOByPageRequest, opts ...http.CallOption) (rsp *ListPictureVOByPageReply, err error)
+	// ListPictureVersions 分页查询图片的历史版本
+	ListPictureVersions(ctx context.Context, req *ListPictureVersionsRequest, opts ...http.CallOption) (rsp *ListPictureVersionsReply, err error)
+	// RestorePictureVersion 回滚到历史版本
+	RestorePictureVersion(ctx context.Context, req *RestorePictureVersionRequest, opts ...http.CallOption) (rsp *RestorePictureVersionReply, err error)
+	// SuggestTags 标签输入建议：按前缀匹配标签名称和别名，结合全站使用次数、当前用户的使用次数和与已选标签的共现排序
+	SuggestTags(ctx context.Context, req *SuggestTagsRequest, opts ...http.CallOption) (rsp *SuggestTagsReply, err error)
 	// UpdatePicture 更新图片信息（管理员）
 	UpdatePicture(ctx context.Context, req *UpdatePictureRequest, opts ...http.CallOption) (rsp *UpdatePictureReply, err error)
 	// UploadPicture 上传图片
@@ -284,6 +748,90 @@ func NewPictureHTTPClient(client *http.Client) PictureHTTPClient {
 	return &PictureHTTPClientImpl{client}
 }
 
+// BatchDeletePictures 批量删除
+func (c *PictureHTTPClientImpl) BatchDeletePictures(ctx context.Context, in *BatchDeletePicturesRequest, opts ...http.CallOption) (*BatchDeletePicturesReply, error) {
+	var out BatchDeletePicturesReply
+	pattern := "/api/picture/batch/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureBatchDeletePictures))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BatchEditPictures 批量修改分类和标签
+func (c *PictureHTTPClientImpl) BatchEditPictures(ctx context.Context, in *BatchEditPicturesRequest, opts ...http.CallOption) (*BatchEditPicturesReply, error) {
+	var out BatchEditPicturesReply
+	pattern := "/api/picture/batch/edit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureBatchEditPictures))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BatchMovePictures 批量移动到相册
+func (c *PictureHTTPClientImpl) BatchMovePictures(ctx context.Context, in *BatchMovePicturesRequest, opts ...http.CallOption) (*BatchMovePicturesReply, error) {
+	var out BatchMovePicturesReply
+	pattern := "/api/picture/batch/move"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureBatchMovePictures))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BatchMovePicturesToSpace 批量移动到空间
+func (c *PictureHTTPClientImpl) BatchMovePicturesToSpace(ctx context.Context, in *BatchMovePicturesToSpaceRequest, opts ...http.CallOption) (*BatchMovePicturesToSpaceReply, error) {
+	var out BatchMovePicturesToSpaceReply
+	pattern := "/api/picture/batch/space"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureBatchMovePicturesToSpace))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BatchRenamePictures 按模板批量重命名
+func (c *PictureHTTPClientImpl) BatchRenamePictures(ctx context.Context, in *BatchRenamePicturesRequest, opts ...http.CallOption) (*BatchRenamePicturesReply, error) {
+	var out BatchRenamePicturesReply
+	pattern := "/api/picture/batch/rename"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureBatchRenamePictures))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BatchReviewPictures 批量审核（管理员）
+func (c *PictureHTTPClientImpl) BatchReviewPictures(ctx context.Context, in *BatchReviewPicturesRequest, opts ...http.CallOption) (*BatchReviewPicturesReply, error) {
+	var out BatchReviewPicturesReply
+	pattern := "/api/picture/batch/review"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureBatchReviewPictures))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // DeletePicture 删除图片
 func (c *PictureHTTPClientImpl) DeletePicture(ctx context.Context, in *DeletePictureRequest, opts ...http.CallOption) (*DeletePictureReply, error) {
 	var out DeletePictureReply
@@ -312,6 +860,76 @@ func (c *PictureHTTPClientImpl) EditPicture(ctx context.Context, in *EditPicture
 	return &out, nil
 }
 
+// EditPictureImage 编辑图片文件（裁剪、旋转、翻转、缩放、水印）
+func (c *PictureHTTPClientImpl) EditPictureImage(ctx context.Context, in *EditPictureImageRequest, opts ...http.CallOption) (*EditPictureImageReply, error) {
+	var out EditPictureImageReply
+	pattern := "/api/picture/image/edit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureEditPictureImage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FavoritePicture 收藏/取消收藏（幂等，重复请求结果不变）
+func (c *PictureHTTPClientImpl) FavoritePicture(ctx context.Context, in *FavoritePictureRequest, opts ...http.CallOption) (*FavoritePictureReply, error) {
+	var out FavoritePictureReply
+	pattern := "/api/picture/favorite"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureFavoritePicture))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FindSimilarPictures 查找相似图片（按感知哈希的汉明距离）
+func (c *PictureHTTPClientImpl) FindSimilarPictures(ctx context.Context, in *FindSimilarPicturesRequest, opts ...http.CallOption) (*FindSimilarPicturesReply, error) {
+	var out FindSimilarPicturesReply
+	pattern := "/api/picture/similar"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPictureFindSimilarPictures))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetFeed 获取关注动态（关注用户最近发布的图片）
+func (c *PictureHTTPClientImpl) GetFeed(ctx context.Context, in *GetFeedRequest, opts ...http.CallOption) (*GetFeedReply, error) {
+	var out GetFeedReply
+	pattern := "/api/picture/feed"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPictureGetFeed))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetImageToken 获取访问图片缩放接口用的短期令牌
+func (c *PictureHTTPClientImpl) GetImageToken(ctx context.Context, in *GetImageTokenRequest, opts ...http.CallOption) (*GetImageTokenReply, error) {
+	var out GetImageTokenReply
+	pattern := "/api/picture/image/token"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPictureGetImageToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetPictureById 根据 ID 获取图片
 func (c *PictureHTTPClientImpl) GetPictureById(ctx context.Context, in *GetPictureByIdRequest, opts ...http.CallOption) (*GetPictureByIdReply, error) {
 	var out GetPictureByIdReply
@@ -354,6 +972,48 @@ func (c *PictureHTTPClientImpl) GetPictureVOById(ctx context.Context, in *GetPic
 	return &out, nil
 }
 
+// LikePicture 点赞/取消点赞（幂等，重复请求结果不变）
+func (c *PictureHTTPClientImpl) LikePicture(ctx context.Context, in *LikePictureRequest, opts ...http.CallOption) (*LikePictureReply, error) {
+	var out LikePictureReply
+	pattern := "/api/picture/like"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureLikePicture))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListDuplicateClusters 重复图片分组报告（管理员）
+func (c *PictureHTTPClientImpl) ListDuplicateClusters(ctx context.Context, in *ListDuplicateClustersRequest, opts ...http.CallOption) (*ListDuplicateClustersReply, error) {
+	var out ListDuplicateClustersReply
+	pattern := "/api/picture/duplicate/clusters"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureListDuplicateClusters))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListMyFavoritePictures 分页获取我的收藏
+func (c *PictureHTTPClientImpl) ListMyFavoritePictures(ctx context.Context, in *ListMyFavoritePicturesRequest, opts ...http.CallOption) (*ListMyFavoritePicturesReply, error) {
+	var out ListMyFavoritePicturesReply
+	pattern := "/api/picture/favorite/list/page"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureListMyFavoritePictures))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListPictureByPage 分页查询图片列表
 func (c *PictureHTTPClientImpl) ListPictureByPage(ctx context.Context, in *ListPictureByPageRequest, opts ...http.CallOption) (*ListPictureByPageReply, error) {
 	var out ListPictureByPageReply
@@ -382,6 +1042,48 @@ func (c *PictureHTTPClientImpl) ListPictureVOByPage(ctx context.Context, in *Lis
 	return &out, nil
 }
 
+// ListPictureVersions 分页查询图片的历史版本
+func (c *PictureHTTPClientImpl) ListPictureVersions(ctx context.Context, in *ListPictureVersionsRequest, opts ...http.CallOption) (*ListPictureVersionsReply, error) {
+	var out ListPictureVersionsReply
+	pattern := "/api/picture/version/list/page"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureListPictureVersions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestorePictureVersion 回滚到历史版本
+func (c *PictureHTTPClientImpl) RestorePictureVersion(ctx context.Context, in *RestorePictureVersionRequest, opts ...http.CallOption) (*RestorePictureVersionReply, error) {
+	var out RestorePictureVersionReply
+	pattern := "/api/picture/version/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPictureRestorePictureVersion))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SuggestTags 标签输入建议：按前缀匹配标签名称和别名，结合全站使用次数、当前用户的使用次数和与已选标签的共现排序
+func (c *PictureHTTPClientImpl) SuggestTags(ctx context.Context, in *SuggestTagsRequest, opts ...http.CallOption) (*SuggestTagsReply, error) {
+	var out SuggestTagsReply
+	pattern := "/api/picture/tag/suggest"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPictureSuggestTags))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdatePicture 更新图片信息（管理员）
 func (c *PictureHTTPClientImpl) UpdatePicture(ctx context.Context, in *UpdatePictureRequest, opts ...http.CallOption) (*UpdatePictureReply, error) {
 	var out UpdatePictureReply
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Error 辅助函数
+
+func ErrorStorageQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_STORAGE_QUOTA_EXCEEDED.String(), format)
+}
+
+func ErrorPictureQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_PICTURE_QUOTA_EXCEEDED.String(), format)
+}
+
+func ErrorDailyUploadQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_DAILY_UPLOAD_QUOTA_EXCEEDED.String(), format)
+}
+
+func ErrorUserNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_USER_NOT_FOUND.String(), format)
+}
+
+func ErrorParamsError(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_PARAMS_ERROR.String(), format)
+}
+
+func ErrorUnauthorized(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_UNAUTHORIZED.String(), format)
+}
+
+func ErrorSystemError(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_SYSTEM_ERROR.String(), format)
+}
+
+// Is 辅助函数
+
+func IsStorageQuotaExceeded(err error) bool {
+	return errors.Reason(err) == ErrorReason_STORAGE_QUOTA_EXCEEDED.String()
+}
+
+func IsPictureQuotaExceeded(err error) bool {
+	return errors.Reason(err) == ErrorReason_PICTURE_QUOTA_EXCEEDED.String()
+}
+
+func IsDailyUploadQuotaExceeded(err error) bool {
+	return errors.Reason(err) == ErrorReason_DAILY_UPLOAD_QUOTA_EXCEEDED.String()
+}
+
+func IsUserNotFound(err error) bool {
+	return errors.Reason(err) == ErrorReason_USER_NOT_FOUND.String()
+}
+
+func IsParamsError(err error) bool {
+	return errors.Reason(err) == ErrorReason_PARAMS_ERROR.String()
+}
+
+func IsUnauthorized(err error) bool {
+	return errors.Reason(err) == ErrorReason_UNAUTHORIZED.String()
+}
+
+func IsSystemError(err error) bool {
+	return errors.Reason(err) == ErrorReason_SYSTEM_ERROR.String()
+}
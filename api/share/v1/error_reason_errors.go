@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Error 辅助函数
+
+func ErrorShareLinkNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_SHARE_LINK_NOT_FOUND.String(), format)
+}
+
+func ErrorShareLinkNoAuth(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_SHARE_LINK_NO_AUTH.String(), format)
+}
+
+func ErrorShareLinkExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(410, ErrorReason_SHARE_LINK_EXPIRED.String(), format)
+}
+
+func ErrorShareLinkExhausted(format string, args ...interface{}) *errors.Error {
+	return errors.New(410, ErrorReason_SHARE_LINK_EXHAUSTED.String(), format)
+}
+
+func ErrorShareLinkPasswordRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_SHARE_LINK_PASSWORD_REQUIRED.String(), format)
+}
+
+func ErrorShareLinkPasswordError(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_SHARE_LINK_PASSWORD_ERROR.String(), format)
+}
+
+func ErrorShareLinkTooManyAttempts(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_SHARE_LINK_TOO_MANY_ATTEMPTS.String(), format)
+}
+
+func ErrorParamsError(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_PARAMS_ERROR.String(), format)
+}
+
+func ErrorUnauthorized(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_UNAUTHORIZED.String(), format)
+}
+
+func ErrorSystemError(format string, args ...interface{}) *errors.Error {
+	return errors.New(500, ErrorReason_SYSTEM_ERROR.String(), format)
+}
+
+// Is 辅助函数
+
+func IsShareLinkNotFound(err error) bool {
+	return errors.Reason(err) == ErrorReason_SHARE_LINK_NOT_FOUND.String()
+}
+
+func IsShareLinkNoAuth(err error) bool {
+	return errors.Reason(err) == ErrorReason_SHARE_LINK_NO_AUTH.String()
+}
+
+func IsShareLinkExpired(err error) bool {
+	return errors.Reason(err) == ErrorReason_SHARE_LINK_EXPIRED.String()
+}
+
+func IsShareLinkExhausted(err error) bool {
+	return errors.Reason(err) == ErrorReason_SHARE_LINK_EXHAUSTED.String()
+}
+
+func IsShareLinkPasswordRequired(err error) bool {
+	return errors.Reason(err) == ErrorReason_SHARE_LINK_PASSWORD_REQUIRED.String()
+}
+
+func IsShareLinkPasswordError(err error) bool {
+	return errors.Reason(err) == ErrorReason_SHARE_LINK_PASSWORD_ERROR.String()
+}
+
+func IsShareLinkTooManyAttempts(err error) bool {
+	return errors.Reason(err) == ErrorReason_SHARE_LINK_TOO_MANY_ATTEMPTS.String()
+}
+
+func IsParamsError(err error) bool {
+	return errors.Reason(err) == ErrorReason_PARAMS_ERROR.String()
+}
+
+func IsUnauthorized(err error) bool {
+	return errors.Reason(err) == ErrorReason_UNAUTHORIZED.String()
+}
+
+func IsSystemError(err error) bool {
+	return errors.Reason(err) == ErrorReason_SYSTEM_ERROR.String()
+}
@@ -54,6 +54,18 @@ const (
 	ErrorReason_VERIFICATION_CODE_EXPIRED ErrorReason = 13
 	// 验证码错误
 	ErrorReason_VERIFICATION_CODE_ERROR ErrorReason = 14
+	// 会员兑换码不存在
+	ErrorReason_VIP_CODE_NOT_FOUND ErrorReason = 15
+	// 会员兑换码已过期
+	ErrorReason_VIP_CODE_EXPIRED ErrorReason = 16
+	// 会员兑换码已用完
+	ErrorReason_VIP_CODE_USED_UP ErrorReason = 17
+	// 会员兑换码已兑换过
+	ErrorReason_VIP_CODE_ALREADY_REDEEMED ErrorReason = 18
+	// 邀请码无效
+	ErrorReason_INVITE_CODE_INVALID ErrorReason = 19
+	// 需要邀请码
+	ErrorReason_INVITE_CODE_REQUIRED ErrorReason = 20
 )
 
 // Enum value maps for ErrorReason.
@@ -74,6 +86,12 @@ var (
 		12: "NO_AUTH_ERROR",
 		13: "VERIFICATION_CODE_EXPIRED",
 		14: "VERIFICATION_CODE_ERROR",
+		15: "VIP_CODE_NOT_FOUND",
+		16: "VIP_CODE_EXPIRED",
+		17: "VIP_CODE_USED_UP",
+		18: "VIP_CODE_ALREADY_REDEEMED",
+		19: "INVITE_CODE_INVALID",
+		20: "INVITE_CODE_REQUIRED",
 	}
 	ErrorReason_value = map[string]int32{
 		"PARAMS_ERROR":                     0,
@@ -91,6 +109,12 @@ var (
 		"NO_AUTH_ERROR":                    12,
 		"VERIFICATION_CODE_EXPIRED":        13,
 		"VERIFICATION_CODE_ERROR":          14,
+		"VIP_CODE_NOT_FOUND":               15,
+		"VIP_CODE_EXPIRED":                 16,
+		"VIP_CODE_USED_UP":                 17,
+		"VIP_CODE_ALREADY_REDEEMED":        18,
+		"INVITE_CODE_INVALID":              19,
+		"INVITE_CODE_REQUIRED":             20,
 	}
 )
 
@@ -127,8 +151,8 @@ var file_user_v1_error_reason_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
 	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x61, 0x70,
 	0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xc7,
-	0x03, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16,
+	0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x81,
+	0x05, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16,
 	0x0a, 0x0c, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00,
 	0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e,
 	0x54, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x1a, 0x04, 0xa8,
@@ -156,11 +180,23 @@ var file_user_v1_error_reason_proto_rawDesc = []byte{
 	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x0d, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12,
 	0x21, 0x0a, 0x17, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
 	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x0e, 0x1a, 0x04, 0xa8, 0x45,
-	0x90, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x3b, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x2a, 0x73, 0x6d, 0x61, 0x72, 0x74,
-	0x2d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x2d, 0x67, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x79, 0x2d,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f,
-	0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x90, 0x03, 0x12, 0x1c, 0x0a, 0x12, 0x56, 0x49, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0f, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03,
+	0x12, 0x1a, 0x0a, 0x10, 0x56, 0x49, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x10, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1a, 0x0a, 0x10,
+	0x56, 0x49, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x55, 0x50,
+	0x10, 0x11, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x23, 0x0a, 0x19, 0x56, 0x49, 0x50, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x52, 0x45, 0x44,
+	0x45, 0x45, 0x4d, 0x45, 0x44, 0x10, 0x12, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x1d, 0x0a,
+	0x13, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x10, 0x13, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x14,
+	0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x14, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x1a, 0x04, 0xa0, 0x45,
+	0xf4, 0x03, 0x42, 0x3b, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x50, 0x01, 0x5a, 0x2a, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x2d, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x62, 0x2d, 0x67, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
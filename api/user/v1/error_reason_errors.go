@@ -125,3 +125,51 @@ func ErrorVerificationCodeError(format string, args ...interface{}) *errors.Erro
 func IsVerificationCodeError(err error) bool {
 	return errors.Reason(err) == ErrorReason_VERIFICATION_CODE_ERROR.String()
 }
+
+func ErrorVipCodeNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_VIP_CODE_NOT_FOUND.String(), format)
+}
+
+func IsVipCodeNotFound(err error) bool {
+	return errors.Reason(err) == ErrorReason_VIP_CODE_NOT_FOUND.String()
+}
+
+func ErrorVipCodeExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_VIP_CODE_EXPIRED.String(), format)
+}
+
+func IsVipCodeExpired(err error) bool {
+	return errors.Reason(err) == ErrorReason_VIP_CODE_EXPIRED.String()
+}
+
+func ErrorVipCodeUsedUp(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_VIP_CODE_USED_UP.String(), format)
+}
+
+func IsVipCodeUsedUp(err error) bool {
+	return errors.Reason(err) == ErrorReason_VIP_CODE_USED_UP.String()
+}
+
+func ErrorVipCodeAlreadyRedeemed(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_VIP_CODE_ALREADY_REDEEMED.String(), format)
+}
+
+func IsVipCodeAlreadyRedeemed(err error) bool {
+	return errors.Reason(err) == ErrorReason_VIP_CODE_ALREADY_REDEEMED.String()
+}
+
+func ErrorInviteCodeInvalid(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVITE_CODE_INVALID.String(), format)
+}
+
+func IsInviteCodeInvalid(err error) bool {
+	return errors.Reason(err) == ErrorReason_INVITE_CODE_INVALID.String()
+}
+
+func ErrorInviteCodeRequired(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVITE_CODE_REQUIRED.String(), format)
+}
+
+func IsInviteCodeRequired(err error) bool {
+	return errors.Reason(err) == ErrorReason_INVITE_CODE_REQUIRED.String()
+}
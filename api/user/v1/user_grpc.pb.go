@@ -33,6 +33,15 @@ const (
 	User_SendEmailVerificationCode_FullMethodName = "/api.user.v1.User/SendEmailVerificationCode"
 	User_VerifyAndUpdateEmail_FullMethodName      = "/api.user.v1.User/VerifyAndUpdateEmail"
 	User_UpdatePassword_FullMethodName            = "/api.user.v1.User/UpdatePassword"
+	User_UpdatePrivacySettings_FullMethodName     = "/api.user.v1.User/UpdatePrivacySettings"
+	User_GenerateVipCodes_FullMethodName          = "/api.user.v1.User/GenerateVipCodes"
+	User_RedeemVipCode_FullMethodName             = "/api.user.v1.User/RedeemVipCode"
+	User_ListMyInvitees_FullMethodName            = "/api.user.v1.User/ListMyInvitees"
+	User_GetInviteStatistics_FullMethodName       = "/api.user.v1.User/GetInviteStatistics"
+	User_FollowUser_FullMethodName                = "/api.user.v1.User/FollowUser"
+	User_UnfollowUser_FullMethodName              = "/api.user.v1.User/UnfollowUser"
+	User_ListFollowers_FullMethodName             = "/api.user.v1.User/ListFollowers"
+	User_ListFollowings_FullMethodName            = "/api.user.v1.User/ListFollowings"
 )
 
 // UserClient is the client API for User service.
@@ -67,6 +76,24 @@ type UserClient interface {
 	VerifyAndUpdateEmail(ctx context.Context, in *VerifyAndUpdateEmailRequest, opts ...grpc.CallOption) (*VerifyAndUpdateEmailReply, error)
 	// 修改用户登录密码
 	UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...grpc.CallOption) (*UpdatePasswordReply, error)
+	// 更新隐私设置
+	UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*UpdatePrivacySettingsReply, error)
+	// 批量生成会员兑换码（仅管理员）
+	GenerateVipCodes(ctx context.Context, in *GenerateVipCodesRequest, opts ...grpc.CallOption) (*GenerateVipCodesReply, error)
+	// 兑换会员
+	RedeemVipCode(ctx context.Context, in *RedeemVipCodeRequest, opts ...grpc.CallOption) (*RedeemVipCodeReply, error)
+	// 分页获取我邀请的用户
+	ListMyInvitees(ctx context.Context, in *ListMyInviteesRequest, opts ...grpc.CallOption) (*ListMyInviteesReply, error)
+	// 获取邀请统计（仅管理员）
+	GetInviteStatistics(ctx context.Context, in *GetInviteStatisticsRequest, opts ...grpc.CallOption) (*GetInviteStatisticsReply, error)
+	// 关注用户
+	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserReply, error)
+	// 取消关注
+	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserReply, error)
+	// 分页获取粉丝列表
+	ListFollowers(ctx context.Context, in *ListFollowUsersRequest, opts ...grpc.CallOption) (*ListFollowUsersReply, error)
+	// 分页获取关注列表
+	ListFollowings(ctx context.Context, in *ListFollowUsersRequest, opts ...grpc.CallOption) (*ListFollowUsersReply, error)
 }
 
 type userClient struct {
@@ -203,6 +230,87 @@ func (c *userClient) UpdatePassword(ctx context.Context, in *UpdatePasswordReque
 	return out, nil
 }
 
+func (c *userClient) UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*UpdatePrivacySettingsReply, error) {
+	out := new(UpdatePrivacySettingsReply)
+	err := c.cc.Invoke(ctx, User_UpdatePrivacySettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GenerateVipCodes(ctx context.Context, in *GenerateVipCodesRequest, opts ...grpc.CallOption) (*GenerateVipCodesReply, error) {
+	out := new(GenerateVipCodesReply)
+	err := c.cc.Invoke(ctx, User_GenerateVipCodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RedeemVipCode(ctx context.Context, in *RedeemVipCodeRequest, opts ...grpc.CallOption) (*RedeemVipCodeReply, error) {
+	out := new(RedeemVipCodeReply)
+	err := c.cc.Invoke(ctx, User_RedeemVipCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListMyInvitees(ctx context.Context, in *ListMyInviteesRequest, opts ...grpc.CallOption) (*ListMyInviteesReply, error) {
+	out := new(ListMyInviteesReply)
+	err := c.cc.Invoke(ctx, User_ListMyInvitees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetInviteStatistics(ctx context.Context, in *GetInviteStatisticsRequest, opts ...grpc.CallOption) (*GetInviteStatisticsReply, error) {
+	out := new(GetInviteStatisticsReply)
+	err := c.cc.Invoke(ctx, User_GetInviteStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*FollowUserReply, error) {
+	out := new(FollowUserReply)
+	err := c.cc.Invoke(ctx, User_FollowUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*UnfollowUserReply, error) {
+	out := new(UnfollowUserReply)
+	err := c.cc.Invoke(ctx, User_UnfollowUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListFollowers(ctx context.Context, in *ListFollowUsersRequest, opts ...grpc.CallOption) (*ListFollowUsersReply, error) {
+	out := new(ListFollowUsersReply)
+	err := c.cc.Invoke(ctx, User_ListFollowers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListFollowings(ctx context.Context, in *ListFollowUsersRequest, opts ...grpc.CallOption) (*ListFollowUsersReply, error) {
+	out := new(ListFollowUsersReply)
+	err := c.cc.Invoke(ctx, User_ListFollowings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility
@@ -235,6 +343,24 @@ type UserServer interface {
 	VerifyAndUpdateEmail(context.Context, *VerifyAndUpdateEmailRequest) (*VerifyAndUpdateEmailReply, error)
 	// 修改用户登录密码
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordReply, error)
+	// 更新隐私设置
+	UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*UpdatePrivacySettingsReply, error)
+	// 批量生成会员兑换码（仅管理员）
+	GenerateVipCodes(context.Context, *GenerateVipCodesRequest) (*GenerateVipCodesReply, error)
+	// 兑换会员
+	RedeemVipCode(context.Context, *RedeemVipCodeRequest) (*RedeemVipCodeReply, error)
+	// 分页获取我邀请的用户
+	ListMyInvitees(context.Context, *ListMyInviteesRequest) (*ListMyInviteesReply, error)
+	// 获取邀请统计（仅管理员）
+	GetInviteStatistics(context.Context, *GetInviteStatisticsRequest) (*GetInviteStatisticsReply, error)
+	// 关注用户
+	FollowUser(context.Context, *FollowUserRequest) (*FollowUserReply, error)
+	// 取消关注
+	UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserReply, error)
+	// 分页获取粉丝列表
+	ListFollowers(context.Context, *ListFollowUsersRequest) (*ListFollowUsersReply, error)
+	// 分页获取关注列表
+	ListFollowings(context.Context, *ListFollowUsersRequest) (*ListFollowUsersReply, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -284,6 +410,33 @@ func (UnimplementedUserServer) VerifyAndUpdateEmail(context.Context, *VerifyAndU
 func (UnimplementedUserServer) UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassword not implemented")
 }
+func (UnimplementedUserServer) UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*UpdatePrivacySettingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrivacySettings not implemented")
+}
+func (UnimplementedUserServer) GenerateVipCodes(context.Context, *GenerateVipCodesRequest) (*GenerateVipCodesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateVipCodes not implemented")
+}
+func (UnimplementedUserServer) RedeemVipCode(context.Context, *RedeemVipCodeRequest) (*RedeemVipCodeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemVipCode not implemented")
+}
+func (UnimplementedUserServer) ListMyInvitees(context.Context, *ListMyInviteesRequest) (*ListMyInviteesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyInvitees not implemented")
+}
+func (UnimplementedUserServer) GetInviteStatistics(context.Context, *GetInviteStatisticsRequest) (*GetInviteStatisticsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInviteStatistics not implemented")
+}
+func (UnimplementedUserServer) FollowUser(context.Context, *FollowUserRequest) (*FollowUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (UnimplementedUserServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (UnimplementedUserServer) ListFollowers(context.Context, *ListFollowUsersRequest) (*ListFollowUsersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedUserServer) ListFollowings(context.Context, *ListFollowUsersRequest) (*ListFollowUsersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowings not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 
 // UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
@@ -549,6 +702,168 @@ func _User_UpdatePassword_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_UpdatePrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePrivacySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdatePrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdatePrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdatePrivacySettings(ctx, req.(*UpdatePrivacySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GenerateVipCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateVipCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GenerateVipCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GenerateVipCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GenerateVipCodes(ctx, req.(*GenerateVipCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RedeemVipCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemVipCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RedeemVipCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RedeemVipCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RedeemVipCode(ctx, req.(*RedeemVipCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListMyInvitees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyInviteesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListMyInvitees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListMyInvitees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListMyInvitees(ctx, req.(*ListMyInviteesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetInviteStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInviteStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetInviteStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetInviteStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetInviteStatistics(ctx, req.(*GetInviteStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_FollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).FollowUser(ctx, req.(*FollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UnfollowUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UnfollowUser(ctx, req.(*UnfollowUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListFollowers(ctx, req.(*ListFollowUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListFollowings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListFollowings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListFollowings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListFollowings(ctx, req.(*ListFollowUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -612,6 +927,42 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePassword",
 			Handler:    _User_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "UpdatePrivacySettings",
+			Handler:    _User_UpdatePrivacySettings_Handler,
+		},
+		{
+			MethodName: "GenerateVipCodes",
+			Handler:    _User_GenerateVipCodes_Handler,
+		},
+		{
+			MethodName: "RedeemVipCode",
+			Handler:    _User_RedeemVipCode_Handler,
+		},
+		{
+			MethodName: "ListMyInvitees",
+			Handler:    _User_ListMyInvitees_Handler,
+		},
+		{
+			MethodName: "GetInviteStatistics",
+			Handler:    _User_GetInviteStatistics_Handler,
+		},
+		{
+			MethodName: "FollowUser",
+			Handler:    _User_FollowUser_Handler,
+		},
+		{
+			MethodName: "UnfollowUser",
+			Handler:    _User_UnfollowUser_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _User_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowings",
+			Handler:    _User_ListFollowings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/v1/user.proto",
@@ -21,16 +21,25 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationUserAddUser = "/api.user.v1.User/AddUser"
 const OperationUserDeleteUser = "/api.user.v1.User/DeleteUser"
+const OperationUserFollowUser = "/api.user.v1.User/FollowUser"
+const OperationUserGenerateVipCodes = "/api.user.v1.User/GenerateVipCodes"
+const OperationUserGetInviteStatistics = "/api.user.v1.User/GetInviteStatistics"
 const OperationUserGetLoginUser = "/api.user.v1.User/GetLoginUser"
 const OperationUserGetUserById = "/api.user.v1.User/GetUserById"
 const OperationUserGetUserVOById = "/api.user.v1.User/GetUserVOById"
+const OperationUserListFollowers = "/api.user.v1.User/ListFollowers"
+const OperationUserListFollowings = "/api.user.v1.User/ListFollowings"
+const OperationUserListMyInvitees = "/api.user.v1.User/ListMyInvitees"
 const OperationUserListUserByPage = "/api.user.v1.User/ListUserByPage"
 const OperationUserLogin = "/api.user.v1.User/Login"
 const OperationUserLogout = "/api.user.v1.User/Logout"
+const OperationUserRedeemVipCode = "/api.user.v1.User/RedeemVipCode"
 const OperationUserRegister = "/api.user.v1.User/Register"
 const OperationUserSendEmailVerificationCode = "/api.user.v1.User/SendEmailVerificationCode"
+const OperationUserUnfollowUser = "/api.user.v1.User/UnfollowUser"
 const OperationUserUpdateMyInfo = "/api.user.v1.User/UpdateMyInfo"
 const OperationUserUpdatePassword = "/api.user.v1.User/UpdatePassword"
+const OperationUserUpdatePrivacySettings = "/api.user.v1.User/UpdatePrivacySettings"
 const OperationUserUpdateUser = "/api.user.v1.User/UpdateUser"
 const OperationUserVerifyAndUpdateEmail = "/api.user.v1.User/VerifyAndUpdateEmail"
 
@@ -39,26 +48,44 @@ type UserHTTPServer interface {
 	AddUser(context.Context, *AddUserRequest) (*AddUserReply, error)
 	// DeleteUser 删除用户（仅管理员）
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserReply, error)
+	// FollowUser 关注用户
+	FollowUser(context.Context, *FollowUserRequest) (*FollowUserReply, error)
+	// GenerateVipCodes 批量生成会员兑换码（仅管理员）
+	GenerateVipCodes(context.Context, *GenerateVipCodesRequest) (*GenerateVipCodesReply, error)
+	// GetInviteStatistics 获取邀请统计（仅管理员）
+	GetInviteStatistics(context.Context, *GetInviteStatisticsRequest) (*GetInviteStatisticsReply, error)
 	// GetLoginUser 获取当前登录用户
 	GetLoginUser(context.Context, *GetLoginUserRequest) (*GetLoginUserReply, error)
 	// GetUserById 根据 ID 获取用户（仅管理员）
 	GetUserById(context.Context, *GetUserByIdRequest) (*GetUserByIdReply, error)
 	// GetUserVOById 根据 ID 获取用户 VO
 	GetUserVOById(context.Context, *GetUserVOByIdRequest) (*GetUserVOByIdReply, error)
+	// ListFollowers 分页获取粉丝列表
+	ListFollowers(context.Context, *ListFollowUsersRequest) (*ListFollowUsersReply, error)
+	// ListFollowings 分页获取关注列表
+	ListFollowings(context.Context, *ListFollowUsersRequest) (*ListFollowUsersReply, error)
+	// ListMyInvitees 分页获取我邀请的用户
+	ListMyInvitees(context.Context, *ListMyInviteesRequest) (*ListMyInviteesReply, error)
 	// ListUserByPage 分页获取用户列表（仅管理员）
 	ListUserByPage(context.Context, *ListUserByPageRequest) (*ListUserByPageReply, error)
 	// Login 用户登录
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
 	// Logout 用户注销
 	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	// RedeemVipCode 兑换会员
+	RedeemVipCode(context.Context, *RedeemVipCodeRequest) (*RedeemVipCodeReply, error)
 	// Register 用户注册
 	Register(context.Context, *RegisterRequest) (*RegisterReply, error)
 	// SendEmailVerificationCode 发送邮箱验证码
 	SendEmailVerificationCode(context.Context, *SendEmailVerificationCodeRequest) (*SendEmailVerificationCodeReply, error)
+	// UnfollowUser 取消关注
+	UnfollowUser(context.Context, *UnfollowUserRequest) (*UnfollowUserReply, error)
 	// UpdateMyInfo 更新个人信息（用户自己）
 	UpdateMyInfo(context.Context, *UpdateMyInfoRequest) (*UpdateMyInfoReply, error)
 	// UpdatePassword 修改用户登录密码
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordReply, error)
+	// UpdatePrivacySettings 更新隐私设置
+	UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*UpdatePrivacySettingsReply, error)
 	// UpdateUser 更新用户（仅管理员）
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserReply, error)
 	// VerifyAndUpdateEmail 验证码校验并更新邮箱
@@ -81,6 +108,15 @@ func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
 	r.POST("/api/user/email/sendcode", _User_SendEmailVerificationCode0_HTTP_Handler(srv))
 	r.POST("/api/user/email/verifycode", _User_VerifyAndUpdateEmail0_HTTP_Handler(srv))
 	r.POST("/api/user/update/password", _User_UpdatePassword0_HTTP_Handler(srv))
+	r.POST("/api/user/update/privacy", _User_UpdatePrivacySettings0_HTTP_Handler(srv))
+	r.POST("/api/user/vip/code/generate", _User_GenerateVipCodes0_HTTP_Handler(srv))
+	r.POST("/api/user/vip/redeem", _User_RedeemVipCode0_HTTP_Handler(srv))
+	r.POST("/api/user/invite/list/page", _User_ListMyInvitees0_HTTP_Handler(srv))
+	r.GET("/api/user/invite/statistics", _User_GetInviteStatistics0_HTTP_Handler(srv))
+	r.POST("/api/user/follow", _User_FollowUser0_HTTP_Handler(srv))
+	r.POST("/api/user/unfollow", _User_UnfollowUser0_HTTP_Handler(srv))
+	r.POST("/api/user/follower/list/page", _User_ListFollowers0_HTTP_Handler(srv))
+	r.POST("/api/user/following/list/page", _User_ListFollowings0_HTTP_Handler(srv))
 }
 
 func _User_Register0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
@@ -382,31 +418,244 @@ func _User_UpdatePassword0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Contex
 	}
 }
 
+func _User_UpdatePrivacySettings0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePrivacySettingsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserUpdatePrivacySettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePrivacySettings(ctx, req.(*UpdatePrivacySettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdatePrivacySettingsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_GenerateVipCodes0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GenerateVipCodesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserGenerateVipCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GenerateVipCodes(ctx, req.(*GenerateVipCodesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GenerateVipCodesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_RedeemVipCode0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RedeemVipCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserRedeemVipCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RedeemVipCode(ctx, req.(*RedeemVipCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RedeemVipCodeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListMyInvitees0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyInviteesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListMyInvitees)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyInvitees(ctx, req.(*ListMyInviteesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMyInviteesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_GetInviteStatistics0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetInviteStatisticsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserGetInviteStatistics)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetInviteStatistics(ctx, req.(*GetInviteStatisticsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetInviteStatisticsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_FollowUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FollowUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserFollowUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FollowUser(ctx, req.(*FollowUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*FollowUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_UnfollowUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnfollowUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserUnfollowUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnfollowUser(ctx, req.(*UnfollowUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnfollowUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListFollowers0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowUsersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListFollowers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowers(ctx, req.(*ListFollowUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListFollowUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListFollowings0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowUsersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListFollowings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowings(ctx, req.(*ListFollowUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListFollowUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserHTTPClient interface {
 	// AddUser 创建用户（仅管理员）
 	AddUser(ctx context.Context, req *AddUserRequest, opts ...http.CallOption) (rsp *AddUserReply, err error)
 	// DeleteUser 删除用户（仅管理员）
 	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *DeleteUserReply, err error)
+	// FollowUser 关注用户
+	FollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *FollowUserReply, err error)
+	// GenerateVipCodes 批量生成会员兑换码（仅管理员）
+	GenerateVipCodes(ctx context.Context, req *GenerateVipCodesRequest, opts ...http.CallOption) (rsp *GenerateVipCodesReply, err error)
+	// GetInviteStatistics 获取邀请统计（仅管理员）
+	GetInviteStatistics(ctx context.Context, req *GetInviteStatisticsRequest, opts ...http.CallOption) (rsp *GetInviteStatisticsReply, err error)
 	// GetLoginUser 获取当前登录用户
 	GetLoginUser(ctx context.Context, req *GetLoginUserRequest, opts ...http.CallOption) (rsp *GetLoginUserReply, err error)
 	// GetUserById 根据 ID 获取用户（仅管理员）
 	GetUserById(ctx context.Context, req *GetUserByIdRequest, opts ...http.CallOption) (rsp *GetUserByIdReply, err error)
 	// GetUserVOById 根据 ID 获取用户 VO
 	GetUserVOById(ctx context.Context, req *GetUserVOByIdRequest, opts ...http.CallOption) (rsp *GetUserVOByIdReply, err error)
+	// ListFollowers 分页获取粉丝列表
+	ListFollowers(ctx context.Context, req *ListFollowUsersRequest, opts ...http.CallOption) (rsp *ListFollowUsersReply, err error)
+	// ListFollowings 分页获取关注列表
+	ListFollowings(ctx context.Context, req *ListFollowUsersRequest, opts ...http.CallOption) (rsp *ListFollowUsersReply, err error)
+	// ListMyInvitees 分页获取我邀请的用户
+	ListMyInvitees(ctx context.Context, req *ListMyInviteesRequest, opts ...http.CallOption) (rsp *ListMyInviteesReply, err error)
 	// ListUserByPage 分页获取用户列表（仅管理员）
 	ListUserByPage(ctx context.Context, req *ListUserByPageRequest, opts ...http.CallOption) (rsp *ListUserByPageReply, err error)
 	// Login 用户登录
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
 	// Logout 用户注销
 	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	// RedeemVipCode 兑换会员
+	RedeemVipCode(ctx context.Context, req *RedeemVipCodeRequest, opts ...http.CallOption) (rsp *RedeemVipCodeReply, err error)
 	// Register 用户注册
 	Register(ctx context.Context, req *RegisterRequest, opts ...http.CallOption) (rsp *RegisterReply, err error)
 	// SendEmailVerificationCode 发送邮箱验证码
 	SendEmailVerificationCode(ctx context.Context, req *SendEmailVerificationCodeRequest, opts ...http.CallOption) (rsp *SendEmailVerificationCodeReply, err error)
+	// UnfollowUser 取消关注
+	UnfollowUser(ctx context.Context, req *UnfollowUserRequest, opts ...http.CallOption) (rsp *UnfollowUserReply, err error)
 	// UpdateMyInfo 更新个人信息（用户自己）
 	UpdateMyInfo(ctx context.Context, req *UpdateMyInfoRequest, opts ...http.CallOption) (rsp *UpdateMyInfoReply, err error)
 	// UpdatePassword 修改用户登录密码
 	UpdatePassword(ctx context.Context, req *UpdatePasswordRequest, opts ...http.CallOption) (rsp *UpdatePasswordReply, err error)
+	// UpdatePrivacySettings 更新隐私设置
+	UpdatePrivacySettings(ctx context.Context, req *UpdatePrivacySettingsRequest, opts ...http.CallOption) (rsp *UpdatePrivacySettingsReply, err error)
 	// UpdateUser 更新用户（仅管理员）
 	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UpdateUserReply, err error)
 	// VerifyAndUpdateEmail 验证码校验并更新邮箱
@@ -449,6 +698,48 @@ func (c *UserHTTPClientImpl) DeleteUser(ctx context.Context, in *DeleteUserReque
 	return &out, nil
 }
 
+// FollowUser 关注用户
+func (c *UserHTTPClientImpl) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...http.CallOption) (*FollowUserReply, error) {
+	var out FollowUserReply
+	pattern := "/api/user/follow"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserFollowUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GenerateVipCodes 批量生成会员兑换码（仅管理员）
+func (c *UserHTTPClientImpl) GenerateVipCodes(ctx context.Context, in *GenerateVipCodesRequest, opts ...http.CallOption) (*GenerateVipCodesReply, error) {
+	var out GenerateVipCodesReply
+	pattern := "/api/user/vip/code/generate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserGenerateVipCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetInviteStatistics 获取邀请统计（仅管理员）
+func (c *UserHTTPClientImpl) GetInviteStatistics(ctx context.Context, in *GetInviteStatisticsRequest, opts ...http.CallOption) (*GetInviteStatisticsReply, error) {
+	var out GetInviteStatisticsReply
+	pattern := "/api/user/invite/statistics"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserGetInviteStatistics))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetLoginUser 获取当前登录用户
 func (c *UserHTTPClientImpl) GetLoginUser(ctx context.Context, in *GetLoginUserRequest, opts ...http.CallOption) (*GetLoginUserReply, error) {
 	var out GetLoginUserReply
@@ -491,6 +782,48 @@ func (c *UserHTTPClientImpl) GetUserVOById(ctx context.Context, in *GetUserVOByI
 	return &out, nil
 }
 
+// ListFollowers 分页获取粉丝列表
+func (c *UserHTTPClientImpl) ListFollowers(ctx context.Context, in *ListFollowUsersRequest, opts ...http.CallOption) (*ListFollowUsersReply, error) {
+	var out ListFollowUsersReply
+	pattern := "/api/user/follower/list/page"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserListFollowers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListFollowings 分页获取关注列表
+func (c *UserHTTPClientImpl) ListFollowings(ctx context.Context, in *ListFollowUsersRequest, opts ...http.CallOption) (*ListFollowUsersReply, error) {
+	var out ListFollowUsersReply
+	pattern := "/api/user/following/list/page"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserListFollowings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListMyInvitees 分页获取我邀请的用户
+func (c *UserHTTPClientImpl) ListMyInvitees(ctx context.Context, in *ListMyInviteesRequest, opts ...http.CallOption) (*ListMyInviteesReply, error) {
+	var out ListMyInviteesReply
+	pattern := "/api/user/invite/list/page"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserListMyInvitees))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListUserByPage 分页获取用户列表（仅管理员）
 func (c *UserHTTPClientImpl) ListUserByPage(ctx context.Context, in *ListUserByPageRequest, opts ...http.CallOption) (*ListUserByPageReply, error) {
 	var out ListUserByPageReply
@@ -533,6 +866,20 @@ func (c *UserHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts
 	return &out, nil
 }
 
+// RedeemVipCode 兑换会员
+func (c *UserHTTPClientImpl) RedeemVipCode(ctx context.Context, in *RedeemVipCodeRequest, opts ...http.CallOption) (*RedeemVipCodeReply, error) {
+	var out RedeemVipCodeReply
+	pattern := "/api/user/vip/redeem"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserRedeemVipCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Register 用户注册
 func (c *UserHTTPClientImpl) Register(ctx context.Context, in *RegisterRequest, opts ...http.CallOption) (*RegisterReply, error) {
 	var out RegisterReply
@@ -561,6 +908,20 @@ func (c *UserHTTPClientImpl) SendEmailVerificationCode(ctx context.Context, in *
 	return &out, nil
 }
 
+// UnfollowUser 取消关注
+func (c *UserHTTPClientImpl) UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...http.CallOption) (*UnfollowUserReply, error) {
+	var out UnfollowUserReply
+	pattern := "/api/user/unfollow"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserUnfollowUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateMyInfo 更新个人信息（用户自己）
 func (c *UserHTTPClientImpl) UpdateMyInfo(ctx context.Context, in *UpdateMyInfoRequest, opts ...http.CallOption) (*UpdateMyInfoReply, error) {
 	var out UpdateMyInfoReply
@@ -589,6 +950,20 @@ func (c *UserHTTPClientImpl) UpdatePassword(ctx context.Context, in *UpdatePassw
 	return &out, nil
 }
 
+// UpdatePrivacySettings 更新隐私设置
+func (c *UserHTTPClientImpl) UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...http.CallOption) (*UpdatePrivacySettingsReply, error) {
+	var out UpdatePrivacySettingsReply
+	pattern := "/api/user/update/privacy"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserUpdatePrivacySettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateUser 更新用户（仅管理员）
 func (c *UserHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...http.CallOption) (*UpdateUserReply, error) {
 	var out UpdateUserReply
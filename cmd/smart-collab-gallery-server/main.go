@@ -60,7 +60,7 @@ func init() {
 	}
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, cs *server.CounterFlushServer, ns *server.NotificationPushServer, ms *server.MultipartCleanupServer, ts *server.TagSuggestRefreshServer) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -70,6 +70,10 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			cs,
+			ns,
+			ms,
+			ts,
 		),
 	)
 }
@@ -32,22 +32,64 @@ func wireApp(bootstrap *conf.Bootstrap, logger log.Logger) (*kratos.App, func(),
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
 	userRepo := data.NewUserRepo(dataData, logger)
-	userUsecase := biz.NewUserUsecase(userRepo, logger)
+	vipRepo := data.NewVipRepo(dataData, logger)
+	transaction := data.NewTransaction(dataData)
+	vipUsecase := biz.NewVipUsecase(vipRepo, userRepo, transaction, logger)
+	pictureSearcher, err := data.NewPictureSearcher(dataData, bootstrap, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userUsecase := biz.NewUserUsecase(userRepo, vipUsecase, pictureSearcher, bootstrap, logger)
+	followRepo := data.NewFollowRepo(dataData, logger)
+	feedRepo := data.NewFeedRepo(dataData, logger)
+	followUsecase := biz.NewFollowUsecase(followRepo, userRepo, feedRepo, logger)
 	jwtManager := service.NewJWTManager(bootstrap)
-	userService := service.NewUserService(userUsecase, jwtManager, logger)
+	userService := service.NewUserService(userUsecase, vipUsecase, followUsecase, jwtManager, logger)
 	pictureRepo := data.NewPictureRepo(dataData, logger)
-	pictureUsecase := biz.NewPictureUsecase(pictureRepo, userRepo, logger)
-	pictureService := service.NewPictureService(pictureUsecase, logger)
-	healthService := service.NewHealthService()
-	grpcServer := server.NewGRPCServer(bootstrap, greeterService, userService, healthService, logger)
+	pictureInteractionRepo := data.NewPictureInteractionRepo(dataData, logger)
+	notificationRepo := data.NewNotificationRepo(dataData, logger)
+	notificationUsecase := biz.NewNotificationUsecase(notificationRepo, userRepo, logger)
+	uploadRepo := data.NewUploadRepo(dataData, logger)
+	quotaRepo := data.NewQuotaRepo(dataData, logger)
+	spaceRepo := data.NewSpaceRepo(dataData, logger)
+	quotaUsecase := biz.NewQuotaUsecase(quotaRepo, uploadRepo, userRepo, spaceRepo, bootstrap, logger)
+	taxonomyRepo := data.NewTaxonomyRepo(dataData, logger)
+	tagSuggester := data.NewTagSuggester(dataData, bootstrap, logger)
+	taxonomyUsecase := biz.NewTaxonomyUsecase(taxonomyRepo, pictureSearcher, tagSuggester, logger)
+	albumRepo := data.NewAlbumRepo(dataData, logger)
+	pictureVersionRepo := data.NewPictureVersionRepo(dataData, logger)
 	cosManager, err := service.NewCOSManager(bootstrap, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	fileService := service.NewFileService(cosManager, logger)
-	httpServer := server.NewHTTPServer(bootstrap, greeterService, userService, fileService, pictureService, healthService, jwtManager, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	pictureFileRepo := data.NewPictureFileRepo(cosManager, logger)
+	pictureUsecase := biz.NewPictureUsecase(pictureRepo, userRepo, followRepo, feedRepo, pictureInteractionRepo, notificationUsecase, uploadRepo, quotaUsecase, pictureSearcher, taxonomyUsecase, albumRepo, spaceRepo, pictureVersionRepo, pictureFileRepo, transaction, bootstrap, logger)
+	pictureService := service.NewPictureService(pictureUsecase, cosManager, jwtManager, bootstrap, logger)
+	commentRepo := data.NewCommentRepo(dataData, logger)
+	commentUsecase := biz.NewCommentUsecase(commentRepo, pictureRepo, userRepo, pictureInteractionRepo, notificationUsecase, bootstrap, logger)
+	commentService := service.NewCommentService(commentUsecase, logger)
+	notificationService := service.NewNotificationService(notificationUsecase, jwtManager, logger)
+	albumUsecase := biz.NewAlbumUsecase(albumRepo, pictureRepo, userRepo, pictureUsecase, transaction, logger)
+	albumService := service.NewAlbumService(albumUsecase, pictureService, logger)
+	spaceUsecase := biz.NewSpaceUsecase(spaceRepo, pictureSearcher, logger)
+	spaceService := service.NewSpaceService(spaceUsecase, logger)
+	healthService := service.NewHealthService()
+	grpcServer := server.NewGRPCServer(bootstrap, greeterService, userService, healthService, logger)
+	uploadUsecase := biz.NewUploadUsecase(uploadRepo, logger)
+	fileService := service.NewFileService(cosManager, uploadUsecase, quotaUsecase, logger)
+	shareRepo := data.NewShareRepo(dataData, logger)
+	shareUsecase := biz.NewShareUsecase(shareRepo, pictureRepo, albumRepo, pictureUsecase, albumUsecase, bootstrap, logger)
+	shareService := service.NewShareService(shareUsecase, pictureService, albumService, cosManager, bootstrap, logger)
+	quotaService := service.NewQuotaService(quotaUsecase, logger)
+	taxonomyService := service.NewTaxonomyService(taxonomyUsecase, logger)
+	httpServer := server.NewHTTPServer(bootstrap, greeterService, userService, fileService, pictureService, commentService, notificationService, albumService, spaceService, shareService, quotaService, taxonomyService, healthService, jwtManager, logger)
+	counterFlushServer := server.NewCounterFlushServer(pictureUsecase, logger)
+	notificationPushServer := server.NewNotificationPushServer(notificationUsecase, logger)
+	multipartCleanupServer := server.NewMultipartCleanupServer(cosManager, logger)
+	tagSuggestRefreshServer := server.NewTagSuggestRefreshServer(taxonomyUsecase, bootstrap, logger)
+	app := newApp(logger, grpcServer, httpServer, counterFlushServer, notificationPushServer, multipartCleanupServer, tagSuggestRefreshServer)
 	return app, func() {
 		cleanup()
 	}, nil
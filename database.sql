@@ -22,8 +22,12 @@ create table if not exists user
     vipNumber     bigint       null comment '会员编号',
     shareCode     varchar(20)  DEFAULT NULL COMMENT '分享码',
     inviteUser    bigint       DEFAULT NULL COMMENT '邀请用户 id',
+    keepPhotoMetadata tinyint  default 0           not null comment '上传图片时是否保留位置等敏感元数据',
     UNIQUE KEY uk_userAccount (userAccount),
-    INDEX idx_userName (userName)
+    INDEX idx_userName (userName),
+    FULLTEXT INDEX ft_userName (userName) WITH PARSER ngram, -- 按作者昵称全文检索图片
+    UNIQUE KEY uk_shareCode (shareCode),
+    INDEX idx_inviteUser (inviteUser)
     ) comment '用户' collate = utf8mb4_unicode_ci;
 
 -- 图片表
@@ -34,13 +38,29 @@ create table if not exists picture
     name         varchar(128)                       not null comment '图片名称',
     introduction varchar(512)                       null comment '简介',
     category     varchar(64)                        null comment '分类',
-    tags         varchar(512)                      null comment '标签（JSON 数组）',
+    tags         varchar(512)                      null comment '标签（JSON 数组，与 picture_tag 同步，用于展示和全文检索）',
     picSize      bigint                             null comment '图片体积',
     picWidth     int                                null comment '图片宽度',
     picHeight    int                                null comment '图片高度',
     picScale     double                             null comment '图片宽高比例',
     picFormat    varchar(32)                        null comment '图片格式',
+    pHash        bigint unsigned                    null comment '感知哈希（dHash）',
+    picColor     varchar(16)                        null comment '主色调（#RRGGBB）',
+    picPalette   varchar(128)                       null comment '调色板（JSON 数组）',
+    colorL       double                             null comment '主色调 CIELAB L',
+    colorA       double                             null comment '主色调 CIELAB a',
+    colorB       double                             null comment '主色调 CIELAB b',
+    picMetadata  text                               null comment '拍摄信息（JSON，来自 EXIF）',
     userId       bigint                             not null comment '创建用户 id',
+    spaceId      bigint                             null comment '所属空间 id，公共图库中的图片为空',
+    reviewStatus int      default 1                 not null comment '审核状态：0-待审核; 1-通过; 2-拒绝',
+    reviewMessage varchar(512)                      null comment '审核信息',
+    reviewerId   bigint                             null comment '审核人 id',
+    reviewTime   datetime                           null comment '审核时间',
+    likeCount     bigint   default 0                 not null comment '点赞数',
+    favoriteCount bigint   default 0                 not null comment '收藏数',
+    viewCount     bigint   default 0                 not null comment '浏览数',
+    commentCount  bigint   default 0                 not null comment '评论数',
     createTime   datetime default CURRENT_TIMESTAMP not null comment '创建时间',
     editTime     datetime default CURRENT_TIMESTAMP not null comment '编辑时间',
     updateTime   datetime default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP comment '更新时间',
@@ -49,6 +69,292 @@ create table if not exists picture
     INDEX idx_introduction (introduction), -- 用于模糊搜索图片简介
     INDEX idx_category (category),         -- 提升基于分类的查询性能
     INDEX idx_tags (tags),                 -- 提升基于标签的查询性能
-    INDEX idx_userId (userId)              -- 提升基于用户 ID 的查询性能
+    INDEX idx_userId (userId),             -- 提升基于用户 ID 的查询性能
+    INDEX idx_spaceId (spaceId),           -- 提升查询空间图片的性能
+    INDEX idx_reviewStatus (reviewStatus), -- 提升按审核状态查询的性能
+    INDEX idx_colorL (colorL),             -- 按颜色搜索时按亮度范围缩小扫描范围
+    INDEX idx_url (url),                   -- 清理历史版本后检查旧图片文件是否仍被引用
+    FULLTEXT INDEX ft_picture_search (name, introduction, category, tags) WITH PARSER ngram -- 全文检索（ngram 分词，支持中文）
     ) comment '图片' collate = utf8mb4_unicode_ci;
 
+
+-- 会员兑换码表
+create table if not exists vip_code
+(
+    id         bigint auto_increment comment 'id' primary key,
+    code       varchar(128)                       not null comment '兑换码',
+    vipDays    int                                not null comment '每次兑换增加的会员天数',
+    maxUses    int      default 1                 not null comment '最大使用次数',
+    usedCount  int      default 0                 not null comment '已使用次数',
+    expireTime datetime                           null comment '兑换码过期时间（为空表示永久有效）',
+    createUser bigint                             not null comment '创建人 id',
+    createTime datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    updateTime datetime default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP comment '更新时间',
+    isDelete   tinyint  default 0                 not null comment '是否删除',
+    UNIQUE KEY uk_code (code)
+    ) comment '会员兑换码' collate = utf8mb4_unicode_ci;
+
+-- 会员兑换记录表
+create table if not exists vip_redeem_record
+(
+    id         bigint auto_increment comment 'id' primary key,
+    codeId     bigint                             not null comment '兑换码 id',
+    userId     bigint                             not null comment '兑换用户 id',
+    createTime datetime default CURRENT_TIMESTAMP not null comment '兑换时间',
+    UNIQUE KEY uk_codeId_userId (codeId, userId), -- 同一用户不能重复兑换同一兑换码
+    INDEX idx_userId (userId)
+    ) comment '会员兑换记录' collate = utf8mb4_unicode_ci;
+
+-- 用户关注表
+create table if not exists user_follow
+(
+    id           bigint auto_increment comment 'id' primary key,
+    userId       bigint                             not null comment '关注者 id',
+    followUserId bigint                             not null comment '被关注者 id',
+    createTime   datetime default CURRENT_TIMESTAMP not null comment '关注时间',
+    UNIQUE KEY uk_userId_followUserId (userId, followUserId), -- 同一用户不能重复关注
+    INDEX idx_followUserId (followUserId)                      -- 提升查询粉丝列表的性能
+    ) comment '用户关注' collate = utf8mb4_unicode_ci;
+
+-- 图片点赞表
+create table if not exists picture_like
+(
+    id         bigint auto_increment comment 'id' primary key,
+    pictureId  bigint                             not null comment '图片 id',
+    userId     bigint                             not null comment '点赞用户 id',
+    createTime datetime default CURRENT_TIMESTAMP not null comment '点赞时间',
+    UNIQUE KEY uk_pictureId_userId (pictureId, userId), -- 同一用户对同一图片只能点赞一次
+    INDEX idx_userId (userId)
+    ) comment '图片点赞' collate = utf8mb4_unicode_ci;
+
+-- 图片收藏表
+create table if not exists picture_favorite
+(
+    id         bigint auto_increment comment 'id' primary key,
+    pictureId  bigint                             not null comment '图片 id',
+    userId     bigint                             not null comment '收藏用户 id',
+    createTime datetime default CURRENT_TIMESTAMP not null comment '收藏时间',
+    UNIQUE KEY uk_pictureId_userId (pictureId, userId), -- 同一用户对同一图片只能收藏一次
+    INDEX idx_userId (userId)                           -- 提升查询我的收藏的性能
+    ) comment '图片收藏' collate = utf8mb4_unicode_ci;
+
+-- 评论表
+create table if not exists comment
+(
+    id            bigint auto_increment comment 'id' primary key,
+    pictureId     bigint                             not null comment '图片 id',
+    userId        bigint                             not null comment '评论用户 id',
+    parentId      bigint   default 0                 not null comment '所属一级评论 id，0 表示一级评论',
+    replyToUserId bigint   default 0                 not null comment '被回复用户 id',
+    content       varchar(512)                       not null comment '评论内容',
+    mentionUserIds varchar(512)                      null comment '提及的用户 id（JSON 数组）',
+    reviewStatus  int      default 0                 not null comment '审核状态：0-待审核; 1-通过; 2-拒绝',
+    reviewMessage varchar(512)                       null comment '审核信息',
+    reviewerId    bigint                             null comment '审核人 id',
+    reviewTime    datetime                           null comment '审核时间',
+    createTime    datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    editTime      datetime default CURRENT_TIMESTAMP not null comment '编辑时间',
+    updateTime    datetime default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP comment '更新时间',
+    isDelete      tinyint  default 0                 not null comment '是否删除',
+    INDEX idx_pictureId_parentId (pictureId, parentId), -- 提升查询图片评论的性能
+    INDEX idx_parentId (parentId),                      -- 提升查询评论回复的性能
+    INDEX idx_userId (userId),
+    INDEX idx_reviewStatus (reviewStatus)               -- 提升查询待审核评论的性能
+    ) comment '评论' collate = utf8mb4_unicode_ci;
+
+-- 通知表
+create table if not exists notification
+(
+    id         bigint auto_increment comment 'id' primary key,
+    userId     bigint                             not null comment '接收用户 id',
+    senderId   bigint   default 0                 not null comment '触发用户 id，系统通知为 0',
+    type       varchar(32)                        not null comment '通知类型',
+    pictureId  bigint   default 0                 not null comment '关联图片 id',
+    commentId  bigint   default 0                 not null comment '关联评论 id',
+    content    varchar(512)                       null comment '通知内容',
+    notifyOnce tinyint                            null comment '只发送一次的通知（如点赞）为 1，其余为空',
+    isRead     tinyint  default 0                 not null comment '是否已读',
+    createTime datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    INDEX idx_userId_isRead (userId, isRead), -- 提升查询通知列表和未读数的性能
+    UNIQUE KEY uk_notifyOnce (type, senderId, pictureId, notifyOnce) -- 同一用户对同一图片的点赞通知只保存一次
+    ) comment '通知' collate = utf8mb4_unicode_ci;
+
+-- 相册表
+create table if not exists album
+(
+    id             bigint auto_increment comment 'id' primary key,
+    userId         bigint                             not null comment '创建用户 id',
+    name           varchar(128)                       not null comment '相册名称',
+    description    varchar(1024)                      null comment '相册描述',
+    coverPictureId bigint   default 0                 not null comment '封面图片 id',
+    visibility     int      default 0                 not null comment '可见范围：0-私有; 1-仅链接可见; 2-公开',
+    shareCode      varchar(32)                        not null comment '分享码',
+    createTime     datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    editTime       datetime default CURRENT_TIMESTAMP not null comment '编辑时间',
+    updateTime     datetime default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP comment '更新时间',
+    isDelete       tinyint  default 0                 not null comment '是否删除',
+    UNIQUE KEY uk_shareCode (shareCode),
+    INDEX idx_userId (userId),         -- 提升查询用户相册的性能
+    INDEX idx_visibility (visibility)  -- 提升查询公开相册的性能
+    ) comment '相册' collate = utf8mb4_unicode_ci;
+
+-- 相册图片关联表
+create table if not exists album_picture
+(
+    id         bigint auto_increment comment 'id' primary key,
+    albumId    bigint                             not null comment '相册 id',
+    pictureId  bigint                             not null comment '图片 id',
+    sortOrder  bigint   default 0                 not null comment '相册内排序，越小越靠前',
+    createTime datetime default CURRENT_TIMESTAMP not null comment '加入时间',
+    UNIQUE KEY uk_albumId_pictureId (albumId, pictureId), -- 同一图片在同一相册中只能出现一次
+    INDEX idx_pictureId (pictureId)
+    ) comment '相册图片' collate = utf8mb4_unicode_ci;
+
+-- 空间表
+create table if not exists space
+(
+    id            bigint auto_increment comment 'id' primary key,
+    userId        bigint                             not null comment '创建用户 id',
+    spaceName     varchar(128)                       not null comment '空间名称',
+    duplicateMode varchar(16) default ''             not null comment '上传查重方式：off-不检测; warn-提示; reject-拒绝; 为空时使用全局配置',
+    maxSize       bigint   default 0                 not null comment '图片总体积上限（字节），0 表示不限',
+    maxCount      bigint   default 0                 not null comment '图片数量上限，0 表示不限',
+    totalSize     bigint   default 0                 not null comment '已用体积（字节）',
+    totalCount    bigint   default 0                 not null comment '图片数量',
+    versionMaxCount int    default 0                 not null comment '每张图片最多保留的历史版本数，0 使用全局配置，负数表示不限制',
+    versionMaxDays  int    default 0                 not null comment '历史版本最长保留天数，0 使用全局配置，负数表示不限制',
+    createTime    datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    editTime      datetime default CURRENT_TIMESTAMP not null comment '编辑时间',
+    updateTime    datetime default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP comment '更新时间',
+    isDelete      tinyint  default 0                 not null comment '是否删除',
+    INDEX idx_userId (userId)  -- 提升查询用户空间的性能
+    ) comment '空间' collate = utf8mb4_unicode_ci;
+
+-- 分享链接表
+create table if not exists share_link
+(
+    id           bigint auto_increment comment 'id' primary key,
+    userId       bigint                             not null comment '创建用户 id',
+    token        varchar(64)                        not null comment '分享链接 token',
+    targetType   varchar(16)                        not null comment '分享对象类型：picture-图片; album-相册',
+    targetId     bigint                             not null comment '分享对象 id',
+    passwordHash varchar(64)                        null comment '访问密码（加密），为空表示不需要密码',
+    maxViews     bigint   default 0                 not null comment '最大访问次数，0 表示不限制',
+    viewCount    bigint   default 0                 not null comment '已访问次数',
+    isRevoked    tinyint  default 0                 not null comment '是否已撤销',
+    expireTime   datetime                           not null comment '过期时间',
+    createTime   datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    updateTime   datetime default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP comment '更新时间',
+    UNIQUE KEY uk_token (token),
+    INDEX idx_userId (userId),                -- 提升查询用户分享链接的性能
+    INDEX idx_target (targetType, targetId)
+    ) comment '分享链接' collate = utf8mb4_unicode_ci;
+
+-- 分享链接访问记录表
+create table if not exists share_access_log
+(
+    id          bigint auto_increment comment 'id' primary key,
+    shareLinkId bigint                             not null comment '分享链接 id',
+    ip          varchar(64)                        null comment '访问者 IP',
+    userAgent   varchar(512)                       null comment '访问者 User-Agent',
+    result      varchar(32)                        not null comment '访问结果：success-成功; password_error-密码错误; expired-已过期; revoked-已撤销; exhausted-访问次数已用完',
+    createTime  datetime default CURRENT_TIMESTAMP not null comment '访问时间',
+    INDEX idx_shareLinkId_createTime (shareLinkId, createTime) -- 提升统计访问记录的性能
+    ) comment '分享链接访问记录' collate = utf8mb4_unicode_ci;
+
+-- 上传记录表
+create table if not exists upload
+(
+    id           bigint auto_increment comment 'id' primary key,
+    userId       bigint                             not null comment '上传用户 id',
+    bucketKey    varchar(64)                        not null comment '存储桶 key',
+    fileKey      varchar(512)                       not null comment '文件 key（路径）',
+    url          varchar(1024)                      not null comment '访问 URL',
+    uploadId     varchar(128)                       null comment 'COS 分片上传 ID，普通上传为空',
+    fileSize     bigint   default 0                 not null comment '文件大小（字节），完成前为声明的大小',
+    contentType  varchar(128)                       null comment '文件 MIME 类型',
+    etag         varchar(128)                       null comment '上传完成时文件的 ETag，图片使用文件时据此确认文件未被改写',
+    status       varchar(16)                        not null comment '状态：pending-等待上传; completed-已完成; consumed-已被图片使用; aborted-已取消; rejected-未通过校验',
+    expireTime   datetime                           not null comment '上传地址过期时间',
+    completeTime datetime                           null comment '完成时间',
+    createTime   datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    updateTime   datetime default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP comment '更新时间',
+    INDEX idx_userId_status (userId, status), -- 提升校验用户已完成上传的性能
+    INDEX idx_userId_createTime (userId, createTime), -- 提升统计每日上传次数的性能
+    INDEX idx_uploadId (uploadId)
+    ) comment '上传记录' collate = utf8mb4_unicode_ci;
+
+-- 用户存储用量及配额表
+create table if not exists user_quota
+(
+    userId          bigint                             not null comment '用户 id' primary key,
+    usedBytes       bigint   default 0                 not null comment '已用存储空间（字节）',
+    pictureCount    bigint   default 0                 not null comment '图片数量',
+    maxBytes        bigint                             null comment '管理员设置的存储空间配额（字节），为空时使用默认配额，0 表示不限制',
+    maxPictures     bigint                             null comment '管理员设置的图片数量配额，为空时使用默认配额，0 表示不限制',
+    maxDailyUploads bigint                             null comment '管理员设置的每日上传次数配额，为空时使用默认配额，0 表示不限制',
+    createTime      datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    updateTime      datetime default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP comment '更新时间'
+    ) comment '用户存储用量及配额' collate = utf8mb4_unicode_ci;
+
+-- 标签表
+create table if not exists tag
+(
+    id         bigint auto_increment comment 'id' primary key,
+    name       varchar(64)                        not null comment '标签名称',
+    createTime datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    updateTime datetime default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP comment '更新时间',
+    UNIQUE KEY uk_name (name)
+    ) comment '标签' collate = utf8mb4_unicode_ci;
+
+-- 分类表（首次启动时预置默认分类，并从图片已有的 category、tags 字段迁移分类和标签）
+create table if not exists category
+(
+    id         bigint auto_increment comment 'id' primary key,
+    name       varchar(64)                        not null comment '分类名称',
+    sortOrder  bigint   default 0                 not null comment '展示顺序，越小越靠前',
+    createTime datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    updateTime datetime default CURRENT_TIMESTAMP not null on update CURRENT_TIMESTAMP comment '更新时间',
+    UNIQUE KEY uk_name (name)
+    ) comment '分类' collate = utf8mb4_unicode_ci;
+
+-- 标签、分类别名表
+create table if not exists taxonomy_alias
+(
+    id         bigint auto_increment comment 'id' primary key,
+    kind       varchar(16)                        not null comment '类型：tag/category',
+    alias      varchar(64)                        not null comment '别名',
+    targetId   bigint                             not null comment '对应的标签或分类 id',
+    createTime datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    UNIQUE KEY uk_kind_alias (kind, alias),     -- 同一类型下别名唯一
+    INDEX idx_kind_targetId (kind, targetId)   -- 提升查询标签、分类别名的性能
+    ) comment '标签、分类别名' collate = utf8mb4_unicode_ci;
+
+-- 图片标签关联表
+create table if not exists picture_tag
+(
+    id         bigint auto_increment comment 'id' primary key,
+    pictureId  bigint                             not null comment '图片 id',
+    tagId      bigint                             not null comment '标签 id',
+    position   bigint   default 0                 not null comment '标签在图片中的顺序',
+    createTime datetime default CURRENT_TIMESTAMP not null comment '创建时间',
+    UNIQUE KEY uk_pictureId_tagId (pictureId, tagId), -- 同一图片不会重复添加同一标签
+    INDEX idx_tagId (tagId)                            -- 提升按标签查询图片和统计使用次数的性能
+    ) comment '图片标签' collate = utf8mb4_unicode_ci;
+
+-- 图片历史版本表
+create table if not exists picture_version
+(
+    id         bigint auto_increment comment 'id' primary key,
+    pictureId  bigint                             not null comment '图片 id',
+    version    bigint                             not null comment '版本号（同一图片内递增）',
+    operation  varchar(32)                        not null comment '修改方式：upload/update/edit/batch_edit/batch_rename/restore',
+    operatorId bigint                             not null comment '修改人 id',
+    url        varchar(512)                       null comment '修改前的图片地址',
+    snapshot   text                               null comment '修改前的图片信息（JSON）',
+    changes    text                               null comment '修改的字段（JSON 数组）',
+    createTime datetime default CURRENT_TIMESTAMP not null comment '修改时间',
+    UNIQUE KEY uk_pictureId_version (pictureId, version), -- 同一图片的版本号唯一
+    INDEX idx_createTime (createTime),                     -- 提升按保留时间清理的性能
+    INDEX idx_url (url)                                    -- 清理版本后检查旧图片文件是否仍被引用
+    ) comment '图片历史版本' collate = utf8mb4_unicode_ci;
@@ -0,0 +1,618 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "smart-collab-gallery-server/api/album/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// AlbumVisibility 相册可见范围
+type AlbumVisibility int32
+
+const (
+	AlbumVisibilityPrivate  AlbumVisibility = 0 // 私有，仅创建者可见
+	AlbumVisibilityUnlisted AlbumVisibility = 1 // 仅链接可见，持有分享码即可访问，不出现在公开列表中
+	AlbumVisibilityPublic   AlbumVisibility = 2 // 公开
+)
+
+const (
+	// albumShareCodeLength 相册分享码长度
+	albumShareCodeLength = 16
+	// maxAlbumNameLength 相册名称最大长度（字符数）
+	maxAlbumNameLength = 64
+	// maxAlbumDescriptionLength 相册描述最大长度（字符数）
+	maxAlbumDescriptionLength = 512
+	// maxAlbumPictures 单个相册最多包含的图片数
+	maxAlbumPictures = 1000
+	// maxAlbumBatchSize 单次添加、移除、排序的最大图片数
+	maxAlbumBatchSize = 100
+	// maxAlbumPageSize 相册分页每页最多条数
+	maxAlbumPageSize = 20
+)
+
+// Album 相册业务对象
+type Album struct {
+	ID             int64
+	UserID         int64
+	Name           string
+	Description    string
+	CoverPictureID int64
+	Visibility     AlbumVisibility
+	ShareCode      string
+	CreateTime     time.Time
+	EditTime       time.Time
+	UpdateTime     time.Time
+}
+
+// AlbumVO 相册视图对象
+type AlbumVO struct {
+	ID             int64
+	UserID         int64
+	Name           string
+	Description    string
+	CoverPictureID int64
+	CoverURL       string
+	Visibility     AlbumVisibility
+	ShareCode      string // 仅创建者可见
+	PictureCount   int64
+	CreateTime     time.Time
+	EditTime       time.Time
+	User           *UserVO
+}
+
+// ObjToVO 将相册业务对象转换为视图对象，分享码需由调用方按权限填充
+func (a *Album) ObjToVO() *AlbumVO {
+	return &AlbumVO{
+		ID:             a.ID,
+		UserID:         a.UserID,
+		Name:           a.Name,
+		Description:    a.Description,
+		CoverPictureID: a.CoverPictureID,
+		Visibility:     a.Visibility,
+		CreateTime:     a.CreateTime,
+		EditTime:       a.EditTime,
+	}
+}
+
+// AlbumQueryParams 相册查询参数
+type AlbumQueryParams struct {
+	UserID       *int64
+	Visibilities []AlbumVisibility // 为空表示不限制
+	Name         string
+	Current      int64
+	PageSize     int64
+}
+
+// AlbumPage 相册分页结果
+type AlbumPage struct {
+	Total    int64
+	List     []*AlbumVO
+	Current  int64
+	PageSize int64
+}
+
+// AlbumRepo 相册仓储接口
+type AlbumRepo interface {
+	// CreateAlbum 创建相册
+	CreateAlbum(ctx context.Context, album *Album) (*Album, error)
+	// GetAlbumByID 根据 ID 查询相册
+	GetAlbumByID(ctx context.Context, id int64) (*Album, error)
+	// GetAlbumForUpdate 根据 ID 查询相册并加行锁（需在事务中调用），用于串行化同一相册的图片添加
+	GetAlbumForUpdate(ctx context.Context, id int64) (*Album, error)
+	// UpdateAlbum 更新相册信息
+	UpdateAlbum(ctx context.Context, album *Album) error
+	// DeleteAlbum 删除相册（逻辑删除），同时移除相册与图片的关联
+	DeleteAlbum(ctx context.Context, id int64) error
+	// ListAlbumByPage 分页查询相册（按创建时间倒序）
+	ListAlbumByPage(ctx context.Context, params *AlbumQueryParams) ([]*Album, int64, error)
+	// CountAlbumPictures 批量统计相册中未删除的图片数
+	CountAlbumPictures(ctx context.Context, albumIDs []int64) (map[int64]int64, error)
+	// AddAlbumPictures 将图片追加到相册末尾，已在相册中的图片会被忽略，返回实际添加的数量
+	AddAlbumPictures(ctx context.Context, albumID int64, pictureIDs []int64) (int64, error)
+	// RemoveAlbumPictures 从相册移除图片，返回实际移除的数量
+	RemoveAlbumPictures(ctx context.Context, albumID int64, pictureIDs []int64) (int64, error)
+	// ReorderAlbumPictures 按 pictureIDs 的顺序重排相册图片，未列出的图片保持原有相对顺序排在后面
+	ReorderAlbumPictures(ctx context.Context, albumID int64, pictureIDs []int64) error
+	// ListAlbumPictureIDsByPage 按相册内顺序分页查询未删除的图片 ID
+	ListAlbumPictureIDsByPage(ctx context.Context, albumID, current, pageSize int64) ([]int64, int64, error)
+	// IsAlbumPicture 判断图片是否在相册中
+	IsAlbumPicture(ctx context.Context, albumID, pictureID int64) (bool, error)
+}
+
+// AlbumUsecase 相册用例
+type AlbumUsecase struct {
+	repo        AlbumRepo
+	pictureRepo PictureRepo
+	userRepo    UserRepo
+	pictureUC   *PictureUsecase // 用于查询并填充相册中的图片
+	tx          Transaction     // 用于添加图片时检查相册容量
+	log         *log.Helper
+}
+
+// NewAlbumUsecase 创建相册用例
+func NewAlbumUsecase(repo AlbumRepo, pictureRepo PictureRepo, userRepo UserRepo, pictureUC *PictureUsecase, tx Transaction, logger log.Logger) *AlbumUsecase {
+	return &AlbumUsecase{
+		repo:        repo,
+		pictureRepo: pictureRepo,
+		userRepo:    userRepo,
+		pictureUC:   pictureUC,
+		tx:          tx,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// AddAlbum 创建相册
+func (uc *AlbumUsecase) AddAlbum(ctx context.Context, userID int64, name, description string, visibility AlbumVisibility, coverPictureID int64) (*AlbumVO, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	name, err := uc.validateAlbum(name, description, visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	shareCode, err := generateRandomCode(albumShareCodeLength)
+	if err != nil {
+		uc.log.Errorf("生成相册分享码失败: %v", err)
+		return nil, v1.ErrorSystemError("创建相册失败")
+	}
+
+	album, err := uc.repo.CreateAlbum(ctx, &Album{
+		UserID:      userID,
+		Name:        name,
+		Description: description,
+		Visibility:  visibility,
+		ShareCode:   shareCode,
+	})
+	if err != nil {
+		uc.log.Errorf("创建相册失败: userID=%d, err=%v", userID, err)
+		return nil, v1.ErrorSystemError("创建相册失败")
+	}
+
+	// 指定的封面图片一并加入相册
+	if coverPictureID > 0 {
+		if _, err := uc.AddAlbumPictures(ctx, userID, album.ID, []int64{coverPictureID}); err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.GetAlbum(ctx, userID, false, album.ID, "")
+}
+
+// UpdateAlbum 更新相册（仅创建者），coverPictureID 为 0 时保留原封面
+func (uc *AlbumUsecase) UpdateAlbum(ctx context.Context, userID, id int64, name, description string, visibility AlbumVisibility, coverPictureID int64) (*AlbumVO, error) {
+	album, err := uc.getOwnAlbum(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	name, err = uc.validateAlbum(name, description, visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	if coverPictureID > 0 && coverPictureID != album.CoverPictureID {
+		in, err := uc.repo.IsAlbumPicture(ctx, id, coverPictureID)
+		if err != nil {
+			uc.log.Errorf("查询相册图片失败: albumID=%d, err=%v", id, err)
+			return nil, v1.ErrorSystemError("更新相册失败")
+		}
+		if !in {
+			return nil, v1.ErrorParamsError("封面图片必须是相册中的图片")
+		}
+	}
+
+	album.Name = name
+	album.Description = description
+	album.Visibility = visibility
+	if coverPictureID > 0 {
+		album.CoverPictureID = coverPictureID
+	}
+	album.EditTime = time.Now()
+	if err := uc.repo.UpdateAlbum(ctx, album); err != nil {
+		uc.log.Errorf("更新相册失败: id=%d, err=%v", id, err)
+		return nil, v1.ErrorSystemError("更新相册失败")
+	}
+
+	return uc.GetAlbum(ctx, userID, false, id, "")
+}
+
+// DeleteAlbum 删除相册（创建者或管理员），相册中的图片不会被删除
+func (uc *AlbumUsecase) DeleteAlbum(ctx context.Context, userID, id int64, isAdmin bool) error {
+	album, err := uc.getAlbum(ctx, id)
+	if err != nil {
+		return err
+	}
+	if album.UserID != userID && !isAdmin {
+		return v1.ErrorAlbumNoAuth("无权限删除该相册")
+	}
+
+	if err := uc.repo.DeleteAlbum(ctx, id); err != nil {
+		uc.log.Errorf("删除相册失败: id=%d, err=%v", id, err)
+		return v1.ErrorSystemError("删除相册失败")
+	}
+	return nil
+}
+
+// GetAlbum 获取相册详情，无权访问的相册视为不存在
+func (uc *AlbumUsecase) GetAlbum(ctx context.Context, viewerID int64, isAdmin bool, id int64, shareCode string) (*AlbumVO, error) {
+	album, err := uc.getAlbum(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canViewAlbum(album, viewerID, isAdmin, shareCode) {
+		return nil, v1.ErrorAlbumNotFound("相册不存在")
+	}
+
+	return uc.toAlbumVO(ctx, album, viewerID), nil
+}
+
+// ListAlbumByPage 分页查询相册，查询自己的相册时返回全部相册，否则只返回公开相册
+func (uc *AlbumUsecase) ListAlbumByPage(ctx context.Context, viewerID, userID int64, name string, current, pageSize int64) (*AlbumPage, error) {
+	if current <= 0 {
+		current = 1
+	}
+	if pageSize <= 0 || pageSize > maxAlbumPageSize {
+		pageSize = maxAlbumPageSize
+	}
+
+	params := &AlbumQueryParams{
+		Name:     strings.TrimSpace(name),
+		Current:  current,
+		PageSize: pageSize,
+	}
+	if userID > 0 {
+		params.UserID = &userID
+	}
+	if userID <= 0 || userID != viewerID {
+		params.Visibilities = []AlbumVisibility{AlbumVisibilityPublic}
+	}
+
+	albums, total, err := uc.repo.ListAlbumByPage(ctx, params)
+	if err != nil {
+		uc.log.Errorf("查询相册列表失败: %v", err)
+		return nil, v1.ErrorSystemError("查询相册列表失败")
+	}
+
+	list := make([]*AlbumVO, 0, len(albums))
+	for _, album := range albums {
+		vo := album.ObjToVO()
+		if album.UserID == viewerID {
+			vo.ShareCode = album.ShareCode
+		}
+		list = append(list, vo)
+	}
+	uc.fillAlbums(ctx, list)
+
+	return &AlbumPage{
+		Total:    total,
+		List:     list,
+		Current:  current,
+		PageSize: pageSize,
+	}, nil
+}
+
+// AddAlbumPictures 向相册添加图片（仅创建者），相册没有封面时使用第一张加入的图片作为封面
+func (uc *AlbumUsecase) AddAlbumPictures(ctx context.Context, userID, albumID int64, pictureIDs []int64) (int64, error) {
+	album, err := uc.getOwnAlbum(ctx, userID, albumID)
+	if err != nil {
+		return 0, err
+	}
+	pictureIDs, err = normalizeAlbumPictureIDs(pictureIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	// 只添加存在的图片
+	pictures, err := uc.pictureRepo.ListPictureByIDs(ctx, pictureIDs)
+	if err != nil {
+		uc.log.Errorf("批量查询图片失败: %v", err)
+		return 0, v1.ErrorSystemError("添加图片失败")
+	}
+	if len(pictures) != len(pictureIDs) {
+		return 0, v1.ErrorParamsError("图片不存在")
+	}
+
+	// 已在相册中的图片不会重复添加，因此在事务中按实际添加后的图片数检查上限
+	// 锁定相册行，避免并发添加同时通过检查
+	var added int64
+	errAlbumFull := v1.ErrorParamsError(fmt.Sprintf("相册最多包含 %d 张图片", maxAlbumPictures))
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		locked, err := uc.repo.GetAlbumForUpdate(ctx, albumID)
+		if err != nil {
+			return err
+		}
+		if locked == nil {
+			return v1.ErrorAlbumNotFound("相册不存在")
+		}
+		album = locked
+
+		if added, err = uc.repo.AddAlbumPictures(ctx, albumID, pictureIDs); err != nil {
+			return err
+		}
+		if added > 0 {
+			counts, err := uc.repo.CountAlbumPictures(ctx, []int64{albumID})
+			if err != nil {
+				return err
+			}
+			if counts[albumID] > maxAlbumPictures {
+				return errAlbumFull
+			}
+		}
+		// 相册没有封面时使用第一张加入的图片作为封面
+		if album.CoverPictureID == 0 {
+			album.CoverPictureID = pictureIDs[0]
+			return uc.repo.UpdateAlbum(ctx, album)
+		}
+		return nil
+	})
+	if err == errAlbumFull || v1.IsAlbumNotFound(err) {
+		return 0, err
+	}
+	if err != nil {
+		uc.log.Errorf("添加相册图片失败: albumID=%d, err=%v", albumID, err)
+		return 0, v1.ErrorSystemError("添加图片失败")
+	}
+
+	return added, nil
+}
+
+// RemoveAlbumPictures 从相册移除图片（仅创建者），移除封面图片时清空封面
+func (uc *AlbumUsecase) RemoveAlbumPictures(ctx context.Context, userID, albumID int64, pictureIDs []int64) (int64, error) {
+	album, err := uc.getOwnAlbum(ctx, userID, albumID)
+	if err != nil {
+		return 0, err
+	}
+	pictureIDs, err = normalizeAlbumPictureIDs(pictureIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	removed, err := uc.repo.RemoveAlbumPictures(ctx, albumID, pictureIDs)
+	if err != nil {
+		uc.log.Errorf("移除相册图片失败: albumID=%d, err=%v", albumID, err)
+		return 0, v1.ErrorSystemError("移除图片失败")
+	}
+
+	for _, id := range pictureIDs {
+		if id == album.CoverPictureID {
+			album.CoverPictureID = 0
+			if err := uc.repo.UpdateAlbum(ctx, album); err != nil {
+				uc.log.Errorf("清空相册封面失败: albumID=%d, err=%v", albumID, err)
+			}
+			break
+		}
+	}
+
+	return removed, nil
+}
+
+// ReorderAlbumPictures 调整相册中图片的顺序（仅创建者）
+func (uc *AlbumUsecase) ReorderAlbumPictures(ctx context.Context, userID, albumID int64, pictureIDs []int64) error {
+	if _, err := uc.getOwnAlbum(ctx, userID, albumID); err != nil {
+		return err
+	}
+	if len(pictureIDs) == 0 {
+		return v1.ErrorParamsError("图片 ID 不能为空")
+	}
+	if len(pictureIDs) > maxAlbumPictures {
+		return v1.ErrorParamsError("图片数量过多")
+	}
+
+	if err := uc.repo.ReorderAlbumPictures(ctx, albumID, pictureIDs); err != nil {
+		uc.log.Errorf("调整相册图片顺序失败: albumID=%d, err=%v", albumID, err)
+		return v1.ErrorSystemError("调整图片顺序失败")
+	}
+	return nil
+}
+
+// ListAlbumPictures 按相册内顺序分页查询图片
+func (uc *AlbumUsecase) ListAlbumPictures(ctx context.Context, viewerID int64, isAdmin bool, albumID int64, shareCode string, current, pageSize int64) (*PicturePage, error) {
+	album, err := uc.getAlbum(ctx, albumID)
+	if err != nil {
+		return nil, err
+	}
+	if !canViewAlbum(album, viewerID, isAdmin, shareCode) {
+		return nil, v1.ErrorAlbumNotFound("相册不存在")
+	}
+	return uc.listAlbumPictures(ctx, viewerID, album.ID, current, pageSize)
+}
+
+// listAlbumPictures 按相册内顺序分页查询图片，不做访问权限校验
+func (uc *AlbumUsecase) listAlbumPictures(ctx context.Context, viewerID, albumID, current, pageSize int64) (*PicturePage, error) {
+	if current <= 0 {
+		current = 1
+	}
+	if pageSize <= 0 || pageSize > maxAlbumPageSize {
+		pageSize = maxAlbumPageSize
+	}
+
+	ids, total, err := uc.repo.ListAlbumPictureIDsByPage(ctx, albumID, current, pageSize)
+	if err != nil {
+		uc.log.Errorf("查询相册图片失败: albumID=%d, err=%v", albumID, err)
+		return nil, v1.ErrorSystemError("查询相册图片失败")
+	}
+
+	list, err := uc.pictureUC.ListPictureVOsByIDs(ctx, ids, viewerID)
+	if err != nil {
+		return nil, v1.ErrorSystemError("查询相册图片失败")
+	}
+	uc.pictureUC.FillInteractions(ctx, viewerID, list)
+
+	return &PicturePage{
+		Total:    total,
+		List:     list,
+		Current:  current,
+		PageSize: pageSize,
+	}, nil
+}
+
+// getAlbum 查询相册，不存在时返回 ALBUM_NOT_FOUND
+func (uc *AlbumUsecase) getAlbum(ctx context.Context, id int64) (*Album, error) {
+	if id <= 0 {
+		return nil, v1.ErrorParamsError("相册 ID 不能为空")
+	}
+
+	album, err := uc.repo.GetAlbumByID(ctx, id)
+	if err != nil {
+		uc.log.Errorf("查询相册失败: id=%d, err=%v", id, err)
+		return nil, v1.ErrorSystemError("查询相册失败")
+	}
+	if album == nil {
+		return nil, v1.ErrorAlbumNotFound("相册不存在")
+	}
+	return album, nil
+}
+
+// toAlbumVO 转换相册为视图对象并填充关联信息，分享码仅对创建者可见
+func (uc *AlbumUsecase) toAlbumVO(ctx context.Context, album *Album, viewerID int64) *AlbumVO {
+	vo := album.ObjToVO()
+	if album.UserID == viewerID {
+		vo.ShareCode = album.ShareCode
+	}
+	uc.fillAlbums(ctx, []*AlbumVO{vo})
+	return vo
+}
+
+// getOwnAlbum 查询当前用户创建的相册
+func (uc *AlbumUsecase) getOwnAlbum(ctx context.Context, userID, id int64) (*Album, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+
+	album, err := uc.getAlbum(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if album.UserID != userID {
+		return nil, v1.ErrorAlbumNoAuth("无权限操作该相册")
+	}
+	return album, nil
+}
+
+// validateAlbum 校验相册信息，返回去除首尾空白后的名称
+func (uc *AlbumUsecase) validateAlbum(name, description string, visibility AlbumVisibility) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", v1.ErrorParamsError("相册名称不能为空")
+	}
+	if utf8.RuneCountInString(name) > maxAlbumNameLength {
+		return "", v1.ErrorParamsError("相册名称过长")
+	}
+	if utf8.RuneCountInString(description) > maxAlbumDescriptionLength {
+		return "", v1.ErrorParamsError("相册描述过长")
+	}
+	if visibility != AlbumVisibilityPrivate && visibility != AlbumVisibilityUnlisted && visibility != AlbumVisibilityPublic {
+		return "", v1.ErrorParamsError("相册可见范围无效")
+	}
+	return name, nil
+}
+
+// fillAlbums 批量填充相册的图片数、封面 URL 和创建者信息
+func (uc *AlbumUsecase) fillAlbums(ctx context.Context, albums []*AlbumVO) {
+	if len(albums) == 0 {
+		return
+	}
+
+	albumIDs := make([]int64, 0, len(albums))
+	coverIDs := make([]int64, 0, len(albums))
+	userIDSet := make(map[int64]struct{})
+	userIDs := make([]int64, 0, len(albums))
+	for _, album := range albums {
+		albumIDs = append(albumIDs, album.ID)
+		if album.CoverPictureID > 0 {
+			coverIDs = append(coverIDs, album.CoverPictureID)
+		}
+		if _, ok := userIDSet[album.UserID]; !ok {
+			userIDSet[album.UserID] = struct{}{}
+			userIDs = append(userIDs, album.UserID)
+		}
+	}
+
+	counts, err := uc.repo.CountAlbumPictures(ctx, albumIDs)
+	if err != nil {
+		uc.log.Errorf("统计相册图片数失败: %v", err)
+	} else {
+		for _, album := range albums {
+			album.PictureCount = counts[album.ID]
+		}
+	}
+
+	if len(coverIDs) > 0 {
+		covers, err := uc.pictureRepo.ListPictureByIDs(ctx, coverIDs)
+		if err != nil {
+			uc.log.Errorf("查询相册封面失败: %v", err)
+		} else {
+			coverMap := make(map[int64]string, len(covers))
+			for _, cover := range covers {
+				coverMap[cover.ID] = cover.URL
+			}
+			for _, album := range albums {
+				album.CoverURL = coverMap[album.CoverPictureID]
+			}
+		}
+	}
+
+	users, err := uc.userRepo.ListUserByIDs(ctx, userIDs)
+	if err != nil {
+		uc.log.Errorf("批量查询用户失败: %v", err)
+		return
+	}
+	userMap := make(map[int64]*UserVO, len(users))
+	for _, user := range users {
+		userMap[user.ID] = &UserVO{
+			ID:          user.ID,
+			UserAccount: user.UserAccount,
+			UserName:    user.UserName,
+			UserAvatar:  user.UserAvatar,
+			UserProfile: user.UserProfile,
+			UserRole:    user.UserRole,
+		}
+	}
+	for _, album := range albums {
+		album.User = userMap[album.UserID]
+	}
+}
+
+// canViewAlbum 判断用户是否可以访问相册
+func canViewAlbum(album *Album, viewerID int64, isAdmin bool, shareCode string) bool {
+	switch {
+	case album.UserID == viewerID, isAdmin:
+		return true
+	case album.Visibility == AlbumVisibilityPublic:
+		return true
+	case album.Visibility == AlbumVisibilityUnlisted:
+		return shareCode != "" && shareCode == album.ShareCode
+	default:
+		return false
+	}
+}
+
+// normalizeAlbumPictureIDs 校验并去重图片 ID，保持原有顺序
+func normalizeAlbumPictureIDs(pictureIDs []int64) ([]int64, error) {
+	if len(pictureIDs) == 0 {
+		return nil, v1.ErrorParamsError("图片 ID 不能为空")
+	}
+	if len(pictureIDs) > maxAlbumBatchSize {
+		return nil, v1.ErrorParamsError("一次最多操作 100 张图片")
+	}
+
+	seen := make(map[int64]struct{}, len(pictureIDs))
+	result := make([]int64, 0, len(pictureIDs))
+	for _, id := range pictureIDs {
+		if id <= 0 {
+			return nil, v1.ErrorParamsError("图片 ID 无效")
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result, nil
+}
@@ -1,6 +1,16 @@
 package biz
 
-import "github.com/google/wire"
+import (
+	"context"
+
+	"github.com/google/wire"
+)
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewGreeterUsecase, NewUserUsecase)
+var ProviderSet = wire.NewSet(NewGreeterUsecase, NewUserUsecase, NewPictureUsecase, NewVipUsecase, NewFollowUsecase, NewCommentUsecase, NewNotificationUsecase, NewAlbumUsecase, NewSpaceUsecase, NewShareUsecase, NewUploadUsecase, NewQuotaUsecase, NewTaxonomyUsecase)
+
+// Transaction 事务接口，由 data 层实现
+type Transaction interface {
+	// ExecTx 在同一个数据库事务中执行 fn，fn 返回错误时整体回滚
+	ExecTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
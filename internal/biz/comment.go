@@ -0,0 +1,662 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "smart-collab-gallery-server/api/comment/v1"
+	"smart-collab-gallery-server/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ReviewStatus 审核状态
+type ReviewStatus int32
+
+const (
+	ReviewStatusPending ReviewStatus = 0 // 待审核
+	ReviewStatusPass    ReviewStatus = 1 // 审核通过
+	ReviewStatusReject  ReviewStatus = 2 // 审核拒绝
+)
+
+const (
+	// maxCommentLength 评论内容最大长度（字符数）
+	maxCommentLength = 500
+	// maxCommentPageSize 评论每页最多返回条数
+	maxCommentPageSize = 20
+	// maxCommentMentions 单条评论最多提及的用户数
+	maxCommentMentions = 10
+)
+
+// mentionPattern 匹配评论中的 @账号
+var mentionPattern = regexp.MustCompile(`@([A-Za-z0-9_.\-]+)`)
+
+// Comment 评论业务对象
+type Comment struct {
+	ID             int64
+	PictureID      int64
+	UserID         int64
+	ParentID       int64 // 所属一级评论 ID，0 表示一级评论（回复只有一层）
+	ReplyToUserID  int64 // 被回复用户 ID
+	Content        string
+	MentionUserIDs []int64
+	ReviewStatus   ReviewStatus
+	ReviewMessage  string
+	ReviewerID     int64
+	ReviewTime     *time.Time
+	CreateTime     time.Time
+	EditTime       time.Time
+	UpdateTime     time.Time
+}
+
+// CommentVO 评论视图对象
+type CommentVO struct {
+	ID             int64
+	PictureID      int64
+	UserID         int64
+	ParentID       int64
+	ReplyToUserID  int64
+	Content        string
+	MentionUserIDs []int64
+	ReviewStatus   ReviewStatus
+	ReviewMessage  string
+	ReplyCount     int64
+	CreateTime     time.Time
+	EditTime       time.Time
+	User           *UserVO
+	ReplyToUser    *UserVO
+	Mentions       []*UserVO
+}
+
+// ObjToVO 将评论业务对象转换为视图对象
+func (c *Comment) ObjToVO() *CommentVO {
+	return &CommentVO{
+		ID:             c.ID,
+		PictureID:      c.PictureID,
+		UserID:         c.UserID,
+		ParentID:       c.ParentID,
+		ReplyToUserID:  c.ReplyToUserID,
+		Content:        c.Content,
+		MentionUserIDs: c.MentionUserIDs,
+		ReviewStatus:   c.ReviewStatus,
+		ReviewMessage:  c.ReviewMessage,
+		CreateTime:     c.CreateTime,
+		EditTime:       c.EditTime,
+	}
+}
+
+// CommentPage 评论游标分页结果
+type CommentPage struct {
+	List       []*CommentVO
+	NextCursor int64
+	HasMore    bool
+}
+
+// CommentRepo 评论仓储接口
+type CommentRepo interface {
+	// CreateComment 创建评论
+	CreateComment(ctx context.Context, comment *Comment) (*Comment, error)
+	// GetCommentByID 根据 ID 查询评论
+	GetCommentByID(ctx context.Context, id int64) (*Comment, error)
+	// UpdateComment 更新评论内容与审核信息，仅当审核状态仍为 expectStatus 时更新，返回是否更新成功
+	UpdateComment(ctx context.Context, comment *Comment, expectStatus ReviewStatus) (bool, error)
+	// DeleteCommentThread 删除评论及其回复（逻辑删除），返回其中审核通过的评论数
+	DeleteCommentThread(ctx context.Context, id int64) (int64, error)
+	// ListRootComments 查询图片 ID 小于 cursor 的一级评论（倒序），只返回审核通过的评论以及 viewerID 自己的评论
+	ListRootComments(ctx context.Context, pictureID, viewerID, cursor int64, limit int) ([]*Comment, error)
+	// ListReplies 查询一级评论下 ID 大于 cursor 的回复（正序），只返回审核通过的回复以及 viewerID 自己的回复
+	ListReplies(ctx context.Context, parentID, viewerID, cursor int64, limit int) ([]*Comment, error)
+	// CountReplies 批量统计一级评论对 viewerID 可见的回复数
+	CountReplies(ctx context.Context, parentIDs []int64, viewerID int64) (map[int64]int64, error)
+	// ListCommentsByReviewStatus 查询指定审核状态中 ID 小于 cursor 的评论（倒序）
+	ListCommentsByReviewStatus(ctx context.Context, status ReviewStatus, cursor int64, limit int) ([]*Comment, error)
+}
+
+// CommentUsecase 评论用例
+type CommentUsecase struct {
+	repo            CommentRepo
+	pictureRepo     PictureRepo
+	userRepo        UserRepo
+	interactionRepo PictureInteractionRepo // 用于图片评论计数
+	notificationUC  *NotificationUsecase   // 用于发送评论、回复、提及和审核通知
+	commentConf     *conf.Comment
+	log             *log.Helper
+}
+
+// NewCommentUsecase 创建评论用例
+func NewCommentUsecase(repo CommentRepo, pictureRepo PictureRepo, userRepo UserRepo, interactionRepo PictureInteractionRepo, notificationUC *NotificationUsecase, bc *conf.Bootstrap, logger log.Logger) *CommentUsecase {
+	return &CommentUsecase{
+		repo:            repo,
+		pictureRepo:     pictureRepo,
+		userRepo:        userRepo,
+		interactionRepo: interactionRepo,
+		notificationUC:  notificationUC,
+		commentConf:     bc.GetComment(),
+		log:             log.NewHelper(logger),
+	}
+}
+
+// AddComment 发表评论，parentID 大于 0 时表示回复
+// 回复某条回复时归入同一条一级评论下，并记录被回复的用户
+func (uc *CommentUsecase) AddComment(ctx context.Context, userID, pictureID, parentID int64, content string) (*CommentVO, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	content, err := uc.validateContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &Comment{
+		PictureID: pictureID,
+		UserID:    userID,
+		Content:   content,
+	}
+
+	if parentID > 0 {
+		target, err := uc.getVisibleComment(ctx, parentID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if pictureID > 0 && pictureID != target.PictureID {
+			return nil, v1.ErrorParamsError("回复的评论不属于该图片")
+		}
+		comment.PictureID = target.PictureID
+		comment.ParentID = target.ID
+		if target.ParentID > 0 {
+			comment.ParentID = target.ParentID
+		}
+		comment.ReplyToUserID = target.UserID
+	}
+	if comment.PictureID <= 0 {
+		return nil, v1.ErrorParamsError("图片 ID 不能为空")
+	}
+
+	picture, err := uc.pictureRepo.GetPictureByID(ctx, comment.PictureID)
+	if err != nil {
+		uc.log.Errorf("查询图片失败: id=%d, err=%v", comment.PictureID, err)
+		return nil, v1.ErrorSystemError("发表评论失败")
+	}
+	if picture == nil {
+		return nil, v1.ErrorPictureNotFound("图片不存在")
+	}
+
+	comment.MentionUserIDs = uc.parseMentions(ctx, content, userID)
+	comment.ReviewStatus, comment.ReviewMessage = uc.moderate(content)
+
+	created, err := uc.repo.CreateComment(ctx, comment)
+	if err != nil {
+		uc.log.Errorf("发表评论失败: pictureID=%d, userID=%d, err=%v", comment.PictureID, userID, err)
+		return nil, v1.ErrorSystemError("发表评论失败")
+	}
+	if created.ReviewStatus == ReviewStatusPass {
+		uc.incrCommentCount(ctx, created.PictureID, 1)
+		uc.notifyComment(ctx, created, picture.UserID)
+	}
+
+	vo := created.ObjToVO()
+	uc.fillCommentUsers(ctx, []*CommentVO{vo})
+	return vo, nil
+}
+
+// EditComment 编辑评论（仅作者），内容变更后重新审核
+func (uc *CommentUsecase) EditComment(ctx context.Context, userID, id int64, content string) (*CommentVO, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	content, err := uc.validateContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := uc.getComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.UserID != userID {
+		return nil, v1.ErrorCommentNoAuth("只能编辑自己的评论")
+	}
+
+	oldStatus := comment.ReviewStatus
+	comment.Content = content
+	comment.MentionUserIDs = uc.parseMentions(ctx, content, userID)
+	comment.ReviewStatus, comment.ReviewMessage = uc.moderate(content)
+	comment.ReviewerID = 0
+	comment.ReviewTime = nil
+	comment.EditTime = time.Now()
+
+	if err := uc.updateComment(ctx, comment, oldStatus); err != nil {
+		return nil, err
+	}
+
+	vo := comment.ObjToVO()
+	uc.fillCommentUsers(ctx, []*CommentVO{vo})
+	return vo, nil
+}
+
+// DeleteComment 删除评论，评论作者、图片作者和管理员可以删除，删除一级评论时同时删除其回复
+func (uc *CommentUsecase) DeleteComment(ctx context.Context, userID, id int64, isAdmin bool) error {
+	if userID <= 0 {
+		return v1.ErrorUnauthorized("请先登录")
+	}
+
+	comment, err := uc.getComment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != userID && !isAdmin {
+		picture, err := uc.pictureRepo.GetPictureByID(ctx, comment.PictureID)
+		if err != nil {
+			uc.log.Errorf("查询图片失败: id=%d, err=%v", comment.PictureID, err)
+			return v1.ErrorSystemError("删除评论失败")
+		}
+		if picture == nil || picture.UserID != userID {
+			return v1.ErrorCommentNoAuth("无权限删除该评论")
+		}
+	}
+
+	approved, err := uc.repo.DeleteCommentThread(ctx, id)
+	if err != nil {
+		uc.log.Errorf("删除评论失败: id=%d, err=%v", id, err)
+		return v1.ErrorSystemError("删除评论失败")
+	}
+	if approved > 0 {
+		uc.incrCommentCount(ctx, comment.PictureID, -approved)
+	}
+
+	return nil
+}
+
+// ListComments 游标分页查询图片的一级评论（按发表时间倒序）
+// 只返回审核通过的评论，以及登录用户自己尚未通过审核的评论
+func (uc *CommentUsecase) ListComments(ctx context.Context, viewerID, pictureID, cursor, pageSize int64) (*CommentPage, error) {
+	if pictureID <= 0 {
+		return nil, v1.ErrorParamsError("图片 ID 不能为空")
+	}
+	if cursor <= 0 {
+		cursor = math.MaxInt64
+	}
+	pageSize = normalizeCommentPageSize(pageSize)
+
+	picture, err := uc.pictureRepo.GetPictureByID(ctx, pictureID)
+	if err != nil {
+		uc.log.Errorf("查询图片失败: id=%d, err=%v", pictureID, err)
+		return nil, v1.ErrorSystemError("查询评论失败")
+	}
+	if picture == nil {
+		return nil, v1.ErrorPictureNotFound("图片不存在")
+	}
+
+	comments, err := uc.repo.ListRootComments(ctx, pictureID, viewerID, cursor, int(pageSize)+1)
+	if err != nil {
+		uc.log.Errorf("查询评论失败: pictureID=%d, err=%v", pictureID, err)
+		return nil, v1.ErrorSystemError("查询评论失败")
+	}
+
+	page := newCommentPage(comments, pageSize)
+	uc.fillReplyCounts(ctx, viewerID, page.List)
+	uc.fillCommentUsers(ctx, page.List)
+	return page, nil
+}
+
+// ListReplies 游标分页查询一级评论的回复（按发表时间正序）
+func (uc *CommentUsecase) ListReplies(ctx context.Context, viewerID, parentID, cursor, pageSize int64) (*CommentPage, error) {
+	if parentID <= 0 {
+		return nil, v1.ErrorParamsError("评论 ID 不能为空")
+	}
+	if cursor < 0 {
+		cursor = 0
+	}
+	pageSize = normalizeCommentPageSize(pageSize)
+
+	parent, err := uc.getVisibleComment(ctx, parentID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if parent.ParentID > 0 {
+		return nil, v1.ErrorParamsError("只能查询一级评论的回复")
+	}
+
+	replies, err := uc.repo.ListReplies(ctx, parentID, viewerID, cursor, int(pageSize)+1)
+	if err != nil {
+		uc.log.Errorf("查询回复失败: parentID=%d, err=%v", parentID, err)
+		return nil, v1.ErrorSystemError("查询回复失败")
+	}
+
+	page := newCommentPage(replies, pageSize)
+	uc.fillCommentUsers(ctx, page.List)
+	return page, nil
+}
+
+// ReviewComment 审核评论（管理员功能）
+func (uc *CommentUsecase) ReviewComment(ctx context.Context, reviewerID, id int64, status ReviewStatus, message string) error {
+	if status != ReviewStatusPass && status != ReviewStatusReject {
+		return v1.ErrorParamsError("审核状态无效")
+	}
+
+	comment, err := uc.getComment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	oldStatus := comment.ReviewStatus
+	now := time.Now()
+	comment.ReviewStatus = status
+	comment.ReviewMessage = message
+	comment.ReviewerID = reviewerID
+	comment.ReviewTime = &now
+
+	if err := uc.updateComment(ctx, comment, oldStatus); err != nil {
+		return err
+	}
+
+	// 通知作者审核结果，评论首次对其他用户可见时再通知被评论、被回复和被提及的用户
+	content := message
+	if content == "" {
+		content = "评论审核通过"
+		if status == ReviewStatusReject {
+			content = "评论审核未通过"
+		}
+	}
+	uc.notificationUC.Notify(ctx, &Notification{
+		UserID:    comment.UserID,
+		SenderID:  reviewerID,
+		Type:      NotificationCommentReview,
+		PictureID: comment.PictureID,
+		CommentID: comment.ID,
+		Content:   content,
+	})
+	if oldStatus != ReviewStatusPass && status == ReviewStatusPass {
+		picture, err := uc.pictureRepo.GetPictureByID(ctx, comment.PictureID)
+		if err != nil {
+			uc.log.Errorf("查询图片失败: id=%d, err=%v", comment.PictureID, err)
+		} else if picture != nil {
+			uc.notifyComment(ctx, comment, picture.UserID)
+		}
+	}
+
+	return nil
+}
+
+// ListCommentsByReviewStatus 游标分页查询指定审核状态的评论（管理员功能）
+func (uc *CommentUsecase) ListCommentsByReviewStatus(ctx context.Context, status ReviewStatus, cursor, pageSize int64) (*CommentPage, error) {
+	if status != ReviewStatusPending && status != ReviewStatusPass && status != ReviewStatusReject {
+		return nil, v1.ErrorParamsError("审核状态无效")
+	}
+	if cursor <= 0 {
+		cursor = math.MaxInt64
+	}
+	pageSize = normalizeCommentPageSize(pageSize)
+
+	comments, err := uc.repo.ListCommentsByReviewStatus(ctx, status, cursor, int(pageSize)+1)
+	if err != nil {
+		uc.log.Errorf("查询待审核评论失败: status=%d, err=%v", status, err)
+		return nil, v1.ErrorSystemError("查询评论失败")
+	}
+
+	page := newCommentPage(comments, pageSize)
+	uc.fillCommentUsers(ctx, page.List)
+	return page, nil
+}
+
+// getComment 查询评论，不存在时返回 COMMENT_NOT_FOUND
+func (uc *CommentUsecase) getComment(ctx context.Context, id int64) (*Comment, error) {
+	if id <= 0 {
+		return nil, v1.ErrorParamsError("评论 ID 不能为空")
+	}
+
+	comment, err := uc.repo.GetCommentByID(ctx, id)
+	if err != nil {
+		uc.log.Errorf("查询评论失败: id=%d, err=%v", id, err)
+		return nil, v1.ErrorSystemError("查询评论失败")
+	}
+	if comment == nil {
+		return nil, v1.ErrorCommentNotFound("评论不存在")
+	}
+	return comment, nil
+}
+
+// getVisibleComment 查询对 viewerID 可见的评论，未通过审核的评论只有作者可见
+func (uc *CommentUsecase) getVisibleComment(ctx context.Context, id, viewerID int64) (*Comment, error) {
+	comment, err := uc.getComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.ReviewStatus != ReviewStatusPass && comment.UserID != viewerID {
+		return nil, v1.ErrorCommentNotFound("评论不存在")
+	}
+	return comment, nil
+}
+
+// updateComment 按审核状态条件更新评论，并根据审核状态的变化调整图片评论数
+func (uc *CommentUsecase) updateComment(ctx context.Context, comment *Comment, oldStatus ReviewStatus) error {
+	updated, err := uc.repo.UpdateComment(ctx, comment, oldStatus)
+	if err != nil {
+		uc.log.Errorf("更新评论失败: id=%d, err=%v", comment.ID, err)
+		return v1.ErrorSystemError("更新评论失败")
+	}
+	if !updated {
+		return v1.ErrorParamsError("评论状态已变化，请刷新后重试")
+	}
+
+	var delta int64
+	if oldStatus == ReviewStatusPass {
+		delta--
+	}
+	if comment.ReviewStatus == ReviewStatusPass {
+		delta++
+	}
+	if delta != 0 {
+		uc.incrCommentCount(ctx, comment.PictureID, delta)
+	}
+	return nil
+}
+
+// validateContent 校验评论内容，返回去除首尾空白后的内容
+func (uc *CommentUsecase) validateContent(content string) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", v1.ErrorParamsError("评论内容不能为空")
+	}
+	if utf8.RuneCountInString(content) > maxCommentLength {
+		return "", v1.ErrorParamsError("评论内容过长")
+	}
+	return content, nil
+}
+
+// moderate 根据屏蔽词与审核配置确定评论的初始审核状态
+func (uc *CommentUsecase) moderate(content string) (ReviewStatus, string) {
+	lower := strings.ToLower(content)
+	for _, keyword := range uc.commentConf.GetBlockedKeywords() {
+		keyword = strings.TrimSpace(keyword)
+		if keyword != "" && strings.Contains(lower, strings.ToLower(keyword)) {
+			return ReviewStatusPending, "评论包含屏蔽词，等待人工审核"
+		}
+	}
+
+	if uc.commentConf.GetReviewRequired() {
+		return ReviewStatusPending, ""
+	}
+	return ReviewStatusPass, ""
+}
+
+// parseMentions 解析评论中 @账号 提及的用户，忽略不存在的账号和自己
+func (uc *CommentUsecase) parseMentions(ctx context.Context, content string, userID int64) []int64 {
+	matches := mentionPattern.FindAllStringSubmatch(content, -1)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	accounts := make(map[string]struct{}, len(matches))
+	mentionIDs := make([]int64, 0, len(matches))
+	for _, match := range matches {
+		account := match[1]
+		if _, ok := accounts[account]; ok {
+			continue
+		}
+		accounts[account] = struct{}{}
+		if len(accounts) > maxCommentMentions {
+			break
+		}
+
+		user, err := uc.userRepo.GetUserByAccount(ctx, account)
+		if err != nil {
+			uc.log.Errorf("查询提及用户失败: account=%s, err=%v", account, err)
+			continue
+		}
+		if user == nil || user.ID == userID {
+			continue
+		}
+		mentionIDs = append(mentionIDs, user.ID)
+	}
+
+	return mentionIDs
+}
+
+// notifyComment 通知图片作者（一级评论）或被回复用户（回复），以及评论中提及的用户
+func (uc *CommentUsecase) notifyComment(ctx context.Context, comment *Comment, pictureOwnerID int64) {
+	notifications := make([]*Notification, 0, len(comment.MentionUserIDs)+1)
+	notified := make(map[int64]struct{}, len(comment.MentionUserIDs)+1)
+
+	if comment.ParentID == 0 {
+		notifications = append(notifications, uc.newCommentNotification(comment, pictureOwnerID, NotificationPictureComment))
+		notified[pictureOwnerID] = struct{}{}
+	} else {
+		notifications = append(notifications, uc.newCommentNotification(comment, comment.ReplyToUserID, NotificationCommentReply))
+		notified[comment.ReplyToUserID] = struct{}{}
+	}
+
+	for _, id := range comment.MentionUserIDs {
+		if _, ok := notified[id]; ok {
+			continue
+		}
+		notified[id] = struct{}{}
+		notifications = append(notifications, uc.newCommentNotification(comment, id, NotificationCommentMention))
+	}
+
+	uc.notificationUC.Notify(ctx, notifications...)
+}
+
+// newCommentNotification 创建评论相关的通知
+func (uc *CommentUsecase) newCommentNotification(comment *Comment, userID int64, notificationType NotificationType) *Notification {
+	return &Notification{
+		UserID:    userID,
+		SenderID:  comment.UserID,
+		Type:      notificationType,
+		PictureID: comment.PictureID,
+		CommentID: comment.ID,
+		Content:   comment.Content,
+	}
+}
+
+// incrCommentCount 更新图片评论数，失败时只记录日志
+func (uc *CommentUsecase) incrCommentCount(ctx context.Context, pictureID, delta int64) {
+	if err := uc.interactionRepo.IncrCounter(ctx, pictureID, PictureCounterComment, delta); err != nil {
+		uc.log.Errorf("更新图片评论数失败: pictureID=%d, delta=%d, err=%v", pictureID, delta, err)
+	}
+}
+
+// fillReplyCounts 批量填充一级评论的回复数
+func (uc *CommentUsecase) fillReplyCounts(ctx context.Context, viewerID int64, comments []*CommentVO) {
+	if len(comments) == 0 {
+		return
+	}
+
+	ids := make([]int64, 0, len(comments))
+	for _, comment := range comments {
+		ids = append(ids, comment.ID)
+	}
+
+	counts, err := uc.repo.CountReplies(ctx, ids, viewerID)
+	if err != nil {
+		uc.log.Errorf("统计评论回复数失败: %v", err)
+		return
+	}
+	for _, comment := range comments {
+		comment.ReplyCount = counts[comment.ID]
+	}
+}
+
+// fillCommentUsers 批量填充评论用户、被回复用户以及提及的用户信息
+func (uc *CommentUsecase) fillCommentUsers(ctx context.Context, comments []*CommentVO) {
+	if len(comments) == 0 {
+		return
+	}
+
+	userIDSet := make(map[int64]struct{})
+	userIDs := make([]int64, 0, len(comments))
+	addUserID := func(id int64) {
+		if id <= 0 {
+			return
+		}
+		if _, ok := userIDSet[id]; !ok {
+			userIDSet[id] = struct{}{}
+			userIDs = append(userIDs, id)
+		}
+	}
+	for _, comment := range comments {
+		addUserID(comment.UserID)
+		addUserID(comment.ReplyToUserID)
+		for _, id := range comment.MentionUserIDs {
+			addUserID(id)
+		}
+	}
+
+	users, err := uc.userRepo.ListUserByIDs(ctx, userIDs)
+	if err != nil {
+		uc.log.Errorf("批量查询用户失败: %v", err)
+		return
+	}
+
+	userMap := make(map[int64]*UserVO, len(users))
+	for _, user := range users {
+		userMap[user.ID] = &UserVO{
+			ID:          user.ID,
+			UserAccount: user.UserAccount,
+			UserName:    user.UserName,
+			UserAvatar:  user.UserAvatar,
+			UserProfile: user.UserProfile,
+			UserRole:    user.UserRole,
+		}
+	}
+
+	for _, comment := range comments {
+		comment.User = userMap[comment.UserID]
+		comment.ReplyToUser = userMap[comment.ReplyToUserID]
+		comment.Mentions = make([]*UserVO, 0, len(comment.MentionUserIDs))
+		for _, id := range comment.MentionUserIDs {
+			if user, ok := userMap[id]; ok {
+				comment.Mentions = append(comment.Mentions, user)
+			}
+		}
+	}
+}
+
+// newCommentPage 根据多查询一条的结果构建游标分页
+func newCommentPage(comments []*Comment, pageSize int64) *CommentPage {
+	page := &CommentPage{List: make([]*CommentVO, 0, len(comments))}
+	if len(comments) > int(pageSize) {
+		page.HasMore = true
+		comments = comments[:pageSize]
+	}
+	for _, comment := range comments {
+		page.List = append(page.List, comment.ObjToVO())
+	}
+	if len(comments) > 0 {
+		page.NextCursor = comments[len(comments)-1].ID
+	}
+	return page
+}
+
+// normalizeCommentPageSize 规范化评论每页条数
+func normalizeCommentPageSize(pageSize int64) int64 {
+	if pageSize <= 0 || pageSize > maxCommentPageSize {
+		return maxCommentPageSize
+	}
+	return pageSize
+}
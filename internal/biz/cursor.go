@@ -0,0 +1,114 @@
+package biz
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// CursorValueKind 排序字段的值类型，决定游标中保存的排序值的格式
+type CursorValueKind int
+
+const (
+	CursorValueInt CursorValueKind = iota
+	CursorValueString
+	CursorValueTime
+)
+
+// defaultCursorSortField 排序字段不在白名单中时使用的排序字段
+const defaultCursorSortField = "createTime"
+
+// PictureSortKinds 图片列表允许按 keyset 游标分页的排序字段及其值类型
+var PictureSortKinds = map[string]CursorValueKind{
+	"createTime":    CursorValueTime,
+	"editTime":      CursorValueTime,
+	"updateTime":    CursorValueTime,
+	"name":          CursorValueString,
+	"picSize":       CursorValueInt,
+	"picWidth":      CursorValueInt,
+	"picHeight":     CursorValueInt,
+	"likeCount":     CursorValueInt,
+	"favoriteCount": CursorValueInt,
+	"viewCount":     CursorValueInt,
+	"commentCount":  CursorValueInt,
+}
+
+// UserSortKinds 用户列表允许按 keyset 游标分页的排序字段及其值类型
+var UserSortKinds = map[string]CursorValueKind{
+	"id":          CursorValueInt,
+	"createTime":  CursorValueTime,
+	"updateTime":  CursorValueTime,
+	"editTime":    CursorValueTime,
+	"userAccount": CursorValueString,
+	"userName":    CursorValueString,
+	"userRole":    CursorValueString,
+}
+
+// ParseValue 按类型还原游标中保存的排序值
+func (k CursorValueKind) ParseValue(s string) (any, error) {
+	switch k {
+	case CursorValueTime:
+		return time.Parse(time.RFC3339Nano, s)
+	case CursorValueInt:
+		return strconv.ParseInt(s, 10, 64)
+	default:
+		return s, nil
+	}
+}
+
+// PageCursor 游标分页的位置，对调用方不透明（JSON 后 base64 编码）
+// 按普通字段排序时记录上一页最后一条记录的排序值和 ID（keyset），按相关度或色差排序时记录偏移量
+type PageCursor struct {
+	SortField string `json:"f,omitempty"` // 请求中的排序字段，与下一页请求不一致时游标无效
+	SortOrder string `json:"s,omitempty"` // 请求中的排序顺序
+	Value     string `json:"v,omitempty"` // 上一页最后一条记录的排序字段值
+	ID        int64  `json:"i,omitempty"` // 上一页最后一条记录的 ID
+	Offset    int64  `json:"o,omitempty"` // 按相关度或色差排序时已返回的记录数
+}
+
+// Encode 编码为字符串
+func (c *PageCursor) Encode() string {
+	if c == nil {
+		return ""
+	}
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// parsePageCursor 解析请求中的游标并校验排序条件与生成游标时一致，空字符串表示第一页
+// kinds 为排序字段的值类型，按 keyset 定位时校验排序值能按排序字段的类型还原，避免篡改的游标进入查询；
+// 为 nil 表示按偏移量定位（相关度、色差排序），游标中不应有排序值
+func parsePageCursor(s, sortField, sortOrder string, kinds map[string]CursorValueKind) (*PageCursor, bool) {
+	if s == "" {
+		return nil, true
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, false
+	}
+	var cursor PageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, false
+	}
+	if cursor.SortField != sortField || cursor.SortOrder != sortOrder || cursor.ID < 0 || cursor.Offset < 0 {
+		return nil, false
+	}
+	if kinds == nil {
+		if cursor.Value != "" || cursor.ID != 0 {
+			return nil, false
+		}
+		return &cursor, true
+	}
+	kind, ok := kinds[sortField]
+	if !ok {
+		kind = kinds[defaultCursorSortField]
+	}
+	if cursor.Offset != 0 {
+		return nil, false
+	}
+	if _, err := kind.ParseValue(cursor.Value); err != nil {
+		return nil, false
+	}
+	return &cursor, true
+}
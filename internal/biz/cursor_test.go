@@ -0,0 +1,159 @@
+package biz
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestParsePageCursor(t *testing.T) {
+	encode := func(c *PageCursor) string { return c.Encode() }
+
+	tests := []struct {
+		name      string
+		cursor    string
+		sortField string
+		sortOrder string
+		kinds     map[string]CursorValueKind
+		wantOK    bool
+		wantNil   bool
+	}{
+		{name: "空游标表示第一页", cursor: "", sortField: "createTime", kinds: PictureSortKinds, wantOK: true, wantNil: true},
+		{name: "非 base64", cursor: "!!!", sortField: "createTime", kinds: PictureSortKinds},
+		{name: "非 JSON", cursor: base64.RawURLEncoding.EncodeToString([]byte("not json")), sortField: "createTime", kinds: PictureSortKinds},
+		{
+			name:      "按时间排序",
+			cursor:    encode(&PageCursor{SortField: "createTime", SortOrder: "descend", Value: "2024-05-01T08:00:00.123456789Z", ID: 42}),
+			sortField: "createTime", sortOrder: "descend", kinds: PictureSortKinds, wantOK: true,
+		},
+		{
+			name:      "按整数排序",
+			cursor:    encode(&PageCursor{SortField: "likeCount", SortOrder: "ascend", Value: "17", ID: 3}),
+			sortField: "likeCount", sortOrder: "ascend", kinds: PictureSortKinds, wantOK: true,
+		},
+		{
+			name:      "按字符串排序",
+			cursor:    encode(&PageCursor{SortField: "name", Value: "' OR 1=1 --", ID: 3}),
+			sortField: "name", kinds: PictureSortKinds, wantOK: true,
+		},
+		{
+			name:      "排序字段不一致",
+			cursor:    encode(&PageCursor{SortField: "createTime", Value: "2024-05-01T08:00:00Z", ID: 1}),
+			sortField: "editTime", kinds: PictureSortKinds,
+		},
+		{
+			name:      "排序顺序不一致",
+			cursor:    encode(&PageCursor{SortField: "createTime", SortOrder: "ascend", Value: "2024-05-01T08:00:00Z", ID: 1}),
+			sortField: "createTime", sortOrder: "descend", kinds: PictureSortKinds,
+		},
+		{
+			name:      "排序值与字段类型不符",
+			cursor:    encode(&PageCursor{SortField: "likeCount", Value: "2024-05-01T08:00:00Z", ID: 1}),
+			sortField: "likeCount", kinds: PictureSortKinds,
+		},
+		{
+			name:      "时间字段的排序值不是时间",
+			cursor:    encode(&PageCursor{SortField: "createTime", Value: "17", ID: 1}),
+			sortField: "createTime", kinds: PictureSortKinds,
+		},
+		{
+			name:      "未知排序字段按默认字段校验",
+			cursor:    encode(&PageCursor{SortField: "unknown", Value: "2024-05-01T08:00:00Z", ID: 1}),
+			sortField: "unknown", kinds: PictureSortKinds, wantOK: true,
+		},
+		{
+			name:      "未知排序字段的排序值不是时间",
+			cursor:    encode(&PageCursor{SortField: "unknown", Value: "abc", ID: 1}),
+			sortField: "unknown", kinds: PictureSortKinds,
+		},
+		{
+			name:      "keyset 游标带偏移量",
+			cursor:    encode(&PageCursor{SortField: "likeCount", Value: "1", ID: 1, Offset: 20}),
+			sortField: "likeCount", kinds: PictureSortKinds,
+		},
+		{
+			name:      "负数 ID",
+			cursor:    encode(&PageCursor{SortField: "likeCount", Value: "1", ID: -1}),
+			sortField: "likeCount", kinds: PictureSortKinds,
+		},
+		{
+			name:   "按偏移量定位",
+			cursor: encode(&PageCursor{Offset: 40}),
+			kinds:  nil, wantOK: true,
+		},
+		{
+			name:   "按偏移量定位时带排序值",
+			cursor: encode(&PageCursor{Value: "1", Offset: 40}),
+			kinds:  nil,
+		},
+		{
+			name:   "按偏移量定位时带 ID",
+			cursor: encode(&PageCursor{ID: 5, Offset: 40}),
+			kinds:  nil,
+		},
+		{
+			name:   "负数偏移量",
+			cursor: encode(&PageCursor{Offset: -1}),
+			kinds:  nil,
+		},
+		{
+			name:      "用户列表按 ID 排序",
+			cursor:    encode(&PageCursor{SortField: "id", Value: "100", ID: 100}),
+			sortField: "id", kinds: UserSortKinds, wantOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, ok := parsePageCursor(tt.cursor, tt.sortField, tt.sortOrder, tt.kinds)
+			if ok != tt.wantOK {
+				t.Fatalf("parsePageCursor() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (cursor == nil) != tt.wantNil {
+				t.Fatalf("parsePageCursor() cursor = %+v, wantNil %v", cursor, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestPageCursorEncodeRoundTrip(t *testing.T) {
+	want := PageCursor{SortField: "name", SortOrder: "descend", Value: "风景 {index}", ID: 9}
+	got, ok := parsePageCursor(want.Encode(), want.SortField, want.SortOrder, PictureSortKinds)
+	if !ok || got == nil {
+		t.Fatalf("parsePageCursor() failed for encoded cursor")
+	}
+	if *got != want {
+		t.Errorf("round trip = %+v, want %+v", *got, want)
+	}
+
+	var nilCursor *PageCursor
+	if s := nilCursor.Encode(); s != "" {
+		t.Errorf("nil cursor Encode() = %q, want empty", s)
+	}
+}
+
+func TestCursorValueKindParseValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    CursorValueKind
+		value   string
+		wantErr bool
+	}{
+		{name: "整数", kind: CursorValueInt, value: "123"},
+		{name: "负整数", kind: CursorValueInt, value: "-5"},
+		{name: "整数溢出", kind: CursorValueInt, value: "99999999999999999999", wantErr: true},
+		{name: "非整数", kind: CursorValueInt, value: "1.5", wantErr: true},
+		{name: "时间", kind: CursorValueTime, value: "2024-05-01T08:00:00+08:00"},
+		{name: "非 RFC3339 时间", kind: CursorValueTime, value: "2024-05-01 08:00:00", wantErr: true},
+		{name: "字符串", kind: CursorValueString, value: "任意内容"},
+		{name: "空字符串", kind: CursorValueString, value: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.kind.ParseValue(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseValue(%q) err = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
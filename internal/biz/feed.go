@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"context"
+	"math"
+
+	v1 "smart-collab-gallery-server/api/picture/v1"
+)
+
+const (
+	// FeedMaxLength 每个用户动态缓存保留的最大条数，超出部分回源数据库
+	FeedMaxLength = 500
+	// maxFeedPageSize 动态每页最多返回条数
+	maxFeedPageSize = 20
+)
+
+// FeedRepo 动态缓存仓储接口
+// 动态以图片 ID 作为排序依据（ID 自增，与发布时间顺序一致），游标即上一页最后一张图片的 ID
+type FeedRepo interface {
+	// PushToFeeds 将图片推送到多个用户的动态缓存，只推送给缓存已存在的用户
+	PushToFeeds(ctx context.Context, userIDs []int64, pictureID int64) error
+	// ListFeedIDs 从动态缓存中查询 ID 小于 cursor 的图片 ID（倒序），缓存不存在时 exists 返回 false
+	ListFeedIDs(ctx context.Context, userID, cursor int64, limit int) (ids []int64, exists bool, err error)
+	// SaveFeed 重建用户的动态缓存
+	SaveFeed(ctx context.Context, userID int64, pictureIDs []int64) error
+	// RemoveFeed 删除用户的动态缓存
+	RemoveFeed(ctx context.Context, userID int64) error
+}
+
+// PictureFeed 动态分页结果
+type PictureFeed struct {
+	List       []*PictureVO
+	NextCursor int64
+	HasMore    bool
+}
+
+// GetFeed 获取关注用户最近发布的图片（游标分页，按发布时间倒序）
+// 优先读取 Redis 动态缓存（发布图片时推送给粉丝），缓存不存在时从数据库拉取并重建，
+// 翻页超出缓存范围时回源数据库
+func (uc *PictureUsecase) GetFeed(ctx context.Context, userID, cursor, pageSize int64) (*PictureFeed, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	if cursor <= 0 {
+		cursor = math.MaxInt64
+	}
+	if pageSize <= 0 || pageSize > maxFeedPageSize {
+		pageSize = maxFeedPageSize
+	}
+	limit := int(pageSize) + 1 // 多取一条用于判断是否还有下一页
+
+	ids, exists, err := uc.feedRepo.ListFeedIDs(ctx, userID, cursor, limit)
+	if err != nil {
+		// 缓存不可用时降级为直接查询数据库
+		uc.log.Errorf("读取动态缓存失败: userID=%d, err=%v", userID, err)
+		ids, exists = nil, false
+	}
+
+	var followingIDs []int64
+	if !exists {
+		followingIDs, err = uc.followRepo.ListAllFollowingIDs(ctx, userID)
+		if err != nil {
+			uc.log.Errorf("查询关注列表失败: userID=%d, err=%v", userID, err)
+			return nil, v1.ErrorSystemError("获取动态失败")
+		}
+		ids, err = uc.rebuildFeed(ctx, userID, followingIDs, cursor, limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 缓存中的数据不足一页时回源数据库（缓存只保留最近 FeedMaxLength 条）
+	if len(ids) < limit {
+		if followingIDs == nil {
+			followingIDs, err = uc.followRepo.ListAllFollowingIDs(ctx, userID)
+			if err != nil {
+				uc.log.Errorf("查询关注列表失败: userID=%d, err=%v", userID, err)
+				return nil, v1.ErrorSystemError("获取动态失败")
+			}
+		}
+		from := cursor
+		if len(ids) > 0 {
+			from = ids[len(ids)-1]
+		}
+		more, err := uc.pictureRepo.ListPictureIDsByUserIDs(ctx, followingIDs, from, limit-len(ids))
+		if err != nil {
+			uc.log.Errorf("查询关注用户图片失败: userID=%d, err=%v", userID, err)
+			return nil, v1.ErrorSystemError("获取动态失败")
+		}
+		ids = append(ids, more...)
+	}
+
+	feed := &PictureFeed{List: []*PictureVO{}}
+	if len(ids) > int(pageSize) {
+		feed.HasMore = true
+		ids = ids[:pageSize]
+	}
+	if len(ids) == 0 {
+		return feed, nil
+	}
+	feed.NextCursor = ids[len(ids)-1]
+
+	// 按 ID 批量查询图片（已删除的图片会被过滤），并保持动态顺序
+	feed.List, err = uc.ListPictureVOsByIDs(ctx, ids, userID)
+	if err != nil {
+		return nil, v1.ErrorSystemError("获取动态失败")
+	}
+
+	return feed, nil
+}
+
+// rebuildFeed 从数据库拉取关注用户最近的图片重建动态缓存，返回 ID 小于 cursor 的前 limit 条
+func (uc *PictureUsecase) rebuildFeed(ctx context.Context, userID int64, followingIDs []int64, cursor int64, limit int) ([]int64, error) {
+	recentIDs, err := uc.pictureRepo.ListPictureIDsByUserIDs(ctx, followingIDs, math.MaxInt64, FeedMaxLength)
+	if err != nil {
+		uc.log.Errorf("查询关注用户图片失败: userID=%d, err=%v", userID, err)
+		return nil, v1.ErrorSystemError("获取动态失败")
+	}
+
+	if err := uc.feedRepo.SaveFeed(ctx, userID, recentIDs); err != nil {
+		uc.log.Errorf("重建动态缓存失败: userID=%d, err=%v", userID, err)
+	}
+
+	ids := make([]int64, 0, limit)
+	for _, id := range recentIDs {
+		if id >= cursor {
+			continue
+		}
+		ids = append(ids, id)
+		if len(ids) == limit {
+			break
+		}
+	}
+	return ids, nil
+}
+
+// pushToFollowerFeeds 将新发布的图片推送到粉丝的动态缓存，失败时只记录日志
+func (uc *PictureUsecase) pushToFollowerFeeds(ctx context.Context, picture *Picture) {
+	followerIDs, err := uc.followRepo.ListAllFollowerIDs(ctx, picture.UserID)
+	if err != nil {
+		uc.log.Errorf("查询粉丝列表失败: userID=%d, err=%v", picture.UserID, err)
+		return
+	}
+	if len(followerIDs) == 0 {
+		return
+	}
+
+	if err := uc.feedRepo.PushToFeeds(ctx, followerIDs, picture.ID); err != nil {
+		uc.log.Errorf("推送动态失败: pictureID=%d, err=%v", picture.ID, err)
+	}
+}
@@ -0,0 +1,212 @@
+package biz
+
+import (
+	"context"
+
+	v1 "smart-collab-gallery-server/api/user/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// FollowStat 用户关注统计
+type FollowStat struct {
+	FollowerCount  int64 // 粉丝数
+	FollowingCount int64 // 关注数
+	Followed       bool  // 当前登录用户是否已关注
+}
+
+// FollowRepo 关注关系仓储接口
+type FollowRepo interface {
+	// CreateFollow 创建关注关系，已关注时返回 false
+	CreateFollow(ctx context.Context, userID, followUserID int64) (bool, error)
+	// DeleteFollow 删除关注关系，未关注时返回 false
+	DeleteFollow(ctx context.Context, userID, followUserID int64) (bool, error)
+	// ListFollowerIDsByPage 分页查询粉丝 ID（按关注时间倒序）
+	ListFollowerIDsByPage(ctx context.Context, userID, current, pageSize int64) ([]int64, int64, error)
+	// ListFollowingIDsByPage 分页查询关注的用户 ID（按关注时间倒序）
+	ListFollowingIDsByPage(ctx context.Context, userID, current, pageSize int64) ([]int64, int64, error)
+	// ListAllFollowerIDs 查询全部粉丝 ID（用于动态推送）
+	ListAllFollowerIDs(ctx context.Context, userID int64) ([]int64, error)
+	// ListAllFollowingIDs 查询全部关注的用户 ID（用于动态拉取）
+	ListAllFollowingIDs(ctx context.Context, userID int64) ([]int64, error)
+	// CountFollowers 批量统计粉丝数
+	CountFollowers(ctx context.Context, userIDs []int64) (map[int64]int64, error)
+	// CountFollowings 批量统计关注数
+	CountFollowings(ctx context.Context, userIDs []int64) (map[int64]int64, error)
+	// ListFollowedUserIDs 查询 userID 关注了 targetIDs 中的哪些用户
+	ListFollowedUserIDs(ctx context.Context, userID int64, targetIDs []int64) (map[int64]bool, error)
+}
+
+// FollowUsecase 关注用例
+type FollowUsecase struct {
+	repo     FollowRepo
+	userRepo UserRepo
+	feedRepo FeedRepo
+	log      *log.Helper
+}
+
+// NewFollowUsecase 创建关注用例
+func NewFollowUsecase(repo FollowRepo, userRepo UserRepo, feedRepo FeedRepo, logger log.Logger) *FollowUsecase {
+	return &FollowUsecase{
+		repo:     repo,
+		userRepo: userRepo,
+		feedRepo: feedRepo,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Follow 关注用户
+func (uc *FollowUsecase) Follow(ctx context.Context, userID, followUserID int64) error {
+	if userID <= 0 {
+		return v1.ErrorNotLoginError("未登录")
+	}
+	if followUserID <= 0 {
+		return v1.ErrorParamsError("用户 ID 无效")
+	}
+	if userID == followUserID {
+		return v1.ErrorParamsError("不能关注自己")
+	}
+
+	target, err := uc.userRepo.GetUserByID(ctx, followUserID)
+	if err != nil {
+		uc.log.Errorf("查询用户失败: userID=%d, err=%v", followUserID, err)
+		return v1.ErrorSystemError("查询用户失败")
+	}
+	if target == nil {
+		return v1.ErrorUserNotFound("用户不存在")
+	}
+
+	created, err := uc.repo.CreateFollow(ctx, userID, followUserID)
+	if err != nil {
+		uc.log.Errorf("关注用户失败: userID=%d, followUserID=%d, err=%v", userID, followUserID, err)
+		return v1.ErrorSystemError("关注失败")
+	}
+
+	// 关注关系变化后清空动态缓存，下次读取时重建
+	if created {
+		uc.invalidateFeed(ctx, userID)
+	}
+
+	return nil
+}
+
+// Unfollow 取消关注
+func (uc *FollowUsecase) Unfollow(ctx context.Context, userID, followUserID int64) error {
+	if userID <= 0 {
+		return v1.ErrorNotLoginError("未登录")
+	}
+	if followUserID <= 0 {
+		return v1.ErrorParamsError("用户 ID 无效")
+	}
+
+	deleted, err := uc.repo.DeleteFollow(ctx, userID, followUserID)
+	if err != nil {
+		uc.log.Errorf("取消关注失败: userID=%d, followUserID=%d, err=%v", userID, followUserID, err)
+		return v1.ErrorSystemError("取消关注失败")
+	}
+
+	if deleted {
+		uc.invalidateFeed(ctx, userID)
+	}
+
+	return nil
+}
+
+// ListFollowers 分页查询粉丝列表
+func (uc *FollowUsecase) ListFollowers(ctx context.Context, userID, current, pageSize int64) (*UserPage, error) {
+	return uc.listFollowUsers(ctx, userID, current, pageSize, uc.repo.ListFollowerIDsByPage)
+}
+
+// ListFollowings 分页查询关注列表
+func (uc *FollowUsecase) ListFollowings(ctx context.Context, userID, current, pageSize int64) (*UserPage, error) {
+	return uc.listFollowUsers(ctx, userID, current, pageSize, uc.repo.ListFollowingIDsByPage)
+}
+
+// listFollowUsers 分页查询关注关系中的用户，保持仓储返回的顺序
+func (uc *FollowUsecase) listFollowUsers(ctx context.Context, userID, current, pageSize int64,
+	listIDs func(ctx context.Context, userID, current, pageSize int64) ([]int64, int64, error)) (*UserPage, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorParamsError("用户 ID 无效")
+	}
+	if current <= 0 {
+		current = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	ids, total, err := listIDs(ctx, userID, current, pageSize)
+	if err != nil {
+		uc.log.Errorf("查询关注关系失败: userID=%d, err=%v", userID, err)
+		return nil, v1.ErrorSystemError("查询关注列表失败")
+	}
+
+	users, err := uc.userRepo.ListUserByIDs(ctx, ids)
+	if err != nil {
+		uc.log.Errorf("批量查询用户失败: %v", err)
+		return nil, v1.ErrorSystemError("查询关注列表失败")
+	}
+
+	userMap := make(map[int64]*User, len(users))
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+	list := make([]*User, 0, len(ids))
+	for _, id := range ids {
+		if user, ok := userMap[id]; ok {
+			list = append(list, user)
+		}
+	}
+
+	return &UserPage{
+		Total:    total,
+		List:     list,
+		Current:  current,
+		PageSize: pageSize,
+	}, nil
+}
+
+// GetFollowStats 批量查询用户的粉丝数、关注数以及登录用户是否已关注
+func (uc *FollowUsecase) GetFollowStats(ctx context.Context, loginUserID int64, userIDs []int64) (map[int64]*FollowStat, error) {
+	stats := make(map[int64]*FollowStat, len(userIDs))
+	if len(userIDs) == 0 {
+		return stats, nil
+	}
+
+	followerCounts, err := uc.repo.CountFollowers(ctx, userIDs)
+	if err != nil {
+		uc.log.Errorf("统计粉丝数失败: %v", err)
+		return nil, v1.ErrorSystemError("查询关注统计失败")
+	}
+	followingCounts, err := uc.repo.CountFollowings(ctx, userIDs)
+	if err != nil {
+		uc.log.Errorf("统计关注数失败: %v", err)
+		return nil, v1.ErrorSystemError("查询关注统计失败")
+	}
+
+	followed := map[int64]bool{}
+	if loginUserID > 0 {
+		followed, err = uc.repo.ListFollowedUserIDs(ctx, loginUserID, userIDs)
+		if err != nil {
+			uc.log.Errorf("查询关注状态失败: %v", err)
+			return nil, v1.ErrorSystemError("查询关注统计失败")
+		}
+	}
+
+	for _, id := range userIDs {
+		stats[id] = &FollowStat{
+			FollowerCount:  followerCounts[id],
+			FollowingCount: followingCounts[id],
+			Followed:       followed[id],
+		}
+	}
+
+	return stats, nil
+}
+
+// invalidateFeed 清空用户的动态缓存，失败时只记录日志
+func (uc *FollowUsecase) invalidateFeed(ctx context.Context, userID int64) {
+	if err := uc.feedRepo.RemoveFeed(ctx, userID); err != nil {
+		uc.log.Errorf("清除动态缓存失败: userID=%d, err=%v", userID, err)
+	}
+}
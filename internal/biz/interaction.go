@@ -0,0 +1,229 @@
+package biz
+
+import (
+	"context"
+
+	v1 "smart-collab-gallery-server/api/picture/v1"
+)
+
+// PictureCounterField 图片计数字段
+type PictureCounterField string
+
+const (
+	PictureCounterLike     PictureCounterField = "like"
+	PictureCounterFavorite PictureCounterField = "favorite"
+	PictureCounterView     PictureCounterField = "view"
+	PictureCounterComment  PictureCounterField = "comment"
+)
+
+const (
+	// counterFlushBatchSize 每批刷新到数据库的图片数量
+	counterFlushBatchSize = 200
+	// counterFlushMaxBatches 每次刷新最多处理的批数，剩余的留到下一次
+	counterFlushMaxBatches = 50
+)
+
+// PictureCounters 图片计数
+type PictureCounters struct {
+	LikeCount     int64
+	FavoriteCount int64
+	ViewCount     int64
+	CommentCount  int64
+}
+
+// PictureInteractionRepo 图片互动（点赞、收藏、浏览、评论）仓储接口
+// 点赞/收藏关系直接写入数据库，计数先缓冲在 Redis 中，由后台任务批量刷新到图片表，
+// 避免热门图片的计数更新造成行锁竞争
+type PictureInteractionRepo interface {
+	// CreateLike 点赞，已点赞时返回 false
+	CreateLike(ctx context.Context, pictureID, userID int64) (bool, error)
+	// DeleteLike 取消点赞，未点赞时返回 false
+	DeleteLike(ctx context.Context, pictureID, userID int64) (bool, error)
+	// CreateFavorite 收藏，已收藏时返回 false
+	CreateFavorite(ctx context.Context, pictureID, userID int64) (bool, error)
+	// DeleteFavorite 取消收藏，未收藏时返回 false
+	DeleteFavorite(ctx context.Context, pictureID, userID int64) (bool, error)
+	// ListLikedPictureIDs 查询用户点赞了 pictureIDs 中的哪些图片
+	ListLikedPictureIDs(ctx context.Context, userID int64, pictureIDs []int64) (map[int64]bool, error)
+	// ListFavoritedPictureIDs 查询用户收藏了 pictureIDs 中的哪些图片
+	ListFavoritedPictureIDs(ctx context.Context, userID int64, pictureIDs []int64) (map[int64]bool, error)
+	// ListFavoritePictureIDsByPage 分页查询用户收藏的图片 ID（按收藏时间倒序）
+	ListFavoritePictureIDsByPage(ctx context.Context, userID, current, pageSize int64) ([]int64, int64, error)
+	// IncrCounter 在 Redis 中累加图片计数增量
+	IncrCounter(ctx context.Context, pictureID int64, field PictureCounterField, delta int64) error
+	// GetPendingCounters 批量查询尚未刷新到数据库的计数增量
+	GetPendingCounters(ctx context.Context, pictureIDs []int64) (map[int64]*PictureCounters, error)
+	// FlushCounters 将一批计数增量刷新到数据库，返回刷新的图片数量
+	FlushCounters(ctx context.Context, batchSize int) (int, error)
+}
+
+// LikePicture 点赞或取消点赞，重复请求不会重复计数
+func (uc *PictureUsecase) LikePicture(ctx context.Context, pictureID, userID int64, like bool) (*PictureVO, error) {
+	return uc.toggleInteraction(ctx, pictureID, userID, like, PictureCounterLike,
+		uc.interactionRepo.CreateLike, uc.interactionRepo.DeleteLike)
+}
+
+// FavoritePicture 收藏或取消收藏，重复请求不会重复计数
+func (uc *PictureUsecase) FavoritePicture(ctx context.Context, pictureID, userID int64, favorite bool) (*PictureVO, error) {
+	return uc.toggleInteraction(ctx, pictureID, userID, favorite, PictureCounterFavorite,
+		uc.interactionRepo.CreateFavorite, uc.interactionRepo.DeleteFavorite)
+}
+
+// toggleInteraction 将点赞/收藏关系设置为目标状态，关系实际发生变化时才更新计数
+func (uc *PictureUsecase) toggleInteraction(ctx context.Context, pictureID, userID int64, on bool, field PictureCounterField,
+	create, remove func(ctx context.Context, pictureID, userID int64) (bool, error)) (*PictureVO, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	if pictureID <= 0 {
+		return nil, v1.ErrorInvalidArgument("图片 ID 不能为空")
+	}
+
+	picture, err := uc.pictureRepo.GetPictureByID(ctx, pictureID)
+	if err != nil {
+		uc.log.Errorf("查询图片失败: id=%d, err=%v", pictureID, err)
+		return nil, v1.ErrorSystemError("查询图片失败")
+	}
+	if picture == nil {
+		return nil, v1.ErrorPictureNotFound("图片不存在")
+	}
+
+	var changed bool
+	var delta int64
+	if on {
+		changed, err = create(ctx, pictureID, userID)
+		delta = 1
+	} else {
+		changed, err = remove(ctx, pictureID, userID)
+		delta = -1
+	}
+	if err != nil {
+		uc.log.Errorf("更新图片互动失败: pictureID=%d, userID=%d, field=%s, err=%v", pictureID, userID, field, err)
+		return nil, v1.ErrorSystemError("操作失败")
+	}
+
+	if changed {
+		if err := uc.interactionRepo.IncrCounter(ctx, pictureID, field, delta); err != nil {
+			uc.log.Errorf("更新图片计数失败: pictureID=%d, field=%s, err=%v", pictureID, field, err)
+		}
+		if on && field == PictureCounterLike {
+			uc.notificationUC.NotifyOnce(ctx, &Notification{
+				UserID:    picture.UserID,
+				SenderID:  userID,
+				Type:      NotificationPictureLike,
+				PictureID: pictureID,
+				Content:   picture.Name,
+			})
+		}
+	}
+
+	vo := picture.ObjToVO()
+	uc.FillInteractions(ctx, userID, []*PictureVO{vo})
+	return vo, nil
+}
+
+// RecordView 记录图片浏览，失败时只记录日志
+func (uc *PictureUsecase) RecordView(ctx context.Context, pictureID int64) {
+	if err := uc.interactionRepo.IncrCounter(ctx, pictureID, PictureCounterView, 1); err != nil {
+		uc.log.Errorf("记录图片浏览失败: pictureID=%d, err=%v", pictureID, err)
+	}
+}
+
+// ListMyFavoritePictures 分页查询我的收藏
+func (uc *PictureUsecase) ListMyFavoritePictures(ctx context.Context, userID, current, pageSize int64) (*PicturePage, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	if current <= 0 {
+		current = 1
+	}
+	if pageSize <= 0 || pageSize > 20 {
+		pageSize = 20
+	}
+
+	ids, total, err := uc.interactionRepo.ListFavoritePictureIDsByPage(ctx, userID, current, pageSize)
+	if err != nil {
+		uc.log.Errorf("查询收藏列表失败: userID=%d, err=%v", userID, err)
+		return nil, v1.ErrorSystemError("查询收藏列表失败")
+	}
+
+	// 保持收藏时间倒序，已删除或未通过审核的图片不再展示
+	list, err := uc.ListPictureVOsByIDs(ctx, ids, userID)
+	if err != nil {
+		return nil, v1.ErrorSystemError("查询收藏列表失败")
+	}
+	uc.FillInteractions(ctx, userID, list)
+
+	return &PicturePage{
+		Total:    total,
+		List:     list,
+		Current:  current,
+		PageSize: pageSize,
+	}, nil
+}
+
+// FillInteractions 填充图片的实时计数（数据库计数 + Redis 中未刷新的增量）以及登录用户的点赞、收藏状态
+func (uc *PictureUsecase) FillInteractions(ctx context.Context, loginUserID int64, pictures []*PictureVO) {
+	if len(pictures) == 0 {
+		return
+	}
+
+	ids := make([]int64, 0, len(pictures))
+	for _, pic := range pictures {
+		ids = append(ids, pic.ID)
+	}
+
+	pending, err := uc.interactionRepo.GetPendingCounters(ctx, ids)
+	if err != nil {
+		uc.log.Errorf("查询图片计数增量失败: %v", err)
+	} else {
+		for _, pic := range pictures {
+			if counters, ok := pending[pic.ID]; ok {
+				pic.LikeCount = max(pic.LikeCount+counters.LikeCount, 0)
+				pic.FavoriteCount = max(pic.FavoriteCount+counters.FavoriteCount, 0)
+				pic.ViewCount = max(pic.ViewCount+counters.ViewCount, 0)
+				pic.CommentCount = max(pic.CommentCount+counters.CommentCount, 0)
+			}
+		}
+	}
+
+	if loginUserID <= 0 {
+		return
+	}
+
+	liked, err := uc.interactionRepo.ListLikedPictureIDs(ctx, loginUserID, ids)
+	if err != nil {
+		uc.log.Errorf("查询点赞状态失败: userID=%d, err=%v", loginUserID, err)
+		return
+	}
+	favorited, err := uc.interactionRepo.ListFavoritedPictureIDs(ctx, loginUserID, ids)
+	if err != nil {
+		uc.log.Errorf("查询收藏状态失败: userID=%d, err=%v", loginUserID, err)
+		return
+	}
+	for _, pic := range pictures {
+		pic.Liked = liked[pic.ID]
+		pic.Favorited = favorited[pic.ID]
+	}
+}
+
+// FlushCounters 将 Redis 中缓冲的计数增量批量刷新到数据库，由后台任务定时调用
+func (uc *PictureUsecase) FlushCounters(ctx context.Context) error {
+	total := 0
+	for i := 0; i < counterFlushMaxBatches; i++ {
+		n, err := uc.interactionRepo.FlushCounters(ctx, counterFlushBatchSize)
+		if err != nil {
+			uc.log.Errorf("刷新图片计数失败: %v", err)
+			return err
+		}
+		total += n
+		if n < counterFlushBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		uc.log.Infof("刷新图片计数完成: count=%d", total)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package biz
+
+import (
+	"context"
+	"errors"
+
+	v1 "smart-collab-gallery-server/api/user/v1"
+)
+
+const (
+	// shareCodeLength 分享码长度
+	shareCodeLength = 8
+	// shareCodeMaxRetry 分享码冲突时的最大重试次数
+	shareCodeMaxRetry = 5
+	// defaultInviteTopN 邀请排行默认返回条数
+	defaultInviteTopN = 10
+	// maxInviteTopN 邀请排行最多返回条数
+	maxInviteTopN = 100
+)
+
+// ErrShareCodeConflict 分享码与已有用户冲突（违反唯一索引），由仓储层在写入分享码时返回
+var ErrShareCodeConflict = errors.New("share code conflict")
+
+// InviterStat 邀请人统计
+type InviterStat struct {
+	User         *User
+	InviteeCount int64
+}
+
+// InviteStatistics 邀请统计
+type InviteStatistics struct {
+	TotalInvitees int64 // 通过邀请注册的用户总数
+	TotalInviters int64 // 成功邀请过用户的邀请人数量
+	TopInviters   []*InviterStat
+}
+
+// ListMyInvitees 分页查询我邀请的用户
+func (uc *UserUsecase) ListMyInvitees(ctx context.Context, userID, current, pageSize int64) (*UserPage, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorNotLoginError("未登录")
+	}
+	if current <= 0 {
+		current = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	page, err := uc.repo.ListUserByInviter(ctx, userID, current, pageSize)
+	if err != nil {
+		uc.log.Errorf("查询邀请用户列表失败: userID=%d, err=%v", userID, err)
+		return nil, v1.ErrorSystemError("查询邀请用户列表失败")
+	}
+
+	return page, nil
+}
+
+// GetInviteStatistics 查询邀请统计（管理员功能）
+func (uc *UserUsecase) GetInviteStatistics(ctx context.Context, topN int32) (*InviteStatistics, error) {
+	if topN <= 0 {
+		topN = defaultInviteTopN
+	}
+	if topN > maxInviteTopN {
+		topN = maxInviteTopN
+	}
+
+	stats, err := uc.repo.GetInviteStatistics(ctx, int(topN))
+	if err != nil {
+		uc.log.Errorf("查询邀请统计失败: %v", err)
+		return nil, v1.ErrorSystemError("查询邀请统计失败")
+	}
+
+	return stats, nil
+}
+
+// saveWithShareCode 生成随机分享码并调用 save 写入，分享码由唯一索引保证不重复，
+// 写入时冲突则重新生成，最多重试 shareCodeMaxRetry 次
+func (uc *UserUsecase) saveWithShareCode(save func(shareCode string) error) error {
+	for i := 0; i < shareCodeMaxRetry; i++ {
+		code, err := generateRandomCode(shareCodeLength)
+		if err != nil {
+			uc.log.Errorf("生成分享码失败: %v", err)
+			return v1.ErrorSystemError("生成分享码失败")
+		}
+		if err := save(code); !errors.Is(err, ErrShareCodeConflict) {
+			return err
+		}
+		uc.log.Warnf("分享码冲突，重新生成: code=%s", code)
+	}
+
+	uc.log.Errorf("生成分享码失败: 重试 %d 次仍然冲突", shareCodeMaxRetry)
+	return v1.ErrorSystemError("生成分享码失败")
+}
+
+// fillShareCode 为没有分享码的老用户补发分享码，失败时只记录日志
+func (uc *UserUsecase) fillShareCode(ctx context.Context, user *User) {
+	err := uc.saveWithShareCode(func(shareCode string) error {
+		if err := uc.repo.UpdateUserShareCode(ctx, user.ID, shareCode); err != nil {
+			return err
+		}
+		user.ShareCode = shareCode
+		return nil
+	})
+	if err != nil {
+		uc.log.Errorf("补发分享码失败: userID=%d, err=%v", user.ID, err)
+	}
+}
+
+// grantInviteRewards 发放邀请奖励，奖励失败不影响注册结果
+func (uc *UserUsecase) grantInviteRewards(ctx context.Context, inviterID, inviteeID int64) {
+	if days := uc.inviteConf.GetInviterVipDays(); days > 0 {
+		if _, err := uc.vipUC.GrantVipDays(ctx, inviterID, days); err != nil {
+			uc.log.Errorf("发放邀请人奖励失败: inviterID=%d, inviteeID=%d, err=%v", inviterID, inviteeID, err)
+		}
+	}
+
+	if days := uc.inviteConf.GetInviteeVipDays(); days > 0 {
+		if _, err := uc.vipUC.GrantVipDays(ctx, inviteeID, days); err != nil {
+			uc.log.Errorf("发放被邀请人奖励失败: inviterID=%d, inviteeID=%d, err=%v", inviterID, inviteeID, err)
+		}
+	}
+
+	uc.log.Infof("邀请注册成功: inviterID=%d, inviteeID=%d", inviterID, inviteeID)
+}
@@ -0,0 +1,377 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	v1 "smart-collab-gallery-server/api/notification/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NotificationType 通知类型
+type NotificationType string
+
+const (
+	NotificationPictureLike    NotificationType = "picture_like"    // 图片被点赞
+	NotificationPictureComment NotificationType = "picture_comment" // 图片被评论
+	NotificationCommentReply   NotificationType = "comment_reply"   // 评论被回复
+	NotificationCommentMention NotificationType = "comment_mention" // 在评论中被提及
+	NotificationCommentReview  NotificationType = "comment_review"  // 评论审核结果
+	NotificationPictureReview  NotificationType = "picture_review"  // 图片审核结果
+)
+
+const (
+	// maxNotificationPageSize 通知每页最多返回条数
+	maxNotificationPageSize = 50
+	// maxNotificationContentLength 通知内容最大长度（字符数），超出部分截断
+	maxNotificationContentLength = 100
+	// notificationSubscriberBuffer 每个实时推送订阅者的缓冲大小，缓冲满时丢弃推送（客户端可通过列表接口补齐）
+	notificationSubscriberBuffer = 16
+	// maxStreamsPerUser 每个用户在本副本上同时建立的实时推送连接上限
+	maxStreamsPerUser = 5
+)
+
+// Notification 通知业务对象
+type Notification struct {
+	ID         int64
+	UserID     int64 // 接收用户 ID
+	SenderID   int64 // 触发用户 ID，系统通知为 0
+	Type       NotificationType
+	PictureID  int64
+	CommentID  int64
+	Content    string
+	Once       bool // 同一触发用户对同一图片的同类通知只保存一次
+	IsRead     bool
+	CreateTime time.Time
+}
+
+// NotificationVO 通知视图对象，同时作为跨副本推送的消息体
+type NotificationVO struct {
+	ID         int64            `json:"id"`
+	UserID     int64            `json:"userId"`
+	SenderID   int64            `json:"senderId"`
+	Type       NotificationType `json:"type"`
+	PictureID  int64            `json:"pictureId"`
+	CommentID  int64            `json:"commentId"`
+	Content    string           `json:"content"`
+	IsRead     bool             `json:"isRead"`
+	CreateTime time.Time        `json:"createTime"`
+	Sender     *UserVO          `json:"sender,omitempty"`
+}
+
+// ObjToVO 将通知业务对象转换为视图对象
+func (n *Notification) ObjToVO() *NotificationVO {
+	return &NotificationVO{
+		ID:         n.ID,
+		UserID:     n.UserID,
+		SenderID:   n.SenderID,
+		Type:       n.Type,
+		PictureID:  n.PictureID,
+		CommentID:  n.CommentID,
+		Content:    n.Content,
+		IsRead:     n.IsRead,
+		CreateTime: n.CreateTime,
+	}
+}
+
+// NotificationPage 通知游标分页结果
+type NotificationPage struct {
+	List       []*NotificationVO
+	NextCursor int64
+	HasMore    bool
+}
+
+// NotificationRepo 通知仓储接口
+type NotificationRepo interface {
+	// CreateNotifications 批量创建通知，Once 为 true 的通知已存在时不创建任何通知并返回空列表
+	CreateNotifications(ctx context.Context, notifications []*Notification) ([]*Notification, error)
+	// ListNotifications 查询用户 ID 小于 cursor 的通知（倒序）
+	ListNotifications(ctx context.Context, userID, cursor int64, limit int, unreadOnly bool) ([]*Notification, error)
+	// CountUnread 统计用户未读通知数
+	CountUnread(ctx context.Context, userID int64) (int64, error)
+	// MarkRead 将用户的指定通知标记为已读，返回实际标记的条数
+	MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error)
+	// MarkAllRead 将用户的全部通知标记为已读，返回实际标记的条数
+	MarkAllRead(ctx context.Context, userID int64) (int64, error)
+	// PublishNotification 通过 Redis 发布通知，所有副本都会收到
+	PublishNotification(ctx context.Context, notification *NotificationVO) error
+	// SubscribeNotifications 订阅所有副本发布的通知，ctx 结束时关闭返回的 channel
+	SubscribeNotifications(ctx context.Context) (<-chan *NotificationVO, error)
+}
+
+// NotificationUsecase 通知用例
+type NotificationUsecase struct {
+	repo     NotificationRepo
+	userRepo UserRepo
+	log      *log.Helper
+
+	// 本副本上通过 SSE 连接的订阅者，key 为用户 ID
+	mu          sync.RWMutex
+	subscribers map[int64]map[chan *NotificationVO]struct{}
+	closed      bool // RunPush 已退出（服务停止），不再接受新的订阅
+}
+
+// NewNotificationUsecase 创建通知用例
+func NewNotificationUsecase(repo NotificationRepo, userRepo UserRepo, logger log.Logger) *NotificationUsecase {
+	return &NotificationUsecase{
+		repo:        repo,
+		userRepo:    userRepo,
+		log:         log.NewHelper(logger),
+		subscribers: make(map[int64]map[chan *NotificationVO]struct{}),
+	}
+}
+
+// Notify 保存并实时推送通知，失败时只记录日志，不影响触发通知的业务
+// 接收者为空或与触发者相同的通知会被忽略
+func (uc *NotificationUsecase) Notify(ctx context.Context, notifications ...*Notification) {
+	valid := make([]*Notification, 0, len(notifications))
+	for _, n := range notifications {
+		if n == nil || n.UserID <= 0 || n.UserID == n.SenderID {
+			continue
+		}
+		n.Content = truncateNotificationContent(n.Content)
+		valid = append(valid, n)
+	}
+	if len(valid) == 0 {
+		return
+	}
+
+	created, err := uc.repo.CreateNotifications(ctx, valid)
+	if err != nil {
+		uc.log.Errorf("保存通知失败: %v", err)
+		return
+	}
+
+	list := make([]*NotificationVO, 0, len(created))
+	for _, n := range created {
+		list = append(list, n.ObjToVO())
+	}
+	uc.fillSenders(ctx, list)
+
+	for _, vo := range list {
+		if err := uc.repo.PublishNotification(ctx, vo); err != nil {
+			uc.log.Errorf("推送通知失败: id=%d, userID=%d, err=%v", vo.ID, vo.UserID, err)
+		}
+	}
+}
+
+// NotifyOnce 同一触发用户对同一图片的同类通知只发送一次（如反复取消再点赞），其余同 Notify
+// 由数据库唯一约束保证，并发发送时也只会保存一条
+func (uc *NotificationUsecase) NotifyOnce(ctx context.Context, n *Notification) {
+	n.Once = true
+	uc.Notify(ctx, n)
+}
+
+// ListNotifications 游标分页查询我的通知（按时间倒序）
+func (uc *NotificationUsecase) ListNotifications(ctx context.Context, userID, cursor, pageSize int64, unreadOnly bool) (*NotificationPage, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	if cursor <= 0 {
+		cursor = math.MaxInt64
+	}
+	if pageSize <= 0 || pageSize > maxNotificationPageSize {
+		pageSize = maxNotificationPageSize
+	}
+
+	notifications, err := uc.repo.ListNotifications(ctx, userID, cursor, int(pageSize)+1, unreadOnly)
+	if err != nil {
+		uc.log.Errorf("查询通知失败: userID=%d, err=%v", userID, err)
+		return nil, v1.ErrorSystemError("查询通知失败")
+	}
+
+	page := &NotificationPage{List: make([]*NotificationVO, 0, len(notifications))}
+	if len(notifications) > int(pageSize) {
+		page.HasMore = true
+		notifications = notifications[:pageSize]
+	}
+	for _, n := range notifications {
+		page.List = append(page.List, n.ObjToVO())
+	}
+	if len(notifications) > 0 {
+		page.NextCursor = notifications[len(notifications)-1].ID
+	}
+	uc.fillSenders(ctx, page.List)
+
+	return page, nil
+}
+
+// GetUnreadCount 查询未读通知数
+func (uc *NotificationUsecase) GetUnreadCount(ctx context.Context, userID int64) (int64, error) {
+	if userID <= 0 {
+		return 0, v1.ErrorUnauthorized("请先登录")
+	}
+
+	count, err := uc.repo.CountUnread(ctx, userID)
+	if err != nil {
+		uc.log.Errorf("统计未读通知失败: userID=%d, err=%v", userID, err)
+		return 0, v1.ErrorSystemError("查询未读通知数失败")
+	}
+	return count, nil
+}
+
+// MarkRead 将指定通知标记为已读，只会标记属于自己的通知
+func (uc *NotificationUsecase) MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error) {
+	if userID <= 0 {
+		return 0, v1.ErrorUnauthorized("请先登录")
+	}
+	if len(ids) == 0 {
+		return 0, v1.ErrorParamsError("通知 ID 不能为空")
+	}
+	if len(ids) > maxNotificationPageSize {
+		return 0, v1.ErrorParamsError("一次最多标记 50 条通知")
+	}
+
+	count, err := uc.repo.MarkRead(ctx, userID, ids)
+	if err != nil {
+		uc.log.Errorf("标记通知已读失败: userID=%d, err=%v", userID, err)
+		return 0, v1.ErrorSystemError("标记已读失败")
+	}
+	return count, nil
+}
+
+// MarkAllRead 将全部通知标记为已读
+func (uc *NotificationUsecase) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+	if userID <= 0 {
+		return 0, v1.ErrorUnauthorized("请先登录")
+	}
+
+	count, err := uc.repo.MarkAllRead(ctx, userID)
+	if err != nil {
+		uc.log.Errorf("标记全部通知已读失败: userID=%d, err=%v", userID, err)
+		return 0, v1.ErrorSystemError("标记已读失败")
+	}
+	return count, nil
+}
+
+// Subscribe 订阅用户的实时通知，调用返回的 cancel 取消订阅
+// 服务停止时返回的 channel 会被关闭；同一用户的订阅数超过上限时返回错误
+func (uc *NotificationUsecase) Subscribe(userID int64) (<-chan *NotificationVO, func(), error) {
+	ch := make(chan *NotificationVO, notificationSubscriberBuffer)
+
+	uc.mu.Lock()
+	if uc.closed {
+		uc.mu.Unlock()
+		return nil, nil, v1.ErrorSystemError("实时推送服务已停止")
+	}
+	if len(uc.subscribers[userID]) >= maxStreamsPerUser {
+		uc.mu.Unlock()
+		return nil, nil, v1.ErrorTooManyStreams("实时推送连接过多，请关闭其他页面后重试")
+	}
+	if uc.subscribers[userID] == nil {
+		uc.subscribers[userID] = make(map[chan *NotificationVO]struct{})
+	}
+	uc.subscribers[userID][ch] = struct{}{}
+	uc.mu.Unlock()
+
+	cancel := func() {
+		uc.mu.Lock()
+		defer uc.mu.Unlock()
+		if subs, ok := uc.subscribers[userID]; ok {
+			if _, ok := subs[ch]; ok {
+				delete(subs, ch)
+				close(ch)
+			}
+			if len(subs) == 0 {
+				delete(uc.subscribers, userID)
+			}
+		}
+	}
+	return ch, cancel, nil
+}
+
+// RunPush 订阅 Redis 中所有副本发布的通知，并分发给本副本上的订阅者，阻塞直到 ctx 结束
+// 退出时关闭所有订阅者的 channel，使 SSE 连接结束，不阻塞 HTTP 服务停止
+func (uc *NotificationUsecase) RunPush(ctx context.Context) error {
+	defer uc.closeSubscribers()
+
+	ch, err := uc.repo.SubscribeNotifications(ctx)
+	if err != nil {
+		uc.log.Errorf("订阅通知失败: %v", err)
+		return err
+	}
+
+	for vo := range ch {
+		uc.dispatch(vo)
+	}
+	return nil
+}
+
+// closeSubscribers 关闭并移除所有订阅者，之后的订阅请求直接返回错误
+func (uc *NotificationUsecase) closeSubscribers() {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	for userID, subs := range uc.subscribers {
+		for ch := range subs {
+			close(ch)
+		}
+		delete(uc.subscribers, userID)
+	}
+	uc.closed = true
+}
+
+// dispatch 将通知分发给本副本上该用户的所有订阅者，订阅者缓冲已满时丢弃
+func (uc *NotificationUsecase) dispatch(vo *NotificationVO) {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	for ch := range uc.subscribers[vo.UserID] {
+		select {
+		case ch <- vo:
+		default:
+			uc.log.Warnf("实时通知缓冲已满，丢弃推送: userID=%d, id=%d", vo.UserID, vo.ID)
+		}
+	}
+}
+
+// fillSenders 批量填充通知的触发用户信息
+func (uc *NotificationUsecase) fillSenders(ctx context.Context, notifications []*NotificationVO) {
+	userIDSet := make(map[int64]struct{})
+	userIDs := make([]int64, 0, len(notifications))
+	for _, n := range notifications {
+		if n.SenderID <= 0 {
+			continue
+		}
+		if _, ok := userIDSet[n.SenderID]; !ok {
+			userIDSet[n.SenderID] = struct{}{}
+			userIDs = append(userIDs, n.SenderID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return
+	}
+
+	users, err := uc.userRepo.ListUserByIDs(ctx, userIDs)
+	if err != nil {
+		uc.log.Errorf("批量查询用户失败: %v", err)
+		return
+	}
+
+	userMap := make(map[int64]*UserVO, len(users))
+	for _, user := range users {
+		userMap[user.ID] = &UserVO{
+			ID:          user.ID,
+			UserAccount: user.UserAccount,
+			UserName:    user.UserName,
+			UserAvatar:  user.UserAvatar,
+			UserProfile: user.UserProfile,
+			UserRole:    user.UserRole,
+		}
+	}
+	for _, n := range notifications {
+		n.Sender = userMap[n.SenderID]
+	}
+}
+
+// truncateNotificationContent 截断过长的通知内容
+func truncateNotificationContent(content string) string {
+	if utf8.RuneCountInString(content) <= maxNotificationContentLength {
+		return content
+	}
+	return string([]rune(content)[:maxNotificationContentLength]) + "..."
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"math"
+	"strings"
 	"time"
 
 	v1 "smart-collab-gallery-server/api/picture/v1"
+	"smart-collab-gallery-server/internal/conf"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -19,44 +21,153 @@ type PictureRepo interface {
 	GetPictureByID(ctx context.Context, id int64) (*Picture, error)
 	// UpdatePicture 更新图片
 	UpdatePicture(ctx context.Context, picture *Picture) error
+	// UpdatePictureSpace 修改图片所属的空间，spaceID 为 0 表示公共图库
+	UpdatePictureSpace(ctx context.Context, id, spaceID int64) error
+	// UpdatePictureReview 更新图片的审核状态、审核信息、审核人和审核时间
+	UpdatePictureReview(ctx context.Context, picture *Picture) error
 	// DeletePicture 删除图片（逻辑删除）
 	DeletePicture(ctx context.Context, id int64) error
 	// ListPictureByPage 分页查询图片
 	ListPictureByPage(ctx context.Context, params *PictureQueryParams) (*PicturePage, error)
+	// ListPictureByIDs 根据 ID 批量查询图片
+	ListPictureByIDs(ctx context.Context, ids []int64) ([]*Picture, error)
+	// ListPictureIDsByUserIDs 查询指定用户发布的、ID 小于 cursor 的审核通过的图片 ID（倒序）
+	ListPictureIDsByUserIDs(ctx context.Context, userIDs []int64, cursor int64, limit int) ([]int64, error)
+	// FindSimilarPictures 查询 scope 范围内感知哈希汉明距离不超过 maxDistance 的图片（按距离从近到远）
+	FindSimilarPictures(ctx context.Context, hash uint64, maxDistance int, scope SimilarScope, excludeID int64, limit int) ([]*PictureDistance, error)
+	// ListPictureHashes 查询最近上传的、已计算感知哈希的图片
+	ListPictureHashes(ctx context.Context, limit int) ([]*PictureHash, error)
+	// CountPictureFacets 统计满足查询条件（不含搜索词）的图片在各分面上的分布
+	CountPictureFacets(ctx context.Context, params *PictureQueryParams) (*PictureFacets, error)
 }
 
+// defaultMaxColorDistance 按颜色搜索时默认的最大色差（CIE76 ΔE），约为肉眼能明显区分的两种颜色
+const defaultMaxColorDistance = 30
+
 // PictureUsecase 图片用例
 type PictureUsecase struct {
-	pictureRepo PictureRepo
-	userRepo    UserRepo // 用于获取用户信息
-	log         *log.Helper
+	pictureRepo       PictureRepo
+	userRepo          UserRepo   // 用于获取用户信息
+	followRepo        FollowRepo // 用于关注动态
+	feedRepo          FeedRepo
+	interactionRepo   PictureInteractionRepo // 用于点赞、收藏、浏览计数
+	notificationUC    *NotificationUsecase   // 用于发送互动通知
+	uploadRepo        UploadRepo             // 用于校验图片文件是否已由当前用户上传完成
+	quotaUC           *QuotaUsecase          // 用于检查和更新存储配额
+	searcher          PictureSearcher        // 用于全文检索图片
+	taxonomyUC        *TaxonomyUsecase       // 用于校验分类和保存标签
+	albumRepo         AlbumRepo              // 用于批量移动图片到相册
+	spaceRepo         SpaceRepo              // 用于校验图片所属空间并读取空间设置
+	versionRepo       PictureVersionRepo     // 用于记录图片历史版本
+	fileRepo          PictureFileRepo        // 用于删除清理历史版本后不再使用的图片文件
+	tx                Transaction            // 用于批量操作
+	duplicate         DuplicateConfig        // 图片查重配置
+	versionRetentions versionRetentions      // 历史版本保留策略
+	log               *log.Helper
 }
 
 // NewPictureUsecase 创建图片用例
-func NewPictureUsecase(pictureRepo PictureRepo, userRepo UserRepo, logger log.Logger) *PictureUsecase {
+func NewPictureUsecase(pictureRepo PictureRepo, userRepo UserRepo, followRepo FollowRepo, feedRepo FeedRepo, interactionRepo PictureInteractionRepo, notificationUC *NotificationUsecase, uploadRepo UploadRepo, quotaUC *QuotaUsecase, searcher PictureSearcher, taxonomyUC *TaxonomyUsecase, albumRepo AlbumRepo, spaceRepo SpaceRepo, versionRepo PictureVersionRepo, fileRepo PictureFileRepo, tx Transaction, bc *conf.Bootstrap, logger log.Logger) *PictureUsecase {
 	return &PictureUsecase{
-		pictureRepo: pictureRepo,
-		userRepo:    userRepo,
-		log:         log.NewHelper(logger),
+		pictureRepo:       pictureRepo,
+		userRepo:          userRepo,
+		followRepo:        followRepo,
+		feedRepo:          feedRepo,
+		interactionRepo:   interactionRepo,
+		notificationUC:    notificationUC,
+		uploadRepo:        uploadRepo,
+		quotaUC:           quotaUC,
+		searcher:          searcher,
+		taxonomyUC:        taxonomyUC,
+		albumRepo:         albumRepo,
+		spaceRepo:         spaceRepo,
+		versionRepo:       versionRepo,
+		fileRepo:          fileRepo,
+		tx:                tx,
+		duplicate:         newDuplicateConfig(bc.GetDuplicate()),
+		versionRetentions: newVersionRetentions(bc.GetPictureVersion()),
+		log:               log.NewHelper(logger),
 	}
 }
 
-// UploadPicture 上传图片
-func (uc *PictureUsecase) UploadPicture(ctx context.Context, req *v1.UploadPictureRequest, userID int64) (*PictureVO, error) {
+// UploadPicture 上传图片，features 为从图片文件中提取的特征（无法解码时为 nil）
+// 查重模式为 warn 时同时返回已存在的相似图片
+func (uc *PictureUsecase) UploadPicture(ctx context.Context, req *v1.UploadPictureRequest, userID int64, features *PictureFeatures) (*PictureVO, []*SimilarPicture, error) {
 	uc.log.WithContext(ctx).Infof("上传图片: userID=%d, name=%s", userID, req.Name)
 
 	// 如果 ID 不为空，表示更新
+	urlChanged := true
+	var existPicture *Picture
+	var picSize, bytesDelta, pictureDelta int64
 	if req.Id > 0 {
 		// 检查图片是否存在
-		existPicture, err := uc.pictureRepo.GetPictureByID(ctx, req.Id)
-		if err != nil {
-			return nil, v1.ErrorPictureNotFound("图片不存在")
+		var err error
+		existPicture, err = uc.pictureRepo.GetPictureByID(ctx, req.Id)
+		if err != nil || existPicture == nil {
+			return nil, nil, v1.ErrorPictureNotFound("图片不存在")
 		}
 
 		// 检查权限：只能更新自己的图片
 		if existPicture.UserID != userID {
-			return nil, v1.ErrorPictureNoAuth("无权限操作该图片")
+			return nil, nil, v1.ErrorPictureNoAuth("无权限操作该图片")
+		}
+		// 地址为空表示只修改图片信息
+		urlChanged = req.Url != "" && existPicture.URL != req.Url
+		picSize = existPicture.PicSize
+	}
+
+	// 新图片上传到指定的空间，已有图片沿用所属空间
+	spaceID := req.SpaceId
+	if existPicture != nil {
+		spaceID = existPicture.SpaceID
+	}
+	space, err := uc.getPictureSpace(ctx, spaceID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 图片地址只能来自当前用户已完成且未被其他图片使用的上传
+	var similar []*SimilarPicture
+	var upload *Upload
+	if urlChanged {
+		var err error
+		upload, err = uc.uploadRepo.GetCompletedUploadByURL(ctx, userID, req.Url)
+		if err != nil {
+			uc.log.Errorf("查询上传记录失败: %v", err)
+			return nil, nil, v1.ErrorSystemError("上传图片失败")
+		}
+		if upload == nil {
+			return nil, nil, v1.ErrorParamsError("图片文件未上传完成、不属于当前用户或已被使用")
+		}
+		if err := uc.verifyUploadedFile(ctx, upload); err != nil {
+			return nil, nil, err
 		}
+
+		// 以上传记录中校验过的实际大小计入存储用量，服务端清除过元数据时为清除后的大小
+		bytesDelta = upload.FileSize - picSize
+		picSize = upload.FileSize
+
+		if features != nil {
+			if similar, err = uc.checkDuplicate(ctx, features.PHash, userID, req.Id, space); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	if req.Id == 0 {
+		pictureDelta = 1
+	}
+	if err := uc.quotaUC.CheckPicture(ctx, userID, bytesDelta, pictureDelta); err != nil {
+		return nil, nil, err
+	}
+
+	// 校验分类和标签，别名归一为正式名称
+	category, err := uc.resolvePictureCategory(ctx, req.Category)
+	if err != nil {
+		return nil, nil, err
+	}
+	tags, tagsJSON, err := uc.resolvePictureTags(ctx, req.Tags)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	// 构造图片对象
@@ -65,46 +176,116 @@ func (uc *PictureUsecase) UploadPicture(ctx context.Context, req *v1.UploadPictu
 		URL:          req.Url,
 		Name:         req.Name,
 		Introduction: req.Introduction,
-		Category:     req.Category,
-		PicSize:      req.PicSize,
+		Category:     category,
+		Tags:         tagsJSON,
+		PicSize:      picSize,
 		PicWidth:     req.PicWidth,
 		PicHeight:    req.PicHeight,
 		PicFormat:    req.PicFormat,
 		UserID:       userID,
+		SpaceID:      spaceID,
+		ReviewStatus: ReviewStatusPass,
+	}
+	if existPicture != nil {
+		// 沿用原图片的审核状态，审核未通过的图片重新上传后进入待审核状态
+		picture.ReviewStatus = existPicture.ReviewStatus
+		if urlChanged {
+			picture.resubmitReview()
+		}
+	}
+
+	switch {
+	case features != nil:
+		// 以从图片文件中解析出的信息为准，不使用客户端传入的宽高和格式
+		picture.PHash = &features.PHash
+		picture.PicColor = features.Color
+		picture.ColorLab = &features.ColorLab
+		if paletteBytes, err := json.Marshal(features.Palette); err == nil && len(features.Palette) > 0 {
+			picture.PicPalette = string(paletteBytes)
+		}
+		picture.PicWidth = features.Width
+		picture.PicHeight = features.Height
+		picture.PicFormat = features.Format
+		if features.Metadata != nil {
+			if metadataBytes, err := json.Marshal(features.Metadata); err == nil {
+				picture.PicMetadata = string(metadataBytes)
+			}
+		}
+	case !urlChanged:
+		// 图片文件未变化时沿用已有的图片信息
+		picture.PHash = existPicture.PHash
+		picture.PicColor = existPicture.PicColor
+		picture.PicPalette = existPicture.PicPalette
+		picture.ColorLab = existPicture.ColorLab
+		picture.PicWidth = existPicture.PicWidth
+		picture.PicHeight = existPicture.PicHeight
+		picture.PicFormat = existPicture.PicFormat
+		picture.PicMetadata = existPicture.PicMetadata
 	}
 
 	// 计算图片宽高比
-	if req.PicHeight > 0 {
-		picture.PicScale = math.Round(float64(req.PicWidth)/float64(req.PicHeight)*100) / 100
+	if picture.PicHeight > 0 {
+		picture.PicScale = math.Round(float64(picture.PicWidth)/float64(picture.PicHeight)*100) / 100
 	}
 
-	// 转换标签为 JSON
-	if len(req.Tags) > 0 {
-		tagsBytes, err := json.Marshal(req.Tags)
-		if err != nil {
-			return nil, v1.ErrorParamsError("标签格式错误")
-		}
-		picture.Tags = string(tagsBytes)
+	// 标签为空时清空图片的标签关联
+	if tags == nil {
+		tags = []*TaxonomyTerm{}
 	}
 
 	var result *Picture
-	var err error
-
 	if req.Id > 0 {
-		// 更新图片
+		// 在同一个事务中使用上传的文件、更新存储用量和空间用量、图片和标签，同时记录修改前的版本
 		picture.EditTime = time.Now()
-		err = uc.pictureRepo.UpdatePicture(ctx, picture)
+		err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+			if err := uc.consumeUpload(ctx, upload); err != nil {
+				return err
+			}
+			if err := uc.quotaUC.ChargeUsage(ctx, userID, bytesDelta, pictureDelta); err != nil {
+				return err
+			}
+			if err := uc.quotaUC.ChargeSpaceUsage(ctx, spaceID, bytesDelta, pictureDelta); err != nil {
+				return err
+			}
+			return uc.savePictureWithVersion(ctx, existPicture, picture, tags, PictureVersionUpload, userID)
+		})
+		if err == errUploadConsumed || isQuotaExceeded(err) {
+			return nil, nil, err
+		}
 		if err != nil {
-			return nil, v1.ErrorPictureUpdateFailed("图片更新失败")
+			return nil, nil, v1.ErrorPictureUpdateFailed("图片更新失败")
 		}
+		uc.pruneVersions(ctx, existPicture)
 		result = picture
 	} else {
-		// 创建新图片
-		result, err = uc.pictureRepo.CreatePicture(ctx, picture)
+		// 在同一个事务中使用上传的文件、更新存储用量和空间用量、创建新图片并保存标签
+		err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+			if err := uc.consumeUpload(ctx, upload); err != nil {
+				return err
+			}
+			if err := uc.quotaUC.ChargeUsage(ctx, userID, bytesDelta, pictureDelta); err != nil {
+				return err
+			}
+			if err := uc.quotaUC.ChargeSpaceUsage(ctx, spaceID, bytesDelta, pictureDelta); err != nil {
+				return err
+			}
+			var err error
+			if result, err = uc.pictureRepo.CreatePicture(ctx, picture); err != nil {
+				return err
+			}
+			return uc.taxonomyUC.SetPictureTags(ctx, result.ID, tags)
+		})
+		if err == errUploadConsumed || isQuotaExceeded(err) {
+			return nil, nil, err
+		}
 		if err != nil {
-			return nil, v1.ErrorPictureUploadFailed("图片上传失败")
+			return nil, nil, v1.ErrorPictureUploadFailed("图片上传失败")
 		}
+
+		// 推送到粉丝的关注动态
+		uc.pushToFollowerFeeds(ctx, result)
 	}
+	uc.indexPicture(ctx, result.ID)
 
 	// 转换为 VO
 	pictureVO := result.ObjToVO()
@@ -122,7 +303,102 @@ func (uc *PictureUsecase) UploadPicture(ctx context.Context, req *v1.UploadPictu
 		}
 	}
 
-	return pictureVO, nil
+	return pictureVO, similar, nil
+}
+
+// getPictureSpace 查询图片所属的空间，spaceID 为 0 时返回 nil
+// 只能向自己创建的空间上传图片
+func (uc *PictureUsecase) getPictureSpace(ctx context.Context, spaceID, userID int64) (*Space, error) {
+	if spaceID <= 0 {
+		return nil, nil
+	}
+
+	space, err := uc.spaceRepo.GetSpaceByID(ctx, spaceID)
+	if err != nil {
+		uc.log.Errorf("查询空间失败: id=%d, err=%v", spaceID, err)
+		return nil, v1.ErrorSystemError("查询空间失败")
+	}
+	if space == nil {
+		return nil, v1.ErrorParamsError("空间不存在")
+	}
+	if space.UserID != userID {
+		return nil, v1.ErrorPictureNoAuth("无权限操作该空间")
+	}
+	return space, nil
+}
+
+// errUploadConsumed 上传的文件已被其他图片使用
+var errUploadConsumed = v1.ErrorParamsError("图片文件已被使用，请重新上传")
+
+// verifyUploadedFile 确认存储桶中的文件在上传完成后未被改写（如客户端在上传地址过期前再次上传）
+// 没有记录 ETag 的旧上传记录不做检查
+func (uc *PictureUsecase) verifyUploadedFile(ctx context.Context, upload *Upload) error {
+	if upload.ETag == "" {
+		return nil
+	}
+	etag, err := uc.fileRepo.GetPictureFileETag(ctx, upload.URL)
+	if err != nil {
+		uc.log.Errorf("查询图片文件失败: url=%s, err=%v", upload.URL, err)
+		return v1.ErrorSystemError("上传图片失败")
+	}
+	if etag != upload.ETag {
+		return v1.ErrorParamsError("图片文件在上传完成后被改写，请重新上传")
+	}
+	return nil
+}
+
+// consumeUpload 将上传标记为已被图片使用，需与创建或更新图片在同一个事务中调用，upload 为 nil 时不处理
+// 同一个上传只能被一张图片使用，已被使用时返回 errUploadConsumed
+func (uc *PictureUsecase) consumeUpload(ctx context.Context, upload *Upload) error {
+	if upload == nil {
+		return nil
+	}
+	ok, err := uc.uploadRepo.ConsumeUpload(ctx, upload.ID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errUploadConsumed
+	}
+	return nil
+}
+
+// ShouldStripMetadata 判断是否需要清除图片文件中的位置等敏感元数据
+// 用户选择保留或文件不是当前用户已完成的上传时不清除，避免改写他人的文件
+func (uc *PictureUsecase) ShouldStripMetadata(ctx context.Context, userID int64, url string) (bool, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		uc.log.Errorf("查询用户失败: %v", err)
+		return false, v1.ErrorSystemError("查询用户失败")
+	}
+	if user == nil || user.KeepPhotoMetadata {
+		return false, nil
+	}
+
+	upload, err := uc.uploadRepo.GetCompletedUploadByURL(ctx, userID, url)
+	if err != nil {
+		uc.log.Errorf("查询上传记录失败: %v", err)
+		return false, v1.ErrorSystemError("查询上传记录失败")
+	}
+	return upload != nil, nil
+}
+
+// RecordRewrittenFile 服务端改写用户已完成上传的文件（如清除敏感元数据）后更新上传记录中的文件大小和 ETag，
+// 使用文件时不会被当作客户端改写而拒绝，图片大小和存储用量按改写后的大小计算
+func (uc *PictureUsecase) RecordRewrittenFile(ctx context.Context, userID int64, url string, fileSize int64, etag string) error {
+	upload, err := uc.uploadRepo.GetCompletedUploadByURL(ctx, userID, url)
+	if err != nil {
+		uc.log.Errorf("查询上传记录失败: %v", err)
+		return v1.ErrorSystemError("查询上传记录失败")
+	}
+	if upload == nil {
+		return nil
+	}
+	if err := uc.uploadRepo.UpdateUploadFile(ctx, upload.ID, fileSize, etag); err != nil {
+		uc.log.Errorf("更新上传记录失败: uploadID=%d, err=%v", upload.ID, err)
+		return v1.ErrorSystemError("更新上传记录失败")
+	}
+	return nil
 }
 
 // GetPictureByID 根据 ID 获取图片
@@ -161,41 +437,110 @@ func (uc *PictureUsecase) GetPictureByID(ctx context.Context, id int64) (*Pictur
 func (uc *PictureUsecase) ListPictureByPage(ctx context.Context, params *PictureQueryParams) (*PicturePage, error) {
 	uc.log.WithContext(ctx).Infof("分页查询图片: current=%d, pageSize=%d", params.Current, params.PageSize)
 
+	if params.Color != nil && params.MaxColorDistance <= 0 {
+		params.MaxColorDistance = defaultMaxColorDistance
+	}
+	params.SearchText = strings.TrimSpace(params.SearchText)
+	if params.CursorMode {
+		// 按相关度或色差排序时游标按偏移量定位
+		kinds := PictureSortKinds
+		if params.SearchText != "" || params.Color != nil {
+			kinds = nil
+		}
+		cursor, ok := parsePageCursor(params.Cursor, params.SortField, params.SortOrder, kinds)
+		if !ok {
+			return nil, v1.ErrorParamsError("无效的游标")
+		}
+		params.After = cursor
+	}
+
+	// 有搜索词时使用全文检索，按相关度排序
+	if params.SearchText != "" {
+		return uc.searchPictures(ctx, params)
+	}
+
 	page, err := uc.pictureRepo.ListPictureByPage(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	// 填充用户信息
-	userIDs := make(map[int64]bool)
-	for _, pic := range page.List {
-		userIDs[pic.UserID] = true
-	}
-
-	// 批量查询用户信息（简化实现，实际可以优化为批量查询）
-	userMap := make(map[int64]*UserVO)
-	for userID := range userIDs {
-		user, err := uc.userRepo.GetUserByID(ctx, userID)
-		if err == nil && user != nil {
-			userMap[userID] = &UserVO{
-				ID:          user.ID,
-				UserAccount: user.UserAccount,
-				UserName:    user.UserName,
-				UserAvatar:  user.UserAvatar,
-				UserProfile: user.UserProfile,
-				UserRole:    user.UserRole,
-			}
+	uc.fillPictureUsers(ctx, page.List)
+
+	return page, nil
+}
+
+// fillPictureUsers 批量查询并填充图片的创建用户信息
+func (uc *PictureUsecase) fillPictureUsers(ctx context.Context, pictures []*PictureVO) {
+	if len(pictures) == 0 {
+		return
+	}
+
+	userIDSet := make(map[int64]struct{})
+	userIDs := make([]int64, 0, len(pictures))
+	for _, pic := range pictures {
+		if _, ok := userIDSet[pic.UserID]; !ok {
+			userIDSet[pic.UserID] = struct{}{}
+			userIDs = append(userIDs, pic.UserID)
 		}
 	}
 
-	// 填充用户信息到图片 VO
-	for _, pic := range page.List {
+	users, err := uc.userRepo.ListUserByIDs(ctx, userIDs)
+	if err != nil {
+		uc.log.Errorf("批量查询用户失败: %v", err)
+		return
+	}
+
+	userMap := make(map[int64]*UserVO, len(users))
+	for _, user := range users {
+		userMap[user.ID] = &UserVO{
+			ID:          user.ID,
+			UserAccount: user.UserAccount,
+			UserName:    user.UserName,
+			UserAvatar:  user.UserAvatar,
+			UserProfile: user.UserProfile,
+			UserRole:    user.UserRole,
+		}
+	}
+
+	for _, pic := range pictures {
 		if user, ok := userMap[pic.UserID]; ok {
 			pic.User = user
 		}
 	}
+}
 
-	return page, nil
+// ListPictureVOsByIDs 按 ID 批量查询图片并填充创建用户信息，保持 ids 的顺序
+// 已删除的图片和对 viewerID（0 表示未登录）不可见的未通过审核的图片会被过滤
+func (uc *PictureUsecase) ListPictureVOsByIDs(ctx context.Context, ids []int64, viewerID int64) ([]*PictureVO, error) {
+	return uc.listPictureVOsByIDs(ctx, ids, func(picture *Picture) bool {
+		return picture.visibleTo(viewerID)
+	})
+}
+
+// listPictureVOsByIDs 按 ID 批量查询满足 keep 的图片并填充创建用户信息，保持 ids 的顺序，keep 为 nil 时不过滤
+func (uc *PictureUsecase) listPictureVOsByIDs(ctx context.Context, ids []int64, keep func(picture *Picture) bool) ([]*PictureVO, error) {
+	pictures, err := uc.pictureRepo.ListPictureByIDs(ctx, ids)
+	if err != nil {
+		uc.log.Errorf("批量查询图片失败: %v", err)
+		return nil, err
+	}
+
+	pictureMap := make(map[int64]*Picture, len(pictures))
+	for _, picture := range pictures {
+		if keep == nil || keep(picture) {
+			pictureMap[picture.ID] = picture
+		}
+	}
+	list := make([]*PictureVO, 0, len(ids))
+	for _, id := range ids {
+		if picture, ok := pictureMap[id]; ok {
+			list = append(list, picture.ObjToVO())
+		}
+	}
+	uc.fillPictureUsers(ctx, list)
+
+	return list, nil
 }
 
 // DeletePicture 删除图片
@@ -223,6 +568,11 @@ func (uc *PictureUsecase) DeletePicture(ctx context.Context, id int64, userID in
 		return v1.ErrorPictureDeleteFailed("图片删除失败")
 	}
 
+	// 释放图片作者的存储用量和所属空间的用量
+	uc.quotaUC.AddUsage(ctx, picture.UserID, -picture.PicSize, -1)
+	uc.quotaUC.AddSpaceUsage(ctx, picture.SpaceID, -picture.PicSize, -1)
+	uc.removePictureIndex(ctx, id)
+
 	return nil
 }
 
@@ -245,25 +595,28 @@ func (uc *PictureUsecase) UpdatePicture(ctx context.Context, id int64, name, int
 		return v1.ErrorPictureNoAuth("无权限操作该图片")
 	}
 
-	// 更新字段
-	picture.Name = name
-	picture.Introduction = introduction
-	picture.Category = category
-	picture.EditTime = time.Now()
-
-	// 转换标签为 JSON
+	// 校验分类和标签，标签为空时保留原有标签
+	old := *picture
+	if picture.Category, err = uc.resolvePictureCategory(ctx, category); err != nil {
+		return err
+	}
+	var tagTerms []*TaxonomyTerm
 	if len(tags) > 0 {
-		tagsBytes, err := json.Marshal(tags)
-		if err != nil {
-			return v1.ErrorParamsError("标签格式错误")
+		if tagTerms, picture.Tags, err = uc.resolvePictureTags(ctx, tags); err != nil {
+			return err
 		}
-		picture.Tags = string(tagsBytes)
 	}
 
-	err = uc.pictureRepo.UpdatePicture(ctx, picture)
+	// 更新字段和标签，同时记录修改前的版本
+	picture.Name = name
+	picture.Introduction = introduction
+	picture.EditTime = time.Now()
+
+	err = uc.updatePictureWithVersion(ctx, &old, picture, tagTerms, PictureVersionUpdate, userID)
 	if err != nil {
 		return v1.ErrorPictureUpdateFailed("图片更新失败")
 	}
+	uc.indexPicture(ctx, id)
 
 	return nil
 }
@@ -287,25 +640,28 @@ func (uc *PictureUsecase) EditPicture(ctx context.Context, id int64, name, intro
 		return v1.ErrorPictureNoAuth("无权限操作该图片")
 	}
 
-	// 更新字段
-	picture.Name = name
-	picture.Introduction = introduction
-	picture.Category = category
-	picture.EditTime = time.Now()
-
-	// 转换标签为 JSON
+	// 校验分类和标签，标签为空时保留原有标签
+	old := *picture
+	if picture.Category, err = uc.resolvePictureCategory(ctx, category); err != nil {
+		return err
+	}
+	var tagTerms []*TaxonomyTerm
 	if len(tags) > 0 {
-		tagsBytes, err := json.Marshal(tags)
-		if err != nil {
-			return v1.ErrorParamsError("标签格式错误")
+		if tagTerms, picture.Tags, err = uc.resolvePictureTags(ctx, tags); err != nil {
+			return err
 		}
-		picture.Tags = string(tagsBytes)
 	}
 
-	err = uc.pictureRepo.UpdatePicture(ctx, picture)
+	// 更新字段和标签，同时记录修改前的版本
+	picture.Name = name
+	picture.Introduction = introduction
+	picture.EditTime = time.Now()
+
+	err = uc.updatePictureWithVersion(ctx, &old, picture, tagTerms, PictureVersionEdit, userID)
 	if err != nil {
 		return v1.ErrorPictureUpdateFailed("图片编辑失败")
 	}
+	uc.indexPicture(ctx, id)
 
 	return nil
 }
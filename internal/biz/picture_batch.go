@@ -0,0 +1,623 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "smart-collab-gallery-server/api/picture/v1"
+)
+
+const (
+	// maxBatchPictures 单次批量操作最多的图片数
+	maxBatchPictures = 200
+	// maxPictureNameLength 图片名称最大长度（字符数）
+	maxPictureNameLength = 128
+	// maxReviewMessageLength 审核信息最大长度（字符数）
+	maxReviewMessageLength = 512
+)
+
+// BatchTagMode 批量修改标签的方式
+type BatchTagMode string
+
+const (
+	BatchTagNone    BatchTagMode = ""        // 不修改标签
+	BatchTagAdd     BatchTagMode = "add"     // 在原有标签后添加
+	BatchTagRemove  BatchTagMode = "remove"  // 从原有标签中移除
+	BatchTagReplace BatchTagMode = "replace" // 替换全部标签
+)
+
+// BatchEditParams 批量修改分类和标签参数
+type BatchEditParams struct {
+	IDs           []int64
+	Category      string // 新分类，为空时不修改
+	ClearCategory bool   // 清空分类
+	TagMode       BatchTagMode
+	Tags          []string
+}
+
+// BatchPictureResult 单张图片的批量操作结果，Err 为 nil 表示成功
+type BatchPictureResult struct {
+	ID  int64
+	Err error
+}
+
+// batchItem 批量操作中有权限操作的图片
+type batchItem struct {
+	picture  *Picture
+	original Picture // 修改前的图片信息，用于记录历史版本
+	result   *BatchPictureResult
+	tagNames []string        // 批量修改标签时图片的新标签名称
+	tags     []*TaxonomyTerm // 批量修改标签时图片的新标签
+}
+
+// BatchEditPictures 批量修改分类和标签，标签修改后超过上限的图片跳过
+func (uc *PictureUsecase) BatchEditPictures(ctx context.Context, params *BatchEditParams, userID int64, isAdmin bool) ([]*BatchPictureResult, error) {
+	uc.log.WithContext(ctx).Infof("批量修改图片: count=%d, userID=%d", len(params.IDs), userID)
+
+	switch params.TagMode {
+	case BatchTagNone, BatchTagReplace:
+	case BatchTagAdd, BatchTagRemove:
+		if len(params.Tags) == 0 {
+			return nil, v1.ErrorParamsError("标签不能为空")
+		}
+	default:
+		return nil, v1.ErrorParamsError("tag_mode 只能为 add、remove 或 replace")
+	}
+	if params.TagMode == BatchTagNone && strings.TrimSpace(params.Category) == "" && !params.ClearCategory {
+		return nil, v1.ErrorParamsError("请指定要修改的分类或标签")
+	}
+
+	// 分类和标签只需解析一次
+	category := ""
+	if !params.ClearCategory {
+		var err error
+		if category, err = uc.resolvePictureCategory(ctx, params.Category); err != nil {
+			return nil, err
+		}
+	}
+	var tagTerms []*TaxonomyTerm
+	removeKeys := make(map[string]struct{})
+	switch params.TagMode {
+	case BatchTagAdd, BatchTagReplace:
+		var err error
+		if tagTerms, _, err = uc.resolvePictureTags(ctx, params.Tags); err != nil {
+			return nil, err
+		}
+	case BatchTagRemove:
+		terms, err := uc.taxonomyUC.FindTags(ctx, params.Tags)
+		if err != nil {
+			return nil, v1.ErrorSystemError("查询标签失败")
+		}
+		for _, tag := range params.Tags {
+			removeKeys[TermKey(strings.TrimSpace(tag))] = struct{}{}
+		}
+		for _, term := range terms {
+			removeKeys[TermKey(term.Name)] = struct{}{}
+		}
+	}
+
+	results, items, err := uc.loadBatchPictures(ctx, params.IDs, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	// 逐张计算新的分类和标签名称
+	pending := items[:0]
+	var allTagNames []string
+	for _, item := range items {
+		picture := item.picture
+		if params.ClearCategory || category != "" {
+			picture.Category = category
+		}
+		if params.TagMode != BatchTagNone {
+			names := make([]string, 0, maxPictureTags)
+			if params.TagMode != BatchTagReplace {
+				for _, tag := range pictureTagNames(picture) {
+					if _, ok := removeKeys[TermKey(tag)]; !ok {
+						names = append(names, tag)
+					}
+				}
+			}
+			for _, term := range tagTerms {
+				names = append(names, term.Name)
+			}
+			if item.tagNames, err = normalizePictureTags(names); err != nil {
+				item.result.Err = err
+				continue
+			}
+			allTagNames = append(allTagNames, item.tagNames...)
+		}
+		picture.EditTime = time.Now()
+		pending = append(pending, item)
+	}
+
+	// 所有图片的标签只解析一次，不存在的标签在此统一创建
+	if params.TagMode != BatchTagNone {
+		terms, err := uc.taxonomyUC.ResolveTagTerms(ctx, allTagNames)
+		if err != nil {
+			return nil, v1.ErrorSystemError("保存标签失败")
+		}
+		for _, item := range pending {
+			item.tags = PickTags(terms, item.tagNames)
+			if item.picture.Tags, err = pictureTagsJSON(item.tags); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = uc.execBatch(ctx, pending, v1.ErrorPictureUpdateFailed("批量修改图片失败"), func(ctx context.Context, item *batchItem) error {
+		if err := uc.pictureRepo.UpdatePicture(ctx, item.picture); err != nil {
+			return err
+		}
+		if params.TagMode != BatchTagNone {
+			if err := uc.savePictureTags(ctx, item.picture.ID, item.tags); err != nil {
+				return err
+			}
+		}
+		return uc.recordVersion(ctx, &item.original, item.picture, PictureVersionBatchEdit, userID)
+	})
+	if err == nil {
+		for _, item := range pending {
+			uc.indexPicture(ctx, item.picture.ID)
+			uc.pruneVersions(ctx, item.picture)
+		}
+	}
+
+	return results, nil
+}
+
+// BatchRenamePictures 按模板批量重命名，{index} 替换为从 startIndex 开始的序号（按 ids 的顺序），{name} 替换为原名称
+func (uc *PictureUsecase) BatchRenamePictures(ctx context.Context, ids []int64, pattern string, startIndex int64, userID int64, isAdmin bool) ([]*BatchPictureResult, error) {
+	uc.log.WithContext(ctx).Infof("批量重命名图片: count=%d, pattern=%s, userID=%d", len(ids), pattern, userID)
+
+	if strings.TrimSpace(pattern) == "" {
+		return nil, v1.ErrorParamsError("名称模板不能为空")
+	}
+	if startIndex <= 0 {
+		startIndex = 1
+	}
+
+	results, items, err := uc.loadBatchPictures(ctx, ids, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[int64]int64, len(results))
+	for i, result := range results {
+		positions[result.ID] = int64(i)
+	}
+	pending := items[:0]
+	for _, item := range items {
+		picture := item.picture
+		name, err := renamePicture(pattern, startIndex+positions[picture.ID], picture.Name)
+		if err != nil {
+			item.result.Err = err
+			continue
+		}
+		picture.Name = name
+		picture.EditTime = time.Now()
+		pending = append(pending, item)
+	}
+
+	err = uc.execBatch(ctx, pending, v1.ErrorPictureUpdateFailed("批量重命名图片失败"), func(ctx context.Context, item *batchItem) error {
+		if err := uc.pictureRepo.UpdatePicture(ctx, item.picture); err != nil {
+			return err
+		}
+		return uc.recordVersion(ctx, &item.original, item.picture, PictureVersionBatchRename, userID)
+	})
+	if err == nil {
+		for _, item := range pending {
+			uc.indexPicture(ctx, item.picture.ID)
+			uc.pruneVersions(ctx, item.picture)
+		}
+	}
+
+	return results, nil
+}
+
+// renamePicture 按模板生成新名称，{index} 替换为序号，{name} 替换为原名称
+func renamePicture(pattern string, index int64, name string) (string, error) {
+	renamed := strings.NewReplacer(
+		"{index}", strconv.FormatInt(index, 10),
+		"{name}", name,
+	).Replace(pattern)
+	renamed = strings.TrimSpace(renamed)
+	if renamed == "" {
+		return "", v1.ErrorParamsError("图片名称不能为空")
+	}
+	if utf8.RuneCountInString(renamed) > maxPictureNameLength {
+		return "", v1.ErrorParamsError(fmt.Sprintf("图片名称不能超过 %d 个字符", maxPictureNameLength))
+	}
+	return renamed, nil
+}
+
+// BatchDeletePictures 批量删除图片，并释放各图片作者的存储用量和所属空间的用量
+func (uc *PictureUsecase) BatchDeletePictures(ctx context.Context, ids []int64, userID int64, isAdmin bool) ([]*BatchPictureResult, error) {
+	uc.log.WithContext(ctx).Infof("批量删除图片: count=%d, userID=%d", len(ids), userID)
+
+	results, items, err := uc.loadBatchPictures(ctx, ids, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.execBatch(ctx, items, v1.ErrorPictureDeleteFailed("批量删除图片失败"), func(ctx context.Context, item *batchItem) error {
+		return uc.pictureRepo.DeletePicture(ctx, item.picture.ID)
+	})
+	if err != nil {
+		return results, nil
+	}
+
+	// 按作者和空间汇总释放的存储用量
+	type usage struct{ bytes, pictures int64 }
+	usages := make(map[int64]*usage)
+	spaceUsages := make(map[int64]*usage)
+	add := func(usages map[int64]*usage, key int64, picture *Picture) {
+		u := usages[key]
+		if u == nil {
+			u = &usage{}
+			usages[key] = u
+		}
+		u.bytes += picture.PicSize
+		u.pictures++
+	}
+	for _, item := range items {
+		add(usages, item.picture.UserID, item.picture)
+		if item.picture.SpaceID > 0 {
+			add(spaceUsages, item.picture.SpaceID, item.picture)
+		}
+		uc.removePictureIndex(ctx, item.picture.ID)
+	}
+	for ownerID, u := range usages {
+		uc.quotaUC.AddUsage(ctx, ownerID, -u.bytes, -u.pictures)
+	}
+	for spaceID, u := range spaceUsages {
+		uc.quotaUC.AddSpaceUsage(ctx, spaceID, -u.bytes, -u.pictures)
+	}
+
+	return results, nil
+}
+
+// BatchMovePictures 批量将图片加入自己创建的相册，fromAlbumID 不为 0 时同时从原相册移除
+func (uc *PictureUsecase) BatchMovePictures(ctx context.Context, ids []int64, albumID, fromAlbumID int64, userID int64, isAdmin bool) ([]*BatchPictureResult, error) {
+	uc.log.WithContext(ctx).Infof("批量移动图片: count=%d, albumID=%d, fromAlbumID=%d, userID=%d", len(ids), albumID, fromAlbumID, userID)
+
+	album, err := uc.getBatchAlbum(ctx, albumID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if fromAlbumID == albumID {
+		return nil, v1.ErrorParamsError("目标相册不能与原相册相同")
+	}
+	var fromAlbum *Album
+	if fromAlbumID > 0 {
+		if fromAlbum, err = uc.getBatchAlbum(ctx, fromAlbumID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	results, items, err := uc.loadBatchPictures(ctx, ids, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return results, nil
+	}
+
+	pictureIDs := make([]int64, 0, len(items))
+	for _, item := range items {
+		pictureIDs = append(pictureIDs, item.picture.ID)
+	}
+	// 已在目标相册中的图片不会重复添加，因此在事务中按实际添加后的图片数检查上限
+	// 锁定目标相册行，避免并发添加同时通过检查
+	errAlbumFull := v1.ErrorParamsError(fmt.Sprintf("相册最多包含 %d 张图片", maxAlbumPictures))
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		locked, err := uc.albumRepo.GetAlbumForUpdate(ctx, albumID)
+		if err != nil {
+			return err
+		}
+		if locked != nil {
+			album = locked
+		}
+
+		added, err := uc.albumRepo.AddAlbumPictures(ctx, albumID, pictureIDs)
+		if err != nil {
+			return err
+		}
+		if added > 0 {
+			counts, err := uc.albumRepo.CountAlbumPictures(ctx, []int64{albumID})
+			if err != nil {
+				return err
+			}
+			if counts[albumID] > maxAlbumPictures {
+				return errAlbumFull
+			}
+		}
+		// 相册没有封面时使用第一张加入的图片作为封面
+		if album.CoverPictureID == 0 {
+			album.CoverPictureID = pictureIDs[0]
+			if err := uc.albumRepo.UpdateAlbum(ctx, album); err != nil {
+				return err
+			}
+		}
+		if fromAlbum == nil {
+			return nil
+		}
+
+		if _, err := uc.albumRepo.RemoveAlbumPictures(ctx, fromAlbumID, pictureIDs); err != nil {
+			return err
+		}
+		// 移出原相册封面图片时清空封面
+		for _, id := range pictureIDs {
+			if id == fromAlbum.CoverPictureID {
+				fromAlbum.CoverPictureID = 0
+				return uc.albumRepo.UpdateAlbum(ctx, fromAlbum)
+			}
+		}
+		return nil
+	})
+	if err == errAlbumFull {
+		return nil, err
+	}
+	if err != nil {
+		uc.log.Errorf("批量移动图片失败: albumID=%d, err=%v", albumID, err)
+		for _, item := range items {
+			item.result.Err = v1.ErrorSystemError("移动图片失败")
+		}
+	}
+
+	return results, nil
+}
+
+// BatchMovePicturesToSpace 批量将图片移动到图片作者创建的空间，spaceID 为 0 时移回公共图库
+// 在同一个事务中转移原空间和目标空间的用量，超出目标空间的上限时整体失败
+func (uc *PictureUsecase) BatchMovePicturesToSpace(ctx context.Context, ids []int64, spaceID int64, userID int64, isAdmin bool) ([]*BatchPictureResult, error) {
+	uc.log.WithContext(ctx).Infof("批量移动图片到空间: count=%d, spaceID=%d, userID=%d", len(ids), spaceID, userID)
+
+	var space *Space
+	if spaceID > 0 {
+		var err error
+		if space, err = uc.spaceRepo.GetSpaceByID(ctx, spaceID); err != nil {
+			uc.log.Errorf("查询空间失败: id=%d, err=%v", spaceID, err)
+			return nil, v1.ErrorSystemError("查询空间失败")
+		}
+		if space == nil {
+			return nil, v1.ErrorParamsError("空间不存在")
+		}
+		if space.UserID != userID && !isAdmin {
+			return nil, v1.ErrorPictureNoAuth("无权限操作该空间")
+		}
+	}
+
+	results, items, err := uc.loadBatchPictures(ctx, ids, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	// 空间中的图片都属于空间创建者，已在目标空间中的图片无需移动
+	pending := items[:0]
+	for _, item := range items {
+		switch {
+		case space != nil && item.picture.UserID != space.UserID:
+			item.result.Err = v1.ErrorPictureNoAuth("只能移动到图片作者创建的空间")
+		case item.picture.SpaceID != spaceID:
+			pending = append(pending, item)
+		}
+	}
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	// 按原空间汇总移出的用量，目标空间增加的用量为其总和
+	type usage struct{ bytes, pictures int64 }
+	var moved usage
+	released := make(map[int64]*usage)
+	for _, item := range pending {
+		moved.bytes += item.picture.PicSize
+		moved.pictures++
+		if item.picture.SpaceID > 0 {
+			u := released[item.picture.SpaceID]
+			if u == nil {
+				u = &usage{}
+				released[item.picture.SpaceID] = u
+			}
+			u.bytes += item.picture.PicSize
+			u.pictures++
+		}
+	}
+
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		for _, item := range pending {
+			if err := uc.pictureRepo.UpdatePictureSpace(ctx, item.picture.ID, spaceID); err != nil {
+				return fmt.Errorf("picture %d: %w", item.picture.ID, err)
+			}
+		}
+		if err := uc.quotaUC.ChargeSpaceUsage(ctx, spaceID, moved.bytes, moved.pictures); err != nil {
+			return err
+		}
+		for fromSpaceID, u := range released {
+			if err := uc.quotaUC.ChargeSpaceUsage(ctx, fromSpaceID, -u.bytes, -u.pictures); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if isQuotaExceeded(err) {
+		return nil, err
+	}
+	if err != nil {
+		uc.log.Errorf("批量移动图片到空间失败: spaceID=%d, err=%v", spaceID, err)
+		for _, item := range pending {
+			item.result.Err = v1.ErrorSystemError("移动图片失败")
+		}
+		return results, nil
+	}
+	for _, item := range pending {
+		uc.indexPicture(ctx, item.picture.ID)
+	}
+
+	return results, nil
+}
+
+// BatchReviewPictures 批量审核图片（管理员功能），审核状态变化时通知图片作者
+func (uc *PictureUsecase) BatchReviewPictures(ctx context.Context, ids []int64, status ReviewStatus, message string, reviewerID int64) ([]*BatchPictureResult, error) {
+	uc.log.WithContext(ctx).Infof("批量审核图片: count=%d, status=%d, reviewerID=%d", len(ids), status, reviewerID)
+
+	if status != ReviewStatusPass && status != ReviewStatusReject {
+		return nil, v1.ErrorParamsError("审核状态无效")
+	}
+	message = strings.TrimSpace(message)
+	if utf8.RuneCountInString(message) > maxReviewMessageLength {
+		return nil, v1.ErrorParamsError(fmt.Sprintf("审核信息不能超过 %d 个字符", maxReviewMessageLength))
+	}
+
+	results, items, err := uc.loadBatchPictures(ctx, ids, reviewerID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, item := range items {
+		item.picture.ReviewStatus = status
+		item.picture.ReviewMessage = message
+		item.picture.ReviewerID = reviewerID
+		item.picture.ReviewTime = &now
+	}
+	err = uc.execBatch(ctx, items, v1.ErrorPictureUpdateFailed("批量审核图片失败"), func(ctx context.Context, item *batchItem) error {
+		return uc.pictureRepo.UpdatePictureReview(ctx, item.picture)
+	})
+	if err != nil {
+		return results, nil
+	}
+
+	content := message
+	if content == "" {
+		content = "图片审核通过"
+		if status == ReviewStatusReject {
+			content = "图片审核未通过"
+		}
+	}
+	notifications := make([]*Notification, 0, len(items))
+	for _, item := range items {
+		uc.indexPicture(ctx, item.picture.ID)
+		if item.original.ReviewStatus != status {
+			notifications = append(notifications, &Notification{
+				UserID:    item.picture.UserID,
+				SenderID:  reviewerID,
+				Type:      NotificationPictureReview,
+				PictureID: item.picture.ID,
+				Content:   content,
+			})
+		}
+	}
+	uc.notificationUC.Notify(ctx, notifications...)
+
+	return results, nil
+}
+
+// loadBatchPictures 查询批量操作的图片并逐张检查权限（图片作者或管理员）
+// 返回按 ids 顺序（去重后）的结果和有权限操作的图片，不存在或无权限的图片直接记录失败
+func (uc *PictureUsecase) loadBatchPictures(ctx context.Context, ids []int64, userID int64, isAdmin bool) ([]*BatchPictureResult, []*batchItem, error) {
+	if len(ids) == 0 {
+		return nil, nil, v1.ErrorParamsError("图片 ID 不能为空")
+	}
+	seen := make(map[int64]struct{}, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, nil, v1.ErrorParamsError("图片 ID 无效")
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	if len(unique) > maxBatchPictures {
+		return nil, nil, v1.ErrorParamsError(fmt.Sprintf("一次最多操作 %d 张图片", maxBatchPictures))
+	}
+
+	pictures, err := uc.pictureRepo.ListPictureByIDs(ctx, unique)
+	if err != nil {
+		uc.log.Errorf("批量查询图片失败: %v", err)
+		return nil, nil, v1.ErrorSystemError("查询图片失败")
+	}
+	pictureMap := make(map[int64]*Picture, len(pictures))
+	for _, picture := range pictures {
+		pictureMap[picture.ID] = picture
+	}
+
+	results := make([]*BatchPictureResult, 0, len(unique))
+	items := make([]*batchItem, 0, len(pictures))
+	for _, id := range unique {
+		result := &BatchPictureResult{ID: id}
+		results = append(results, result)
+
+		picture, ok := pictureMap[id]
+		switch {
+		case !ok:
+			result.Err = v1.ErrorPictureNotFound("图片不存在")
+		case picture.UserID != userID && !isAdmin:
+			result.Err = v1.ErrorPictureNoAuth("无权限操作该图片")
+		default:
+			items = append(items, &batchItem{picture: picture, original: *picture, result: result})
+		}
+	}
+	return results, items, nil
+}
+
+// execBatch 在同一个事务中逐张执行 fn，任意一张失败时整体回滚，所有图片记录为 failErr
+func (uc *PictureUsecase) execBatch(ctx context.Context, items []*batchItem, failErr error, fn func(ctx context.Context, item *batchItem) error) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		for _, item := range items {
+			if err := fn(ctx, item); err != nil {
+				return fmt.Errorf("picture %d: %w", item.picture.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		uc.log.Errorf("批量操作图片失败: %v", err)
+		for _, item := range items {
+			item.result.Err = failErr
+		}
+	}
+	return err
+}
+
+// getBatchAlbum 查询当前用户创建的相册
+func (uc *PictureUsecase) getBatchAlbum(ctx context.Context, albumID, userID int64) (*Album, error) {
+	if albumID <= 0 {
+		return nil, v1.ErrorParamsError("相册 ID 不能为空")
+	}
+
+	album, err := uc.albumRepo.GetAlbumByID(ctx, albumID)
+	if err != nil {
+		uc.log.Errorf("查询相册失败: id=%d, err=%v", albumID, err)
+		return nil, v1.ErrorSystemError("查询相册失败")
+	}
+	if album == nil {
+		return nil, v1.ErrorParamsError("相册不存在")
+	}
+	if album.UserID != userID {
+		return nil, v1.ErrorPictureNoAuth("无权限操作该相册")
+	}
+	return album, nil
+}
+
+// pictureTagNames 解析图片的标签 JSON
+func pictureTagNames(picture *Picture) []string {
+	var tags []string
+	if picture.Tags != "" {
+		_ = json.Unmarshal([]byte(picture.Tags), &tags)
+	}
+	return tags
+}
@@ -0,0 +1,48 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+
+	v1 "smart-collab-gallery-server/api/picture/v1"
+)
+
+func TestRenamePicture(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		index    int64
+		original string
+		want     string
+		wantErr  bool
+	}{
+		{name: "序号", pattern: "旅行-{index}", index: 3, original: "IMG_0001", want: "旅行-3"},
+		{name: "原名称", pattern: "{name} (副本)", index: 1, original: "日落", want: "日落 (副本)"},
+		{name: "序号和原名称", pattern: "{index}_{name}", index: 10, original: "海边", want: "10_海边"},
+		{name: "多次出现", pattern: "{index}-{index}", index: 7, original: "a", want: "7-7"},
+		{name: "没有占位符", pattern: "统一名称", index: 1, original: "a", want: "统一名称"},
+		{name: "原名称中的占位符不再替换", pattern: "{name}-{index}", index: 2, original: "{index}", want: "{index}-2"},
+		{name: "去除首尾空白", pattern: "  {name}  ", index: 1, original: "山", want: "山"},
+		{name: "结果为空", pattern: "{name}", index: 1, original: "   ", wantErr: true},
+		{name: "按字符计算长度", pattern: "{name}", index: 1, original: strings.Repeat("图", maxPictureNameLength), want: strings.Repeat("图", maxPictureNameLength)},
+		{name: "超过长度限制", pattern: "{name}{index}", index: 1, original: strings.Repeat("图", maxPictureNameLength), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := renamePicture(tt.pattern, tt.index, tt.original)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("renamePicture() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !v1.IsParamsError(err) {
+					t.Errorf("renamePicture() err = %v, want params error", err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("renamePicture() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package biz
+
+import (
+	"context"
+	"math/bits"
+	"sort"
+
+	v1 "smart-collab-gallery-server/api/picture/v1"
+	"smart-collab-gallery-server/internal/conf"
+)
+
+// DuplicateMode 检测到相似图片时的处理方式
+type DuplicateMode string
+
+const (
+	DuplicateModeOff    DuplicateMode = "off"    // 不检测
+	DuplicateModeWarn   DuplicateMode = "warn"   // 允许上传，返回已存在的相似图片
+	DuplicateModeReject DuplicateMode = "reject" // 拒绝上传
+)
+
+// DuplicateScope 查重范围
+type DuplicateScope string
+
+const (
+	DuplicateScopeUser   DuplicateScope = "user"   // 只与自己的图片比较
+	DuplicateScopeGlobal DuplicateScope = "global" // 与所有用户的图片比较
+)
+
+const (
+	defaultDuplicateMaxDistance = 5
+	maxDuplicateDistance        = 32    // 超过该距离的两张图片已基本不相关
+	uploadSimilarLimit          = 10    // 上传时返回的相似图片数量
+	defaultSimilarLimit         = 20    // 查找相似图片默认返回数量
+	maxSimilarLimit             = 50    // 查找相似图片最多返回数量
+	defaultDuplicateScanLimit   = 2000  // 重复图片报告默认扫描的图片数量
+	maxDuplicateScanLimit       = 10000 // 重复图片报告最多扫描的图片数量
+	maxDuplicateClusters        = 100   // 重复图片报告最多返回的分组数
+)
+
+// DuplicateConfig 图片查重配置
+type DuplicateConfig struct {
+	Mode        DuplicateMode
+	MaxDistance int
+	Scope       DuplicateScope
+}
+
+// SimilarScope 查找相似图片的范围
+type SimilarScope struct {
+	UserID  int64  // 只查询该用户的图片，0 表示不限用户
+	SpaceID *int64 // 只查询该空间中的图片，指向 0 表示只查询公共图库，nil 表示不限空间
+}
+
+// PictureDistance 图片及其与目标感知哈希的汉明距离
+type PictureDistance struct {
+	PictureID int64
+	Distance  int
+}
+
+// PictureHash 图片的感知哈希
+type PictureHash struct {
+	PictureID int64
+	UserID    int64
+	PHash     uint64
+}
+
+// SimilarPicture 相似图片
+type SimilarPicture struct {
+	Picture  *PictureVO
+	Distance int
+}
+
+// DuplicateReport 重复图片报告
+type DuplicateReport struct {
+	Clusters [][]*PictureVO // 每组按 ID 升序
+	Scanned  int64
+}
+
+// newDuplicateConfig 读取查重配置，未配置或配置无效的项使用默认值
+func newDuplicateConfig(c *conf.Duplicate) DuplicateConfig {
+	config := DuplicateConfig{
+		Mode:        DuplicateModeWarn,
+		MaxDistance: defaultDuplicateMaxDistance,
+		Scope:       DuplicateScopeUser,
+	}
+	if c == nil {
+		return config
+	}
+
+	switch mode := DuplicateMode(c.Mode); mode {
+	case DuplicateModeOff, DuplicateModeWarn, DuplicateModeReject:
+		config.Mode = mode
+	}
+	if c.MaxDistance > 0 && c.MaxDistance <= maxDuplicateDistance {
+		config.MaxDistance = int(c.MaxDistance)
+	}
+	if DuplicateScope(c.Scope) == DuplicateScopeGlobal {
+		config.Scope = DuplicateScopeGlobal
+	}
+	return config
+}
+
+// checkDuplicate 上传新图片文件前按配置查重
+// 空间中的图片按空间设置的查重方式只与同一空间中的图片比较，公共图库中的图片按全局配置的范围与公共图库中的图片比较
+// warn 模式返回相似图片，reject 模式存在相似图片时返回错误；查询失败不影响上传
+func (uc *PictureUsecase) checkDuplicate(ctx context.Context, hash uint64, userID, pictureID int64, space *Space) ([]*SimilarPicture, error) {
+	mode := uc.duplicate.Mode
+	var spaceID int64
+	scope := SimilarScope{SpaceID: &spaceID}
+	if space != nil {
+		spaceID = space.ID
+		if space.DuplicateMode != "" {
+			mode = space.DuplicateMode
+		}
+	} else if uc.duplicate.Scope == DuplicateScopeUser {
+		scope.UserID = userID
+	}
+	if mode == DuplicateModeOff {
+		return nil, nil
+	}
+
+	similar, err := uc.findSimilar(ctx, hash, uc.duplicate.MaxDistance, scope, pictureID, uploadSimilarLimit, userID)
+	if err != nil {
+		uc.log.Errorf("图片查重失败: userID=%d, err=%v", userID, err)
+		return nil, nil
+	}
+	if len(similar) > 0 && mode == DuplicateModeReject {
+		return nil, v1.ErrorPictureDuplicate("已存在相似图片，请勿重复上传")
+	}
+	return similar, nil
+}
+
+// FindSimilarPictures 查找与指定图片相似的图片，maxDistance、limit 为 0 时使用默认值
+// 只返回对 viewerID 可见的图片（审核通过或 viewerID 自己的图片）
+func (uc *PictureUsecase) FindSimilarPictures(ctx context.Context, id int64, maxDistance, limit int, viewerID int64) ([]*SimilarPicture, error) {
+	if id <= 0 {
+		return nil, v1.ErrorParamsError("图片 ID 不能为空")
+	}
+	if maxDistance == 0 {
+		maxDistance = uc.duplicate.MaxDistance
+	}
+	if maxDistance < 0 || maxDistance > maxDuplicateDistance {
+		return nil, v1.ErrorParamsError("最大汉明距离需在 1 到 32 之间")
+	}
+	if limit <= 0 {
+		limit = defaultSimilarLimit
+	}
+	if limit > maxSimilarLimit {
+		limit = maxSimilarLimit
+	}
+
+	picture, err := uc.pictureRepo.GetPictureByID(ctx, id)
+	if err != nil {
+		uc.log.Errorf("查询图片失败: %v", err)
+		return nil, v1.ErrorSystemError("查询图片失败")
+	}
+	if picture == nil || !picture.visibleTo(viewerID) {
+		return nil, v1.ErrorPictureNotFound("图片不存在")
+	}
+	// 无法解码的图片没有感知哈希
+	if picture.PHash == nil {
+		return []*SimilarPicture{}, nil
+	}
+
+	similar, err := uc.findSimilar(ctx, *picture.PHash, maxDistance, SimilarScope{}, id, limit, viewerID)
+	if err != nil {
+		uc.log.Errorf("查询相似图片失败: %v", err)
+		return nil, v1.ErrorSystemError("查询相似图片失败")
+	}
+	return similar, nil
+}
+
+// ListDuplicateClusters 扫描最近上传的图片，将相似的图片归为一组，返回图片数量最多的若干组
+func (uc *PictureUsecase) ListDuplicateClusters(ctx context.Context, maxDistance, scanLimit int) (*DuplicateReport, error) {
+	if maxDistance == 0 {
+		maxDistance = uc.duplicate.MaxDistance
+	}
+	if maxDistance < 0 || maxDistance > maxDuplicateDistance {
+		return nil, v1.ErrorParamsError("最大汉明距离需在 1 到 32 之间")
+	}
+	if scanLimit <= 0 {
+		scanLimit = defaultDuplicateScanLimit
+	}
+	if scanLimit > maxDuplicateScanLimit {
+		scanLimit = maxDuplicateScanLimit
+	}
+
+	hashes, err := uc.pictureRepo.ListPictureHashes(ctx, scanLimit)
+	if err != nil {
+		uc.log.Errorf("查询图片感知哈希失败: %v", err)
+		return nil, v1.ErrorSystemError("查询重复图片失败")
+	}
+
+	// 并查集：距离不超过阈值的两张图片合并到同一组
+	parent := make([]int, len(hashes))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := 0; i < len(hashes); i++ {
+		for j := i + 1; j < len(hashes); j++ {
+			if bits.OnesCount64(hashes[i].PHash^hashes[j].PHash) <= maxDistance {
+				parent[find(i)] = find(j)
+			}
+		}
+	}
+
+	groups := make(map[int][]int64)
+	for i, hash := range hashes {
+		root := find(i)
+		groups[root] = append(groups[root], hash.PictureID)
+	}
+	clusterIDs := make([][]int64, 0)
+	for _, ids := range groups {
+		if len(ids) < 2 {
+			continue
+		}
+		sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+		clusterIDs = append(clusterIDs, ids)
+	}
+	sort.Slice(clusterIDs, func(a, b int) bool {
+		if len(clusterIDs[a]) != len(clusterIDs[b]) {
+			return len(clusterIDs[a]) > len(clusterIDs[b])
+		}
+		return clusterIDs[a][0] < clusterIDs[b][0]
+	})
+	if len(clusterIDs) > maxDuplicateClusters {
+		clusterIDs = clusterIDs[:maxDuplicateClusters]
+	}
+
+	report := &DuplicateReport{
+		Clusters: make([][]*PictureVO, 0, len(clusterIDs)),
+		Scanned:  int64(len(hashes)),
+	}
+	if len(clusterIDs) == 0 {
+		return report, nil
+	}
+
+	ids := make([]int64, 0)
+	for _, cluster := range clusterIDs {
+		ids = append(ids, cluster...)
+	}
+	pictures, err := uc.listPictureVOsByIDs(ctx, ids, nil)
+	if err != nil {
+		return nil, v1.ErrorSystemError("查询重复图片失败")
+	}
+	pictureMap := make(map[int64]*PictureVO, len(pictures))
+	for _, picture := range pictures {
+		pictureMap[picture.ID] = picture
+	}
+
+	for _, cluster := range clusterIDs {
+		list := make([]*PictureVO, 0, len(cluster))
+		for _, id := range cluster {
+			if picture, ok := pictureMap[id]; ok {
+				list = append(list, picture)
+			}
+		}
+		if len(list) >= 2 {
+			report.Clusters = append(report.Clusters, list)
+		}
+	}
+	return report, nil
+}
+
+// findSimilar 查询相似图片并填充图片信息，保持按距离排序，对 viewerID 不可见的未通过审核的图片会被过滤
+func (uc *PictureUsecase) findSimilar(ctx context.Context, hash uint64, maxDistance int, scope SimilarScope, excludeID int64, limit int, viewerID int64) ([]*SimilarPicture, error) {
+	distances, err := uc.pictureRepo.FindSimilarPictures(ctx, hash, maxDistance, scope, excludeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(distances) == 0 {
+		return []*SimilarPicture{}, nil
+	}
+
+	ids := make([]int64, 0, len(distances))
+	for _, d := range distances {
+		ids = append(ids, d.PictureID)
+	}
+	pictures, err := uc.ListPictureVOsByIDs(ctx, ids, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	pictureMap := make(map[int64]*PictureVO, len(pictures))
+	for _, picture := range pictures {
+		pictureMap[picture.ID] = picture
+	}
+
+	result := make([]*SimilarPicture, 0, len(distances))
+	for _, d := range distances {
+		if picture, ok := pictureMap[d.PictureID]; ok {
+			result = append(result, &SimilarPicture{Picture: picture, Distance: d.Distance})
+		}
+	}
+	return result, nil
+}
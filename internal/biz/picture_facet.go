@@ -0,0 +1,109 @@
+package biz
+
+import (
+	"context"
+	"strings"
+)
+
+// PictureFacetLimit 分类、标签、格式分面最多返回的取值数（按图片数从多到少）
+const PictureFacetLimit = 30
+
+// FacetCount 分面取值及满足条件的图片数
+type FacetCount struct {
+	Value string
+	Count int64
+}
+
+// PictureFacets 图片列表的分面统计
+// 单选条件（分类、格式、体积、宽高比）统计时不应用自身的过滤条件，便于切换取值；标签为多选交集，统计时应用全部条件
+type PictureFacets struct {
+	Categories  []*FacetCount
+	Tags        []*FacetCount
+	Formats     []*FacetCount
+	SizeRanges  []*FacetCount // 按 PictureSizeRanges 的顺序返回全部区间
+	ScaleRanges []*FacetCount // 按 PictureScaleRanges 的顺序返回全部区间
+}
+
+// PictureRange 数值区间 [Min, Max)，Max 为 0 表示不限上限
+type PictureRange struct {
+	Key string
+	Min float64
+	Max float64
+}
+
+// Contains 数值是否在区间内
+func (r *PictureRange) Contains(v float64) bool {
+	return v >= r.Min && (r.Max == 0 || v < r.Max)
+}
+
+// PictureSizeRanges 图片体积区间（字节），区间需连续且按从小到大排列
+var PictureSizeRanges = []*PictureRange{
+	{Key: "small", Min: 0, Max: 1 << 20},        // 1MB 以下
+	{Key: "medium", Min: 1 << 20, Max: 5 << 20}, // 1-5MB
+	{Key: "large", Min: 5 << 20, Max: 20 << 20}, // 5-20MB
+	{Key: "huge", Min: 20 << 20, Max: 0},        // 20MB 以上
+}
+
+// PictureScaleRanges 图片宽高比区间，区间需连续且按从小到大排列
+var PictureScaleRanges = []*PictureRange{
+	{Key: "portrait", Min: 0, Max: 0.9},  // 竖图
+	{Key: "square", Min: 0.9, Max: 1.1},  // 方图
+	{Key: "landscape", Min: 1.1, Max: 2}, // 横图
+	{Key: "panorama", Min: 2, Max: 0},    // 宽幅
+}
+
+// FindPictureRange 按 key 查找区间，不存在时返回 nil
+func FindPictureRange(ranges []*PictureRange, key string) *PictureRange {
+	for _, r := range ranges {
+		if r.Key == key {
+			return r
+		}
+	}
+	return nil
+}
+
+// 分面
+const (
+	FacetCategory = "category"
+	FacetTag      = "tag"
+	FacetFormat   = "format"
+	FacetSize     = "size"
+	FacetScale    = "scale"
+)
+
+// ForFacet 返回统计指定分面时使用的查询条件：单选分面不应用自身的过滤条件，标签分面应用全部条件
+func (p *PictureQueryParams) ForFacet(facet string) *PictureQueryParams {
+	params := *p
+	switch facet {
+	case FacetCategory:
+		params.Category = ""
+	case FacetFormat:
+		params.PicFormat = ""
+	case FacetSize:
+		params.SizeRange = nil
+	case FacetScale:
+		params.ScaleRange = nil
+	}
+	return &params
+}
+
+// ListPictureFacets 统计满足查询条件的图片在各分面上的分布，有搜索词时由全文检索引擎统计
+func (uc *PictureUsecase) ListPictureFacets(ctx context.Context, params *PictureQueryParams) (*PictureFacets, error) {
+	if params.Color != nil && params.MaxColorDistance <= 0 {
+		params.MaxColorDistance = defaultMaxColorDistance
+	}
+
+	var facets *PictureFacets
+	var err error
+	params.SearchText = strings.TrimSpace(params.SearchText)
+	if params.SearchText != "" {
+		facets, err = uc.searcher.CountFacets(ctx, params)
+	} else {
+		facets, err = uc.pictureRepo.CountPictureFacets(ctx, params)
+	}
+	if err != nil {
+		uc.log.Errorf("统计图片分面失败: %v", err)
+		return nil, err
+	}
+	return facets, nil
+}
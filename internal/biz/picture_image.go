@@ -0,0 +1,87 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	v1 "smart-collab-gallery-server/api/picture/v1"
+)
+
+// EditedPictureImage 编辑后保存到存储桶的图片文件
+type EditedPictureImage struct {
+	URL      string
+	Size     int64
+	Features *PictureFeatures
+}
+
+// GetEditablePicture 查询图片并检查是否可以编辑图片文件（图片作者或管理员）
+func (uc *PictureUsecase) GetEditablePicture(ctx context.Context, pictureID, userID int64, isAdmin bool) (*Picture, error) {
+	return uc.getVersionedPicture(ctx, pictureID, userID, isAdmin)
+}
+
+// CheckPictureImageSize 检查将图片文件替换为 size 大小的新文件后作者的存储空间是否足够
+// 在保存新文件之前调用，避免空间不足时在存储桶中留下无用的文件
+func (uc *PictureUsecase) CheckPictureImageSize(ctx context.Context, picture *Picture, size int64) error {
+	return uc.quotaUC.CheckPicture(ctx, picture.UserID, size-picture.PicSize, 0)
+}
+
+// SaveEditedPictureImage 将图片文件替换为编辑后的新文件，同时记录修改前的版本
+// 原文件保留在存储桶中，可以通过历史版本回滚；编辑后的文件不包含 EXIF，拍摄信息沿用原图（方向已校正）
+func (uc *PictureUsecase) SaveEditedPictureImage(ctx context.Context, picture *Picture, file *EditedPictureImage, operatorID int64) (*PictureVO, error) {
+	uc.log.WithContext(ctx).Infof("保存编辑后的图片: pictureID=%d, url=%s, size=%d", picture.ID, file.URL, file.Size)
+
+	updated := *picture
+	updated.URL = file.URL
+	updated.PicSize = file.Size
+	updated.EditTime = time.Now()
+	updated.resubmitReview()
+	if features := file.Features; features != nil {
+		updated.PHash = &features.PHash
+		updated.PicColor = features.Color
+		updated.ColorLab = &features.ColorLab
+		updated.PicPalette = ""
+		if paletteBytes, err := json.Marshal(features.Palette); err == nil && len(features.Palette) > 0 {
+			updated.PicPalette = string(paletteBytes)
+		}
+		updated.PicWidth = features.Width
+		updated.PicHeight = features.Height
+		updated.PicFormat = features.Format
+	}
+	if updated.PicHeight > 0 {
+		updated.PicScale = math.Round(float64(updated.PicWidth)/float64(updated.PicHeight)*100) / 100
+	}
+	if picture.PicMetadata != "" {
+		var metadata PictureMetadata
+		if err := json.Unmarshal([]byte(picture.PicMetadata), &metadata); err == nil && metadata.Orientation != 0 {
+			metadata.Orientation = 0
+			if metadataBytes, err := json.Marshal(&metadata); err == nil {
+				updated.PicMetadata = string(metadataBytes)
+			}
+		}
+	}
+
+	// 在同一个事务中更新存储用量、空间用量和图片，同时记录修改前的版本
+	bytesDelta := updated.PicSize - picture.PicSize
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.quotaUC.ChargeUsage(ctx, picture.UserID, bytesDelta, 0); err != nil {
+			return err
+		}
+		if err := uc.quotaUC.ChargeSpaceUsage(ctx, picture.SpaceID, bytesDelta, 0); err != nil {
+			return err
+		}
+		return uc.savePictureWithVersion(ctx, picture, &updated, nil, PictureVersionImageEdit, operatorID)
+	})
+	if isQuotaExceeded(err) {
+		return nil, err
+	}
+	if err != nil {
+		uc.log.Errorf("保存编辑后的图片失败: pictureID=%d, err=%v", picture.ID, err)
+		return nil, v1.ErrorPictureUpdateFailed("保存编辑后的图片失败")
+	}
+	uc.pruneVersions(ctx, picture)
+	uc.indexPicture(ctx, picture.ID)
+
+	return uc.GetPictureByID(ctx, picture.ID)
+}
@@ -0,0 +1,89 @@
+package biz
+
+import (
+	"context"
+)
+
+// 搜索结果高亮的字段
+const (
+	SearchFieldName         = "name"
+	SearchFieldIntroduction = "introduction"
+	SearchFieldCategory     = "category"
+	SearchFieldTags         = "tags"
+	SearchFieldUserName     = "userName"
+)
+
+// PictureSearcher 图片全文检索接口，由 data 层按配置选择 MySQL FULLTEXT 或内存倒排索引实现
+type PictureSearcher interface {
+	// Search 按 params.SearchText 检索图片（按相关度从高到低），同时应用 params 中的其他过滤条件
+	Search(ctx context.Context, params *PictureQueryParams) (*PictureSearchResult, error)
+	// CountFacets 统计检索结果在各分面上的分布
+	CountFacets(ctx context.Context, params *PictureQueryParams) (*PictureFacets, error)
+	// IndexPicture 新增或更新图片的索引
+	IndexPicture(ctx context.Context, id int64) error
+	// RemovePicture 删除图片的索引
+	RemovePicture(ctx context.Context, id int64) error
+	// IndexUserPictures 更新用户所有图片的索引（用户昵称变化时调用）
+	IndexUserPictures(ctx context.Context, userID int64) error
+}
+
+// PictureSearchHit 检索命中的图片
+type PictureSearchHit struct {
+	PictureID  int64
+	Score      float64
+	Highlights map[string]string // 字段名 -> 带 <em> 标记的片段，只包含命中的字段
+}
+
+// PictureSearchResult 检索结果
+type PictureSearchResult struct {
+	Total      int64
+	Hits       []*PictureSearchHit
+	NextCursor string // 游标分页的下一页游标（按偏移量定位）
+}
+
+// searchPictures 全文检索图片，按检索结果的顺序返回并填充相关度和高亮片段
+func (uc *PictureUsecase) searchPictures(ctx context.Context, params *PictureQueryParams) (*PicturePage, error) {
+	result, err := uc.searcher.Search(ctx, params)
+	if err != nil {
+		uc.log.Errorf("检索图片失败: %v", err)
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(result.Hits))
+	hitMap := make(map[int64]*PictureSearchHit, len(result.Hits))
+	for _, hit := range result.Hits {
+		ids = append(ids, hit.PictureID)
+		hitMap[hit.PictureID] = hit
+	}
+	list, err := uc.ListPictureVOsByIDs(ctx, ids, params.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, pic := range list {
+		hit := hitMap[pic.ID]
+		pic.SearchScore = hit.Score
+		pic.Highlights = hit.Highlights
+	}
+
+	return &PicturePage{
+		Total:      result.Total,
+		List:       list,
+		Current:    params.Current,
+		PageSize:   params.PageSize,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+// indexPicture 同步图片的检索索引，失败时只记录日志（内存索引在服务重启时会从数据库重建）
+func (uc *PictureUsecase) indexPicture(ctx context.Context, id int64) {
+	if err := uc.searcher.IndexPicture(ctx, id); err != nil {
+		uc.log.Errorf("更新图片索引失败: id=%d, err=%v", id, err)
+	}
+}
+
+// removePictureIndex 删除图片的检索索引，失败时只记录日志
+func (uc *PictureUsecase) removePictureIndex(ctx context.Context, id int64) {
+	if err := uc.searcher.RemovePicture(ctx, id); err != nil {
+		uc.log.Errorf("删除图片索引失败: id=%d, err=%v", id, err)
+	}
+}
@@ -0,0 +1,108 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	v1 "smart-collab-gallery-server/api/picture/v1"
+)
+
+// resolvePictureCategory 校验图片的分类，别名归一为分类名称，为空表示不设置分类
+func (uc *PictureUsecase) resolvePictureCategory(ctx context.Context, category string) (string, error) {
+	category = strings.TrimSpace(category)
+	if category == "" {
+		return "", nil
+	}
+
+	term, err := uc.taxonomyUC.ResolveCategory(ctx, category)
+	if err != nil {
+		return "", v1.ErrorSystemError("查询分类失败")
+	}
+	if term == nil {
+		return "", v1.ErrorParamsError("分类不存在：" + category)
+	}
+	return term.Name, nil
+}
+
+// resolvePictureTags 校验图片的标签，别名归一为标签名称，不存在的标签自动创建
+// 返回去重后的标签及其 JSON 数组（用于写入 picture.tags），标签为空时 JSON 为空字符串
+func (uc *PictureUsecase) resolvePictureTags(ctx context.Context, tags []string) ([]*TaxonomyTerm, string, error) {
+	names, err := normalizePictureTags(tags)
+	if err != nil {
+		return nil, "", err
+	}
+
+	terms, err := uc.taxonomyUC.ResolveTags(ctx, names)
+	if err != nil {
+		return nil, "", v1.ErrorSystemError("保存标签失败")
+	}
+	if len(terms) == 0 {
+		return nil, "", nil
+	}
+	tagsJSON, err := pictureTagsJSON(terms)
+	if err != nil {
+		return nil, "", err
+	}
+	return terms, tagsJSON, nil
+}
+
+// normalizePictureTags 校验图片的标签名称，去除空白和重复的名称，检查长度和数量
+func normalizePictureTags(tags []string) ([]string, error) {
+	names := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxTermNameLength {
+			return nil, v1.ErrorParamsError(fmt.Sprintf("标签不能超过 %d 个字符", maxTermNameLength))
+		}
+		if _, ok := seen[TermKey(tag)]; ok {
+			continue
+		}
+		seen[TermKey(tag)] = struct{}{}
+		names = append(names, tag)
+	}
+	if len(names) > maxPictureTags {
+		return nil, v1.ErrorParamsError(fmt.Sprintf("标签不能超过 %d 个", maxPictureTags))
+	}
+	return names, nil
+}
+
+// pictureTagsJSON 将标签名称转为写入 picture.tags 的 JSON 数组，标签为空时为空字符串
+func pictureTagsJSON(terms []*TaxonomyTerm) (string, error) {
+	if len(terms) == 0 {
+		return "", nil
+	}
+	tagNames := make([]string, 0, len(terms))
+	for _, term := range terms {
+		tagNames = append(tagNames, term.Name)
+	}
+	tagsBytes, err := json.Marshal(tagNames)
+	if err != nil {
+		return "", v1.ErrorParamsError("标签格式错误")
+	}
+	return string(tagsBytes), nil
+}
+
+// GetPictureTagCategory 获取使用次数最多的 tagLimit 个标签和全部分类
+func (uc *PictureUsecase) GetPictureTagCategory(ctx context.Context, tagLimit int) ([]*TaxonomyTerm, []*TaxonomyTerm, error) {
+	return uc.taxonomyUC.GetTagCategory(ctx, tagLimit)
+}
+
+// SuggestTags 标签输入建议
+func (uc *PictureUsecase) SuggestTags(ctx context.Context, params *TagSuggestParams) ([]*TagSuggestion, error) {
+	return uc.taxonomyUC.SuggestTags(ctx, params)
+}
+
+// savePictureTags 保存图片与标签的关联（图片保存后调用）
+func (uc *PictureUsecase) savePictureTags(ctx context.Context, pictureID int64, tags []*TaxonomyTerm) error {
+	if err := uc.taxonomyUC.SetPictureTags(ctx, pictureID, tags); err != nil {
+		return v1.ErrorPictureUpdateFailed("保存图片标签失败")
+	}
+	return nil
+}
@@ -0,0 +1,452 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "smart-collab-gallery-server/api/picture/v1"
+	"smart-collab-gallery-server/internal/conf"
+)
+
+const (
+	// defaultMaxPictureVersions 每张图片默认保留的历史版本数
+	defaultMaxPictureVersions = 20
+	// maxVersionPageSize 历史版本分页每页最多条数
+	maxVersionPageSize = 50
+)
+
+// PictureVersionOperation 产生历史版本的修改方式
+type PictureVersionOperation string
+
+const (
+	PictureVersionUpload      PictureVersionOperation = "upload"       // 重新上传（UploadPicture 带 id）
+	PictureVersionUpdate      PictureVersionOperation = "update"       // 更新图片信息
+	PictureVersionEdit        PictureVersionOperation = "edit"         // 编辑图片信息
+	PictureVersionBatchEdit   PictureVersionOperation = "batch_edit"   // 批量修改分类和标签
+	PictureVersionBatchRename PictureVersionOperation = "batch_rename" // 批量重命名
+	PictureVersionRestore     PictureVersionOperation = "restore"      // 回滚到历史版本
+	PictureVersionImageEdit   PictureVersionOperation = "image_edit"   // 编辑图片文件（裁剪、旋转、水印等）
+)
+
+// PictureChange 字段修改
+type PictureChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// PictureVersion 图片历史版本，记录修改前的图片信息和本次修改的字段，创建后不再修改
+type PictureVersion struct {
+	ID         int64
+	PictureID  int64
+	Version    int64 // 同一图片内递增，由仓储在创建时分配
+	Operation  PictureVersionOperation
+	OperatorID int64
+	Snapshot   *Picture // 修改前的图片信息（只包含图片内容，不包含计数）
+	Changes    []*PictureChange
+	CreateTime time.Time
+}
+
+// PictureVersionVO 历史版本视图对象
+type PictureVersionVO struct {
+	*PictureVersion
+	Tags     []string
+	Operator *UserVO
+}
+
+// PictureVersionPage 历史版本分页结果
+type PictureVersionPage struct {
+	Total    int64
+	List     []*PictureVersionVO
+	Current  int64
+	PageSize int64
+}
+
+// PictureVersionRepo 图片历史版本仓储接口
+type PictureVersionRepo interface {
+	// CreateVersion 创建历史版本并分配版本号，需在更新图片的事务中调用（图片行锁保证版本号不冲突）
+	CreateVersion(ctx context.Context, version *PictureVersion) error
+	// GetVersionByID 查询历史版本，不存在时返回 nil
+	GetVersionByID(ctx context.Context, id int64) (*PictureVersion, error)
+	// ListVersions 按版本号倒序分页查询图片的历史版本
+	ListVersions(ctx context.Context, pictureID, current, pageSize int64) ([]*PictureVersion, int64, error)
+	// PruneVersions 删除超出保留数量（keep 小于等于 0 表示不限制）或早于 before（零值表示不限制）的历史版本，返回被删除版本引用的图片地址
+	PruneVersions(ctx context.Context, pictureID int64, keep int, before time.Time) ([]string, error)
+	// ListReferencedURLs 返回 urls 中仍被图片（包括已删除的图片）或历史版本引用的地址
+	ListReferencedURLs(ctx context.Context, urls []string) ([]string, error)
+}
+
+// PictureFileRepo 图片文件仓储接口
+type PictureFileRepo interface {
+	// DeletePictureFile 删除存储桶中的图片文件
+	DeletePictureFile(ctx context.Context, url string) error
+	// GetPictureFileETag 查询存储桶中图片文件当前的 ETag，文件不存在时返回空字符串
+	GetPictureFileETag(ctx context.Context, url string) (string, error)
+}
+
+// VersionRetention 历史版本保留策略
+type VersionRetention struct {
+	MaxVersions int           // 每张图片最多保留的版本数，小于等于 0 表示不限制
+	MaxAge      time.Duration // 最长保留时间，0 表示不限制
+}
+
+// versionRetentions 按图片作者身份区分的保留策略
+type versionRetentions struct {
+	user  VersionRetention
+	vip   VersionRetention
+	admin VersionRetention
+}
+
+// newVersionRetentions 读取历史版本保留配置，未配置的版本数使用默认值
+func newVersionRetentions(c *conf.PictureVersion) versionRetentions {
+	return versionRetentions{
+		user:  convertVersionRetention(c.GetUser()),
+		vip:   convertVersionRetention(c.GetVip()),
+		admin: convertVersionRetention(c.GetAdmin()),
+	}
+}
+
+// convertVersionRetention 转换保留策略配置
+func convertVersionRetention(c *conf.VersionRetention) VersionRetention {
+	retention := VersionRetention{
+		MaxVersions: defaultMaxPictureVersions,
+		MaxAge:      c.GetMaxAge().AsDuration(),
+	}
+	switch maxVersions := c.GetMaxVersions(); {
+	case maxVersions > 0:
+		retention.MaxVersions = int(maxVersions)
+	case maxVersions < 0:
+		retention.MaxVersions = 0
+	}
+	if retention.MaxAge < 0 {
+		retention.MaxAge = 0
+	}
+	return retention
+}
+
+// forUser 图片作者适用的保留策略
+func (r versionRetentions) forUser(user *User) VersionRetention {
+	switch {
+	case user == nil:
+		return r.user
+	case user.UserRole == "admin":
+		return r.admin
+	case user.IsVip():
+		return r.vip
+	default:
+		return r.user
+	}
+}
+
+// forSpace 使用空间单独设置的保留策略覆盖全局配置，空间未设置（为 0）的项保持不变
+func (r VersionRetention) forSpace(space *Space) VersionRetention {
+	if space == nil {
+		return r
+	}
+	switch {
+	case space.VersionMaxCount > 0:
+		r.MaxVersions = int(space.VersionMaxCount)
+	case space.VersionMaxCount < 0:
+		r.MaxVersions = 0
+	}
+	switch {
+	case space.VersionMaxDays > 0:
+		r.MaxAge = time.Duration(space.VersionMaxDays) * 24 * time.Hour
+	case space.VersionMaxDays < 0:
+		r.MaxAge = 0
+	}
+	return r
+}
+
+// ListPictureVersions 分页查询图片的历史版本（图片作者或管理员）
+func (uc *PictureUsecase) ListPictureVersions(ctx context.Context, pictureID, current, pageSize, userID int64, isAdmin bool) (*PictureVersionPage, error) {
+	if _, err := uc.getVersionedPicture(ctx, pictureID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	if current <= 0 {
+		current = 1
+	}
+	if pageSize <= 0 || pageSize > maxVersionPageSize {
+		pageSize = maxVersionPageSize
+	}
+
+	versions, total, err := uc.versionRepo.ListVersions(ctx, pictureID, current, pageSize)
+	if err != nil {
+		uc.log.Errorf("查询图片历史版本失败: pictureID=%d, err=%v", pictureID, err)
+		return nil, v1.ErrorSystemError("查询历史版本失败")
+	}
+
+	// 填充修改人信息
+	operatorIDs := make([]int64, 0, len(versions))
+	for _, version := range versions {
+		operatorIDs = append(operatorIDs, version.OperatorID)
+	}
+	operators := make(map[int64]*UserVO)
+	if len(operatorIDs) > 0 {
+		users, err := uc.userRepo.ListUserByIDs(ctx, operatorIDs)
+		if err != nil {
+			uc.log.Errorf("查询修改人失败: %v", err)
+		}
+		for _, user := range users {
+			operators[user.ID] = &UserVO{
+				ID:          user.ID,
+				UserAccount: user.UserAccount,
+				UserName:    user.UserName,
+				UserAvatar:  user.UserAvatar,
+				UserProfile: user.UserProfile,
+				UserRole:    user.UserRole,
+			}
+		}
+	}
+
+	list := make([]*PictureVersionVO, 0, len(versions))
+	for _, version := range versions {
+		vo := &PictureVersionVO{
+			PictureVersion: version,
+			Tags:           pictureTagNames(version.Snapshot),
+		}
+		vo.Operator = operators[version.OperatorID]
+		list = append(list, vo)
+	}
+
+	return &PictureVersionPage{
+		Total:    total,
+		List:     list,
+		Current:  current,
+		PageSize: pageSize,
+	}, nil
+}
+
+// RestorePictureVersion 将图片恢复为历史版本记录的修改前的状态（图片作者或管理员）
+// 回滚本身也会记录历史版本，可以再次回滚；历史版本中的分类已被删除时清空分类，标签已被删除时重新创建
+func (uc *PictureUsecase) RestorePictureVersion(ctx context.Context, pictureID, versionID, userID int64, isAdmin bool) (*PictureVO, error) {
+	uc.log.WithContext(ctx).Infof("回滚图片历史版本: pictureID=%d, versionID=%d, userID=%d", pictureID, versionID, userID)
+
+	picture, err := uc.getVersionedPicture(ctx, pictureID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	version, err := uc.versionRepo.GetVersionByID(ctx, versionID)
+	if err != nil {
+		uc.log.Errorf("查询图片历史版本失败: id=%d, err=%v", versionID, err)
+		return nil, v1.ErrorSystemError("查询历史版本失败")
+	}
+	if version == nil || version.PictureID != pictureID {
+		return nil, v1.ErrorPictureVersionNotFound("历史版本不存在")
+	}
+
+	snapshot := version.Snapshot
+	restored := *picture
+	restored.Name = snapshot.Name
+	restored.Introduction = snapshot.Introduction
+	if restored.Category, err = uc.resolvePictureCategory(ctx, snapshot.Category); err != nil {
+		if !v1.IsParamsError(err) {
+			return nil, err
+		}
+		restored.Category = ""
+	}
+	tags, tagsJSON, err := uc.resolvePictureTags(ctx, pictureTagNames(snapshot))
+	if err != nil {
+		return nil, err
+	}
+	restored.Tags = tagsJSON
+	if snapshot.URL != "" && snapshot.URL != picture.URL {
+		restored.URL = snapshot.URL
+		restored.resubmitReview()
+		restored.PicSize = snapshot.PicSize
+		restored.PicWidth = snapshot.PicWidth
+		restored.PicHeight = snapshot.PicHeight
+		restored.PicScale = snapshot.PicScale
+		restored.PicFormat = snapshot.PicFormat
+		restored.PHash = snapshot.PHash
+		restored.PicColor = snapshot.PicColor
+		restored.PicPalette = snapshot.PicPalette
+		restored.ColorLab = snapshot.ColorLab
+		restored.PicMetadata = snapshot.PicMetadata
+	}
+	restored.EditTime = time.Now()
+
+	// 恢复为更大的图片文件时检查作者的存储空间
+	bytesDelta := restored.PicSize - picture.PicSize
+	if err := uc.quotaUC.CheckPicture(ctx, picture.UserID, bytesDelta, 0); err != nil {
+		return nil, err
+	}
+
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.quotaUC.ChargeUsage(ctx, picture.UserID, bytesDelta, 0); err != nil {
+			return err
+		}
+		if err := uc.quotaUC.ChargeSpaceUsage(ctx, picture.SpaceID, bytesDelta, 0); err != nil {
+			return err
+		}
+		if err := uc.pictureRepo.UpdatePicture(ctx, &restored); err != nil {
+			return err
+		}
+		if err := uc.savePictureTags(ctx, pictureID, tags); err != nil {
+			return err
+		}
+		return uc.recordVersion(ctx, picture, &restored, PictureVersionRestore, userID)
+	})
+	if isQuotaExceeded(err) {
+		return nil, err
+	}
+	if err != nil {
+		uc.log.Errorf("回滚图片历史版本失败: pictureID=%d, versionID=%d, err=%v", pictureID, versionID, err)
+		return nil, v1.ErrorPictureUpdateFailed("回滚历史版本失败")
+	}
+	uc.indexPicture(ctx, pictureID)
+	uc.pruneVersions(ctx, picture)
+
+	return uc.GetPictureByID(ctx, pictureID)
+}
+
+// getVersionedPicture 查询图片并检查是否可以查看和回滚历史版本
+func (uc *PictureUsecase) getVersionedPicture(ctx context.Context, pictureID, userID int64, isAdmin bool) (*Picture, error) {
+	if pictureID <= 0 {
+		return nil, v1.ErrorInvalidArgument("图片 ID 不能为空")
+	}
+
+	picture, err := uc.pictureRepo.GetPictureByID(ctx, pictureID)
+	if err != nil || picture == nil {
+		return nil, v1.ErrorPictureNotFound("图片不存在")
+	}
+	if picture.UserID != userID && !isAdmin {
+		return nil, v1.ErrorPictureNoAuth("无权限操作该图片")
+	}
+	return picture, nil
+}
+
+// updatePictureWithVersion 在同一个事务中更新图片、替换标签关联并记录修改前的版本，tags 为 nil 时不修改标签关联
+func (uc *PictureUsecase) updatePictureWithVersion(ctx context.Context, old, updated *Picture, tags []*TaxonomyTerm, operation PictureVersionOperation, operatorID int64) error {
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		return uc.savePictureWithVersion(ctx, old, updated, tags, operation, operatorID)
+	})
+	if err != nil {
+		return err
+	}
+	uc.pruneVersions(ctx, old)
+	return nil
+}
+
+// savePictureWithVersion 更新图片和标签并记录修改前的版本，需在事务中调用，调用方提交后负责清理历史版本
+func (uc *PictureUsecase) savePictureWithVersion(ctx context.Context, old, updated *Picture, tags []*TaxonomyTerm, operation PictureVersionOperation, operatorID int64) error {
+	if err := uc.pictureRepo.UpdatePicture(ctx, updated); err != nil {
+		return err
+	}
+	if tags != nil {
+		if err := uc.taxonomyUC.SetPictureTags(ctx, updated.ID, tags); err != nil {
+			return err
+		}
+	}
+	return uc.recordVersion(ctx, old, updated, operation, operatorID)
+}
+
+// recordVersion 记录图片修改前的版本，没有字段变化时不记录
+// 需在更新图片之后、同一个事务中调用
+func (uc *PictureUsecase) recordVersion(ctx context.Context, old, updated *Picture, operation PictureVersionOperation, operatorID int64) error {
+	changes := diffPicture(old, updated)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	snapshot := *old
+	return uc.versionRepo.CreateVersion(ctx, &PictureVersion{
+		PictureID:  old.ID,
+		Operation:  operation,
+		OperatorID: operatorID,
+		Snapshot:   &snapshot,
+		Changes:    changes,
+	})
+}
+
+// pruneVersions 按图片作者的保留策略清理历史版本，空间中的图片优先使用空间的保留策略，失败时只记录日志
+// 被清理的版本引用的旧图片文件不再被任何图片或历史版本使用时，在后台从存储桶中删除
+func (uc *PictureUsecase) pruneVersions(ctx context.Context, picture *Picture) {
+	owner, err := uc.userRepo.GetUserByID(ctx, picture.UserID)
+	if err != nil {
+		uc.log.Errorf("查询图片作者失败: userID=%d, err=%v", picture.UserID, err)
+		return
+	}
+
+	retention := uc.versionRetentions.forUser(owner)
+	if picture.SpaceID > 0 {
+		space, err := uc.spaceRepo.GetSpaceByID(ctx, picture.SpaceID)
+		if err != nil {
+			uc.log.Errorf("查询空间失败: id=%d, err=%v", picture.SpaceID, err)
+			return
+		}
+		retention = retention.forSpace(space)
+	}
+	var before time.Time
+	if retention.MaxAge > 0 {
+		before = time.Now().Add(-retention.MaxAge)
+	}
+	if retention.MaxVersions <= 0 && before.IsZero() {
+		return
+	}
+	urls, err := uc.versionRepo.PruneVersions(ctx, picture.ID, retention.MaxVersions, before)
+	if err != nil {
+		uc.log.Errorf("清理图片历史版本失败: pictureID=%d, err=%v", picture.ID, err)
+	}
+	if len(urls) > 0 {
+		ctx = context.WithoutCancel(ctx)
+		go uc.deleteUnreferencedFiles(ctx, picture.ID, urls)
+	}
+}
+
+// deleteUnreferencedFiles 删除不再被任何图片或历史版本引用的图片文件，失败时只记录日志
+func (uc *PictureUsecase) deleteUnreferencedFiles(ctx context.Context, pictureID int64, urls []string) {
+	referenced, err := uc.versionRepo.ListReferencedURLs(ctx, urls)
+	if err != nil {
+		uc.log.Errorf("查询图片文件引用失败: pictureID=%d, err=%v", pictureID, err)
+		return
+	}
+	inUse := make(map[string]bool, len(referenced))
+	for _, url := range referenced {
+		inUse[url] = true
+	}
+
+	for _, url := range urls {
+		if inUse[url] {
+			continue
+		}
+		if err := uc.fileRepo.DeletePictureFile(ctx, url); err != nil {
+			uc.log.Errorf("删除历史版本图片文件失败: pictureID=%d, url=%s, err=%v", pictureID, url, err)
+		}
+	}
+}
+
+// diffPicture 比较修改前后的图片信息，updated 的地址为空表示图片文件未变化
+func diffPicture(old, updated *Picture) []*PictureChange {
+	var changes []*PictureChange
+	add := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, &PictureChange{Field: field, Old: oldValue, New: newValue})
+		}
+	}
+
+	if updated.URL != "" {
+		add("url", old.URL, updated.URL)
+		add("picSize", strconv.FormatInt(old.PicSize, 10), strconv.FormatInt(updated.PicSize, 10))
+		add("picWidth", strconv.Itoa(int(old.PicWidth)), strconv.Itoa(int(updated.PicWidth)))
+		add("picHeight", strconv.Itoa(int(old.PicHeight)), strconv.Itoa(int(updated.PicHeight)))
+		add("picFormat", old.PicFormat, updated.PicFormat)
+	}
+	add("name", old.Name, updated.Name)
+	add("introduction", old.Introduction, updated.Introduction)
+	add("category", old.Category, updated.Category)
+	if oldTags, newTags := pictureTagNames(old), pictureTagNames(updated); strings.Join(oldTags, "\x00") != strings.Join(newTags, "\x00") {
+		add("tags", tagsJSONOrEmpty(old.Tags), tagsJSONOrEmpty(updated.Tags))
+	}
+	return changes
+}
+
+// tagsJSONOrEmpty 标签 JSON，为空时返回空数组
+func tagsJSONOrEmpty(tags string) string {
+	if tags == "" {
+		return "[]"
+	}
+	return tags
+}
@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiffPicture(t *testing.T) {
+	old := &Picture{
+		URL:          "https://cdn.example.com/a.jpg",
+		Name:         "日落",
+		Introduction: "海边",
+		Category:     "风景",
+		Tags:         `["海","夕阳"]`,
+		PicSize:      1024,
+		PicWidth:     800,
+		PicHeight:    600,
+		PicFormat:    "jpg",
+	}
+
+	tests := []struct {
+		name    string
+		updated *Picture
+		want    []*PictureChange
+	}{
+		{
+			name:    "没有变化",
+			updated: &Picture{Name: "日落", Introduction: "海边", Category: "风景", Tags: `["海","夕阳"]`},
+			want:    nil,
+		},
+		{
+			name:    "修改基本信息",
+			updated: &Picture{Name: "晚霞", Introduction: "", Category: "风景", Tags: `["海","夕阳"]`},
+			want: []*PictureChange{
+				{Field: "name", Old: "日落", New: "晚霞"},
+				{Field: "introduction", Old: "海边", New: ""},
+			},
+		},
+		{
+			name:    "地址为空时不比较图片文件",
+			updated: &Picture{Name: "日落", Introduction: "海边", Category: "风景", Tags: `["海","夕阳"]`, PicSize: 1, PicFormat: "png"},
+			want:    nil,
+		},
+		{
+			name: "替换图片文件",
+			updated: &Picture{
+				URL: "https://cdn.example.com/b.png", Name: "日落", Introduction: "海边", Category: "风景", Tags: `["海","夕阳"]`,
+				PicSize: 2048, PicWidth: 800, PicHeight: 1200, PicFormat: "png",
+			},
+			want: []*PictureChange{
+				{Field: "url", Old: "https://cdn.example.com/a.jpg", New: "https://cdn.example.com/b.png"},
+				{Field: "picSize", Old: "1024", New: "2048"},
+				{Field: "picHeight", Old: "600", New: "1200"},
+				{Field: "picFormat", Old: "jpg", New: "png"},
+			},
+		},
+		{
+			name:    "标签 JSON 格式不同但内容相同",
+			updated: &Picture{Name: "日落", Introduction: "海边", Category: "风景", Tags: `[ "海", "夕阳" ]`},
+			want:    nil,
+		},
+		{
+			name:    "标签顺序变化",
+			updated: &Picture{Name: "日落", Introduction: "海边", Category: "风景", Tags: `["夕阳","海"]`},
+			want:    []*PictureChange{{Field: "tags", Old: `["海","夕阳"]`, New: `["夕阳","海"]`}},
+		},
+		{
+			name:    "清空标签",
+			updated: &Picture{Name: "日落", Introduction: "海边", Category: "风景"},
+			want:    []*PictureChange{{Field: "tags", Old: `["海","夕阳"]`, New: "[]"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffPicture(old, tt.updated)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffPicture() = %+v, want %+v", formatChanges(got), formatChanges(tt.want))
+			}
+		})
+	}
+}
+
+func formatChanges(changes []*PictureChange) []PictureChange {
+	result := make([]PictureChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, *c)
+	}
+	return result
+}
+
+func TestVersionRetentionForSpace(t *testing.T) {
+	global := VersionRetention{MaxVersions: 20, MaxAge: 30 * 24 * time.Hour}
+
+	tests := []struct {
+		name  string
+		space *Space
+		want  VersionRetention
+	}{
+		{name: "公共图库", space: nil, want: global},
+		{name: "空间未设置", space: &Space{}, want: global},
+		{name: "覆盖保留数量", space: &Space{VersionMaxCount: 5}, want: VersionRetention{MaxVersions: 5, MaxAge: global.MaxAge}},
+		{name: "覆盖保留天数", space: &Space{VersionMaxDays: 7}, want: VersionRetention{MaxVersions: 20, MaxAge: 7 * 24 * time.Hour}},
+		{name: "不限制", space: &Space{VersionMaxCount: -1, VersionMaxDays: -1}, want: VersionRetention{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := global.forSpace(tt.space); got != tt.want {
+				t.Errorf("forSpace() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,22 +7,37 @@ import (
 
 // PictureVO 图片视图对象
 type PictureVO struct {
-	ID           int64     `json:"id"`
-	URL          string    `json:"url"`
-	Name         string    `json:"name"`
-	Introduction string    `json:"introduction"`
-	Tags         []string  `json:"tags"`
-	Category     string    `json:"category"`
-	PicSize      int64     `json:"picSize"`
-	PicWidth     int32     `json:"picWidth"`
-	PicHeight    int32     `json:"picHeight"`
-	PicScale     float64   `json:"picScale"`
-	PicFormat    string    `json:"picFormat"`
-	UserID       int64     `json:"userId"`
-	CreateTime   time.Time `json:"createTime"`
-	EditTime     time.Time `json:"editTime"`
-	UpdateTime   time.Time `json:"updateTime"`
-	User         *UserVO   `json:"user,omitempty"` // 创建用户信息
+	ID            int64            `json:"id"`
+	URL           string           `json:"url"`
+	Name          string           `json:"name"`
+	Introduction  string           `json:"introduction"`
+	Tags          []string         `json:"tags"`
+	Category      string           `json:"category"`
+	PicSize       int64            `json:"picSize"`
+	PicWidth      int32            `json:"picWidth"`
+	PicHeight     int32            `json:"picHeight"`
+	PicScale      float64          `json:"picScale"`
+	PicFormat     string           `json:"picFormat"`
+	PicColor      string           `json:"picColor"`           // 主色调（#RRGGBB）
+	PicPalette    []string         `json:"picPalette"`         // 调色板（按占比从高到低）
+	Metadata      *PictureMetadata `json:"metadata,omitempty"` // 拍摄信息（EXIF）
+	UserID        int64            `json:"userId"`
+	SpaceID       int64            `json:"spaceId"` // 所属空间，0 表示公共图库
+	ReviewStatus  ReviewStatus     `json:"reviewStatus"`
+	ReviewMessage string           `json:"reviewMessage"`
+	CreateTime    time.Time        `json:"createTime"`
+	EditTime      time.Time        `json:"editTime"`
+	UpdateTime    time.Time        `json:"updateTime"`
+	User          *UserVO          `json:"user,omitempty"` // 创建用户信息
+	LikeCount     int64            `json:"likeCount"`
+	FavoriteCount int64            `json:"favoriteCount"`
+	ViewCount     int64            `json:"viewCount"`
+	CommentCount  int64            `json:"commentCount"`
+	Liked         bool             `json:"liked"`     // 当前登录用户是否已点赞
+	Favorited     bool             `json:"favorited"` // 当前登录用户是否已收藏
+	// 按关键词搜索时的相关度和高亮片段（字段名 -> 带 <em> 标记的片段）
+	SearchScore float64           `json:"searchScore,omitempty"`
+	Highlights  map[string]string `json:"highlights,omitempty"`
 }
 
 // Picture 业务对象
@@ -38,11 +53,66 @@ type Picture struct {
 	PicHeight    int32
 	PicScale     float64
 	PicFormat    string
+	PHash        *uint64   // 感知哈希（dHash），无法解码的图片为空
+	PicColor     string    // 主色调（#RRGGBB），无法解码的图片为空
+	PicPalette   string    // 调色板 JSON 字符串
+	ColorLab     *LabColor // 主色调的 CIELAB 坐标，用于按颜色搜索
+	PicMetadata  string    // 拍摄信息 JSON 字符串
 	UserID       int64
-	CreateTime   time.Time
-	EditTime     time.Time
-	UpdateTime   time.Time
-	IsDelete     int8
+	SpaceID      int64 // 所属空间，0 表示公共图库
+	// 审核状态，审核未通过或待审核的图片只对作者和管理员可见
+	ReviewStatus  ReviewStatus
+	ReviewMessage string
+	ReviewerID    int64
+	ReviewTime    *time.Time
+	LikeCount     int64
+	FavoriteCount int64
+	ViewCount     int64
+	CommentCount  int64
+	CreateTime    time.Time
+	EditTime      time.Time
+	UpdateTime    time.Time
+	IsDelete      int8
+}
+
+// LabColor CIELAB 颜色坐标，坐标间的欧氏距离即感知色差（CIE76 ΔE）
+type LabColor struct {
+	L float64
+	A float64
+	B float64
+}
+
+// PictureFeatures 读取图片文件提取的特征
+type PictureFeatures struct {
+	PHash    uint64   // 感知哈希（dHash）
+	Color    string   // 主色调（#RRGGBB）
+	ColorLab LabColor // 主色调的 CIELAB 坐标
+	Palette  []string // 调色板（#RRGGBB，按占比从高到低）
+	Width    int32    // 按 EXIF 方向校正后的显示宽度
+	Height   int32    // 按 EXIF 方向校正后的显示高度
+	Format   string   // 实际图片格式
+	Metadata *PictureMetadata
+}
+
+// PictureMetadata 图片拍摄信息（从 EXIF 中解析）
+type PictureMetadata struct {
+	CameraMake   string       `json:"cameraMake,omitempty"`
+	CameraModel  string       `json:"cameraModel,omitempty"`
+	LensModel    string       `json:"lensModel,omitempty"`
+	ShootTime    *time.Time   `json:"shootTime,omitempty"`
+	Orientation  int32        `json:"orientation,omitempty"`
+	FNumber      float64      `json:"fNumber,omitempty"`
+	ExposureTime string       `json:"exposureTime,omitempty"`
+	ISO          int32        `json:"iso,omitempty"`
+	FocalLength  float64      `json:"focalLength,omitempty"`
+	GPS          *GPSLocation `json:"gps,omitempty"` // 用户未选择保留位置信息时为空
+}
+
+// GPSLocation 拍摄位置
+type GPSLocation struct {
+	Latitude  float64  `json:"latitude"`
+	Longitude float64  `json:"longitude"`
+	Altitude  *float64 `json:"altitude,omitempty"`
 }
 
 // PictureQueryParams 图片查询参数
@@ -54,17 +124,35 @@ type PictureQueryParams struct {
 	Category     string
 	Tags         []string
 	UserID       *int64
-	SearchText   string // 搜索词（同时搜名称、简介等）
+	SpaceID      int64  // 所属空间，0 表示不限
+	SearchText   string // 搜索词（全文检索名称、简介、标签、分类和作者昵称），指定时按相关度排序
 	SortField    string
 	SortOrder    string // ascend 或 descend
+	// 按颜色搜索：只返回主色调色差不超过 MaxColorDistance 的图片，按色差从小到大排序
+	Color            *LabColor
+	MaxColorDistance float64
+	// 按分面取值过滤
+	PicFormat  string
+	SizeRange  *PictureRange // 体积区间（PictureSizeRanges 之一）
+	ScaleRange *PictureRange // 宽高比区间（PictureScaleRanges 之一）
+	// 游标分页：CursorMode 为 true 时忽略 Current，从 Cursor（上一页返回的游标，空表示第一页）之后开始查询
+	CursorMode bool
+	Cursor     string
+	After      *PageCursor // 解析后的游标，由用例层设置
+	WithTotal  bool        // 游标分页时是否统计总数（偏移量分页始终统计）
+	// 审核状态过滤：PassedOnly 为 true 时只查询审核通过的图片和 ViewerID（当前登录用户，0 表示未登录）自己的图片
+	PassedOnly   bool
+	ViewerID     int64
+	ReviewStatus *ReviewStatus // 按审核状态过滤（管理员）
 }
 
 // PicturePage 图片分页结果
 type PicturePage struct {
-	Total    int64
-	List     []*PictureVO
-	Current  int64
-	PageSize int64
+	Total      int64
+	List       []*PictureVO
+	Current    int64
+	PageSize   int64
+	NextCursor string // 游标分页的下一页游标，没有更多数据时为空
 }
 
 // ObjToVO 对象转 VO
@@ -74,20 +162,28 @@ func (p *Picture) ObjToVO() *PictureVO {
 	}
 
 	vo := &PictureVO{
-		ID:           p.ID,
-		URL:          p.URL,
-		Name:         p.Name,
-		Introduction: p.Introduction,
-		Category:     p.Category,
-		PicSize:      p.PicSize,
-		PicWidth:     p.PicWidth,
-		PicHeight:    p.PicHeight,
-		PicScale:     p.PicScale,
-		PicFormat:    p.PicFormat,
-		UserID:       p.UserID,
-		CreateTime:   p.CreateTime,
-		EditTime:     p.EditTime,
-		UpdateTime:   p.UpdateTime,
+		ID:            p.ID,
+		URL:           p.URL,
+		Name:          p.Name,
+		Introduction:  p.Introduction,
+		Category:      p.Category,
+		PicSize:       p.PicSize,
+		PicWidth:      p.PicWidth,
+		PicHeight:     p.PicHeight,
+		PicScale:      p.PicScale,
+		PicFormat:     p.PicFormat,
+		PicColor:      p.PicColor,
+		UserID:        p.UserID,
+		SpaceID:       p.SpaceID,
+		ReviewStatus:  p.ReviewStatus,
+		ReviewMessage: p.ReviewMessage,
+		CreateTime:    p.CreateTime,
+		EditTime:      p.EditTime,
+		UpdateTime:    p.UpdateTime,
+		LikeCount:     p.LikeCount,
+		FavoriteCount: p.FavoriteCount,
+		ViewCount:     p.ViewCount,
+		CommentCount:  p.CommentCount,
 	}
 
 	// 解析 JSON 标签
@@ -98,9 +194,42 @@ func (p *Picture) ObjToVO() *PictureVO {
 		}
 	}
 
+	// 解析 JSON 调色板
+	if p.PicPalette != "" {
+		var palette []string
+		if err := json.Unmarshal([]byte(p.PicPalette), &palette); err == nil {
+			vo.PicPalette = palette
+		}
+	}
+
+	// 解析 JSON 拍摄信息
+	if p.PicMetadata != "" {
+		var metadata PictureMetadata
+		if err := json.Unmarshal([]byte(p.PicMetadata), &metadata); err == nil {
+			vo.Metadata = &metadata
+		}
+	}
+
 	return vo
 }
 
+// visibleTo 图片是否对 viewerID 可见，审核未通过或待审核的图片只对作者可见
+func (p *Picture) visibleTo(viewerID int64) bool {
+	return p.ReviewStatus == ReviewStatusPass || (viewerID > 0 && p.UserID == viewerID)
+}
+
+// VisibleTo 图片是否对 viewerID（0 表示未登录）可见，未通过审核的图片只对作者和管理员可见
+func (vo *PictureVO) VisibleTo(viewerID int64, isAdmin bool) bool {
+	return isAdmin || vo.ReviewStatus == ReviewStatusPass || (viewerID > 0 && vo.UserID == viewerID)
+}
+
+// resubmitReview 审核未通过的图片修改图片文件后重新进入待审核状态
+func (p *Picture) resubmitReview() {
+	if p.ReviewStatus == ReviewStatusReject {
+		p.ReviewStatus = ReviewStatusPending
+	}
+}
+
 // VOToObj VO 转对象
 func VOToObj(vo *PictureVO) *Picture {
 	if vo == nil {
@@ -108,20 +237,24 @@ func VOToObj(vo *PictureVO) *Picture {
 	}
 
 	obj := &Picture{
-		ID:           vo.ID,
-		URL:          vo.URL,
-		Name:         vo.Name,
-		Introduction: vo.Introduction,
-		Category:     vo.Category,
-		PicSize:      vo.PicSize,
-		PicWidth:     vo.PicWidth,
-		PicHeight:    vo.PicHeight,
-		PicScale:     vo.PicScale,
-		PicFormat:    vo.PicFormat,
-		UserID:       vo.UserID,
-		CreateTime:   vo.CreateTime,
-		EditTime:     vo.EditTime,
-		UpdateTime:   vo.UpdateTime,
+		ID:            vo.ID,
+		URL:           vo.URL,
+		Name:          vo.Name,
+		Introduction:  vo.Introduction,
+		Category:      vo.Category,
+		PicSize:       vo.PicSize,
+		PicWidth:      vo.PicWidth,
+		PicHeight:     vo.PicHeight,
+		PicScale:      vo.PicScale,
+		PicFormat:     vo.PicFormat,
+		PicColor:      vo.PicColor,
+		UserID:        vo.UserID,
+		SpaceID:       vo.SpaceID,
+		ReviewStatus:  vo.ReviewStatus,
+		ReviewMessage: vo.ReviewMessage,
+		CreateTime:    vo.CreateTime,
+		EditTime:      vo.EditTime,
+		UpdateTime:    vo.UpdateTime,
 	}
 
 	// 转换标签为 JSON
@@ -131,5 +264,19 @@ func VOToObj(vo *PictureVO) *Picture {
 		}
 	}
 
+	// 转换调色板为 JSON
+	if len(vo.PicPalette) > 0 {
+		if paletteBytes, err := json.Marshal(vo.PicPalette); err == nil {
+			obj.PicPalette = string(paletteBytes)
+		}
+	}
+
+	// 转换拍摄信息为 JSON
+	if vo.Metadata != nil {
+		if metadataBytes, err := json.Marshal(vo.Metadata); err == nil {
+			obj.PicMetadata = string(metadataBytes)
+		}
+	}
+
 	return obj
 }
@@ -0,0 +1,361 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "smart-collab-gallery-server/api/quota/v1"
+	"smart-collab-gallery-server/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 未配置配额时的默认值，0 表示不限制
+var (
+	defaultUserQuota  = QuotaLimit{MaxBytes: 1 << 30, MaxPictures: 1000, MaxDailyUploads: 100}
+	defaultVipQuota   = QuotaLimit{MaxBytes: 10 << 30, MaxPictures: 10000, MaxDailyUploads: 1000}
+	defaultAdminQuota = QuotaLimit{}
+)
+
+// QuotaLimit 配额限制，各项为 0 表示不限制
+type QuotaLimit struct {
+	MaxBytes        int64
+	MaxPictures     int64
+	MaxDailyUploads int64
+}
+
+// UserQuota 用户存储用量及管理员设置的配额
+type UserQuota struct {
+	UserID       int64
+	UsedBytes    int64
+	PictureCount int64
+	// 管理员单独设置的配额，为 nil 时使用按角色和会员状态计算的默认配额
+	MaxBytes        *int64
+	MaxPictures     *int64
+	MaxDailyUploads *int64
+}
+
+// Usage 存储用量和生效的配额
+type Usage struct {
+	UserID       int64
+	UsedBytes    int64
+	PictureCount int64
+	DailyUploads int64
+	Limit        QuotaLimit
+	Overridden   bool
+}
+
+// QuotaRepo 配额仓储接口
+type QuotaRepo interface {
+	// GetUserQuota 查询用户用量，尚未初始化时返回 nil
+	GetUserQuota(ctx context.Context, userID int64) (*UserQuota, error)
+	// InitUserQuota 根据用户现有图片初始化用量，已初始化时不做任何操作
+	InitUserQuota(ctx context.Context, userID int64) error
+	// IncrUsage 增量更新用户用量
+	IncrUsage(ctx context.Context, userID, bytesDelta, pictureDelta int64) error
+	// IncrUsageWithinLimit 增量更新用户用量，增量为正的项更新后不能超过 limit 中对应的限制（0 表示不限制）
+	// 检查和更新在同一条语句中完成，超出限制时不更新并返回 false
+	IncrUsageWithinLimit(ctx context.Context, userID, bytesDelta, pictureDelta int64, limit QuotaLimit) (bool, error)
+	// SetQuotaOverride 设置管理员单独指定的配额，nil 表示恢复默认配额
+	SetQuotaOverride(ctx context.Context, userID int64, maxBytes, maxPictures, maxDailyUploads *int64) error
+}
+
+// QuotaUsecase 配额业务逻辑
+type QuotaUsecase struct {
+	repo       QuotaRepo
+	uploadRepo UploadRepo // 用于统计每日上传次数
+	userRepo   UserRepo   // 用于获取用户角色和会员状态
+	spaceRepo  SpaceRepo  // 用于检查和更新空间容量
+	userLimit  QuotaLimit
+	vipLimit   QuotaLimit
+	adminLimit QuotaLimit
+	log        *log.Helper
+}
+
+// NewQuotaUsecase 创建配额业务逻辑
+func NewQuotaUsecase(repo QuotaRepo, uploadRepo UploadRepo, userRepo UserRepo, spaceRepo SpaceRepo, bc *conf.Bootstrap, logger log.Logger) *QuotaUsecase {
+	uc := &QuotaUsecase{
+		repo:       repo,
+		uploadRepo: uploadRepo,
+		userRepo:   userRepo,
+		spaceRepo:  spaceRepo,
+		userLimit:  defaultUserQuota,
+		vipLimit:   defaultVipQuota,
+		adminLimit: defaultAdminQuota,
+		log:        log.NewHelper(logger),
+	}
+
+	if q := bc.GetQuota(); q != nil {
+		if q.User != nil {
+			uc.userLimit = convertQuotaLimit(q.User)
+		}
+		if q.Vip != nil {
+			uc.vipLimit = convertQuotaLimit(q.Vip)
+		}
+		if q.Admin != nil {
+			uc.adminLimit = convertQuotaLimit(q.Admin)
+		}
+	}
+	return uc
+}
+
+// GetUsage 查询用户的存储用量和生效的配额
+func (uc *QuotaUsecase) GetUsage(ctx context.Context, userID int64) (*Usage, error) {
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		uc.log.Errorf("查询用户失败: %v", err)
+		return nil, v1.ErrorSystemError("查询用户失败")
+	}
+	if user == nil {
+		return nil, v1.ErrorUserNotFound("用户不存在")
+	}
+
+	quota, err := uc.getUserQuota(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dailyUploads, err := uc.uploadRepo.CountUploadsSince(ctx, userID, startOfToday())
+	if err != nil {
+		uc.log.Errorf("统计今日上传次数失败: %v", err)
+		return nil, v1.ErrorSystemError("查询存储用量失败")
+	}
+
+	usage := &Usage{
+		UserID:       userID,
+		UsedBytes:    quota.UsedBytes,
+		PictureCount: quota.PictureCount,
+		DailyUploads: dailyUploads,
+		Limit:        uc.defaultLimit(user),
+	}
+	if quota.MaxBytes != nil {
+		usage.Limit.MaxBytes = *quota.MaxBytes
+		usage.Overridden = true
+	}
+	if quota.MaxPictures != nil {
+		usage.Limit.MaxPictures = *quota.MaxPictures
+		usage.Overridden = true
+	}
+	if quota.MaxDailyUploads != nil {
+		usage.Limit.MaxDailyUploads = *quota.MaxDailyUploads
+		usage.Overridden = true
+	}
+	return usage, nil
+}
+
+// CheckUpload 获取上传地址前检查每日上传次数和剩余存储空间
+func (uc *QuotaUsecase) CheckUpload(ctx context.Context, userID, fileSize int64) error {
+	usage, err := uc.GetUsage(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if usage.Limit.MaxDailyUploads > 0 && usage.DailyUploads >= usage.Limit.MaxDailyUploads {
+		return v1.ErrorDailyUploadQuotaExceeded("今日上传次数已达上限 %d 次", usage.Limit.MaxDailyUploads)
+	}
+	if usage.Limit.MaxBytes > 0 && usage.UsedBytes+fileSize > usage.Limit.MaxBytes {
+		return v1.ErrorStorageQuotaExceeded("存储空间不足")
+	}
+	return nil
+}
+
+// CheckPicture 创建或替换图片前检查图片数量和存储空间，bytesDelta、pictureDelta 为本次操作带来的增量
+func (uc *QuotaUsecase) CheckPicture(ctx context.Context, userID, bytesDelta, pictureDelta int64) error {
+	usage, err := uc.GetUsage(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if pictureDelta > 0 && usage.Limit.MaxPictures > 0 && usage.PictureCount+pictureDelta > usage.Limit.MaxPictures {
+		return v1.ErrorPictureQuotaExceeded("图片数量已达上限 %d 张", usage.Limit.MaxPictures)
+	}
+	if bytesDelta > 0 && usage.Limit.MaxBytes > 0 && usage.UsedBytes+bytesDelta > usage.Limit.MaxBytes {
+		return v1.ErrorStorageQuotaExceeded("存储空间不足")
+	}
+	return nil
+}
+
+// ChargeUsage 创建或替换图片时增量更新用量，需与图片写入在同一个事务中调用
+// 增量为正的项在同一条更新语句中检查配额，并发上传不会同时通过检查；图片写入失败时用量随事务回滚
+func (uc *QuotaUsecase) ChargeUsage(ctx context.Context, userID, bytesDelta, pictureDelta int64) error {
+	if bytesDelta == 0 && pictureDelta == 0 {
+		return nil
+	}
+	usage, err := uc.GetUsage(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// 只减少用量时不需要检查配额
+	if bytesDelta <= 0 && pictureDelta <= 0 {
+		if err := uc.repo.IncrUsage(ctx, userID, bytesDelta, pictureDelta); err != nil {
+			uc.log.Errorf("更新用户存储用量失败: userID=%d, bytes=%d, pictures=%d, err=%v", userID, bytesDelta, pictureDelta, err)
+			return v1.ErrorSystemError("更新存储用量失败")
+		}
+		return nil
+	}
+
+	ok, err := uc.repo.IncrUsageWithinLimit(ctx, userID, bytesDelta, pictureDelta, usage.Limit)
+	if err != nil {
+		uc.log.Errorf("更新用户存储用量失败: userID=%d, bytes=%d, pictures=%d, err=%v", userID, bytesDelta, pictureDelta, err)
+		return v1.ErrorSystemError("更新存储用量失败")
+	}
+	if ok {
+		return nil
+	}
+
+	// 超出配额，按最新用量确定超出的是图片数量还是存储空间
+	quota, err := uc.getUserQuota(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if pictureDelta > 0 && usage.Limit.MaxPictures > 0 && quota.PictureCount+pictureDelta > usage.Limit.MaxPictures {
+		return v1.ErrorPictureQuotaExceeded("图片数量已达上限 %d 张", usage.Limit.MaxPictures)
+	}
+	return v1.ErrorStorageQuotaExceeded("存储空间不足")
+}
+
+// AddUsage 图片删除后增量更新用量，失败只记录日志
+func (uc *QuotaUsecase) AddUsage(ctx context.Context, userID, bytesDelta, pictureDelta int64) {
+	if bytesDelta == 0 && pictureDelta == 0 {
+		return
+	}
+
+	// 确保用量已初始化，否则增量会丢失用户已有的图片
+	if _, err := uc.getUserQuota(ctx, userID); err != nil {
+		return
+	}
+	if err := uc.repo.IncrUsage(ctx, userID, bytesDelta, pictureDelta); err != nil {
+		uc.log.Errorf("更新用户存储用量失败: userID=%d, bytes=%d, pictures=%d, err=%v", userID, bytesDelta, pictureDelta, err)
+	}
+}
+
+// errSpaceDeleted 图片所属的空间已被删除
+var errSpaceDeleted = v1.ErrorParamsError("空间不存在")
+
+// ChargeSpaceUsage 向空间添加图片或替换空间中图片的文件时增量更新空间用量，需与图片写入在同一个事务中调用，spaceID 为 0 时不处理
+// 增量为正的项在同一条更新语句中检查空间上限；空间已被删除时返回 errSpaceDeleted
+func (uc *QuotaUsecase) ChargeSpaceUsage(ctx context.Context, spaceID, sizeDelta, countDelta int64) error {
+	if spaceID <= 0 || (sizeDelta == 0 && countDelta == 0) {
+		return nil
+	}
+
+	ok, err := uc.spaceRepo.IncrSpaceUsage(ctx, spaceID, sizeDelta, countDelta)
+	if err != nil {
+		uc.log.Errorf("更新空间用量失败: spaceID=%d, size=%d, count=%d, err=%v", spaceID, sizeDelta, countDelta, err)
+		return v1.ErrorSystemError("更新空间用量失败")
+	}
+	// 只减少用量时用量可能没有变化，不检查更新结果
+	if ok || (sizeDelta <= 0 && countDelta <= 0) {
+		return nil
+	}
+
+	// 按最新用量确定超出的是图片数量还是容量
+	space, err := uc.spaceRepo.GetSpaceByID(ctx, spaceID)
+	if err != nil {
+		uc.log.Errorf("查询空间失败: id=%d, err=%v", spaceID, err)
+		return v1.ErrorSystemError("更新空间用量失败")
+	}
+	if space == nil {
+		return errSpaceDeleted
+	}
+	if countDelta > 0 && space.MaxCount > 0 && space.TotalCount+countDelta > space.MaxCount {
+		return v1.ErrorPictureQuotaExceeded("空间图片数量已达上限")
+	}
+	return v1.ErrorStorageQuotaExceeded("空间容量不足")
+}
+
+// AddSpaceUsage 删除空间中的图片后增量更新空间用量，spaceID 为 0 时不处理，失败只记录日志
+func (uc *QuotaUsecase) AddSpaceUsage(ctx context.Context, spaceID, sizeDelta, countDelta int64) {
+	if spaceID <= 0 || (sizeDelta == 0 && countDelta == 0) {
+		return
+	}
+	if _, err := uc.spaceRepo.IncrSpaceUsage(ctx, spaceID, sizeDelta, countDelta); err != nil {
+		uc.log.Errorf("更新空间用量失败: spaceID=%d, size=%d, count=%d, err=%v", spaceID, sizeDelta, countDelta, err)
+	}
+}
+
+// SetUserQuota 管理员单独设置用户配额，负数表示该项恢复默认配额
+func (uc *QuotaUsecase) SetUserQuota(ctx context.Context, userID, maxBytes, maxPictures, maxDailyUploads int64) (*Usage, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorParamsError("用户 ID 不能为空")
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		uc.log.Errorf("查询用户失败: %v", err)
+		return nil, v1.ErrorSystemError("查询用户失败")
+	}
+	if user == nil {
+		return nil, v1.ErrorUserNotFound("用户不存在")
+	}
+
+	if _, err := uc.getUserQuota(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.SetQuotaOverride(ctx, userID, quotaOverride(maxBytes), quotaOverride(maxPictures), quotaOverride(maxDailyUploads)); err != nil {
+		uc.log.Errorf("设置用户配额失败: %v", err)
+		return nil, v1.ErrorSystemError("设置用户配额失败")
+	}
+
+	uc.log.Infof("设置用户配额: userID=%d, maxBytes=%d, maxPictures=%d, maxDailyUploads=%d", userID, maxBytes, maxPictures, maxDailyUploads)
+	return uc.GetUsage(ctx, userID)
+}
+
+// getUserQuota 查询用户用量，首次查询时根据已有图片初始化
+func (uc *QuotaUsecase) getUserQuota(ctx context.Context, userID int64) (*UserQuota, error) {
+	quota, err := uc.repo.GetUserQuota(ctx, userID)
+	if err == nil && quota == nil {
+		if err = uc.repo.InitUserQuota(ctx, userID); err == nil {
+			quota, err = uc.repo.GetUserQuota(ctx, userID)
+		}
+	}
+	if err != nil {
+		uc.log.Errorf("查询用户存储用量失败: userID=%d, err=%v", userID, err)
+		return nil, v1.ErrorSystemError("查询存储用量失败")
+	}
+	if quota == nil {
+		return nil, v1.ErrorSystemError("查询存储用量失败")
+	}
+	return quota, nil
+}
+
+// defaultLimit 按用户角色和会员状态确定默认配额
+func (uc *QuotaUsecase) defaultLimit(user *User) QuotaLimit {
+	switch {
+	case user.UserRole == "admin":
+		return uc.adminLimit
+	case user.IsVip():
+		return uc.vipLimit
+	default:
+		return uc.userLimit
+	}
+}
+
+// convertQuotaLimit 转换配额配置
+func convertQuotaLimit(c *conf.QuotaLimit) QuotaLimit {
+	return QuotaLimit{
+		MaxBytes:        c.MaxBytes,
+		MaxPictures:     c.MaxPictures,
+		MaxDailyUploads: c.MaxDailyUploads,
+	}
+}
+
+// isQuotaExceeded 判断错误是否为存储空间或图片数量超出配额（含空间上限），或图片所属的空间已被删除
+func isQuotaExceeded(err error) bool {
+	return v1.IsStorageQuotaExceeded(err) || v1.IsPictureQuotaExceeded(err) || err == errSpaceDeleted
+}
+
+// quotaOverride 负数表示不单独设置
+func quotaOverride(value int64) *int64 {
+	if value < 0 {
+		return nil
+	}
+	return &value
+}
+
+// startOfToday 今天零点
+func startOfToday() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
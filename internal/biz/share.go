@@ -0,0 +1,595 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "smart-collab-gallery-server/api/share/v1"
+	"smart-collab-gallery-server/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ShareTargetType 分享对象类型
+type ShareTargetType string
+
+const (
+	ShareTargetPicture ShareTargetType = "picture" // 图片
+	ShareTargetAlbum   ShareTargetType = "album"   // 相册
+)
+
+// ShareAccessResult 分享链接访问结果
+type ShareAccessResult string
+
+const (
+	ShareAccessSuccess       ShareAccessResult = "success"        // 访问成功
+	ShareAccessPasswordError ShareAccessResult = "password_error" // 密码错误
+	ShareAccessExpired       ShareAccessResult = "expired"        // 已过期
+	ShareAccessRevoked       ShareAccessResult = "revoked"        // 已撤销
+	ShareAccessExhausted     ShareAccessResult = "exhausted"      // 访问次数已用完
+)
+
+const (
+	// shareTokenLength 分享链接 token 长度
+	shareTokenLength = 24
+	// defaultShareExpire 分享链接默认有效期
+	defaultShareExpire = 7 * 24 * time.Hour
+	// defaultShareMaxExpire 分享链接默认最长有效期
+	defaultShareMaxExpire = 30 * 24 * time.Hour
+	// defaultShareURLExpire 访问分享链接时返回的下载地址默认有效期
+	defaultShareURLExpire = 10 * time.Minute
+	// defaultShareMaxPasswordAttempts 同一 IP 每个统计窗口内默认最多输错密码次数
+	defaultShareMaxPasswordAttempts = 10
+	// defaultShareMaxLinkPasswordAttempts 每个分享链接每个统计窗口内默认最多输错密码次数（不区分 IP）
+	defaultShareMaxLinkPasswordAttempts = 100
+	// sharePasswordAttemptWindow 密码错误次数的统计窗口
+	sharePasswordAttemptWindow = time.Hour
+	// maxSharePasswordLength 分享密码最大长度（字节数），bcrypt 只使用前 72 个字节
+	maxSharePasswordLength = 72
+	// maxShareLinkPageSize 分享链接分页每页最多条数
+	maxShareLinkPageSize = 20
+	// defaultShareStatsDays 默认统计最近多少天的每日访问量
+	defaultShareStatsDays = 7
+	// maxShareStatsDays 最多统计最近多少天的每日访问量
+	maxShareStatsDays = 30
+)
+
+// ShareLink 分享链接业务对象
+type ShareLink struct {
+	ID           int64
+	UserID       int64
+	Token        string
+	TargetType   ShareTargetType
+	TargetID     int64
+	PasswordHash string // 为空表示不需要密码
+	MaxViews     int64  // 0 表示不限制
+	ViewCount    int64
+	IsRevoked    bool
+	ExpireTime   time.Time
+	CreateTime   time.Time
+	UpdateTime   time.Time
+}
+
+// ShareLinkVO 分享链接视图对象
+type ShareLinkVO struct {
+	ID          int64
+	UserID      int64
+	Token       string
+	TargetType  ShareTargetType
+	TargetID    int64
+	HasPassword bool
+	MaxViews    int64
+	ViewCount   int64
+	IsRevoked   bool
+	ExpireTime  time.Time
+	CreateTime  time.Time
+}
+
+// ObjToVO 将分享链接业务对象转换为视图对象
+func (l *ShareLink) ObjToVO() *ShareLinkVO {
+	return &ShareLinkVO{
+		ID:          l.ID,
+		UserID:      l.UserID,
+		Token:       l.Token,
+		TargetType:  l.TargetType,
+		TargetID:    l.TargetID,
+		HasPassword: l.PasswordHash != "",
+		MaxViews:    l.MaxViews,
+		ViewCount:   l.ViewCount,
+		IsRevoked:   l.IsRevoked,
+		ExpireTime:  l.ExpireTime,
+		CreateTime:  l.CreateTime,
+	}
+}
+
+// ShareLinkPage 分享链接分页结果
+type ShareLinkPage struct {
+	Total    int64
+	List     []*ShareLinkVO
+	Current  int64
+	PageSize int64
+}
+
+// ShareAccessLog 分享链接访问记录
+type ShareAccessLog struct {
+	ShareLinkID int64
+	IP          string
+	UserAgent   string
+	Result      ShareAccessResult
+	CreateTime  time.Time
+}
+
+// ShareDailyStat 分享链接每日访问量
+type ShareDailyStat struct {
+	Date  string // yyyy-MM-dd
+	Count int64
+}
+
+// ShareLinkStats 分享链接访问统计
+type ShareLinkStats struct {
+	ViewCount          int64
+	UniqueVisitorCount int64
+	DeniedCount        int64
+	LastAccessTime     *time.Time
+	Daily              []*ShareDailyStat
+}
+
+// ShareContent 通过分享链接访问到的内容
+type ShareContent struct {
+	TargetType ShareTargetType
+	Picture    *PictureVO   // 分享的图片
+	Album      *AlbumVO     // 分享的相册
+	Pictures   *PicturePage // 相册中的图片
+	ExpireTime time.Time    // 分享链接过期时间
+	URLExpire  time.Duration
+}
+
+// ShareRepo 分享链接仓储接口
+type ShareRepo interface {
+	// CreateShareLink 创建分享链接
+	CreateShareLink(ctx context.Context, link *ShareLink) (*ShareLink, error)
+	// GetShareLinkByID 根据 ID 查询分享链接
+	GetShareLinkByID(ctx context.Context, id int64) (*ShareLink, error)
+	// GetShareLinkByToken 根据 token 查询分享链接
+	GetShareLinkByToken(ctx context.Context, token string) (*ShareLink, error)
+	// RevokeShareLink 撤销分享链接
+	RevokeShareLink(ctx context.Context, id int64) error
+	// ListShareLinksByUser 分页查询用户创建的分享链接（按创建时间倒序），targetType 为空、targetID 为 0 表示不筛选
+	ListShareLinksByUser(ctx context.Context, userID int64, targetType ShareTargetType, targetID, current, pageSize int64) ([]*ShareLink, int64, error)
+	// IncrViewCount 未超过最大访问次数时访问次数加一，返回是否成功
+	IncrViewCount(ctx context.Context, id int64) (bool, error)
+	// CreateAccessLog 记录一次访问
+	CreateAccessLog(ctx context.Context, accessLog *ShareAccessLog) error
+	// GetShareLinkStats 统计分享链接的访问记录，每日访问量只统计 since 之后的记录
+	GetShareLinkStats(ctx context.Context, id int64, since time.Time) (*ShareLinkStats, error)
+	// GetPasswordFailures 查询 IP 在统计窗口内对分享链接输错密码的次数，ip 为空时查询该链接所有 IP 的合计次数
+	GetPasswordFailures(ctx context.Context, token, ip string) (int64, error)
+	// IncrPasswordFailures 累加 IP 输错密码的次数，ip 为空时累加该链接的合计次数，首次累加时设置统计窗口
+	IncrPasswordFailures(ctx context.Context, token, ip string, window time.Duration) (int64, error)
+}
+
+// ShareUsecase 分享链接用例
+type ShareUsecase struct {
+	repo        ShareRepo
+	pictureRepo PictureRepo
+	albumRepo   AlbumRepo
+	pictureUC   *PictureUsecase
+	albumUC     *AlbumUsecase
+	shareConf   *conf.Share
+	log         *log.Helper
+}
+
+// NewShareUsecase 创建分享链接用例
+func NewShareUsecase(repo ShareRepo, pictureRepo PictureRepo, albumRepo AlbumRepo, pictureUC *PictureUsecase, albumUC *AlbumUsecase, bc *conf.Bootstrap, logger log.Logger) *ShareUsecase {
+	return &ShareUsecase{
+		repo:        repo,
+		pictureRepo: pictureRepo,
+		albumRepo:   albumRepo,
+		pictureUC:   pictureUC,
+		albumUC:     albumUC,
+		shareConf:   bc.GetShare(),
+		log:         log.NewHelper(logger),
+	}
+}
+
+// CreateShareLink 为自己的图片或相册创建分享链接
+func (uc *ShareUsecase) CreateShareLink(ctx context.Context, userID int64, targetType ShareTargetType, targetID, expireSeconds int64, password string, maxViews int64) (*ShareLinkVO, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	if err := uc.checkTargetOwner(ctx, userID, targetType, targetID); err != nil {
+		return nil, err
+	}
+
+	expire := uc.defaultExpire()
+	if expireSeconds < 0 {
+		return nil, v1.ErrorParamsError("有效期无效")
+	}
+	if expireSeconds > 0 {
+		expire = time.Duration(expireSeconds) * time.Second
+	}
+	if expire > uc.maxExpire() {
+		return nil, v1.ErrorParamsError("有效期超过上限")
+	}
+	if len(password) > maxSharePasswordLength {
+		return nil, v1.ErrorParamsError("访问密码过长")
+	}
+	if maxViews < 0 {
+		return nil, v1.ErrorParamsError("最大访问次数无效")
+	}
+
+	token, err := generateRandomCode(shareTokenLength)
+	if err != nil {
+		uc.log.Errorf("生成分享链接 token 失败: %v", err)
+		return nil, v1.ErrorSystemError("创建分享链接失败")
+	}
+
+	link := &ShareLink{
+		UserID:     userID,
+		Token:      token,
+		TargetType: targetType,
+		TargetID:   targetID,
+		MaxViews:   maxViews,
+		ExpireTime: time.Now().Add(expire),
+	}
+	if password != "" {
+		link.PasswordHash, err = encryptSharePassword(password)
+		if err != nil {
+			uc.log.Errorf("加密分享密码失败: %v", err)
+			return nil, v1.ErrorSystemError("创建分享链接失败")
+		}
+	}
+
+	link, err = uc.repo.CreateShareLink(ctx, link)
+	if err != nil {
+		uc.log.Errorf("创建分享链接失败: userID=%d, err=%v", userID, err)
+		return nil, v1.ErrorSystemError("创建分享链接失败")
+	}
+	return link.ObjToVO(), nil
+}
+
+// RevokeShareLink 撤销分享链接（创建者或管理员），重复撤销视为成功
+func (uc *ShareUsecase) RevokeShareLink(ctx context.Context, userID, id int64, isAdmin bool) error {
+	link, err := uc.getManageableLink(ctx, userID, id, isAdmin)
+	if err != nil {
+		return err
+	}
+	if link.IsRevoked {
+		return nil
+	}
+
+	if err := uc.repo.RevokeShareLink(ctx, id); err != nil {
+		uc.log.Errorf("撤销分享链接失败: id=%d, err=%v", id, err)
+		return v1.ErrorSystemError("撤销分享链接失败")
+	}
+	return nil
+}
+
+// ListMyShareLinks 分页查询我创建的分享链接
+func (uc *ShareUsecase) ListMyShareLinks(ctx context.Context, userID int64, targetType ShareTargetType, targetID, current, pageSize int64) (*ShareLinkPage, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	if targetType != "" && targetType != ShareTargetPicture && targetType != ShareTargetAlbum {
+		return nil, v1.ErrorParamsError("分享对象类型无效")
+	}
+	if current <= 0 {
+		current = 1
+	}
+	if pageSize <= 0 || pageSize > maxShareLinkPageSize {
+		pageSize = maxShareLinkPageSize
+	}
+
+	links, total, err := uc.repo.ListShareLinksByUser(ctx, userID, targetType, targetID, current, pageSize)
+	if err != nil {
+		uc.log.Errorf("查询分享链接失败: userID=%d, err=%v", userID, err)
+		return nil, v1.ErrorSystemError("查询分享链接失败")
+	}
+
+	list := make([]*ShareLinkVO, 0, len(links))
+	for _, link := range links {
+		list = append(list, link.ObjToVO())
+	}
+	return &ShareLinkPage{
+		Total:    total,
+		List:     list,
+		Current:  current,
+		PageSize: pageSize,
+	}, nil
+}
+
+// GetShareLinkStats 查询分享链接的访问统计（创建者或管理员）
+func (uc *ShareUsecase) GetShareLinkStats(ctx context.Context, userID, id int64, isAdmin bool, days int32) (*ShareLinkStats, error) {
+	link, err := uc.getManageableLink(ctx, userID, id, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if days <= 0 {
+		days = defaultShareStatsDays
+	}
+	if days > maxShareStatsDays {
+		days = maxShareStatsDays
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	since := today.AddDate(0, 0, -int(days-1))
+
+	stats, err := uc.repo.GetShareLinkStats(ctx, id, since)
+	if err != nil {
+		uc.log.Errorf("统计分享链接访问失败: id=%d, err=%v", id, err)
+		return nil, v1.ErrorSystemError("查询访问统计失败")
+	}
+	stats.ViewCount = link.ViewCount
+
+	// 补齐没有访问记录的日期
+	counts := make(map[string]int64, len(stats.Daily))
+	for _, d := range stats.Daily {
+		counts[d.Date] = d.Count
+	}
+	daily := make([]*ShareDailyStat, 0, days)
+	for d := since; !d.After(today); d = d.AddDate(0, 0, 1) {
+		date := d.Format("2006-01-02")
+		daily = append(daily, &ShareDailyStat{Date: date, Count: counts[date]})
+	}
+	stats.Daily = daily
+
+	return stats, nil
+}
+
+// ResolveShareLink 通过分享链接访问内容（无需登录），每次成功访问（包括相册翻页）都计入访问次数
+func (uc *ShareUsecase) ResolveShareLink(ctx context.Context, token, password, ip, userAgent string, current, pageSize int64) (*ShareContent, error) {
+	if token == "" {
+		return nil, v1.ErrorParamsError("分享链接无效")
+	}
+
+	link, err := uc.repo.GetShareLinkByToken(ctx, token)
+	if err != nil {
+		uc.log.Errorf("查询分享链接失败: %v", err)
+		return nil, v1.ErrorSystemError("访问分享链接失败")
+	}
+	if link == nil {
+		return nil, v1.ErrorShareLinkNotFound("分享链接不存在")
+	}
+
+	if link.IsRevoked {
+		uc.recordAccess(ctx, link.ID, ip, userAgent, ShareAccessRevoked)
+		return nil, v1.ErrorShareLinkExpired("分享链接已被取消")
+	}
+	if !time.Now().Before(link.ExpireTime) {
+		uc.recordAccess(ctx, link.ID, ip, userAgent, ShareAccessExpired)
+		return nil, v1.ErrorShareLinkExpired("分享链接已过期")
+	}
+	if err := uc.checkPassword(ctx, link, password, ip, userAgent); err != nil {
+		return nil, err
+	}
+
+	// 先加载内容，分享对象已删除时不消耗访问次数
+	content, err := uc.loadContent(ctx, link, current, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := uc.repo.IncrViewCount(ctx, link.ID)
+	if err != nil {
+		uc.log.Errorf("累加分享链接访问次数失败: id=%d, err=%v", link.ID, err)
+		return nil, v1.ErrorSystemError("访问分享链接失败")
+	}
+	if !ok {
+		uc.recordAccess(ctx, link.ID, ip, userAgent, ShareAccessExhausted)
+		return nil, v1.ErrorShareLinkExhausted("分享链接访问次数已用完")
+	}
+	uc.recordAccess(ctx, link.ID, ip, userAgent, ShareAccessSuccess)
+
+	return content, nil
+}
+
+// checkTargetOwner 校验分享对象存在且属于当前用户
+func (uc *ShareUsecase) checkTargetOwner(ctx context.Context, userID int64, targetType ShareTargetType, targetID int64) error {
+	if targetID <= 0 {
+		return v1.ErrorParamsError("分享对象 ID 不能为空")
+	}
+
+	switch targetType {
+	case ShareTargetPicture:
+		picture, err := uc.pictureRepo.GetPictureByID(ctx, targetID)
+		if err != nil {
+			uc.log.Errorf("查询图片失败: id=%d, err=%v", targetID, err)
+			return v1.ErrorSystemError("创建分享链接失败")
+		}
+		if picture == nil {
+			return v1.ErrorShareLinkNotFound("图片不存在")
+		}
+		if picture.UserID != userID {
+			return v1.ErrorShareLinkNoAuth("只能分享自己的图片")
+		}
+	case ShareTargetAlbum:
+		album, err := uc.albumRepo.GetAlbumByID(ctx, targetID)
+		if err != nil {
+			uc.log.Errorf("查询相册失败: id=%d, err=%v", targetID, err)
+			return v1.ErrorSystemError("创建分享链接失败")
+		}
+		if album == nil {
+			return v1.ErrorShareLinkNotFound("相册不存在")
+		}
+		if album.UserID != userID {
+			return v1.ErrorShareLinkNoAuth("只能分享自己的相册")
+		}
+	default:
+		return v1.ErrorParamsError("分享对象类型无效")
+	}
+	return nil
+}
+
+// getManageableLink 查询当前用户可管理的分享链接（创建者或管理员）
+func (uc *ShareUsecase) getManageableLink(ctx context.Context, userID, id int64, isAdmin bool) (*ShareLink, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	if id <= 0 {
+		return nil, v1.ErrorParamsError("分享链接 ID 不能为空")
+	}
+
+	link, err := uc.repo.GetShareLinkByID(ctx, id)
+	if err != nil {
+		uc.log.Errorf("查询分享链接失败: id=%d, err=%v", id, err)
+		return nil, v1.ErrorSystemError("查询分享链接失败")
+	}
+	if link == nil {
+		return nil, v1.ErrorShareLinkNotFound("分享链接不存在")
+	}
+	if link.UserID != userID && !isAdmin {
+		return nil, v1.ErrorShareLinkNoAuth("无权限操作该分享链接")
+	}
+	return link, nil
+}
+
+// checkPassword 校验访问密码，同一 IP 或同一链接输错次数过多时暂时拒绝访问
+func (uc *ShareUsecase) checkPassword(ctx context.Context, link *ShareLink, password, ip, userAgent string) error {
+	if link.PasswordHash == "" {
+		return nil
+	}
+	if password == "" {
+		return v1.ErrorShareLinkPasswordRequired("请输入访问密码")
+	}
+
+	// 客户端 IP 可能无法准确获取，链接维度的合计次数不依赖 IP，限制总的尝试次数
+	linkFailures, err := uc.repo.GetPasswordFailures(ctx, link.Token, "")
+	if err != nil {
+		uc.log.Errorf("查询密码错误次数失败: %v", err)
+	}
+	if linkFailures >= uc.maxLinkPasswordAttempts() {
+		return v1.ErrorShareLinkTooManyAttempts("密码错误次数过多，请稍后再试")
+	}
+	failures, err := uc.repo.GetPasswordFailures(ctx, link.Token, ip)
+	if err != nil {
+		uc.log.Errorf("查询密码错误次数失败: %v", err)
+	}
+	if failures >= uc.maxPasswordAttempts() {
+		return v1.ErrorShareLinkTooManyAttempts("密码错误次数过多，请稍后再试")
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(password)) == nil {
+		return nil
+	}
+
+	if _, err := uc.repo.IncrPasswordFailures(ctx, link.Token, ip, sharePasswordAttemptWindow); err != nil {
+		uc.log.Errorf("记录密码错误次数失败: %v", err)
+	}
+	if _, err := uc.repo.IncrPasswordFailures(ctx, link.Token, "", sharePasswordAttemptWindow); err != nil {
+		uc.log.Errorf("记录密码错误次数失败: %v", err)
+	}
+	uc.recordAccess(ctx, link.ID, ip, userAgent, ShareAccessPasswordError)
+	return v1.ErrorShareLinkPasswordError("访问密码错误")
+}
+
+// loadContent 加载分享对象，分享对象已删除时返回 SHARE_LINK_NOT_FOUND
+func (uc *ShareUsecase) loadContent(ctx context.Context, link *ShareLink, current, pageSize int64) (*ShareContent, error) {
+	content := &ShareContent{
+		TargetType: link.TargetType,
+		ExpireTime: link.ExpireTime,
+		URLExpire:  uc.urlExpire(),
+	}
+
+	switch link.TargetType {
+	case ShareTargetPicture:
+		picture, err := uc.pictureRepo.GetPictureByID(ctx, link.TargetID)
+		if err != nil {
+			uc.log.Errorf("查询图片失败: id=%d, err=%v", link.TargetID, err)
+			return nil, v1.ErrorSystemError("访问分享链接失败")
+		}
+		if picture == nil {
+			return nil, v1.ErrorShareLinkNotFound("分享的图片已被删除")
+		}
+		if !picture.visibleTo(0) {
+			return nil, v1.ErrorShareLinkNotFound("分享的图片未通过审核")
+		}
+		pictures, err := uc.pictureUC.ListPictureVOsByIDs(ctx, []int64{picture.ID}, 0)
+		if err != nil || len(pictures) == 0 {
+			return nil, v1.ErrorSystemError("访问分享链接失败")
+		}
+		content.Picture = pictures[0]
+		uc.pictureUC.FillInteractions(ctx, 0, pictures)
+		uc.pictureUC.RecordView(ctx, picture.ID)
+	case ShareTargetAlbum:
+		album, err := uc.albumRepo.GetAlbumByID(ctx, link.TargetID)
+		if err != nil {
+			uc.log.Errorf("查询相册失败: id=%d, err=%v", link.TargetID, err)
+			return nil, v1.ErrorSystemError("访问分享链接失败")
+		}
+		if album == nil {
+			return nil, v1.ErrorShareLinkNotFound("分享的相册已被删除")
+		}
+		content.Album = uc.albumUC.toAlbumVO(ctx, album, 0)
+		content.Pictures, err = uc.albumUC.listAlbumPictures(ctx, 0, album.ID, current, pageSize)
+		if err != nil {
+			return nil, v1.ErrorSystemError("访问分享链接失败")
+		}
+	default:
+		return nil, v1.ErrorShareLinkNotFound("分享链接不存在")
+	}
+	return content, nil
+}
+
+// recordAccess 记录访问，失败时只记录日志
+func (uc *ShareUsecase) recordAccess(ctx context.Context, linkID int64, ip, userAgent string, result ShareAccessResult) {
+	err := uc.repo.CreateAccessLog(ctx, &ShareAccessLog{
+		ShareLinkID: linkID,
+		IP:          ip,
+		UserAgent:   userAgent,
+		Result:      result,
+	})
+	if err != nil {
+		uc.log.Errorf("记录分享链接访问失败: id=%d, err=%v", linkID, err)
+	}
+}
+
+// defaultExpire 分享链接默认有效期
+func (uc *ShareUsecase) defaultExpire() time.Duration {
+	if uc.shareConf != nil && uc.shareConf.DefaultExpire != nil {
+		return uc.shareConf.DefaultExpire.AsDuration()
+	}
+	return defaultShareExpire
+}
+
+// maxExpire 分享链接最长有效期
+func (uc *ShareUsecase) maxExpire() time.Duration {
+	if uc.shareConf != nil && uc.shareConf.MaxExpire != nil {
+		return uc.shareConf.MaxExpire.AsDuration()
+	}
+	return defaultShareMaxExpire
+}
+
+// urlExpire 访问分享链接时返回的下载地址有效期
+func (uc *ShareUsecase) urlExpire() time.Duration {
+	if uc.shareConf != nil && uc.shareConf.UrlExpire != nil {
+		return uc.shareConf.UrlExpire.AsDuration()
+	}
+	return defaultShareURLExpire
+}
+
+// maxPasswordAttempts 统计窗口内最多输错密码次数
+func (uc *ShareUsecase) maxPasswordAttempts() int64 {
+	if uc.shareConf != nil && uc.shareConf.MaxPasswordAttempts > 0 {
+		return int64(uc.shareConf.MaxPasswordAttempts)
+	}
+	return defaultShareMaxPasswordAttempts
+}
+
+// maxLinkPasswordAttempts 统计窗口内每个分享链接最多输错密码次数（不区分 IP）
+func (uc *ShareUsecase) maxLinkPasswordAttempts() int64 {
+	if uc.shareConf != nil && uc.shareConf.MaxLinkPasswordAttempts > 0 {
+		return int64(uc.shareConf.MaxLinkPasswordAttempts)
+	}
+	return defaultShareMaxLinkPasswordAttempts
+}
+
+// encryptSharePassword 使用 bcrypt 加密分享密码，每次生成随机盐
+func encryptSharePassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
@@ -0,0 +1,248 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "smart-collab-gallery-server/api/space/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// maxSpaceNameLength 空间名称最大长度（字符数）
+	maxSpaceNameLength = 64
+	// maxSpacesPerUser 每个用户最多创建的空间数
+	maxSpacesPerUser = 20
+	// maxSpacePageSize 空间分页每页最多条数
+	maxSpacePageSize = 20
+)
+
+// Space 空间业务对象
+// 空间中的图片都属于空间创建者，DuplicateMode 为空时使用全局查重配置
+type Space struct {
+	ID            int64
+	UserID        int64
+	SpaceName     string
+	DuplicateMode DuplicateMode
+	MaxSize       int64 // 总体积上限（字节），0 表示不限
+	MaxCount      int64 // 图片数量上限，0 表示不限
+	TotalSize     int64 // 已用体积（字节），随图片增删增量更新
+	TotalCount    int64 // 图片数量，随图片增删增量更新
+	// 历史版本保留策略，0 使用图片作者身份对应的全局配置，负数表示不限制
+	VersionMaxCount int32
+	VersionMaxDays  int32
+	CreateTime      time.Time
+	EditTime        time.Time
+	UpdateTime      time.Time
+}
+
+// SpaceQueryParams 空间查询参数
+type SpaceQueryParams struct {
+	UserID    int64
+	SpaceName string
+	Current   int64
+	PageSize  int64
+}
+
+// SpacePage 空间分页结果
+type SpacePage struct {
+	Total    int64
+	List     []*Space
+	Current  int64
+	PageSize int64
+}
+
+// SpaceRepo 空间仓储接口
+type SpaceRepo interface {
+	// CreateSpace 创建空间
+	CreateSpace(ctx context.Context, space *Space) (*Space, error)
+	// GetSpaceByID 根据 ID 查询空间
+	GetSpaceByID(ctx context.Context, id int64) (*Space, error)
+	// UpdateSpace 更新空间信息
+	UpdateSpace(ctx context.Context, space *Space) error
+	// DeleteSpace 删除空间（逻辑删除），空间中的图片移回公共图库
+	DeleteSpace(ctx context.Context, id int64) error
+	// ListSpaceByPage 分页查询空间（按创建时间倒序）
+	ListSpaceByPage(ctx context.Context, params *SpaceQueryParams) ([]*Space, int64, error)
+	// CountUserSpaces 统计用户创建的未删除空间数
+	CountUserSpaces(ctx context.Context, userID int64) (int64, error)
+	// IncrSpaceUsage 增量更新空间用量，增量为正的项更新后不能超过空间的上限，超出或空间已删除时不更新并返回 false
+	IncrSpaceUsage(ctx context.Context, id, sizeDelta, countDelta int64) (bool, error)
+}
+
+// SpaceUsecase 空间用例
+type SpaceUsecase struct {
+	repo     SpaceRepo
+	searcher PictureSearcher // 删除空间后更新图片的检索索引
+	log      *log.Helper
+}
+
+// NewSpaceUsecase 创建空间用例
+func NewSpaceUsecase(repo SpaceRepo, searcher PictureSearcher, logger log.Logger) *SpaceUsecase {
+	return &SpaceUsecase{
+		repo:     repo,
+		searcher: searcher,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// AddSpace 创建空间
+func (uc *SpaceUsecase) AddSpace(ctx context.Context, userID int64, space *Space) (*Space, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	if err := uc.validateSpace(space); err != nil {
+		return nil, err
+	}
+
+	count, err := uc.repo.CountUserSpaces(ctx, userID)
+	if err != nil {
+		uc.log.Errorf("统计用户空间数失败: userID=%d, err=%v", userID, err)
+		return nil, v1.ErrorSystemError("创建空间失败")
+	}
+	if count >= maxSpacesPerUser {
+		return nil, v1.ErrorParamsError("空间数量已达上限")
+	}
+
+	space.UserID = userID
+	created, err := uc.repo.CreateSpace(ctx, space)
+	if err != nil {
+		uc.log.Errorf("创建空间失败: userID=%d, err=%v", userID, err)
+		return nil, v1.ErrorSystemError("创建空间失败")
+	}
+	return created, nil
+}
+
+// UpdateSpace 更新空间（仅创建者）
+func (uc *SpaceUsecase) UpdateSpace(ctx context.Context, userID int64, update *Space) (*Space, error) {
+	space, err := uc.getSpace(ctx, update.ID)
+	if err != nil {
+		return nil, err
+	}
+	if space.UserID != userID {
+		return nil, v1.ErrorSpaceNoAuth("无权限操作该空间")
+	}
+	if err := uc.validateSpace(update); err != nil {
+		return nil, err
+	}
+
+	space.SpaceName = update.SpaceName
+	space.DuplicateMode = update.DuplicateMode
+	space.MaxSize = update.MaxSize
+	space.MaxCount = update.MaxCount
+	space.VersionMaxCount = update.VersionMaxCount
+	space.VersionMaxDays = update.VersionMaxDays
+	space.EditTime = time.Now()
+	if err := uc.repo.UpdateSpace(ctx, space); err != nil {
+		uc.log.Errorf("更新空间失败: id=%d, err=%v", space.ID, err)
+		return nil, v1.ErrorSystemError("更新空间失败")
+	}
+	return space, nil
+}
+
+// DeleteSpace 删除空间（创建者或管理员），空间中的图片移回公共图库
+func (uc *SpaceUsecase) DeleteSpace(ctx context.Context, userID, id int64, isAdmin bool) error {
+	space, err := uc.getSpace(ctx, id)
+	if err != nil {
+		return err
+	}
+	if space.UserID != userID && !isAdmin {
+		return v1.ErrorSpaceNoAuth("无权限删除该空间")
+	}
+
+	if err := uc.repo.DeleteSpace(ctx, id); err != nil {
+		uc.log.Errorf("删除空间失败: id=%d, err=%v", id, err)
+		return v1.ErrorSystemError("删除空间失败")
+	}
+	// 空间中的图片都属于空间创建者，更新其图片的索引
+	if err := uc.searcher.IndexUserPictures(ctx, space.UserID); err != nil {
+		uc.log.Errorf("更新图片索引失败: userID=%d, err=%v", space.UserID, err)
+	}
+	return nil
+}
+
+// GetSpace 获取空间详情（创建者或管理员），无权访问的空间视为不存在
+func (uc *SpaceUsecase) GetSpace(ctx context.Context, userID int64, isAdmin bool, id int64) (*Space, error) {
+	space, err := uc.getSpace(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if space.UserID != userID && !isAdmin {
+		return nil, v1.ErrorSpaceNotFound("空间不存在")
+	}
+	return space, nil
+}
+
+// ListSpaceByPage 分页查询空间，只有管理员可以查询其他用户的空间
+func (uc *SpaceUsecase) ListSpaceByPage(ctx context.Context, loginUserID int64, isAdmin bool, userID int64, spaceName string, current, pageSize int64) (*SpacePage, error) {
+	if userID <= 0 {
+		userID = loginUserID
+	}
+	if userID != loginUserID && !isAdmin {
+		return nil, v1.ErrorSpaceNoAuth("无权限查询其他用户的空间")
+	}
+	if current <= 0 {
+		current = 1
+	}
+	if pageSize <= 0 || pageSize > maxSpacePageSize {
+		pageSize = maxSpacePageSize
+	}
+
+	spaces, total, err := uc.repo.ListSpaceByPage(ctx, &SpaceQueryParams{
+		UserID:    userID,
+		SpaceName: strings.TrimSpace(spaceName),
+		Current:   current,
+		PageSize:  pageSize,
+	})
+	if err != nil {
+		uc.log.Errorf("查询空间列表失败: %v", err)
+		return nil, v1.ErrorSystemError("查询空间列表失败")
+	}
+
+	return &SpacePage{
+		Total:    total,
+		List:     spaces,
+		Current:  current,
+		PageSize: pageSize,
+	}, nil
+}
+
+// getSpace 查询空间，不存在时返回 SPACE_NOT_FOUND
+func (uc *SpaceUsecase) getSpace(ctx context.Context, id int64) (*Space, error) {
+	if id <= 0 {
+		return nil, v1.ErrorParamsError("空间 ID 不能为空")
+	}
+
+	space, err := uc.repo.GetSpaceByID(ctx, id)
+	if err != nil {
+		uc.log.Errorf("查询空间失败: id=%d, err=%v", id, err)
+		return nil, v1.ErrorSystemError("查询空间失败")
+	}
+	if space == nil {
+		return nil, v1.ErrorSpaceNotFound("空间不存在")
+	}
+	return space, nil
+}
+
+// validateSpace 校验空间设置，并去除名称首尾空白
+func (uc *SpaceUsecase) validateSpace(space *Space) error {
+	space.SpaceName = strings.TrimSpace(space.SpaceName)
+	if space.SpaceName == "" {
+		return v1.ErrorParamsError("空间名称不能为空")
+	}
+	if utf8.RuneCountInString(space.SpaceName) > maxSpaceNameLength {
+		return v1.ErrorParamsError("空间名称过长")
+	}
+	switch space.DuplicateMode {
+	case "", DuplicateModeOff, DuplicateModeWarn, DuplicateModeReject:
+	default:
+		return v1.ErrorParamsError("查重方式只能为 off、warn 或 reject")
+	}
+	if space.MaxSize < 0 || space.MaxCount < 0 {
+		return v1.ErrorParamsError("空间容量上限不能为负数")
+	}
+	return nil
+}
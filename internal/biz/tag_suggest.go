@@ -0,0 +1,76 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	v1 "smart-collab-gallery-server/api/taxonomy/v1"
+)
+
+const (
+	// defaultTagSuggestLimit 标签建议默认返回数量
+	defaultTagSuggestLimit = 10
+	// maxTagSuggestLimit 标签建议最多返回数量
+	maxTagSuggestLimit = 50
+)
+
+// TagSuggestParams 标签建议参数
+type TagSuggestParams struct {
+	Prefix   string   // 已输入的前缀，为空时按已选标签和使用习惯推荐
+	UserID   int64    // 当前用户，用于结合用户自己的使用次数
+	Selected []string // 图片上已选的标签，用于计算共现，结果中不再包含
+	Limit    int
+}
+
+// TagSuggestion 标签建议
+type TagSuggestion struct {
+	Name         string
+	PictureCount int64   // 全站使用次数
+	UserCount    int64   // 当前用户使用次数
+	Score        float64 // 排序得分
+}
+
+// TagSuggester 标签建议索引，由 data 层实现（内存索引，定时从图片数据刷新）
+type TagSuggester interface {
+	// Suggest 按前缀匹配标签名称和别名，按全站使用次数、用户使用次数和与已选标签的共现综合排序
+	Suggest(ctx context.Context, params *TagSuggestParams) ([]*TagSuggestion, error)
+	// Refresh 从图片数据重建索引
+	Refresh(ctx context.Context) error
+}
+
+// SuggestTags 标签输入建议
+func (uc *TaxonomyUsecase) SuggestTags(ctx context.Context, params *TagSuggestParams) ([]*TagSuggestion, error) {
+	params.Prefix = strings.TrimSpace(params.Prefix)
+	if utf8.RuneCountInString(params.Prefix) > maxTermNameLength {
+		return []*TagSuggestion{}, nil
+	}
+	if params.Limit <= 0 {
+		params.Limit = defaultTagSuggestLimit
+	}
+	params.Limit = min(params.Limit, maxTagSuggestLimit)
+
+	selected := make([]string, 0, len(params.Selected))
+	for _, tag := range params.Selected {
+		if tag = strings.TrimSpace(tag); tag != "" && len(selected) < maxPictureTags {
+			selected = append(selected, tag)
+		}
+	}
+	params.Selected = selected
+
+	suggestions, err := uc.suggester.Suggest(ctx, params)
+	if err != nil {
+		uc.log.Errorf("查询标签建议失败: %v", err)
+		return nil, v1.ErrorSystemError("查询标签建议失败")
+	}
+	return suggestions, nil
+}
+
+// RefreshTagSuggestions 从图片数据重建标签建议索引
+func (uc *TaxonomyUsecase) RefreshTagSuggestions(ctx context.Context) error {
+	if err := uc.suggester.Refresh(ctx); err != nil {
+		uc.log.Errorf("刷新标签建议索引失败: %v", err)
+		return err
+	}
+	return nil
+}
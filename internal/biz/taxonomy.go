@@ -0,0 +1,498 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	v1 "smart-collab-gallery-server/api/taxonomy/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TaxonomyKind 标签或分类
+type TaxonomyKind string
+
+const (
+	TaxonomyTag      TaxonomyKind = "tag"      // 标签，一张图片可以有多个，上传时可使用新标签
+	TaxonomyCategory TaxonomyKind = "category" // 分类，一张图片最多一个，只能使用管理员创建的分类
+)
+
+// ParseTaxonomyKind 解析标签或分类类型
+func ParseTaxonomyKind(s string) (TaxonomyKind, bool) {
+	switch kind := TaxonomyKind(s); kind {
+	case TaxonomyTag, TaxonomyCategory:
+		return kind, true
+	default:
+		return "", false
+	}
+}
+
+// label 类型的中文名称，用于提示信息
+func (k TaxonomyKind) label() string {
+	if k == TaxonomyCategory {
+		return "分类"
+	}
+	return "标签"
+}
+
+const (
+	// maxTermNameLength 标签、分类名称及别名最大长度（字符数）
+	maxTermNameLength = 32
+	// maxPictureTags 单张图片最多的标签数
+	maxPictureTags = 10
+	// defaultTopTagLimit 标签分类接口默认返回的标签数
+	defaultTopTagLimit = 20
+	// maxTopTagLimit 标签分类接口最多返回的标签数
+	maxTopTagLimit = 100
+	// maxTermPageSize 标签、分类分页每页最多条数
+	maxTermPageSize = 100
+	// maxMergeTerms 单次最多合并的标签或分类数
+	maxMergeTerms = 50
+)
+
+// TaxonomyTerm 标签或分类
+type TaxonomyTerm struct {
+	ID           int64
+	Kind         TaxonomyKind
+	Name         string
+	SortOrder    int64    // 分类的展示顺序，越小越靠前（标签不使用）
+	Aliases      []string // 别名，上传或编辑图片时使用别名会归一为该名称
+	PictureCount int64    // 使用该标签或分类的未删除图片数
+	CreateTime   time.Time
+	UpdateTime   time.Time
+}
+
+// TermKey 名称比较时使用的键（不区分大小写，与数据库排序规则一致）
+func TermKey(name string) string {
+	return strings.ToLower(name)
+}
+
+// TermQueryParams 标签、分类查询参数
+type TermQueryParams struct {
+	Kind       TaxonomyKind
+	SearchText string // 名称或别名（模糊查询）
+	Current    int64
+	PageSize   int64
+	SortField  string // pictureCount、name、createTime、sortOrder，为空时标签按使用次数倒序，分类按展示顺序
+	SortOrder  string
+}
+
+// TermPage 标签、分类分页结果
+type TermPage struct {
+	Total    int64
+	List     []*TaxonomyTerm
+	Current  int64
+	PageSize int64
+}
+
+// TaxonomyRepo 标签、分类仓储接口
+// 图片的分类保存在 picture.category，标签保存在 picture_tag 关联表，picture.tags 为同步的 JSON 副本（用于展示和全文检索）
+type TaxonomyRepo interface {
+	// CreateTerm 创建标签或分类
+	CreateTerm(ctx context.Context, term *TaxonomyTerm) (*TaxonomyTerm, error)
+	// EnsureTags 创建不存在的标签，已存在（包括并发创建）的忽略
+	EnsureTags(ctx context.Context, names []string) error
+	// GetTermByID 查询标签或分类（包含别名和使用次数），不存在时返回 nil
+	GetTermByID(ctx context.Context, kind TaxonomyKind, id int64) (*TaxonomyTerm, error)
+	// ResolveTerms 按名称或别名查询标签或分类，返回 TermKey(名称或别名) -> 标签或分类，未找到的不返回
+	ResolveTerms(ctx context.Context, kind TaxonomyKind, names []string) (map[string]*TaxonomyTerm, error)
+	// ListTerms 分页查询标签或分类（包含别名和使用次数）
+	ListTerms(ctx context.Context, params *TermQueryParams) ([]*TaxonomyTerm, int64, error)
+	// ListTopTags 按使用次数从多到少查询标签，不包含未使用的标签
+	ListTopTags(ctx context.Context, limit int) ([]*TaxonomyTerm, error)
+	// ListCategories 按展示顺序查询全部分类（包含使用次数）
+	ListCategories(ctx context.Context) ([]*TaxonomyTerm, error)
+	// UpdateTerm 更新名称和展示顺序，名称变化时同步更新已使用的图片，返回受影响的图片 ID
+	UpdateTerm(ctx context.Context, term *TaxonomyTerm, oldName string) ([]int64, error)
+	// DeleteTerm 删除标签或分类及其别名，并从已使用的图片上移除，返回受影响的图片 ID
+	DeleteTerm(ctx context.Context, term *TaxonomyTerm) ([]int64, error)
+	// MergeTerms 将 sources 合并到 target，sources 的名称及别名转为 target 的别名，返回受影响的图片 ID
+	MergeTerms(ctx context.Context, sources []*TaxonomyTerm, target *TaxonomyTerm) ([]int64, error)
+	// AddAlias 添加别名
+	AddAlias(ctx context.Context, kind TaxonomyKind, termID int64, alias string) error
+	// DeleteAlias 删除别名，别名不存在时返回 false
+	DeleteAlias(ctx context.Context, kind TaxonomyKind, termID int64, alias string) (bool, error)
+	// SetPictureTags 按顺序设置图片的标签，替换原有标签
+	SetPictureTags(ctx context.Context, pictureID int64, tagIDs []int64) error
+}
+
+// TaxonomyUsecase 标签、分类用例
+type TaxonomyUsecase struct {
+	repo      TaxonomyRepo
+	searcher  PictureSearcher // 图片的标签、分类变化时同步检索索引
+	suggester TagSuggester    // 标签输入建议
+	log       *log.Helper
+}
+
+// NewTaxonomyUsecase 创建标签、分类用例
+func NewTaxonomyUsecase(repo TaxonomyRepo, searcher PictureSearcher, suggester TagSuggester, logger log.Logger) *TaxonomyUsecase {
+	return &TaxonomyUsecase{
+		repo:      repo,
+		searcher:  searcher,
+		suggester: suggester,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// ListTerms 分页查询标签或分类
+func (uc *TaxonomyUsecase) ListTerms(ctx context.Context, params *TermQueryParams) (*TermPage, error) {
+	if params.Current <= 0 {
+		params.Current = 1
+	}
+	if params.PageSize <= 0 || params.PageSize > maxTermPageSize {
+		params.PageSize = maxTermPageSize
+	}
+	params.SearchText = strings.TrimSpace(params.SearchText)
+
+	terms, total, err := uc.repo.ListTerms(ctx, params)
+	if err != nil {
+		uc.log.Errorf("查询%s列表失败: %v", params.Kind.label(), err)
+		return nil, v1.ErrorSystemError("查询" + params.Kind.label() + "列表失败")
+	}
+
+	return &TermPage{
+		Total:    total,
+		List:     terms,
+		Current:  params.Current,
+		PageSize: params.PageSize,
+	}, nil
+}
+
+// AddTerm 创建标签或分类
+func (uc *TaxonomyUsecase) AddTerm(ctx context.Context, kind TaxonomyKind, name string, sortOrder int64) (*TaxonomyTerm, error) {
+	name, err := normalizeTermName(kind, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkNameAvailable(ctx, kind, name, 0); err != nil {
+		return nil, err
+	}
+
+	term := &TaxonomyTerm{Kind: kind, Name: name}
+	if kind == TaxonomyCategory {
+		term.SortOrder = sortOrder
+	}
+	term, err = uc.repo.CreateTerm(ctx, term)
+	if err != nil {
+		uc.log.Errorf("创建%s失败: %v", kind.label(), err)
+		return nil, v1.ErrorSystemError("创建" + kind.label() + "失败")
+	}
+	return term, nil
+}
+
+// UpdateTerm 重命名标签或分类、调整分类的展示顺序，返回更新后的标签或分类和同步更新的图片数
+// keepAlias 为 true 时原名称保留为别名，之后上传图片时使用原名称仍会归一为新名称
+func (uc *TaxonomyUsecase) UpdateTerm(ctx context.Context, kind TaxonomyKind, id int64, name string, sortOrder int64, keepAlias bool) (*TaxonomyTerm, int64, error) {
+	term, err := uc.getTerm(ctx, kind, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	oldName := term.Name
+	if strings.TrimSpace(name) != "" {
+		if name, err = normalizeTermName(kind, name); err != nil {
+			return nil, 0, err
+		}
+		if err := uc.checkNameAvailable(ctx, kind, name, id); err != nil {
+			return nil, 0, err
+		}
+		term.Name = name
+	}
+	if kind == TaxonomyCategory {
+		term.SortOrder = sortOrder
+	}
+
+	affected, err := uc.repo.UpdateTerm(ctx, term, oldName)
+	if err != nil {
+		uc.log.Errorf("更新%s失败: %v", kind.label(), err)
+		return nil, 0, v1.ErrorSystemError("更新" + kind.label() + "失败")
+	}
+	if keepAlias && TermKey(term.Name) != TermKey(oldName) {
+		if err := uc.repo.AddAlias(ctx, kind, id, oldName); err != nil {
+			uc.log.Errorf("保留%s原名称为别名失败: %v", kind.label(), err)
+		}
+	}
+	uc.reindexPictures(ctx, affected)
+
+	term, err = uc.getTerm(ctx, kind, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return term, int64(len(affected)), nil
+}
+
+// DeleteTerm 删除标签或分类，并从已使用的图片上移除，返回受影响的图片数
+func (uc *TaxonomyUsecase) DeleteTerm(ctx context.Context, kind TaxonomyKind, id int64) (int64, error) {
+	term, err := uc.getTerm(ctx, kind, id)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := uc.repo.DeleteTerm(ctx, term)
+	if err != nil {
+		uc.log.Errorf("删除%s失败: %v", kind.label(), err)
+		return 0, v1.ErrorSystemError("删除" + kind.label() + "失败")
+	}
+	uc.reindexPictures(ctx, affected)
+
+	return int64(len(affected)), nil
+}
+
+// MergeTerms 将多个标签或分类合并到目标，返回合并后的目标和同步更新的图片数
+func (uc *TaxonomyUsecase) MergeTerms(ctx context.Context, kind TaxonomyKind, sourceIDs []int64, targetID int64) (*TaxonomyTerm, int64, error) {
+	if len(sourceIDs) == 0 {
+		return nil, 0, v1.ErrorParamsError("请选择要合并的" + kind.label())
+	}
+	if len(sourceIDs) > maxMergeTerms {
+		return nil, 0, v1.ErrorParamsError(fmt.Sprintf("单次最多合并 %d 个%s", maxMergeTerms, kind.label()))
+	}
+
+	target, err := uc.getTerm(ctx, kind, targetID)
+	if err != nil {
+		return nil, 0, err
+	}
+	sources := make([]*TaxonomyTerm, 0, len(sourceIDs))
+	seen := make(map[int64]struct{}, len(sourceIDs))
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, 0, v1.ErrorParamsError("不能将" + kind.label() + "合并到自身")
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		source, err := uc.getTerm(ctx, kind, id)
+		if err != nil {
+			return nil, 0, err
+		}
+		sources = append(sources, source)
+	}
+
+	affected, err := uc.repo.MergeTerms(ctx, sources, target)
+	if err != nil {
+		uc.log.Errorf("合并%s失败: %v", kind.label(), err)
+		return nil, 0, v1.ErrorSystemError("合并" + kind.label() + "失败")
+	}
+	uc.reindexPictures(ctx, affected)
+
+	target, err = uc.getTerm(ctx, kind, targetID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return target, int64(len(affected)), nil
+}
+
+// AddAlias 为标签或分类添加别名
+func (uc *TaxonomyUsecase) AddAlias(ctx context.Context, kind TaxonomyKind, id int64, alias string) (*TaxonomyTerm, error) {
+	alias, err := normalizeTermName(kind, alias)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.getTerm(ctx, kind, id); err != nil {
+		return nil, err
+	}
+	if err := uc.checkNameAvailable(ctx, kind, alias, 0); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.AddAlias(ctx, kind, id, alias); err != nil {
+		uc.log.Errorf("添加%s别名失败: %v", kind.label(), err)
+		return nil, v1.ErrorSystemError("添加别名失败")
+	}
+	return uc.getTerm(ctx, kind, id)
+}
+
+// DeleteAlias 删除标签或分类的别名
+func (uc *TaxonomyUsecase) DeleteAlias(ctx context.Context, kind TaxonomyKind, id int64, alias string) (*TaxonomyTerm, error) {
+	if _, err := uc.getTerm(ctx, kind, id); err != nil {
+		return nil, err
+	}
+
+	deleted, err := uc.repo.DeleteAlias(ctx, kind, id, strings.TrimSpace(alias))
+	if err != nil {
+		uc.log.Errorf("删除%s别名失败: %v", kind.label(), err)
+		return nil, v1.ErrorSystemError("删除别名失败")
+	}
+	if !deleted {
+		return nil, v1.ErrorTermNotFound("别名不存在")
+	}
+	return uc.getTerm(ctx, kind, id)
+}
+
+// GetTagCategory 查询使用次数最多的 tagLimit 个标签和全部分类
+func (uc *TaxonomyUsecase) GetTagCategory(ctx context.Context, tagLimit int) ([]*TaxonomyTerm, []*TaxonomyTerm, error) {
+	if tagLimit <= 0 {
+		tagLimit = defaultTopTagLimit
+	}
+	tagLimit = min(tagLimit, maxTopTagLimit)
+
+	tags, err := uc.repo.ListTopTags(ctx, tagLimit)
+	if err != nil {
+		uc.log.Errorf("查询热门标签失败: %v", err)
+		return nil, nil, v1.ErrorSystemError("查询标签失败")
+	}
+	categories, err := uc.repo.ListCategories(ctx)
+	if err != nil {
+		uc.log.Errorf("查询分类失败: %v", err)
+		return nil, nil, v1.ErrorSystemError("查询分类失败")
+	}
+	return tags, categories, nil
+}
+
+// ResolveCategory 按名称或别名查找分类，不存在时返回 nil
+func (uc *TaxonomyUsecase) ResolveCategory(ctx context.Context, name string) (*TaxonomyTerm, error) {
+	terms, err := uc.repo.ResolveTerms(ctx, TaxonomyCategory, []string{name})
+	if err != nil {
+		uc.log.Errorf("查询分类失败: %v", err)
+		return nil, err
+	}
+	return terms[TermKey(name)], nil
+}
+
+// FindTags 按名称或别名查找已有的标签，返回 TermKey(名称或别名) -> 标签，不存在的不返回
+func (uc *TaxonomyUsecase) FindTags(ctx context.Context, names []string) (map[string]*TaxonomyTerm, error) {
+	if len(names) == 0 {
+		return map[string]*TaxonomyTerm{}, nil
+	}
+
+	terms, err := uc.repo.ResolveTerms(ctx, TaxonomyTag, names)
+	if err != nil {
+		uc.log.Errorf("查询标签失败: %v", err)
+		return nil, err
+	}
+	return terms, nil
+}
+
+// ResolveTags 按名称或别名查找标签，不存在的标签自动创建，按 names 的顺序返回并去除归一后重复的标签
+func (uc *TaxonomyUsecase) ResolveTags(ctx context.Context, names []string) ([]*TaxonomyTerm, error) {
+	terms, err := uc.ResolveTagTerms(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	return PickTags(terms, names), nil
+}
+
+// ResolveTagTerms 按名称或别名查找标签，不存在的标签自动创建，返回 TermKey(名称或别名) -> 标签
+// 批量操作时先收集所有名称调用一次，再用 PickTags 取出每一项的标签
+func (uc *TaxonomyUsecase) ResolveTagTerms(ctx context.Context, names []string) (map[string]*TaxonomyTerm, error) {
+	if len(names) == 0 {
+		return map[string]*TaxonomyTerm{}, nil
+	}
+
+	terms, err := uc.repo.ResolveTerms(ctx, TaxonomyTag, names)
+	if err != nil {
+		uc.log.Errorf("查询标签失败: %v", err)
+		return nil, err
+	}
+	var missing []string
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		key := TermKey(name)
+		if _, ok := terms[key]; ok {
+			continue
+		}
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		if err := uc.repo.EnsureTags(ctx, missing); err != nil {
+			uc.log.Errorf("创建标签失败: %v", err)
+			return nil, err
+		}
+		if terms, err = uc.repo.ResolveTerms(ctx, TaxonomyTag, names); err != nil {
+			uc.log.Errorf("查询标签失败: %v", err)
+			return nil, err
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := terms[TermKey(name)]; !ok {
+			return nil, fmt.Errorf("tag %q not found after creation", name)
+		}
+	}
+	return terms, nil
+}
+
+// PickTags 从 ResolveTagTerms 的结果中按 names 的顺序取出标签，去除归一后重复的标签
+func PickTags(terms map[string]*TaxonomyTerm, names []string) []*TaxonomyTerm {
+	tags := make([]*TaxonomyTerm, 0, len(names))
+	seen := make(map[int64]struct{}, len(names))
+	for _, name := range names {
+		tag, ok := terms[TermKey(name)]
+		if !ok {
+			continue
+		}
+		if _, ok := seen[tag.ID]; ok {
+			continue
+		}
+		seen[tag.ID] = struct{}{}
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// SetPictureTags 设置图片的标签
+func (uc *TaxonomyUsecase) SetPictureTags(ctx context.Context, pictureID int64, tags []*TaxonomyTerm) error {
+	tagIDs := make([]int64, 0, len(tags))
+	for _, tag := range tags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	if err := uc.repo.SetPictureTags(ctx, pictureID, tagIDs); err != nil {
+		uc.log.Errorf("保存图片标签失败: pictureID=%d, err=%v", pictureID, err)
+		return err
+	}
+	return nil
+}
+
+// getTerm 查询标签或分类，不存在时返回错误
+func (uc *TaxonomyUsecase) getTerm(ctx context.Context, kind TaxonomyKind, id int64) (*TaxonomyTerm, error) {
+	term, err := uc.repo.GetTermByID(ctx, kind, id)
+	if err != nil {
+		uc.log.Errorf("查询%s失败: %v", kind.label(), err)
+		return nil, v1.ErrorSystemError("查询" + kind.label() + "失败")
+	}
+	if term == nil {
+		return nil, v1.ErrorTermNotFound(kind.label() + "不存在")
+	}
+	return term, nil
+}
+
+// checkNameAvailable 检查名称未被其他标签或分类用作名称或别名，selfID 为当前修改的标签或分类
+func (uc *TaxonomyUsecase) checkNameAvailable(ctx context.Context, kind TaxonomyKind, name string, selfID int64) error {
+	terms, err := uc.repo.ResolveTerms(ctx, kind, []string{name})
+	if err != nil {
+		uc.log.Errorf("查询%s失败: %v", kind.label(), err)
+		return v1.ErrorSystemError("查询" + kind.label() + "失败")
+	}
+	if term, ok := terms[TermKey(name)]; ok && term.ID != selfID {
+		return v1.ErrorTermAlreadyExists(fmt.Sprintf("名称「%s」已被%s「%s」使用", name, kind.label(), term.Name))
+	}
+	return nil
+}
+
+// reindexPictures 同步标签、分类变化的图片的检索索引，失败时只记录日志
+func (uc *TaxonomyUsecase) reindexPictures(ctx context.Context, pictureIDs []int64) {
+	for _, id := range pictureIDs {
+		if err := uc.searcher.IndexPicture(ctx, id); err != nil {
+			uc.log.Errorf("更新图片索引失败: id=%d, err=%v", id, err)
+		}
+	}
+}
+
+// normalizeTermName 去除首尾空白并校验名称长度
+func normalizeTermName(kind TaxonomyKind, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", v1.ErrorParamsError(kind.label() + "名称不能为空")
+	}
+	if utf8.RuneCountInString(name) > maxTermNameLength {
+		return "", v1.ErrorParamsError(fmt.Sprintf("%s名称不能超过 %d 个字符", kind.label(), maxTermNameLength))
+	}
+	return name, nil
+}
@@ -0,0 +1,152 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	v1 "smart-collab-gallery-server/api/file/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// UploadStatus 上传状态
+type UploadStatus string
+
+const (
+	UploadPending   UploadStatus = "pending"   // 已获取上传地址，等待上传完成
+	UploadCompleted UploadStatus = "completed" // 已上传并通过校验
+	UploadConsumed  UploadStatus = "consumed"  // 已被图片使用，不能再用于其他图片
+	UploadAborted   UploadStatus = "aborted"   // 已取消
+	UploadRejected  UploadStatus = "rejected"  // 上传的文件未通过校验（大小超限或内容与扩展名不符）
+)
+
+// Upload 上传记录业务对象
+type Upload struct {
+	ID           int64
+	UserID       int64
+	BucketKey    string // 存储桶 key
+	FileKey      string // 文件 key（路径）
+	URL          string // 访问 URL
+	UploadID     string // COS 分片上传 ID，普通上传为空
+	FileSize     int64  // 声明的文件大小，上传完成后更新为实际大小
+	ContentType  string
+	ETag         string // 上传完成时文件的 ETag，使用文件时据此确认文件未被改写
+	Status       UploadStatus
+	ExpireTime   time.Time // 上传地址（或分片上传凭证）过期时间
+	CompleteTime *time.Time
+	CreateTime   time.Time
+	UpdateTime   time.Time
+}
+
+// UploadRepo 上传记录仓储接口
+type UploadRepo interface {
+	CreateUpload(ctx context.Context, upload *Upload) (*Upload, error)
+	GetUploadByID(ctx context.Context, id int64) (*Upload, error)
+	GetUploadByMultipartID(ctx context.Context, uploadID string) (*Upload, error)
+	// GetCompletedUploadByURL 查询用户已完成的上传记录，不存在时返回 nil
+	GetCompletedUploadByURL(ctx context.Context, userID int64, url string) (*Upload, error)
+	// UpdateUploadStatus 仅当上传处于等待状态时更新状态，返回是否更新成功
+	UpdateUploadStatus(ctx context.Context, id int64, status UploadStatus, fileSize int64, etag string) (bool, error)
+	// ConsumeUpload 仅当上传处于已完成状态时标记为已被图片使用，返回是否标记成功
+	ConsumeUpload(ctx context.Context, id int64) (bool, error)
+	// UpdateUploadFile 服务端改写已完成上传的文件后，更新记录中的文件大小和 ETag
+	UpdateUploadFile(ctx context.Context, id int64, fileSize int64, etag string) error
+	// CountUploadsSince 统计用户在指定时间之后发起的上传次数
+	CountUploadsSince(ctx context.Context, userID int64, since time.Time) (int64, error)
+}
+
+// UploadUsecase 上传记录业务逻辑
+type UploadUsecase struct {
+	repo UploadRepo
+	log  *log.Helper
+}
+
+// NewUploadUsecase 创建上传记录业务逻辑
+func NewUploadUsecase(repo UploadRepo, logger log.Logger) *UploadUsecase {
+	return &UploadUsecase{
+		repo: repo,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateUpload 记录一次待完成的上传
+func (uc *UploadUsecase) CreateUpload(ctx context.Context, upload *Upload) (*Upload, error) {
+	upload.Status = UploadPending
+	result, err := uc.repo.CreateUpload(ctx, upload)
+	if err != nil {
+		uc.log.Errorf("创建上传记录失败: %v", err)
+		return nil, v1.ErrorSystemError("创建上传记录失败")
+	}
+	return result, nil
+}
+
+// GetUpload 查询用户自己的上传记录，不属于该用户时按不存在处理
+func (uc *UploadUsecase) GetUpload(ctx context.Context, userID, id int64) (*Upload, error) {
+	if id <= 0 {
+		return nil, v1.ErrorParamsError("上传 ID 不能为空")
+	}
+
+	upload, err := uc.repo.GetUploadByID(ctx, id)
+	if err != nil {
+		uc.log.Errorf("查询上传记录失败: %v", err)
+		return nil, v1.ErrorSystemError("查询上传记录失败")
+	}
+	if upload == nil || upload.UserID != userID {
+		return nil, v1.ErrorUploadNotFound("上传记录不存在")
+	}
+	return upload, nil
+}
+
+// GetMultipartUpload 根据 COS 分片上传 ID 查询用户自己的上传记录
+func (uc *UploadUsecase) GetMultipartUpload(ctx context.Context, userID int64, uploadID string) (*Upload, error) {
+	upload, err := uc.repo.GetUploadByMultipartID(ctx, uploadID)
+	if err != nil {
+		uc.log.Errorf("查询上传记录失败: %v", err)
+		return nil, v1.ErrorSystemError("查询上传记录失败")
+	}
+	if upload == nil || upload.UserID != userID {
+		return nil, v1.ErrorUploadNotFound("上传记录不存在")
+	}
+	return upload, nil
+}
+
+// CompleteUpload 标记上传完成并记录实际文件大小和 ETag，重复完成时直接返回
+func (uc *UploadUsecase) CompleteUpload(ctx context.Context, upload *Upload, fileSize int64, etag string) (*Upload, error) {
+	if upload.Status == UploadCompleted || upload.Status == UploadConsumed {
+		return upload, nil
+	}
+	if upload.Status != UploadPending {
+		return nil, v1.ErrorUploadNotFound("上传已取消或未通过校验")
+	}
+
+	ok, err := uc.repo.UpdateUploadStatus(ctx, upload.ID, UploadCompleted, fileSize, etag)
+	if err != nil {
+		uc.log.Errorf("更新上传记录失败: %v", err)
+		return nil, v1.ErrorSystemError("更新上传记录失败")
+	}
+	if !ok {
+		// 并发请求已经更新过状态，以数据库中的结果为准
+		return uc.GetUpload(ctx, upload.UserID, upload.ID)
+	}
+
+	now := time.Now()
+	upload.Status = UploadCompleted
+	upload.FileSize = fileSize
+	upload.ETag = etag
+	upload.CompleteTime = &now
+	return upload, nil
+}
+
+// CloseUpload 将等待中的上传标记为已取消或未通过校验
+func (uc *UploadUsecase) CloseUpload(ctx context.Context, upload *Upload, status UploadStatus) error {
+	if upload.Status != UploadPending {
+		return nil
+	}
+
+	if _, err := uc.repo.UpdateUploadStatus(ctx, upload.ID, status, upload.FileSize, upload.ETag); err != nil {
+		uc.log.Errorf("更新上传记录失败: %v", err)
+		return v1.ErrorSystemError("更新上传记录失败")
+	}
+	upload.Status = status
+	return nil
+}
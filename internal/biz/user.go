@@ -4,11 +4,13 @@ import (
 	"context"
 	"crypto/md5"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	v1 "smart-collab-gallery-server/api/user/v1"
+	"smart-collab-gallery-server/internal/conf"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -27,8 +29,12 @@ type User struct {
 	UserAddress         string
 	UserTags            string
 	UserRole            string
+	VipCode             string
 	VipNumber           int64
 	VipExpireTime       *time.Time
+	ShareCode           string
+	InviteUser          int64
+	KeepPhotoMetadata   bool // 上传图片时是否保留位置、设备序列号等敏感元数据，默认清除
 	CreateTime          time.Time
 	UpdateTime          time.Time
 }
@@ -44,14 +50,20 @@ type UserQueryParams struct {
 	SortOrder   string // ascend 或 descend
 	Current     int64
 	PageSize    int64
+	// 游标分页：CursorMode 为 true 时忽略 Current，从 Cursor（上一页返回的游标，空表示第一页）之后开始查询
+	CursorMode bool
+	Cursor     string
+	After      *PageCursor // 解析后的游标，由用例层设置
+	WithTotal  bool        // 游标分页时是否统计总数（偏移量分页始终统计）
 }
 
 // UserPage 用户分页结果
 type UserPage struct {
-	Total    int64
-	List     []*User
-	Current  int64
-	PageSize int64
+	Total      int64
+	List       []*User
+	Current    int64
+	PageSize   int64
+	NextCursor string // 游标分页的下一页游标，没有更多数据时为空
 }
 
 // UserRepo 用户仓储接口
@@ -64,6 +76,8 @@ type UserRepo interface {
 	GetUserByAccountAndPassword(ctx context.Context, account, password string) (*User, error)
 	// GetUserByID 根据 ID 查询用户
 	GetUserByID(ctx context.Context, id int64) (*User, error)
+	// ListUserByIDs 根据 ID 批量查询用户
+	ListUserByIDs(ctx context.Context, ids []int64) ([]*User, error)
 	// UpdateUser 更新用户
 	UpdateUser(ctx context.Context, user *User) error
 	// DeleteUser 删除用户
@@ -78,19 +92,35 @@ type UserRepo interface {
 	DeleteEmailVerificationCode(ctx context.Context, userID int64) error
 	// SendEmailVerificationCode 发送邮箱验证码
 	SendEmailVerificationCode(ctx context.Context, email, code string) error
+	// GetUserByShareCode 根据分享码查询用户
+	GetUserByShareCode(ctx context.Context, shareCode string) (*User, error)
+	// UpdateUserShareCode 更新用户分享码
+	UpdateUserShareCode(ctx context.Context, userID int64, shareCode string) error
+	// UpdatePrivacySettings 更新用户隐私设置
+	UpdatePrivacySettings(ctx context.Context, userID int64, keepPhotoMetadata bool) error
+	// ListUserByInviter 分页查询某个用户邀请的用户
+	ListUserByInviter(ctx context.Context, inviterID, current, pageSize int64) (*UserPage, error)
+	// GetInviteStatistics 查询邀请统计
+	GetInviteStatistics(ctx context.Context, topN int) (*InviteStatistics, error)
 }
 
 // UserUsecase 用户用例
 type UserUsecase struct {
-	repo UserRepo
-	log  *log.Helper
+	repo       UserRepo
+	vipUC      *VipUsecase
+	searcher   PictureSearcher // 昵称变化时更新图片检索索引
+	inviteConf *conf.Invite
+	log        *log.Helper
 }
 
 // NewUserUsecase 创建用户用例
-func NewUserUsecase(repo UserRepo, logger log.Logger) *UserUsecase {
+func NewUserUsecase(repo UserRepo, vipUC *VipUsecase, searcher PictureSearcher, bc *conf.Bootstrap, logger log.Logger) *UserUsecase {
 	return &UserUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:       repo,
+		vipUC:      vipUC,
+		searcher:   searcher,
+		inviteConf: bc.GetInvite(),
+		log:        log.NewHelper(logger),
 	}
 }
 
@@ -100,33 +130,68 @@ const (
 )
 
 // Register 用户注册
-func (uc *UserUsecase) Register(ctx context.Context, userAccount, userPassword, checkPassword string) (int64, error) {
+func (uc *UserUsecase) Register(ctx context.Context, userAccount, userPassword, checkPassword, inviteCode string) (int64, error) {
 	// 1. 校验参数
 	if err := uc.validateRegisterParams(userAccount, userPassword, checkPassword); err != nil {
 		return 0, err
 	}
 
+	inviteCode = strings.ToUpper(strings.TrimSpace(inviteCode))
+	if inviteCode == "" && uc.inviteConf.GetInviteOnly() {
+		return 0, v1.ErrorInviteCodeRequired("当前仅支持邀请注册，请填写邀请码")
+	}
+
 	// 2. 检查账号是否已存在
 	existUser, err := uc.repo.GetUserByAccount(ctx, userAccount)
 	if err == nil && existUser != nil {
 		return 0, v1.ErrorAccountDuplicate("账号已存在")
 	}
 
-	// 3. 加密密码
+	// 3. 校验邀请码
+	var inviter *User
+	if inviteCode != "" {
+		inviter, err = uc.repo.GetUserByShareCode(ctx, inviteCode)
+		if err != nil {
+			uc.log.Errorf("根据邀请码查询用户失败: inviteCode=%s, err=%v", inviteCode, err)
+			return 0, v1.ErrorSystemError("查询邀请码失败")
+		}
+		if inviter == nil {
+			return 0, v1.ErrorInviteCodeInvalid("邀请码无效")
+		}
+	}
+
+	// 4. 加密密码
 	encryptPassword := uc.encryptPassword(userPassword)
 
-	// 4. 创建用户
+	// 5. 生成分享码并创建用户
 	user := &User{
 		UserAccount:  userAccount,
 		UserPassword: encryptPassword,
 		UserName:     "无名",
 		UserRole:     "user",
 	}
+	if inviter != nil {
+		user.InviteUser = inviter.ID
+	}
 
-	newUser, err := uc.repo.CreateUser(ctx, user)
+	var newUser *User
+	err = uc.saveWithShareCode(func(shareCode string) error {
+		user.ShareCode = shareCode
+		var createErr error
+		newUser, createErr = uc.repo.CreateUser(ctx, user)
+		if createErr != nil && !errors.Is(createErr, ErrShareCodeConflict) {
+			uc.log.Errorf("创建用户失败: %v", createErr)
+			return v1.ErrorSystemError("注册失败，数据库错误")
+		}
+		return createErr
+	})
 	if err != nil {
-		uc.log.Errorf("创建用户失败: %v", err)
-		return 0, v1.ErrorSystemError("注册失败，数据库错误")
+		return 0, err
+	}
+
+	// 6. 发放邀请奖励
+	if inviter != nil {
+		uc.grantInviteRewards(ctx, inviter.ID, newUser.ID)
 	}
 
 	return newUser.ID, nil
@@ -226,6 +291,11 @@ func (uc *UserUsecase) GetLoginUser(ctx context.Context, userID int64) (*User, e
 		return nil, v1.ErrorUserNotFound("用户不存在")
 	}
 
+	// 老用户没有分享码时补发
+	if user.ShareCode == "" {
+		uc.fillShareCode(ctx, user)
+	}
+
 	return user, nil
 }
 
@@ -294,11 +364,20 @@ func (uc *UserUsecase) AddUser(ctx context.Context, user *User) (int64, error) {
 		user.UserRole = "user"
 	}
 
-	// 5. 创建用户
-	newUser, err := uc.repo.CreateUser(ctx, user)
+	// 5. 生成分享码并创建用户
+	var newUser *User
+	err = uc.saveWithShareCode(func(shareCode string) error {
+		user.ShareCode = shareCode
+		var createErr error
+		newUser, createErr = uc.repo.CreateUser(ctx, user)
+		if createErr != nil && !errors.Is(createErr, ErrShareCodeConflict) {
+			uc.log.Errorf("创建用户失败: %v", createErr)
+			return v1.ErrorSystemError("创建用户失败")
+		}
+		return createErr
+	})
 	if err != nil {
-		uc.log.Errorf("创建用户失败: %v", err)
-		return 0, v1.ErrorSystemError("创建用户失败")
+		return 0, err
 	}
 
 	return newUser.ID, nil
@@ -387,6 +466,7 @@ func (uc *UserUsecase) UpdateUser(ctx context.Context, user *User) error {
 		uc.log.Errorf("更新用户失败: id=%d, err=%v", user.ID, err)
 		return v1.ErrorSystemError("更新用户失败")
 	}
+	uc.reindexUserPictures(ctx, existUser, user.UserName)
 
 	return nil
 }
@@ -400,6 +480,13 @@ func (uc *UserUsecase) ListUserByPage(ctx context.Context, params *UserQueryPara
 	if params.PageSize <= 0 || params.PageSize > 100 {
 		params.PageSize = 10
 	}
+	if params.CursorMode {
+		cursor, ok := parsePageCursor(params.Cursor, params.SortField, params.SortOrder, UserSortKinds)
+		if !ok {
+			return nil, v1.ErrorParamsError("无效的游标")
+		}
+		params.After = cursor
+	}
 
 	// 查询用户列表
 	userPage, err := uc.repo.ListUserByPage(ctx, params)
@@ -489,10 +576,21 @@ func (uc *UserUsecase) UpdateMyInfo(ctx context.Context, userID int64, password,
 		uc.log.Errorf("更新个人信息失败: userID=%d, err=%v", userID, err)
 		return v1.ErrorSystemError("更新个人信息失败")
 	}
+	uc.reindexUserPictures(ctx, existUser, user.UserName)
 
 	return nil
 }
 
+// reindexUserPictures 昵称变化时更新用户所有图片的检索索引，失败时只记录日志
+func (uc *UserUsecase) reindexUserPictures(ctx context.Context, existUser *User, newName string) {
+	if newName == "" || newName == existUser.UserName {
+		return
+	}
+	if err := uc.searcher.IndexUserPictures(ctx, existUser.ID); err != nil {
+		uc.log.Errorf("更新用户图片索引失败: userID=%d, err=%v", existUser.ID, err)
+	}
+}
+
 // SendEmailVerificationCode 发送邮箱验证码
 func (uc *UserUsecase) SendEmailVerificationCode(ctx context.Context, userID int64) (string, error) {
 	if userID <= 0 {
@@ -645,6 +743,19 @@ func (uc *UserUsecase) UpdatePassword(ctx context.Context, userID int64, oldPass
 	return "密码修改成功", nil
 }
 
+// UpdatePrivacySettings 更新隐私设置
+func (uc *UserUsecase) UpdatePrivacySettings(ctx context.Context, userID int64, keepPhotoMetadata bool) error {
+	if userID <= 0 {
+		return v1.ErrorNotLoginError("未登录")
+	}
+
+	if err := uc.repo.UpdatePrivacySettings(ctx, userID, keepPhotoMetadata); err != nil {
+		uc.log.Errorf("更新隐私设置失败: userID=%d, err=%v", userID, err)
+		return v1.ErrorSystemError("更新隐私设置失败")
+	}
+	return nil
+}
+
 // generateVerificationCode 生成6位验证码
 func (uc *UserUsecase) generateVerificationCode() string {
 	// 使用时间戳生成6位数字验证码
@@ -0,0 +1,296 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	v1 "smart-collab-gallery-server/api/user/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// vipCodeLength 兑换码长度
+	vipCodeLength = 16
+	// vipCodeCharset 兑换码字符集（去掉了易混淆的 0/O/1/I）
+	vipCodeCharset = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	// maxVipCodeBatchSize 单次最多生成的兑换码数量
+	maxVipCodeBatchSize = 1000
+)
+
+// VipCode 会员兑换码业务对象
+type VipCode struct {
+	ID         int64
+	Code       string
+	VipDays    int32
+	MaxUses    int32
+	UsedCount  int32
+	ExpireTime *time.Time // 兑换码过期时间，nil 表示永久有效
+	CreateUser int64
+	CreateTime time.Time
+}
+
+// VipRepo 会员仓储接口
+type VipRepo interface {
+	// BatchCreateVipCodes 批量创建兑换码
+	BatchCreateVipCodes(ctx context.Context, codes []*VipCode) error
+	// GetVipCodeForUpdate 根据兑换码查询并加行锁（需在事务中调用）
+	GetVipCodeForUpdate(ctx context.Context, code string) (*VipCode, error)
+	// IncrVipCodeUsedCount 兑换码使用次数 +1，已用完时返回 false
+	IncrVipCodeUsedCount(ctx context.Context, id int64) (bool, error)
+	// HasRedeemed 查询用户是否兑换过该兑换码
+	HasRedeemed(ctx context.Context, codeID, userID int64) (bool, error)
+	// CreateRedeemRecord 记录兑换记录
+	CreateRedeemRecord(ctx context.Context, codeID, userID int64) error
+	// GetUserForUpdate 查询用户并加行锁（需在事务中调用）
+	GetUserForUpdate(ctx context.Context, userID int64) (*User, error)
+	// UpdateUserVip 更新用户会员信息
+	UpdateUserVip(ctx context.Context, userID int64, vipCode string, vipNumber int64, vipExpireTime time.Time) error
+	// NextVipNumber 分配下一个会员编号（多副本全局递增）
+	NextVipNumber(ctx context.Context) (int64, error)
+}
+
+// VipUsecase 会员用例
+type VipUsecase struct {
+	repo     VipRepo
+	userRepo UserRepo
+	tx       Transaction
+	log      *log.Helper
+}
+
+// NewVipUsecase 创建会员用例
+func NewVipUsecase(repo VipRepo, userRepo UserRepo, tx Transaction, logger log.Logger) *VipUsecase {
+	return &VipUsecase{
+		repo:     repo,
+		userRepo: userRepo,
+		tx:       tx,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// IsVip 判断用户当前是否为有效会员
+func (u *User) IsVip() bool {
+	return u != nil && u.VipExpireTime != nil && u.VipExpireTime.After(time.Now())
+}
+
+// IsVip 根据用户 ID 判断用户当前是否为有效会员（供配额、上传限制等功能使用）
+func (uc *VipUsecase) IsVip(ctx context.Context, userID int64) (bool, error) {
+	if userID <= 0 {
+		return false, nil
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		uc.log.Errorf("查询用户失败: userID=%d, err=%v", userID, err)
+		return false, v1.ErrorSystemError("查询用户失败")
+	}
+
+	return user.IsVip(), nil
+}
+
+// GenerateVipCodes 批量生成会员兑换码（管理员功能）
+func (uc *VipUsecase) GenerateVipCodes(ctx context.Context, adminID int64, count, vipDays, maxUses, expireDays int32) ([]*VipCode, error) {
+	// 参数校验
+	if count <= 0 || count > maxVipCodeBatchSize {
+		return nil, v1.ErrorParamsError("生成数量必须在 1-1000 之间")
+	}
+	if vipDays <= 0 {
+		return nil, v1.ErrorParamsError("会员天数必须大于 0")
+	}
+	if maxUses <= 0 {
+		maxUses = 1
+	}
+	if expireDays < 0 {
+		return nil, v1.ErrorParamsError("兑换码有效天数不能为负数")
+	}
+
+	var expireTime *time.Time
+	if expireDays > 0 {
+		t := time.Now().AddDate(0, 0, int(expireDays))
+		expireTime = &t
+	}
+
+	codes := make([]*VipCode, 0, count)
+	for i := int32(0); i < count; i++ {
+		code, err := generateRandomCode(vipCodeLength)
+		if err != nil {
+			uc.log.Errorf("生成兑换码失败: %v", err)
+			return nil, v1.ErrorSystemError("生成兑换码失败")
+		}
+		codes = append(codes, &VipCode{
+			Code:       code,
+			VipDays:    vipDays,
+			MaxUses:    maxUses,
+			ExpireTime: expireTime,
+			CreateUser: adminID,
+		})
+	}
+
+	if err := uc.repo.BatchCreateVipCodes(ctx, codes); err != nil {
+		uc.log.Errorf("保存兑换码失败: %v", err)
+		return nil, v1.ErrorSystemError("保存兑换码失败")
+	}
+
+	uc.log.Infof("管理员生成会员兑换码: adminID=%d, count=%d, vipDays=%d, maxUses=%d", adminID, count, vipDays, maxUses)
+	return codes, nil
+}
+
+// RedeemVipCode 兑换会员
+// 兑换码行在事务中加锁，多副本并发兑换同一兑换码时串行执行，保证不会超额兑换
+func (uc *VipUsecase) RedeemVipCode(ctx context.Context, userID int64, code string) (*User, error) {
+	if userID <= 0 {
+		return nil, v1.ErrorNotLoginError("未登录")
+	}
+
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, v1.ErrorParamsError("兑换码不能为空")
+	}
+
+	var result *User
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		// 1. 锁定兑换码
+		vipCode, err := uc.repo.GetVipCodeForUpdate(ctx, code)
+		if err != nil {
+			uc.log.Errorf("查询兑换码失败: code=%s, err=%v", code, err)
+			return v1.ErrorSystemError("查询兑换码失败")
+		}
+		if vipCode == nil {
+			return v1.ErrorVipCodeNotFound("兑换码不存在")
+		}
+
+		// 2. 校验兑换码状态
+		now := time.Now()
+		if vipCode.ExpireTime != nil && !vipCode.ExpireTime.After(now) {
+			return v1.ErrorVipCodeExpired("兑换码已过期")
+		}
+		if vipCode.UsedCount >= vipCode.MaxUses {
+			return v1.ErrorVipCodeUsedUp("兑换码已被使用")
+		}
+
+		redeemed, err := uc.repo.HasRedeemed(ctx, vipCode.ID, userID)
+		if err != nil {
+			uc.log.Errorf("查询兑换记录失败: codeID=%d, userID=%d, err=%v", vipCode.ID, userID, err)
+			return v1.ErrorSystemError("查询兑换记录失败")
+		}
+		if redeemed {
+			return v1.ErrorVipCodeAlreadyRedeemed("您已兑换过该兑换码")
+		}
+
+		// 3. 锁定用户
+		user, err := uc.repo.GetUserForUpdate(ctx, userID)
+		if err != nil {
+			uc.log.Errorf("查询用户失败: userID=%d, err=%v", userID, err)
+			return v1.ErrorSystemError("查询用户失败")
+		}
+		if user == nil {
+			return v1.ErrorUserNotFound("用户不存在")
+		}
+
+		// 4. 扣减兑换码次数并记录兑换
+		ok, err := uc.repo.IncrVipCodeUsedCount(ctx, vipCode.ID)
+		if err != nil {
+			uc.log.Errorf("更新兑换码使用次数失败: codeID=%d, err=%v", vipCode.ID, err)
+			return v1.ErrorSystemError("兑换失败")
+		}
+		if !ok {
+			return v1.ErrorVipCodeUsedUp("兑换码已被使用")
+		}
+		if err := uc.repo.CreateRedeemRecord(ctx, vipCode.ID, userID); err != nil {
+			uc.log.Errorf("保存兑换记录失败: codeID=%d, userID=%d, err=%v", vipCode.ID, userID, err)
+			return v1.ErrorSystemError("兑换失败")
+		}
+
+		// 5. 顺延会员时长
+		if err := uc.extendUserVip(ctx, user, vipCode.Code, vipCode.VipDays); err != nil {
+			return err
+		}
+		result = user
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Infof("用户兑换会员成功: userID=%d, vipNumber=%d, expireTime=%s", userID, result.VipNumber, result.VipExpireTime.Format(time.RFC3339))
+	return result, nil
+}
+
+// GrantVipDays 赠送会员天数（邀请奖励等场景使用）
+func (uc *VipUsecase) GrantVipDays(ctx context.Context, userID int64, days int32) (*User, error) {
+	if userID <= 0 || days <= 0 {
+		return nil, v1.ErrorParamsError("参数错误")
+	}
+
+	var result *User
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		user, err := uc.repo.GetUserForUpdate(ctx, userID)
+		if err != nil {
+			uc.log.Errorf("查询用户失败: userID=%d, err=%v", userID, err)
+			return v1.ErrorSystemError("查询用户失败")
+		}
+		if user == nil {
+			return v1.ErrorUserNotFound("用户不存在")
+		}
+
+		if err := uc.extendUserVip(ctx, user, user.VipCode, days); err != nil {
+			return err
+		}
+		result = user
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.log.Infof("赠送会员成功: userID=%d, days=%d, expireTime=%s", userID, days, result.VipExpireTime.Format(time.RFC3339))
+	return result, nil
+}
+
+// extendUserVip 为已加锁的用户顺延会员时长（需在事务中调用）
+// 会员未过期时在原过期时间上顺延，首次成为会员时分配会员编号
+func (uc *VipUsecase) extendUserVip(ctx context.Context, user *User, vipCode string, days int32) error {
+	start := time.Now()
+	if user.IsVip() {
+		start = *user.VipExpireTime
+	}
+	expireTime := start.AddDate(0, 0, int(days))
+
+	vipNumber := user.VipNumber
+	if vipNumber == 0 {
+		var err error
+		vipNumber, err = uc.repo.NextVipNumber(ctx)
+		if err != nil {
+			uc.log.Errorf("分配会员编号失败: userID=%d, err=%v", user.ID, err)
+			return v1.ErrorSystemError("分配会员编号失败")
+		}
+	}
+
+	if err := uc.repo.UpdateUserVip(ctx, user.ID, vipCode, vipNumber, expireTime); err != nil {
+		uc.log.Errorf("更新用户会员信息失败: userID=%d, err=%v", user.ID, err)
+		return v1.ErrorSystemError("更新会员信息失败")
+	}
+
+	user.VipCode = vipCode
+	user.VipNumber = vipNumber
+	user.VipExpireTime = &expireTime
+	return nil
+}
+
+// generateRandomCode 从兑换码字符集中生成指定长度的随机码（兑换码、分享码共用）
+func generateRandomCode(length int) (string, error) {
+	var sb strings.Builder
+	sb.Grow(length)
+	max := big.NewInt(int64(len(vipCodeCharset)))
+	for i := 0; i < length; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(vipCodeCharset[n.Int64()])
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,352 @@
+package data
+
+import (
+	"context"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type albumRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAlbumRepo 创建相册仓储
+func NewAlbumRepo(data *Data, logger log.Logger) biz.AlbumRepo {
+	return &albumRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateAlbum 创建相册
+func (r *albumRepo) CreateAlbum(ctx context.Context, album *biz.Album) (*biz.Album, error) {
+	entity := r.convertToEntity(album)
+	if err := r.data.DB(ctx).Create(entity).Error; err != nil {
+		r.log.Errorf("创建相册失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToAlbum(entity), nil
+}
+
+// GetAlbumByID 根据 ID 查询相册
+func (r *albumRepo) GetAlbumByID(ctx context.Context, id int64) (*biz.Album, error) {
+	var entity Album
+	err := r.data.DB(ctx).
+		Where("id = ? AND isDelete = 0", id).
+		First(&entity).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("查询相册失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToAlbum(&entity), nil
+}
+
+// GetAlbumForUpdate 根据 ID 查询相册并加行锁
+func (r *albumRepo) GetAlbumForUpdate(ctx context.Context, id int64) (*biz.Album, error) {
+	var entity Album
+	err := r.data.DB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND isDelete = 0", id).
+		First(&entity).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("查询相册失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToAlbum(&entity), nil
+}
+
+// UpdateAlbum 更新相册信息
+func (r *albumRepo) UpdateAlbum(ctx context.Context, album *biz.Album) error {
+	err := r.data.DB(ctx).
+		Model(&Album{}).
+		Where("id = ? AND isDelete = 0", album.ID).
+		Updates(map[string]interface{}{
+			"name":           album.Name,
+			"description":    album.Description,
+			"visibility":     int32(album.Visibility),
+			"coverPictureId": album.CoverPictureID,
+			"editTime":       album.EditTime,
+		}).Error
+
+	if err != nil {
+		r.log.Errorf("更新相册失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteAlbum 删除相册（逻辑删除），同时移除相册与图片的关联
+func (r *albumRepo) DeleteAlbum(ctx context.Context, id int64) error {
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&Album{}).
+			Where("id = ? AND isDelete = 0", id).
+			Update("isDelete", 1).Error; err != nil {
+			return err
+		}
+		return tx.Where("albumId = ?", id).Delete(&AlbumPicture{}).Error
+	})
+
+	if err != nil {
+		r.log.Errorf("删除相册失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ListAlbumByPage 分页查询相册（按创建时间倒序）
+func (r *albumRepo) ListAlbumByPage(ctx context.Context, params *biz.AlbumQueryParams) ([]*biz.Album, int64, error) {
+	var total int64
+	var entities []Album
+
+	query := r.data.DB(ctx).Model(&Album{}).Where("isDelete = 0")
+	if params.UserID != nil {
+		query = query.Where("userId = ?", *params.UserID)
+	}
+	if len(params.Visibilities) > 0 {
+		visibilities := make([]int32, 0, len(params.Visibilities))
+		for _, v := range params.Visibilities {
+			visibilities = append(visibilities, int32(v))
+		}
+		query = query.Where("visibility IN ?", visibilities)
+	}
+	if params.Name != "" {
+		query = query.Where("name LIKE ?", "%"+params.Name+"%")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("统计相册总数失败: %v", err)
+		return nil, 0, err
+	}
+
+	offset := (params.Current - 1) * params.PageSize
+	if err := query.Order("createTime DESC, id DESC").
+		Offset(int(offset)).
+		Limit(int(params.PageSize)).
+		Find(&entities).Error; err != nil {
+		r.log.Errorf("查询相册列表失败: %v", err)
+		return nil, 0, err
+	}
+
+	albums := make([]*biz.Album, 0, len(entities))
+	for i := range entities {
+		albums = append(albums, r.convertToAlbum(&entities[i]))
+	}
+	return albums, total, nil
+}
+
+// CountAlbumPictures 批量统计相册中未删除的图片数
+func (r *albumRepo) CountAlbumPictures(ctx context.Context, albumIDs []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(albumIDs))
+	if len(albumIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		AlbumID int64 `gorm:"column:albumId"`
+		Count   int64 `gorm:"column:count"`
+	}
+	err := r.data.DB(ctx).
+		Table("album_picture AS ap").
+		Select("ap.albumId AS albumId, COUNT(*) AS count").
+		Joins("JOIN picture AS p ON p.id = ap.pictureId AND p.isDelete = 0").
+		Where("ap.albumId IN ?", albumIDs).
+		Group("ap.albumId").
+		Scan(&rows).Error
+
+	if err != nil {
+		r.log.Errorf("统计相册图片数失败: %v", err)
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.AlbumID] = row.Count
+	}
+	return counts, nil
+}
+
+// AddAlbumPictures 将图片追加到相册末尾，已在相册中的图片会被忽略，返回实际添加的数量
+func (r *albumRepo) AddAlbumPictures(ctx context.Context, albumID int64, pictureIDs []int64) (int64, error) {
+	var added int64
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var maxOrder int64
+		if err := tx.Model(&AlbumPicture{}).
+			Where("albumId = ?", albumID).
+			Select("COALESCE(MAX(sortOrder), 0)").
+			Scan(&maxOrder).Error; err != nil {
+			return err
+		}
+
+		for _, pictureID := range pictureIDs {
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&AlbumPicture{AlbumID: albumID, PictureID: pictureID, SortOrder: maxOrder + 1})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 1 {
+				added++
+				maxOrder++
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		r.log.Errorf("添加相册图片失败: %v", err)
+		return 0, err
+	}
+	return added, nil
+}
+
+// RemoveAlbumPictures 从相册移除图片，返回实际移除的数量
+func (r *albumRepo) RemoveAlbumPictures(ctx context.Context, albumID int64, pictureIDs []int64) (int64, error) {
+	result := r.data.DB(ctx).
+		Where("albumId = ? AND pictureId IN ?", albumID, pictureIDs).
+		Delete(&AlbumPicture{})
+
+	if result.Error != nil {
+		r.log.Errorf("移除相册图片失败: %v", result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// ReorderAlbumPictures 按 pictureIDs 的顺序重排相册图片，未列出的图片保持原有相对顺序排在后面
+func (r *albumRepo) ReorderAlbumPictures(ctx context.Context, albumID int64, pictureIDs []int64) error {
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var entities []AlbumPicture
+		if err := tx.Where("albumId = ?", albumID).
+			Order("sortOrder ASC, id ASC").
+			Find(&entities).Error; err != nil {
+			return err
+		}
+
+		// 先排列出的图片（忽略不在相册中的 ID），再排其余图片
+		existing := make(map[int64]*AlbumPicture, len(entities))
+		for i := range entities {
+			existing[entities[i].PictureID] = &entities[i]
+		}
+		ordered := make([]*AlbumPicture, 0, len(entities))
+		placed := make(map[int64]struct{}, len(entities))
+		for _, pictureID := range pictureIDs {
+			entity, ok := existing[pictureID]
+			if !ok {
+				continue
+			}
+			if _, ok := placed[pictureID]; ok {
+				continue
+			}
+			placed[pictureID] = struct{}{}
+			ordered = append(ordered, entity)
+		}
+		for i := range entities {
+			if _, ok := placed[entities[i].PictureID]; !ok {
+				ordered = append(ordered, &entities[i])
+			}
+		}
+
+		for i, entity := range ordered {
+			sortOrder := int64(i + 1)
+			if entity.SortOrder == sortOrder {
+				continue
+			}
+			if err := tx.Model(&AlbumPicture{}).
+				Where("id = ?", entity.ID).
+				Update("sortOrder", sortOrder).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		r.log.Errorf("调整相册图片顺序失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ListAlbumPictureIDsByPage 按相册内顺序分页查询未删除的图片 ID
+func (r *albumRepo) ListAlbumPictureIDsByPage(ctx context.Context, albumID, current, pageSize int64) ([]int64, int64, error) {
+	var total int64
+	query := r.data.DB(ctx).
+		Table("album_picture AS ap").
+		Joins("JOIN picture AS p ON p.id = ap.pictureId AND p.isDelete = 0").
+		Where("ap.albumId = ?", albumID)
+
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("统计相册图片数失败: %v", err)
+		return nil, 0, err
+	}
+
+	var ids []int64
+	offset := (current - 1) * pageSize
+	if err := query.Order("ap.sortOrder ASC, ap.id ASC").
+		Offset(int(offset)).
+		Limit(int(pageSize)).
+		Pluck("ap.pictureId", &ids).Error; err != nil {
+		r.log.Errorf("查询相册图片失败: %v", err)
+		return nil, 0, err
+	}
+
+	return ids, total, nil
+}
+
+// IsAlbumPicture 判断图片是否在相册中
+func (r *albumRepo) IsAlbumPicture(ctx context.Context, albumID, pictureID int64) (bool, error) {
+	var count int64
+	err := r.data.DB(ctx).
+		Model(&AlbumPicture{}).
+		Where("albumId = ? AND pictureId = ?", albumID, pictureID).
+		Count(&count).Error
+
+	if err != nil {
+		r.log.Errorf("查询相册图片失败: %v", err)
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// convertToAlbum 转换实体为业务对象
+func (r *albumRepo) convertToAlbum(entity *Album) *biz.Album {
+	return &biz.Album{
+		ID:             entity.ID,
+		UserID:         entity.UserID,
+		Name:           entity.Name,
+		Description:    entity.Description,
+		CoverPictureID: entity.CoverPictureID,
+		Visibility:     biz.AlbumVisibility(entity.Visibility),
+		ShareCode:      entity.ShareCode,
+		CreateTime:     entity.CreateTime,
+		EditTime:       entity.EditTime,
+		UpdateTime:     entity.UpdateTime,
+	}
+}
+
+// convertToEntity 转换业务对象为实体
+func (r *albumRepo) convertToEntity(album *biz.Album) *Album {
+	return &Album{
+		ID:             album.ID,
+		UserID:         album.UserID,
+		Name:           album.Name,
+		Description:    album.Description,
+		CoverPictureID: album.CoverPictureID,
+		Visibility:     int32(album.Visibility),
+		ShareCode:      album.ShareCode,
+	}
+}
@@ -0,0 +1,39 @@
+package data
+
+import (
+	"time"
+)
+
+// Album 相册实体
+type Album struct {
+	ID             int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	UserID         int64     `gorm:"column:userId;not null;index:idx_userId" json:"userId"`
+	Name           string    `gorm:"column:name;type:varchar(128);not null" json:"name"`
+	Description    string    `gorm:"column:description;type:varchar(1024)" json:"description"`
+	CoverPictureID int64     `gorm:"column:coverPictureId;not null;default:0" json:"coverPictureId"`
+	Visibility     int32     `gorm:"column:visibility;not null;default:0;index:idx_visibility" json:"visibility"`
+	ShareCode      string    `gorm:"column:shareCode;type:varchar(32);not null;uniqueIndex:uk_shareCode" json:"shareCode"`
+	CreateTime     time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+	EditTime       time.Time `gorm:"column:editTime;autoCreateTime" json:"editTime"`
+	UpdateTime     time.Time `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
+	IsDelete       int8      `gorm:"column:isDelete;default:0" json:"isDelete"`
+}
+
+// TableName 指定表名
+func (Album) TableName() string {
+	return "album"
+}
+
+// AlbumPicture 相册图片关联实体
+type AlbumPicture struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	AlbumID    int64     `gorm:"column:albumId;not null;uniqueIndex:uk_albumId_pictureId" json:"albumId"`
+	PictureID  int64     `gorm:"column:pictureId;not null;uniqueIndex:uk_albumId_pictureId;index:idx_pictureId" json:"pictureId"`
+	SortOrder  int64     `gorm:"column:sortOrder;not null;default:0" json:"sortOrder"`
+	CreateTime time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+}
+
+// TableName 指定表名
+func (AlbumPicture) TableName() string {
+	return "album_picture"
+}
@@ -0,0 +1,258 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type commentRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCommentRepo 创建评论仓储
+func NewCommentRepo(data *Data, logger log.Logger) biz.CommentRepo {
+	return &commentRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateComment 创建评论
+func (r *commentRepo) CreateComment(ctx context.Context, comment *biz.Comment) (*biz.Comment, error) {
+	entity := r.convertToEntity(comment)
+	if err := r.data.DB(ctx).Create(entity).Error; err != nil {
+		r.log.Errorf("创建评论失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToComment(entity), nil
+}
+
+// GetCommentByID 根据 ID 查询评论
+func (r *commentRepo) GetCommentByID(ctx context.Context, id int64) (*biz.Comment, error) {
+	var entity Comment
+	err := r.data.DB(ctx).
+		Where("id = ? AND isDelete = 0", id).
+		First(&entity).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("查询评论失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToComment(&entity), nil
+}
+
+// UpdateComment 更新评论内容与审核信息，审核状态已被并发修改时不更新
+func (r *commentRepo) UpdateComment(ctx context.Context, comment *biz.Comment, expectStatus biz.ReviewStatus) (bool, error) {
+	result := r.data.DB(ctx).
+		Model(&Comment{}).
+		Where("id = ? AND reviewStatus = ? AND isDelete = 0", comment.ID, int32(expectStatus)).
+		Updates(map[string]interface{}{
+			"content":        comment.Content,
+			"mentionUserIds": mentionIDsToJSON(comment.MentionUserIDs),
+			"reviewStatus":   int32(comment.ReviewStatus),
+			"reviewMessage":  comment.ReviewMessage,
+			"reviewerId":     comment.ReviewerID,
+			"reviewTime":     comment.ReviewTime,
+			"editTime":       comment.EditTime,
+		})
+
+	if result.Error != nil {
+		r.log.Errorf("更新评论失败: %v", result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteCommentThread 删除评论及其回复（逻辑删除），返回其中审核通过的评论数
+func (r *commentRepo) DeleteCommentThread(ctx context.Context, id int64) (int64, error) {
+	var approved int64
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		thread := func() *gorm.DB {
+			return tx.Model(&Comment{}).Where("(id = ? OR parentId = ?) AND isDelete = 0", id, id)
+		}
+
+		if err := thread().Where("reviewStatus = ?", int32(biz.ReviewStatusPass)).Count(&approved).Error; err != nil {
+			return err
+		}
+		return thread().Update("isDelete", 1).Error
+	})
+
+	if err != nil {
+		r.log.Errorf("删除评论失败: %v", err)
+		return 0, err
+	}
+
+	return approved, nil
+}
+
+// ListRootComments 查询图片 ID 小于 cursor 的一级评论（倒序）
+func (r *commentRepo) ListRootComments(ctx context.Context, pictureID, viewerID, cursor int64, limit int) ([]*biz.Comment, error) {
+	var entities []Comment
+	err := r.visible(r.data.DB(ctx), viewerID).
+		Where("pictureId = ? AND parentId = 0 AND id < ? AND isDelete = 0", pictureID, cursor).
+		Order("id DESC").
+		Limit(limit).
+		Find(&entities).Error
+
+	if err != nil {
+		r.log.Errorf("查询评论失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToComments(entities), nil
+}
+
+// ListReplies 查询一级评论下 ID 大于 cursor 的回复（正序）
+func (r *commentRepo) ListReplies(ctx context.Context, parentID, viewerID, cursor int64, limit int) ([]*biz.Comment, error) {
+	var entities []Comment
+	err := r.visible(r.data.DB(ctx), viewerID).
+		Where("parentId = ? AND id > ? AND isDelete = 0", parentID, cursor).
+		Order("id ASC").
+		Limit(limit).
+		Find(&entities).Error
+
+	if err != nil {
+		r.log.Errorf("查询回复失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToComments(entities), nil
+}
+
+// CountReplies 批量统计一级评论对 viewerID 可见的回复数
+func (r *commentRepo) CountReplies(ctx context.Context, parentIDs []int64, viewerID int64) (map[int64]int64, error) {
+	result := make(map[int64]int64, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		ParentID int64 `gorm:"column:parentId"`
+		Count    int64 `gorm:"column:count"`
+	}
+	err := r.visible(r.data.DB(ctx).Model(&Comment{}), viewerID).
+		Select("parentId, COUNT(*) AS count").
+		Where("parentId IN ? AND isDelete = 0", parentIDs).
+		Group("parentId").
+		Scan(&rows).Error
+
+	if err != nil {
+		r.log.Errorf("统计评论回复数失败: %v", err)
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.ParentID] = row.Count
+	}
+	return result, nil
+}
+
+// ListCommentsByReviewStatus 查询指定审核状态中 ID 小于 cursor 的评论（倒序）
+func (r *commentRepo) ListCommentsByReviewStatus(ctx context.Context, status biz.ReviewStatus, cursor int64, limit int) ([]*biz.Comment, error) {
+	var entities []Comment
+	err := r.data.DB(ctx).
+		Where("reviewStatus = ? AND id < ? AND isDelete = 0", int32(status), cursor).
+		Order("id DESC").
+		Limit(limit).
+		Find(&entities).Error
+
+	if err != nil {
+		r.log.Errorf("按审核状态查询评论失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToComments(entities), nil
+}
+
+// visible 只保留审核通过的评论以及 viewerID 自己的评论
+func (r *commentRepo) visible(db *gorm.DB, viewerID int64) *gorm.DB {
+	if viewerID > 0 {
+		return db.Where("(reviewStatus = ? OR userId = ?)", int32(biz.ReviewStatusPass), viewerID)
+	}
+	return db.Where("reviewStatus = ?", int32(biz.ReviewStatusPass))
+}
+
+// convertToComments 批量转换实体为业务对象
+func (r *commentRepo) convertToComments(entities []Comment) []*biz.Comment {
+	list := make([]*biz.Comment, 0, len(entities))
+	for i := range entities {
+		list = append(list, r.convertToComment(&entities[i]))
+	}
+	return list
+}
+
+// convertToComment 转换实体为业务对象
+func (r *commentRepo) convertToComment(entity *Comment) *biz.Comment {
+	return &biz.Comment{
+		ID:             entity.ID,
+		PictureID:      entity.PictureID,
+		UserID:         entity.UserID,
+		ParentID:       entity.ParentID,
+		ReplyToUserID:  entity.ReplyToUserID,
+		Content:        entity.Content,
+		MentionUserIDs: mentionIDsFromJSON(entity.MentionUserIDs),
+		ReviewStatus:   biz.ReviewStatus(entity.ReviewStatus),
+		ReviewMessage:  entity.ReviewMessage,
+		ReviewerID:     entity.ReviewerID,
+		ReviewTime:     entity.ReviewTime,
+		CreateTime:     entity.CreateTime,
+		EditTime:       entity.EditTime,
+		UpdateTime:     entity.UpdateTime,
+	}
+}
+
+// convertToEntity 转换业务对象为实体
+func (r *commentRepo) convertToEntity(comment *biz.Comment) *Comment {
+	return &Comment{
+		ID:             comment.ID,
+		PictureID:      comment.PictureID,
+		UserID:         comment.UserID,
+		ParentID:       comment.ParentID,
+		ReplyToUserID:  comment.ReplyToUserID,
+		Content:        comment.Content,
+		MentionUserIDs: mentionIDsToJSON(comment.MentionUserIDs),
+		ReviewStatus:   int32(comment.ReviewStatus),
+		ReviewMessage:  comment.ReviewMessage,
+		ReviewerID:     comment.ReviewerID,
+		ReviewTime:     comment.ReviewTime,
+		CreateTime:     comment.CreateTime,
+		EditTime:       comment.EditTime,
+		UpdateTime:     comment.UpdateTime,
+	}
+}
+
+// mentionIDsToJSON 提及用户 ID 数组转 JSON 字符串
+func mentionIDsToJSON(ids []int64) string {
+	if len(ids) == 0 {
+		return "[]"
+	}
+	bytes, err := json.Marshal(ids)
+	if err != nil {
+		return "[]"
+	}
+	return string(bytes)
+}
+
+// mentionIDsFromJSON JSON 字符串转提及用户 ID 数组
+func mentionIDsFromJSON(idsJSON string) []int64 {
+	if idsJSON == "" {
+		return []int64{}
+	}
+	var ids []int64
+	if err := json.Unmarshal([]byte(idsJSON), &ids); err != nil {
+		return []int64{}
+	}
+	return ids
+}
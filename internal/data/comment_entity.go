@@ -0,0 +1,29 @@
+package data
+
+import (
+	"time"
+)
+
+// Comment 评论实体
+type Comment struct {
+	ID             int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	PictureID      int64      `gorm:"column:pictureId;not null;index:idx_pictureId_parentId" json:"pictureId"`
+	UserID         int64      `gorm:"column:userId;not null;index:idx_userId" json:"userId"`
+	ParentID       int64      `gorm:"column:parentId;not null;default:0;index:idx_pictureId_parentId;index:idx_parentId" json:"parentId"`
+	ReplyToUserID  int64      `gorm:"column:replyToUserId;not null;default:0" json:"replyToUserId"`
+	Content        string     `gorm:"column:content;type:varchar(512);not null" json:"content"`
+	MentionUserIDs string     `gorm:"column:mentionUserIds;type:varchar(512)" json:"mentionUserIds"` // JSON 数组
+	ReviewStatus   int32      `gorm:"column:reviewStatus;not null;default:0;index:idx_reviewStatus" json:"reviewStatus"`
+	ReviewMessage  string     `gorm:"column:reviewMessage;type:varchar(512)" json:"reviewMessage"`
+	ReviewerID     int64      `gorm:"column:reviewerId" json:"reviewerId"`
+	ReviewTime     *time.Time `gorm:"column:reviewTime" json:"reviewTime"`
+	CreateTime     time.Time  `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+	EditTime       time.Time  `gorm:"column:editTime;autoCreateTime" json:"editTime"`
+	UpdateTime     time.Time  `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
+	IsDelete       int8       `gorm:"column:isDelete;default:0" json:"isDelete"`
+}
+
+// TableName 指定表名
+func (Comment) TableName() string {
+	return "comment"
+}
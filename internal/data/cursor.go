@@ -0,0 +1,110 @@
+package data
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// sortColumn 允许排序的列
+type sortColumn[T any] struct {
+	column string              // 列名
+	expr   string              // 游标分页时的排序表达式，可为空的列使用 COALESCE 以便比较，为空时使用列名
+	kind   biz.CursorValueKind // 值类型，与 biz 中排序字段的类型一致
+	value  func(entity *T) any // 读取记录的排序值
+}
+
+// keysetExpr 游标分页时的排序表达式
+func (c sortColumn[T]) keysetExpr() string {
+	if c.expr != "" {
+		return c.expr
+	}
+	return c.column
+}
+
+// formatValue 排序值转为游标中保存的字符串
+func (c sortColumn[T]) formatValue(entity *T) string {
+	switch v := c.value(entity).(type) {
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// applyKeyset 按 (排序列, id) 添加游标条件和排序，多查询一条用于判断是否还有下一页
+func applyKeyset[T any](query *gorm.DB, col sortColumn[T], desc bool, after *biz.PageCursor, pageSize int64) (*gorm.DB, error) {
+	expr := col.keysetExpr()
+	op, direction := ">", "ASC"
+	if desc {
+		op, direction = "<", "DESC"
+	}
+
+	if after != nil {
+		value, err := col.kind.ParseValue(after.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor value: %w", err)
+		}
+		query = query.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND id %s ?))", expr, op, expr, op), value, value, after.ID)
+	}
+	return query.Order(expr + " " + direction + ", id " + direction).Limit(int(pageSize) + 1), nil
+}
+
+// keysetNextPage 截去多查询的一条记录，还有下一页时返回以本页最后一条记录定位的游标
+func keysetNextPage[T any](entities []T, col sortColumn[T], id func(entity *T) int64, params cursorParams) ([]T, string) {
+	if int64(len(entities)) <= params.pageSize {
+		return entities, ""
+	}
+	entities = entities[:params.pageSize]
+	last := &entities[len(entities)-1]
+	cursor := &biz.PageCursor{
+		SortField: params.sortField,
+		SortOrder: params.sortOrder,
+		Value:     col.formatValue(last),
+		ID:        id(last),
+	}
+	return entities, cursor.Encode()
+}
+
+// cursorParams 生成下一页游标所需的请求参数
+type cursorParams struct {
+	sortField string
+	sortOrder string
+	pageSize  int64
+}
+
+// pictureCursorParams 图片列表生成下一页游标所需的参数
+func pictureCursorParams(params *biz.PictureQueryParams) cursorParams {
+	return cursorParams{sortField: params.SortField, sortOrder: params.SortOrder, pageSize: params.PageSize}
+}
+
+// cursorOffset 按偏移量定位的游标分页（相关度、色差排序）的起始位置
+func cursorOffset(after *biz.PageCursor) int64 {
+	if after == nil {
+		return 0
+	}
+	return after.Offset
+}
+
+// offsetNextCursor 按偏移量定位时的下一页游标，没有更多数据时为空
+func offsetNextCursor(params cursorParams, offset, returned int64, hasMore bool) string {
+	if !hasMore {
+		return ""
+	}
+	cursor := &biz.PageCursor{
+		SortField: params.sortField,
+		SortOrder: params.sortOrder,
+		Offset:    offset + returned,
+	}
+	return cursor.Encode()
+}
@@ -0,0 +1,175 @@
+package data
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// newDryRunDB 创建只生成 SQL、不连接数据库的 gorm 实例
+func newDryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		DSN:                       "user:pass@tcp(127.0.0.1:3306)/test?parseTime=true",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("open dry run db: %v", err)
+	}
+	return db
+}
+
+func TestApplyKeyset(t *testing.T) {
+	createTime := time.Date(2024, 5, 1, 8, 0, 0, 123456789, time.UTC)
+
+	tests := []struct {
+		name     string
+		col      sortColumn[Picture]
+		desc     bool
+		after    *biz.PageCursor
+		wantSQL  string
+		wantVars []any
+		wantErr  bool
+	}{
+		{
+			name:    "第一页降序",
+			col:     pictureSortFields["createTime"],
+			desc:    true,
+			wantSQL: "SELECT * FROM `picture` ORDER BY createTime DESC, id DESC LIMIT ?",
+			wantVars: []any{
+				11,
+			},
+		},
+		{
+			name:    "第一页升序",
+			col:     pictureSortFields["name"],
+			wantSQL: "SELECT * FROM `picture` ORDER BY name ASC, id ASC LIMIT ?",
+			wantVars: []any{
+				11,
+			},
+		},
+		{
+			name:    "按时间降序翻页",
+			col:     pictureSortFields["createTime"],
+			desc:    true,
+			after:   &biz.PageCursor{Value: createTime.Format(time.RFC3339Nano), ID: 42},
+			wantSQL: "SELECT * FROM `picture` WHERE (createTime < ? OR (createTime = ? AND id < ?)) ORDER BY createTime DESC, id DESC LIMIT ?",
+			wantVars: []any{
+				createTime, createTime, int64(42), 11,
+			},
+		},
+		{
+			name:    "可为空的列按 COALESCE 升序翻页",
+			col:     pictureSortFields["picSize"],
+			after:   &biz.PageCursor{Value: "2048", ID: 7},
+			wantSQL: "SELECT * FROM `picture` WHERE (COALESCE(picSize, 0) > ? OR (COALESCE(picSize, 0) = ? AND id > ?)) ORDER BY COALESCE(picSize, 0) ASC, id ASC LIMIT ?",
+			wantVars: []any{
+				int64(2048), int64(2048), int64(7), 11,
+			},
+		},
+		{
+			name:    "字符串排序值作为参数传入",
+			col:     pictureSortFields["name"],
+			desc:    true,
+			after:   &biz.PageCursor{Value: "' OR 1=1 --", ID: 3},
+			wantSQL: "SELECT * FROM `picture` WHERE (name < ? OR (name = ? AND id < ?)) ORDER BY name DESC, id DESC LIMIT ?",
+			wantVars: []any{
+				"' OR 1=1 --", "' OR 1=1 --", int64(3), 11,
+			},
+		},
+		{
+			name:    "排序值与列类型不符",
+			col:     pictureSortFields["likeCount"],
+			after:   &biz.PageCursor{Value: "abc", ID: 1},
+			wantErr: true,
+		},
+	}
+
+	db := newDryRunDB(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := applyKeyset(db.Model(&Picture{}), tt.col, tt.desc, tt.after, 10)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyKeyset() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			var pictures []Picture
+			stmt := query.Find(&pictures).Statement
+			if got := stmt.SQL.String(); got != tt.wantSQL {
+				t.Errorf("SQL = %s\nwant  %s", got, tt.wantSQL)
+			}
+			if !reflect.DeepEqual(stmt.Vars, tt.wantVars) {
+				t.Errorf("Vars = %#v, want %#v", stmt.Vars, tt.wantVars)
+			}
+		})
+	}
+}
+
+func TestKeysetNextPage(t *testing.T) {
+	col := pictureSortFields["likeCount"]
+	id := func(p *Picture) int64 { return p.ID }
+	params := cursorParams{sortField: "likeCount", sortOrder: "descend", pageSize: 2}
+
+	tests := []struct {
+		name       string
+		entities   []Picture
+		wantLen    int
+		wantCursor *biz.PageCursor
+	}{
+		{name: "没有下一页", entities: []Picture{{ID: 3, LikeCount: 9}, {ID: 2, LikeCount: 5}}, wantLen: 2},
+		{
+			name:       "有下一页",
+			entities:   []Picture{{ID: 3, LikeCount: 9}, {ID: 2, LikeCount: 5}, {ID: 1, LikeCount: 1}},
+			wantLen:    2,
+			wantCursor: &biz.PageCursor{SortField: "likeCount", SortOrder: "descend", Value: "5", ID: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, next := keysetNextPage(tt.entities, col, id, params)
+			if len(page) != tt.wantLen {
+				t.Errorf("len(page) = %d, want %d", len(page), tt.wantLen)
+			}
+			if want := tt.wantCursor.Encode(); next != want {
+				t.Errorf("next cursor = %q, want %q", next, want)
+			}
+		})
+	}
+}
+
+// TestSortFieldKinds 排序列的值类型需与 biz 中用于校验游标的类型一致
+func TestSortFieldKinds(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns map[string]biz.CursorValueKind
+		kinds   map[string]biz.CursorValueKind
+	}{
+		{name: "图片", columns: columnKinds(pictureSortFields), kinds: biz.PictureSortKinds},
+		{name: "用户", columns: columnKinds(userSortFields), kinds: biz.UserSortKinds},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.columns, tt.kinds) {
+				t.Errorf("sort column kinds = %v, want %v", tt.columns, tt.kinds)
+			}
+		})
+	}
+}
+
+func columnKinds[T any](fields map[string]sortColumn[T]) map[string]biz.CursorValueKind {
+	kinds := make(map[string]biz.CursorValueKind, len(fields))
+	for name, col := range fields {
+		kinds[name] = col.kind
+	}
+	return kinds
+}
@@ -2,18 +2,25 @@ package data
 
 import (
 	"context"
+	"errors"
+	"smart-collab-gallery-server/internal/biz"
 	"smart-collab-gallery-server/internal/conf"
 	"smart-collab-gallery-server/internal/pkg"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
+	mysqldriver "github.com/go-sql-driver/mysql"
 	"github.com/google/wire"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
 
+// mysqlErrDuplicateEntry MySQL 唯一索引冲突的错误码
+const mysqlErrDuplicateEntry = 1062
+
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewGreeterRepo, NewUserRepo)
+var ProviderSet = wire.NewSet(NewData, NewTransaction, NewGreeterRepo, NewUserRepo, NewPictureRepo, NewVipRepo, NewFollowRepo, NewFeedRepo, NewPictureInteractionRepo, NewCommentRepo, NewNotificationRepo, NewAlbumRepo, NewSpaceRepo, NewShareRepo, NewUploadRepo, NewQuotaRepo, NewPictureSearcher, NewTaxonomyRepo, NewTagSuggester, NewPictureVersionRepo, NewPictureFileRepo)
 
 // Data .
 type Data struct {
@@ -34,11 +41,17 @@ func NewData(c *conf.Bootstrap, logger log.Logger) (*Data, func(), error) {
 	}
 
 	// 自动迁移数据表
-	if err := db.AutoMigrate(&User{}); err != nil {
+	if err := db.AutoMigrate(&User{}, &Picture{}, &VipCode{}, &VipRedeemRecord{}, &UserFollow{}, &PictureLike{}, &PictureFavorite{}, &Comment{}, &Notification{}, &Album{}, &AlbumPicture{}, &Space{}, &ShareLink{}, &ShareAccessLog{}, &Upload{}, &UserQuota{}, &Tag{}, &Category{}, &TaxonomyAlias{}, &PictureTag{}, &PictureVersion{}); err != nil {
 		log.Errorf("failed to migrate database: %v", err)
 		return nil, nil, err
 	}
 
+	// 预置默认分类并迁移图片已有的分类和标签
+	if err := initTaxonomy(db); err != nil {
+		log.Errorf("failed to init taxonomy: %v", err)
+		return nil, nil, err
+	}
+
 	// 初始化 Redis 连接
 	rdb := redis.NewClient(&redis.Options{
 		Network:      c.Data.Redis.Network,
@@ -78,3 +91,37 @@ func NewData(c *conf.Bootstrap, logger log.Logger) (*Data, func(), error) {
 
 	return &Data{db: db, rdb: rdb, emailSender: emailSender}, cleanup, nil
 }
+
+// contextTxKey 上下文中存储事务连接的 key
+type contextTxKey struct{}
+
+// NewTransaction 创建事务管理器
+func NewTransaction(d *Data) biz.Transaction {
+	return d
+}
+
+// ExecTx 在同一个数据库事务中执行 fn，fn 内的仓储操作通过 DB(ctx) 复用该事务
+func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		ctx = context.WithValue(ctx, contextTxKey{}, tx)
+		return fn(ctx)
+	})
+}
+
+// DB 获取当前上下文的数据库连接，处于事务中时返回事务连接
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return d.db.WithContext(ctx)
+}
+
+// isDuplicateKeyError 判断错误是否为指定唯一索引的冲突
+func isDuplicateKeyError(err error, key string) bool {
+	var mysqlErr *mysqldriver.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlErrDuplicateEntry {
+		return false
+	}
+	// 错误信息形如 Duplicate entry 'xxx' for key 'user.uk_shareCode'
+	return strings.HasSuffix(mysqlErr.Message, key+"'")
+}
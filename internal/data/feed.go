@@ -0,0 +1,125 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// feedExpiration 动态缓存过期时间，长期不活跃的用户缓存自动释放
+	feedExpiration = 7 * 24 * time.Hour
+	// feedPlaceholder 空动态的占位成员，避免没有动态的用户每次都重建缓存
+	feedPlaceholder = "0"
+)
+
+// pushFeedScript 仅当动态缓存存在时写入，并裁剪到最大长度
+var pushFeedScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('ZADD', KEYS[1], ARGV[1], ARGV[1])
+	redis.call('ZREMRANGEBYRANK', KEYS[1], 0, -tonumber(ARGV[2]) - 1)
+end
+return 0
+`)
+
+type feedRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewFeedRepo 创建动态缓存仓储
+func NewFeedRepo(data *Data, logger log.Logger) biz.FeedRepo {
+	return &feedRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// PushToFeeds 将图片推送到多个用户的动态缓存
+func (r *feedRepo) PushToFeeds(ctx context.Context, userIDs []int64, pictureID int64) error {
+	pipe := r.data.rdb.Pipeline()
+	for _, userID := range userIDs {
+		pushFeedScript.Eval(ctx, pipe, []string{r.getFeedKey(userID)}, pictureID, biz.FeedMaxLength)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.Errorf("推送动态失败: pictureID=%d, err=%v", pictureID, err)
+		return err
+	}
+
+	return nil
+}
+
+// ListFeedIDs 从动态缓存中查询 ID 小于 cursor 的图片 ID
+func (r *feedRepo) ListFeedIDs(ctx context.Context, userID, cursor int64, limit int) ([]int64, bool, error) {
+	key := r.getFeedKey(userID)
+
+	exists, err := r.data.rdb.Exists(ctx, key).Result()
+	if err != nil {
+		r.log.Errorf("查询动态缓存失败: userID=%d, err=%v", userID, err)
+		return nil, false, err
+	}
+	if exists == 0 {
+		return nil, false, nil
+	}
+
+	// 分数即图片 ID，(0, cursor) 开区间同时排除了占位成员
+	members, err := r.data.rdb.ZRevRangeByScore(ctx, key, &redis.ZRangeBy{
+		Max:   "(" + strconv.FormatInt(cursor, 10),
+		Min:   "(0",
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		r.log.Errorf("查询动态缓存失败: userID=%d, err=%v", userID, err)
+		return nil, false, err
+	}
+
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, true, nil
+}
+
+// SaveFeed 重建用户的动态缓存
+func (r *feedRepo) SaveFeed(ctx context.Context, userID int64, pictureIDs []int64) error {
+	key := r.getFeedKey(userID)
+
+	members := make([]redis.Z, 0, len(pictureIDs)+1)
+	members = append(members, redis.Z{Score: 0, Member: feedPlaceholder})
+	for _, id := range pictureIDs {
+		members = append(members, redis.Z{Score: float64(id), Member: id})
+	}
+
+	pipe := r.data.rdb.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.ZAdd(ctx, key, members...)
+	pipe.Expire(ctx, key, feedExpiration)
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.Errorf("重建动态缓存失败: userID=%d, err=%v", userID, err)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveFeed 删除用户的动态缓存
+func (r *feedRepo) RemoveFeed(ctx context.Context, userID int64) error {
+	return r.data.rdb.Del(ctx, r.getFeedKey(userID)).Err()
+}
+
+// getFeedKey 获取用户动态缓存的 Redis key
+func (r *feedRepo) getFeedKey(userID int64) string {
+	return fmt.Sprintf("feed:%d", userID)
+}
@@ -0,0 +1,178 @@
+package data
+
+import (
+	"context"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+type followRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewFollowRepo 创建关注关系仓储
+func NewFollowRepo(data *Data, logger log.Logger) biz.FollowRepo {
+	return &followRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateFollow 创建关注关系，已关注时忽略
+func (r *followRepo) CreateFollow(ctx context.Context, userID, followUserID int64) (bool, error) {
+	result := r.data.DB(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&UserFollow{UserID: userID, FollowUserID: followUserID})
+
+	if result.Error != nil {
+		r.log.Errorf("创建关注关系失败: %v", result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// DeleteFollow 删除关注关系
+func (r *followRepo) DeleteFollow(ctx context.Context, userID, followUserID int64) (bool, error) {
+	result := r.data.DB(ctx).
+		Where("userId = ? AND followUserId = ?", userID, followUserID).
+		Delete(&UserFollow{})
+
+	if result.Error != nil {
+		r.log.Errorf("删除关注关系失败: %v", result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// ListFollowerIDsByPage 分页查询粉丝 ID
+func (r *followRepo) ListFollowerIDsByPage(ctx context.Context, userID, current, pageSize int64) ([]int64, int64, error) {
+	return r.listIDsByPage(ctx, "followUserId", "userId", userID, current, pageSize)
+}
+
+// ListFollowingIDsByPage 分页查询关注的用户 ID
+func (r *followRepo) ListFollowingIDsByPage(ctx context.Context, userID, current, pageSize int64) ([]int64, int64, error) {
+	return r.listIDsByPage(ctx, "userId", "followUserId", userID, current, pageSize)
+}
+
+// listIDsByPage 按 whereColumn = userID 分页查询 selectColumn，按关注时间倒序
+func (r *followRepo) listIDsByPage(ctx context.Context, whereColumn, selectColumn string, userID, current, pageSize int64) ([]int64, int64, error) {
+	var total int64
+	query := r.data.DB(ctx).Model(&UserFollow{}).Where(whereColumn+" = ?", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("统计关注关系总数失败: %v", err)
+		return nil, 0, err
+	}
+
+	var ids []int64
+	offset := (current - 1) * pageSize
+	err := query.Order("id DESC").
+		Offset(int(offset)).
+		Limit(int(pageSize)).
+		Pluck(selectColumn, &ids).Error
+	if err != nil {
+		r.log.Errorf("分页查询关注关系失败: %v", err)
+		return nil, 0, err
+	}
+
+	return ids, total, nil
+}
+
+// ListAllFollowerIDs 查询全部粉丝 ID
+func (r *followRepo) ListAllFollowerIDs(ctx context.Context, userID int64) ([]int64, error) {
+	var ids []int64
+	err := r.data.DB(ctx).
+		Model(&UserFollow{}).
+		Where("followUserId = ?", userID).
+		Pluck("userId", &ids).Error
+
+	if err != nil {
+		r.log.Errorf("查询粉丝列表失败: %v", err)
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ListAllFollowingIDs 查询全部关注的用户 ID
+func (r *followRepo) ListAllFollowingIDs(ctx context.Context, userID int64) ([]int64, error) {
+	ids := []int64{}
+	err := r.data.DB(ctx).
+		Model(&UserFollow{}).
+		Where("userId = ?", userID).
+		Pluck("followUserId", &ids).Error
+
+	if err != nil {
+		r.log.Errorf("查询关注列表失败: %v", err)
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// CountFollowers 批量统计粉丝数
+func (r *followRepo) CountFollowers(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	return r.countGroupBy(ctx, "followUserId", userIDs)
+}
+
+// CountFollowings 批量统计关注数
+func (r *followRepo) CountFollowings(ctx context.Context, userIDs []int64) (map[int64]int64, error) {
+	return r.countGroupBy(ctx, "userId", userIDs)
+}
+
+// countGroupBy 按 column 分组统计关注关系数量
+func (r *followRepo) countGroupBy(ctx context.Context, column string, userIDs []int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(userIDs))
+	if len(userIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		UserID int64
+		Total  int64
+	}
+	err := r.data.DB(ctx).
+		Model(&UserFollow{}).
+		Select(column+" AS user_id, COUNT(*) AS total").
+		Where(column+" IN ?", userIDs).
+		Group(column).
+		Scan(&rows).Error
+	if err != nil {
+		r.log.Errorf("统计关注关系失败: %v", err)
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.UserID] = row.Total
+	}
+	return counts, nil
+}
+
+// ListFollowedUserIDs 查询 userID 关注了 targetIDs 中的哪些用户
+func (r *followRepo) ListFollowedUserIDs(ctx context.Context, userID int64, targetIDs []int64) (map[int64]bool, error) {
+	followed := make(map[int64]bool, len(targetIDs))
+	if len(targetIDs) == 0 {
+		return followed, nil
+	}
+
+	var ids []int64
+	err := r.data.DB(ctx).
+		Model(&UserFollow{}).
+		Where("userId = ? AND followUserId IN ?", userID, targetIDs).
+		Pluck("followUserId", &ids).Error
+	if err != nil {
+		r.log.Errorf("查询关注状态失败: %v", err)
+		return nil, err
+	}
+
+	for _, id := range ids {
+		followed[id] = true
+	}
+	return followed, nil
+}
@@ -0,0 +1,18 @@
+package data
+
+import (
+	"time"
+)
+
+// UserFollow 用户关注关系实体
+type UserFollow struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	UserID       int64     `gorm:"column:userId;not null;uniqueIndex:uk_userId_followUserId" json:"userId"`
+	FollowUserID int64     `gorm:"column:followUserId;not null;uniqueIndex:uk_userId_followUserId;index:idx_followUserId" json:"followUserId"`
+	CreateTime   time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+}
+
+// TableName 指定表名
+func (UserFollow) TableName() string {
+	return "user_follow"
+}
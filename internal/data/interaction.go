@@ -0,0 +1,310 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// pictureCounterKeyPrefix 图片计数增量的 Redis key 前缀（hash，field 为计数类型）
+	pictureCounterKeyPrefix = "picture:counter:"
+	// pictureCounterDirtyKey 存在未刷新增量的图片 ID 集合
+	pictureCounterDirtyKey = "picture:counter:dirty"
+)
+
+// pictureCounterColumns 计数类型对应的图片表字段
+var pictureCounterColumns = map[biz.PictureCounterField]string{
+	biz.PictureCounterLike:     "likeCount",
+	biz.PictureCounterFavorite: "favoriteCount",
+	biz.PictureCounterView:     "viewCount",
+	biz.PictureCounterComment:  "commentCount",
+}
+
+// popCounterScript 原子地取出并删除图片的计数增量
+var popCounterScript = redis.NewScript(`
+local values = redis.call('HGETALL', KEYS[1])
+redis.call('DEL', KEYS[1])
+return values
+`)
+
+type pictureInteractionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPictureInteractionRepo 创建图片互动仓储
+func NewPictureInteractionRepo(data *Data, logger log.Logger) biz.PictureInteractionRepo {
+	return &pictureInteractionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateLike 点赞
+func (r *pictureInteractionRepo) CreateLike(ctx context.Context, pictureID, userID int64) (bool, error) {
+	return r.create(ctx, &PictureLike{PictureID: pictureID, UserID: userID})
+}
+
+// DeleteLike 取消点赞
+func (r *pictureInteractionRepo) DeleteLike(ctx context.Context, pictureID, userID int64) (bool, error) {
+	return r.delete(ctx, &PictureLike{}, pictureID, userID)
+}
+
+// CreateFavorite 收藏
+func (r *pictureInteractionRepo) CreateFavorite(ctx context.Context, pictureID, userID int64) (bool, error) {
+	return r.create(ctx, &PictureFavorite{PictureID: pictureID, UserID: userID})
+}
+
+// DeleteFavorite 取消收藏
+func (r *pictureInteractionRepo) DeleteFavorite(ctx context.Context, pictureID, userID int64) (bool, error) {
+	return r.delete(ctx, &PictureFavorite{}, pictureID, userID)
+}
+
+// create 写入互动关系，已存在时忽略
+func (r *pictureInteractionRepo) create(ctx context.Context, entity interface{}) (bool, error) {
+	result := r.data.DB(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(entity)
+
+	if result.Error != nil {
+		r.log.Errorf("保存图片互动失败: %v", result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// delete 删除互动关系
+func (r *pictureInteractionRepo) delete(ctx context.Context, model interface{}, pictureID, userID int64) (bool, error) {
+	result := r.data.DB(ctx).
+		Where("pictureId = ? AND userId = ?", pictureID, userID).
+		Delete(model)
+
+	if result.Error != nil {
+		r.log.Errorf("删除图片互动失败: %v", result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// ListLikedPictureIDs 查询用户点赞了哪些图片
+func (r *pictureInteractionRepo) ListLikedPictureIDs(ctx context.Context, userID int64, pictureIDs []int64) (map[int64]bool, error) {
+	return r.listPictureIDs(ctx, &PictureLike{}, userID, pictureIDs)
+}
+
+// ListFavoritedPictureIDs 查询用户收藏了哪些图片
+func (r *pictureInteractionRepo) ListFavoritedPictureIDs(ctx context.Context, userID int64, pictureIDs []int64) (map[int64]bool, error) {
+	return r.listPictureIDs(ctx, &PictureFavorite{}, userID, pictureIDs)
+}
+
+// listPictureIDs 查询用户与 pictureIDs 中哪些图片存在互动关系
+func (r *pictureInteractionRepo) listPictureIDs(ctx context.Context, model interface{}, userID int64, pictureIDs []int64) (map[int64]bool, error) {
+	result := make(map[int64]bool, len(pictureIDs))
+	if len(pictureIDs) == 0 {
+		return result, nil
+	}
+
+	var ids []int64
+	err := r.data.DB(ctx).
+		Model(model).
+		Where("userId = ? AND pictureId IN ?", userID, pictureIDs).
+		Pluck("pictureId", &ids).Error
+	if err != nil {
+		r.log.Errorf("查询图片互动状态失败: %v", err)
+		return nil, err
+	}
+
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result, nil
+}
+
+// ListFavoritePictureIDsByPage 分页查询用户收藏的图片 ID
+func (r *pictureInteractionRepo) ListFavoritePictureIDsByPage(ctx context.Context, userID, current, pageSize int64) ([]int64, int64, error) {
+	var total int64
+	query := r.data.DB(ctx).Model(&PictureFavorite{}).Where("userId = ?", userID)
+
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("统计收藏总数失败: %v", err)
+		return nil, 0, err
+	}
+
+	var ids []int64
+	offset := (current - 1) * pageSize
+	err := query.Order("id DESC").
+		Offset(int(offset)).
+		Limit(int(pageSize)).
+		Pluck("pictureId", &ids).Error
+	if err != nil {
+		r.log.Errorf("分页查询收藏失败: %v", err)
+		return nil, 0, err
+	}
+
+	return ids, total, nil
+}
+
+// IncrCounter 在 Redis 中累加图片计数增量，并标记该图片待刷新
+func (r *pictureInteractionRepo) IncrCounter(ctx context.Context, pictureID int64, field biz.PictureCounterField, delta int64) error {
+	pipe := r.data.rdb.TxPipeline()
+	pipe.HIncrBy(ctx, r.getCounterKey(pictureID), string(field), delta)
+	pipe.SAdd(ctx, pictureCounterDirtyKey, pictureID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.Errorf("累加图片计数失败: pictureID=%d, field=%s, err=%v", pictureID, field, err)
+		return err
+	}
+
+	return nil
+}
+
+// GetPendingCounters 批量查询尚未刷新到数据库的计数增量
+func (r *pictureInteractionRepo) GetPendingCounters(ctx context.Context, pictureIDs []int64) (map[int64]*biz.PictureCounters, error) {
+	result := make(map[int64]*biz.PictureCounters, len(pictureIDs))
+	if len(pictureIDs) == 0 {
+		return result, nil
+	}
+
+	pipe := r.data.rdb.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(pictureIDs))
+	for _, id := range pictureIDs {
+		cmds = append(cmds, pipe.HGetAll(ctx, r.getCounterKey(id)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.Errorf("查询图片计数增量失败: %v", err)
+		return nil, err
+	}
+
+	for i, cmd := range cmds {
+		values := cmd.Val()
+		if len(values) == 0 {
+			continue
+		}
+		result[pictureIDs[i]] = parsePictureCounters(values)
+	}
+	return result, nil
+}
+
+// FlushCounters 将一批计数增量刷新到数据库
+// 增量先从 Redis 中原子取出，数据库更新失败时再加回 Redis，保证计数不丢失
+func (r *pictureInteractionRepo) FlushCounters(ctx context.Context, batchSize int) (int, error) {
+	members, err := r.data.rdb.SPopN(ctx, pictureCounterDirtyKey, int64(batchSize)).Result()
+	if err != nil {
+		r.log.Errorf("获取待刷新图片失败: %v", err)
+		return 0, err
+	}
+	if len(members) == 0 {
+		return 0, nil
+	}
+
+	pictureIDs := make([]int64, 0, len(members))
+	for _, member := range members {
+		id, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		pictureIDs = append(pictureIDs, id)
+	}
+
+	pipe := r.data.rdb.Pipeline()
+	cmds := make([]*redis.Cmd, 0, len(pictureIDs))
+	for _, id := range pictureIDs {
+		cmds = append(cmds, popCounterScript.Eval(ctx, pipe, []string{r.getCounterKey(id)}))
+	}
+	// 逐条检查结果，取出失败的增量仍在 Redis 中，重新标记待刷新
+	_, _ = pipe.Exec(ctx)
+
+	deltas := make(map[int64]*biz.PictureCounters, len(pictureIDs))
+	for i, cmd := range cmds {
+		values, err := cmd.StringSlice()
+		if err != nil {
+			r.log.Errorf("取出图片计数增量失败: pictureID=%d, err=%v", pictureIDs[i], err)
+			r.data.rdb.SAdd(ctx, pictureCounterDirtyKey, pictureIDs[i])
+			continue
+		}
+		if len(values) == 0 {
+			continue
+		}
+		fields := make(map[string]string, len(values)/2)
+		for j := 0; j+1 < len(values); j += 2 {
+			fields[values[j]] = values[j+1]
+		}
+		deltas[pictureIDs[i]] = parsePictureCounters(fields)
+	}
+
+	err = r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for id, delta := range deltas {
+			updates := make(map[string]interface{}, len(pictureCounterColumns))
+			for field, value := range map[biz.PictureCounterField]int64{
+				biz.PictureCounterLike:     delta.LikeCount,
+				biz.PictureCounterFavorite: delta.FavoriteCount,
+				biz.PictureCounterView:     delta.ViewCount,
+				biz.PictureCounterComment:  delta.CommentCount,
+			} {
+				if value != 0 {
+					column := pictureCounterColumns[field]
+					updates[column] = gorm.Expr("GREATEST("+column+" + ?, 0)", value)
+				}
+			}
+			if len(updates) == 0 {
+				continue
+			}
+			// 只更新计数，不刷新 updateTime
+			if err := tx.Model(&Picture{}).Where("id = ?", id).UpdateColumns(updates).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Errorf("刷新图片计数到数据库失败: %v", err)
+		r.restoreCounters(ctx, deltas)
+		return 0, err
+	}
+
+	return len(pictureIDs), nil
+}
+
+// restoreCounters 数据库更新失败时将增量加回 Redis
+func (r *pictureInteractionRepo) restoreCounters(ctx context.Context, deltas map[int64]*biz.PictureCounters) {
+	pipe := r.data.rdb.Pipeline()
+	for id, delta := range deltas {
+		key := r.getCounterKey(id)
+		pipe.HIncrBy(ctx, key, string(biz.PictureCounterLike), delta.LikeCount)
+		pipe.HIncrBy(ctx, key, string(biz.PictureCounterFavorite), delta.FavoriteCount)
+		pipe.HIncrBy(ctx, key, string(biz.PictureCounterView), delta.ViewCount)
+		pipe.HIncrBy(ctx, key, string(biz.PictureCounterComment), delta.CommentCount)
+		pipe.SAdd(ctx, pictureCounterDirtyKey, id)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		r.log.Errorf("恢复图片计数增量失败: %v", err)
+	}
+}
+
+// getCounterKey 获取图片计数增量的 Redis key
+func (r *pictureInteractionRepo) getCounterKey(pictureID int64) string {
+	return fmt.Sprintf("%s%d", pictureCounterKeyPrefix, pictureID)
+}
+
+// parsePictureCounters 解析 Redis hash 中的计数增量
+func parsePictureCounters(values map[string]string) *biz.PictureCounters {
+	parse := func(field biz.PictureCounterField) int64 {
+		n, _ := strconv.ParseInt(values[string(field)], 10, 64)
+		return n
+	}
+
+	return &biz.PictureCounters{
+		LikeCount:     parse(biz.PictureCounterLike),
+		FavoriteCount: parse(biz.PictureCounterFavorite),
+		ViewCount:     parse(biz.PictureCounterView),
+		CommentCount:  parse(biz.PictureCounterComment),
+	}
+}
@@ -0,0 +1,31 @@
+package data
+
+import (
+	"time"
+)
+
+// PictureLike 图片点赞实体
+type PictureLike struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	PictureID  int64     `gorm:"column:pictureId;not null;uniqueIndex:uk_pictureId_userId" json:"pictureId"`
+	UserID     int64     `gorm:"column:userId;not null;uniqueIndex:uk_pictureId_userId;index:idx_userId" json:"userId"`
+	CreateTime time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+}
+
+// TableName 指定表名
+func (PictureLike) TableName() string {
+	return "picture_like"
+}
+
+// PictureFavorite 图片收藏实体
+type PictureFavorite struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	PictureID  int64     `gorm:"column:pictureId;not null;uniqueIndex:uk_pictureId_userId" json:"pictureId"`
+	UserID     int64     `gorm:"column:userId;not null;uniqueIndex:uk_pictureId_userId;index:idx_userId" json:"userId"`
+	CreateTime time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+}
+
+// TableName 指定表名
+func (PictureFavorite) TableName() string {
+	return "picture_favorite"
+}
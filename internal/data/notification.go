@@ -0,0 +1,202 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// notificationChannel 通知推送的 Redis pub/sub 频道，所有副本共同订阅
+const notificationChannel = "notification:push"
+
+type notificationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewNotificationRepo 创建通知仓储
+func NewNotificationRepo(data *Data, logger log.Logger) biz.NotificationRepo {
+	return &notificationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateNotifications 批量创建通知
+func (r *notificationRepo) CreateNotifications(ctx context.Context, notifications []*biz.Notification) ([]*biz.Notification, error) {
+	if len(notifications) == 0 {
+		return []*biz.Notification{}, nil
+	}
+
+	entities := make([]*Notification, 0, len(notifications))
+	for _, n := range notifications {
+		entity := &Notification{
+			UserID:    n.UserID,
+			SenderID:  n.SenderID,
+			Type:      string(n.Type),
+			PictureID: n.PictureID,
+			CommentID: n.CommentID,
+			Content:   n.Content,
+		}
+		if n.Once {
+			once := int8(1)
+			entity.NotifyOnce = &once
+		}
+		entities = append(entities, entity)
+	}
+
+	if err := r.data.DB(ctx).Create(&entities).Error; err != nil {
+		// 只发送一次的通知已存在时不再创建
+		if isDuplicateKeyError(err, "uk_notifyOnce") {
+			return []*biz.Notification{}, nil
+		}
+		r.log.Errorf("创建通知失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*biz.Notification, 0, len(entities))
+	for _, entity := range entities {
+		list = append(list, r.convertToNotification(entity))
+	}
+	return list, nil
+}
+
+// ListNotifications 查询用户 ID 小于 cursor 的通知（倒序）
+func (r *notificationRepo) ListNotifications(ctx context.Context, userID, cursor int64, limit int, unreadOnly bool) ([]*biz.Notification, error) {
+	query := r.data.DB(ctx).Where("userId = ? AND id < ?", userID, cursor)
+	if unreadOnly {
+		query = query.Where("isRead = 0")
+	}
+
+	var entities []Notification
+	err := query.Order("id DESC").
+		Limit(limit).
+		Find(&entities).Error
+
+	if err != nil {
+		r.log.Errorf("查询通知失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*biz.Notification, 0, len(entities))
+	for i := range entities {
+		list = append(list, r.convertToNotification(&entities[i]))
+	}
+	return list, nil
+}
+
+// CountUnread 统计用户未读通知数
+func (r *notificationRepo) CountUnread(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.data.DB(ctx).
+		Model(&Notification{}).
+		Where("userId = ? AND isRead = 0", userID).
+		Count(&count).Error
+
+	if err != nil {
+		r.log.Errorf("统计未读通知失败: %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// MarkRead 将用户的指定通知标记为已读
+func (r *notificationRepo) MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error) {
+	result := r.data.DB(ctx).
+		Model(&Notification{}).
+		Where("userId = ? AND id IN ? AND isRead = 0", userID, ids).
+		Update("isRead", 1)
+
+	if result.Error != nil {
+		r.log.Errorf("标记通知已读失败: %v", result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// MarkAllRead 将用户的全部通知标记为已读
+func (r *notificationRepo) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+	result := r.data.DB(ctx).
+		Model(&Notification{}).
+		Where("userId = ? AND isRead = 0", userID).
+		Update("isRead", 1)
+
+	if result.Error != nil {
+		r.log.Errorf("标记全部通知已读失败: %v", result.Error)
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// PublishNotification 通过 Redis 发布通知
+func (r *notificationRepo) PublishNotification(ctx context.Context, notification *biz.NotificationVO) error {
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	if err := r.data.rdb.Publish(ctx, notificationChannel, payload).Err(); err != nil {
+		r.log.Errorf("发布通知失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// SubscribeNotifications 订阅所有副本发布的通知，断线时由 go-redis 自动重连
+func (r *notificationRepo) SubscribeNotifications(ctx context.Context) (<-chan *biz.NotificationVO, error) {
+	pubsub := r.data.rdb.Subscribe(ctx, notificationChannel)
+	// 等待订阅确认，确保 Redis 可用
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		r.log.Errorf("订阅通知频道失败: %v", err)
+		return nil, err
+	}
+
+	out := make(chan *biz.NotificationVO)
+	go func() {
+		defer close(out)
+		defer pubsub.Close()
+
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				var notification biz.NotificationVO
+				if err := json.Unmarshal([]byte(msg.Payload), &notification); err != nil {
+					r.log.Errorf("解析通知消息失败: %v", err)
+					continue
+				}
+				select {
+				case out <- &notification:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// convertToNotification 转换实体为业务对象
+func (r *notificationRepo) convertToNotification(entity *Notification) *biz.Notification {
+	return &biz.Notification{
+		ID:         entity.ID,
+		UserID:     entity.UserID,
+		SenderID:   entity.SenderID,
+		Type:       biz.NotificationType(entity.Type),
+		PictureID:  entity.PictureID,
+		CommentID:  entity.CommentID,
+		Content:    entity.Content,
+		IsRead:     entity.IsRead == 1,
+		CreateTime: entity.CreateTime,
+	}
+}
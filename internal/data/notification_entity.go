@@ -0,0 +1,24 @@
+package data
+
+import (
+	"time"
+)
+
+// Notification 通知实体
+type Notification struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	UserID     int64     `gorm:"column:userId;not null;index:idx_userId_isRead" json:"userId"`
+	SenderID   int64     `gorm:"column:senderId;not null;default:0;uniqueIndex:uk_notifyOnce,priority:2" json:"senderId"`
+	Type       string    `gorm:"column:type;type:varchar(32);not null;uniqueIndex:uk_notifyOnce,priority:1" json:"type"`
+	PictureID  int64     `gorm:"column:pictureId;not null;default:0;uniqueIndex:uk_notifyOnce,priority:3" json:"pictureId"`
+	NotifyOnce *int8     `gorm:"column:notifyOnce;uniqueIndex:uk_notifyOnce,priority:4" json:"notifyOnce"` // 只发送一次的通知为 1，其余为 NULL，不受唯一约束限制
+	CommentID  int64     `gorm:"column:commentId;not null;default:0" json:"commentId"`
+	Content    string    `gorm:"column:content;type:varchar(512)" json:"content"`
+	IsRead     int8      `gorm:"column:isRead;not null;default:0;index:idx_userId_isRead" json:"isRead"`
+	CreateTime time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+}
+
+// TableName 指定表名
+func (Notification) TableName() string {
+	return "notification"
+}
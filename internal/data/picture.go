@@ -8,8 +8,27 @@ import (
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// colorDistanceSQL 图片主色调与指定 CIELAB 坐标的色差平方
+const colorDistanceSQL = "(POW(colorL - ?, 2) + POW(colorA - ?, 2) + POW(colorB - ?, 2))"
+
+// pictureSortFields 允许排序的字段
+var pictureSortFields = map[string]sortColumn[Picture]{
+	"createTime":    {column: "createTime", kind: biz.CursorValueTime, value: func(p *Picture) any { return p.CreateTime }},
+	"editTime":      {column: "editTime", kind: biz.CursorValueTime, value: func(p *Picture) any { return p.EditTime }},
+	"updateTime":    {column: "updateTime", kind: biz.CursorValueTime, value: func(p *Picture) any { return p.UpdateTime }},
+	"name":          {column: "name", kind: biz.CursorValueString, value: func(p *Picture) any { return p.Name }},
+	"picSize":       {column: "picSize", expr: "COALESCE(picSize, 0)", kind: biz.CursorValueInt, value: func(p *Picture) any { return p.PicSize }},
+	"picWidth":      {column: "picWidth", expr: "COALESCE(picWidth, 0)", kind: biz.CursorValueInt, value: func(p *Picture) any { return p.PicWidth }},
+	"picHeight":     {column: "picHeight", expr: "COALESCE(picHeight, 0)", kind: biz.CursorValueInt, value: func(p *Picture) any { return p.PicHeight }},
+	"likeCount":     {column: "likeCount", kind: biz.CursorValueInt, value: func(p *Picture) any { return p.LikeCount }},
+	"favoriteCount": {column: "favoriteCount", kind: biz.CursorValueInt, value: func(p *Picture) any { return p.FavoriteCount }},
+	"viewCount":     {column: "viewCount", kind: biz.CursorValueInt, value: func(p *Picture) any { return p.ViewCount }},
+	"commentCount":  {column: "commentCount", kind: biz.CursorValueInt, value: func(p *Picture) any { return p.CommentCount }},
+}
+
 type pictureRepo struct {
 	data *Data
 	log  *log.Helper
@@ -36,10 +55,17 @@ func (r *pictureRepo) CreatePicture(ctx context.Context, picture *biz.Picture) (
 		PicHeight:    picture.PicHeight,
 		PicScale:     picture.PicScale,
 		PicFormat:    picture.PicFormat,
+		PHash:        picture.PHash,
+		PicColor:     picture.PicColor,
+		PicPalette:   picture.PicPalette,
+		PicMetadata:  picture.PicMetadata,
 		UserID:       picture.UserID,
+		SpaceID:      spaceIDToColumn(picture.SpaceID),
+		ReviewStatus: int32(picture.ReviewStatus),
 	}
+	pictureEntity.ColorL, pictureEntity.ColorA, pictureEntity.ColorB = labToColumns(picture.ColorLab)
 
-	if err := r.data.db.WithContext(ctx).Create(pictureEntity).Error; err != nil {
+	if err := r.data.DB(ctx).Create(pictureEntity).Error; err != nil {
 		r.log.Errorf("创建图片失败: %v", err)
 		return nil, err
 	}
@@ -88,9 +114,15 @@ func (r *pictureRepo) UpdatePicture(ctx context.Context, picture *biz.Picture) e
 		updates["picHeight"] = picture.PicHeight
 		updates["picScale"] = picture.PicScale
 		updates["picFormat"] = picture.PicFormat
+		updates["pHash"] = picture.PHash
+		updates["picColor"] = picture.PicColor
+		updates["picPalette"] = picture.PicPalette
+		updates["picMetadata"] = picture.PicMetadata
+		updates["colorL"], updates["colorA"], updates["colorB"] = labToColumns(picture.ColorLab)
+		updates["reviewStatus"] = int32(picture.ReviewStatus)
 	}
 
-	err := r.data.db.WithContext(ctx).
+	err := r.data.DB(ctx).
 		Model(&Picture{}).
 		Where("id = ? AND isDelete = 0", picture.ID).
 		Updates(updates).Error
@@ -103,9 +135,40 @@ func (r *pictureRepo) UpdatePicture(ctx context.Context, picture *biz.Picture) e
 	return nil
 }
 
+// UpdatePictureSpace 修改图片所属的空间
+func (r *pictureRepo) UpdatePictureSpace(ctx context.Context, id, spaceID int64) error {
+	err := r.data.DB(ctx).
+		Model(&Picture{}).
+		Where("id = ? AND isDelete = 0", id).
+		Update("spaceId", spaceIDToColumn(spaceID)).Error
+	if err != nil {
+		r.log.Errorf("修改图片空间失败: id=%d, err=%v", id, err)
+		return err
+	}
+	return nil
+}
+
+// UpdatePictureReview 更新图片的审核信息
+func (r *pictureRepo) UpdatePictureReview(ctx context.Context, picture *biz.Picture) error {
+	err := r.data.DB(ctx).
+		Model(&Picture{}).
+		Where("id = ? AND isDelete = 0", picture.ID).
+		Updates(map[string]interface{}{
+			"reviewStatus":  int32(picture.ReviewStatus),
+			"reviewMessage": picture.ReviewMessage,
+			"reviewerId":    picture.ReviewerID,
+			"reviewTime":    picture.ReviewTime,
+		}).Error
+	if err != nil {
+		r.log.Errorf("更新图片审核信息失败: id=%d, err=%v", picture.ID, err)
+		return err
+	}
+	return nil
+}
+
 // DeletePicture 删除图片（逻辑删除）
 func (r *pictureRepo) DeletePicture(ctx context.Context, id int64) error {
-	err := r.data.db.WithContext(ctx).
+	err := r.data.DB(ctx).
 		Model(&Picture{}).
 		Where("id = ? AND isDelete = 0", id).
 		Update("isDelete", 1).Error
@@ -118,20 +181,96 @@ func (r *pictureRepo) DeletePicture(ctx context.Context, id int64) error {
 	return nil
 }
 
-// ListPictureByPage 分页查询图片
+// ListPictureByPage 分页查询图片，支持偏移量分页和游标分页
 func (r *pictureRepo) ListPictureByPage(ctx context.Context, params *biz.PictureQueryParams) (*biz.PicturePage, error) {
 	var total int64
 	var pictures []Picture
 
-	// 构建查询
-	query := r.data.db.WithContext(ctx).Model(&Picture{}).Where("isDelete = 0")
+	// 构建查询（搜索词由 PictureSearcher 处理）
+	query := applyPictureFilters(r.data.db.WithContext(ctx).Model(&Picture{}).Where("isDelete = 0"), params)
+
+	// 统计总数（游标分页时按需统计）
+	if !params.CursorMode || params.WithTotal {
+		if err := query.Count(&total).Error; err != nil {
+			r.log.Errorf("统计图片总数失败: %v", err)
+			return nil, err
+		}
+	}
+
+	// 排序（只允许按白名单中的字段排序，防止 SQL 注入）
+	sortCol, ok := pictureSortFields[params.SortField]
+	if !ok {
+		sortCol = pictureSortFields["createTime"]
+	}
+	desc := params.SortOrder != "ascend"
+	offset := (params.Current - 1) * params.PageSize
+
+	if params.Color != nil {
+		// 按颜色查询时按色差从小到大排序，游标分页按偏移量定位
+		query = query.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                colorDistanceSQL + " ASC, id DESC",
+			Vars:               []interface{}{params.Color.L, params.Color.A, params.Color.B},
+			WithoutParentheses: true,
+		}})
+		if params.CursorMode {
+			offset = cursorOffset(params.After)
+			query = query.Offset(int(offset)).Limit(int(params.PageSize) + 1)
+		}
+	} else if params.CursorMode {
+		// 游标分页按 (排序字段, id) 定位，新增的图片不会导致翻页时结果错位
+		var err error
+		if query, err = applyKeyset(query, sortCol, desc, params.After, params.PageSize); err != nil {
+			return nil, err
+		}
+	} else {
+		sortOrder := "desc"
+		if !desc {
+			sortOrder = "asc"
+		}
+		// 追加 id 保证排序字段相同时顺序稳定，翻页不会重复或遗漏
+		query = query.Order(sortCol.column + " " + sortOrder + ", id " + sortOrder)
+	}
 
-	// 搜索词查询（同时搜索名称和简介）
-	if params.SearchText != "" {
-		query = query.Where("name LIKE ? OR introduction LIKE ?",
-			"%"+params.SearchText+"%", "%"+params.SearchText+"%")
+	// 偏移量分页
+	if !params.CursorMode && params.Current > 0 && params.PageSize > 0 {
+		query = query.Offset(int(offset)).Limit(int(params.PageSize))
 	}
 
+	// 查询数据
+	if err := query.Find(&pictures).Error; err != nil {
+		r.log.Errorf("查询图片列表失败: %v", err)
+		return nil, err
+	}
+
+	var nextCursor string
+	if params.CursorMode {
+		if params.Color != nil {
+			hasMore := int64(len(pictures)) > params.PageSize
+			pictures = pictures[:min(int64(len(pictures)), params.PageSize)]
+			nextCursor = offsetNextCursor(pictureCursorParams(params), offset, int64(len(pictures)), hasMore)
+		} else {
+			pictures, nextCursor = keysetNextPage(pictures, sortCol, func(p *Picture) int64 { return p.ID }, pictureCursorParams(params))
+		}
+	}
+
+	// 转换为 VO
+	list := make([]*biz.PictureVO, 0, len(pictures))
+	for _, pic := range pictures {
+		bizPic := r.convertToPicture(&pic)
+		list = append(list, bizPic.ObjToVO())
+	}
+
+	return &biz.PicturePage{
+		Total:      total,
+		List:       list,
+		Current:    params.Current,
+		PageSize:   params.PageSize,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// applyPictureFilters 添加图片列表的条件查询（名称、简介、分类、用户、空间、标签、颜色、格式、体积、宽高比）
+func applyPictureFilters(query *gorm.DB, params *biz.PictureQueryParams) *gorm.DB {
 	// 条件查询
 	if params.Name != "" {
 		query = query.Where("name LIKE ?", "%"+params.Name+"%")
@@ -145,100 +284,264 @@ func (r *pictureRepo) ListPictureByPage(ctx context.Context, params *biz.Picture
 	if params.UserID != nil {
 		query = query.Where("userId = ?", *params.UserID)
 	}
+	if params.SpaceID > 0 {
+		query = query.Where("spaceId = ?", params.SpaceID)
+	}
+	if params.ReviewStatus != nil {
+		query = query.Where("reviewStatus = ?", int32(*params.ReviewStatus))
+	}
+	if params.PassedOnly {
+		query = query.Where("(reviewStatus = ? OR userId = ?)", int32(biz.ReviewStatusPass), params.ViewerID)
+	}
 
-	// 标签查询（JSON 数组）
-	if len(params.Tags) > 0 {
-		for _, tag := range params.Tags {
-			query = query.Where("JSON_CONTAINS(tags, ?)", `"`+tag+`"`)
-		}
+	// 标签查询（按 picture_tag 关联，需同时包含全部标签）
+	for _, tag := range params.Tags {
+		query = query.Where("picture.id IN (SELECT picture_tag.pictureId FROM picture_tag JOIN tag ON tag.id = picture_tag.tagId WHERE tag.name = ?)", tag)
+	}
+
+	// 颜色查询：先按坐标范围过滤（可使用 colorL 索引），再按色差精确过滤
+	if params.Color != nil {
+		c, d := params.Color, params.MaxColorDistance
+		query = query.
+			Where("colorL BETWEEN ? AND ? AND colorA BETWEEN ? AND ? AND colorB BETWEEN ? AND ?",
+				c.L-d, c.L+d, c.A-d, c.A+d, c.B-d, c.B+d).
+			Where(colorDistanceSQL+" <= ?", c.L, c.A, c.B, d*d)
+	}
+
+	// 分面取值查询
+	if params.PicFormat != "" {
+		query = query.Where("picFormat = ?", params.PicFormat)
+	}
+	if params.SizeRange != nil {
+		query = applyRangeFilter(query, "picSize", params.SizeRange)
+	}
+	if params.ScaleRange != nil {
+		// 宽高比未知的图片不属于任何区间
+		query = applyRangeFilter(query.Where("picScale > 0"), "picScale", params.ScaleRange)
+	}
+	return query
+}
+
+// applyRangeFilter 添加数值区间 [Min, Max) 查询
+func applyRangeFilter(query *gorm.DB, column string, r *biz.PictureRange) *gorm.DB {
+	query = query.Where(column+" >= ?", r.Min)
+	if r.Max > 0 {
+		query = query.Where(column+" < ?", r.Max)
+	}
+	return query
+}
+
+// ListPictureByIDs 根据 ID 批量查询图片
+func (r *pictureRepo) ListPictureByIDs(ctx context.Context, ids []int64) ([]*biz.Picture, error) {
+	if len(ids) == 0 {
+		return []*biz.Picture{}, nil
 	}
 
-	// 统计总数
-	if err := query.Count(&total).Error; err != nil {
-		r.log.Errorf("统计图片总数失败: %v", err)
+	var pictures []Picture
+	err := r.data.db.WithContext(ctx).
+		Where("id IN ? AND isDelete = 0", ids).
+		Find(&pictures).Error
+
+	if err != nil {
+		r.log.Errorf("批量查询图片失败: %v", err)
 		return nil, err
 	}
 
-	// 排序
-	sortField := "createTime"
-	if params.SortField != "" {
-		sortField = params.SortField
+	list := make([]*biz.Picture, 0, len(pictures))
+	for i := range pictures {
+		list = append(list, r.convertToPicture(&pictures[i]))
 	}
-	sortOrder := "desc"
-	if params.SortOrder == "ascend" {
-		sortOrder = "asc"
+	return list, nil
+}
+
+// ListPictureIDsByUserIDs 查询指定用户发布的、ID 小于 cursor 的图片 ID（倒序）
+func (r *pictureRepo) ListPictureIDsByUserIDs(ctx context.Context, userIDs []int64, cursor int64, limit int) ([]int64, error) {
+	if len(userIDs) == 0 || limit <= 0 {
+		return []int64{}, nil
 	}
-	query = query.Order(sortField + " " + sortOrder)
 
-	// 分页
-	if params.Current > 0 && params.PageSize > 0 {
-		offset := (params.Current - 1) * params.PageSize
-		query = query.Offset(int(offset)).Limit(int(params.PageSize))
+	var ids []int64
+	err := r.data.db.WithContext(ctx).
+		Model(&Picture{}).
+		Where("userId IN ? AND id < ? AND isDelete = 0 AND reviewStatus = ?", userIDs, cursor, int32(biz.ReviewStatusPass)).
+		Order("id DESC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+
+	if err != nil {
+		r.log.Errorf("查询用户图片 ID 失败: %v", err)
+		return nil, err
 	}
 
-	// 查询数据
-	if err := query.Find(&pictures).Error; err != nil {
-		r.log.Errorf("查询图片列表失败: %v", err)
+	return ids, nil
+}
+
+// FindSimilarPictures 查询感知哈希与 hash 的汉明距离不超过 maxDistance 的图片，按距离从近到远排序
+// excludeID 为 0 时不排除任何图片
+func (r *pictureRepo) FindSimilarPictures(ctx context.Context, hash uint64, maxDistance int, scope biz.SimilarScope, excludeID int64, limit int) ([]*biz.PictureDistance, error) {
+	var rows []struct {
+		ID       int64 `gorm:"column:id"`
+		Distance int   `gorm:"column:distance"`
+	}
+
+	query := r.data.db.WithContext(ctx).
+		Model(&Picture{}).
+		Select("id, BIT_COUNT(pHash ^ ?) AS distance", hash).
+		Where("isDelete = 0 AND pHash IS NOT NULL AND BIT_COUNT(pHash ^ ?) <= ?", hash, maxDistance)
+	if scope.UserID > 0 {
+		query = query.Where("userId = ?", scope.UserID)
+	}
+	if scope.SpaceID != nil {
+		if *scope.SpaceID > 0 {
+			query = query.Where("spaceId = ?", *scope.SpaceID)
+		} else {
+			query = query.Where("spaceId IS NULL")
+		}
+	}
+	if excludeID > 0 {
+		query = query.Where("id <> ?", excludeID)
+	}
+
+	err := query.Order("distance ASC, id DESC").Limit(limit).Scan(&rows).Error
+	if err != nil {
+		r.log.Errorf("查询相似图片失败: %v", err)
 		return nil, err
 	}
 
-	// 转换为 VO
-	list := make([]*biz.PictureVO, 0, len(pictures))
-	for _, pic := range pictures {
-		bizPic := r.convertToPicture(&pic)
-		list = append(list, bizPic.ObjToVO())
+	result := make([]*biz.PictureDistance, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &biz.PictureDistance{PictureID: row.ID, Distance: row.Distance})
 	}
+	return result, nil
+}
 
-	return &biz.PicturePage{
-		Total:    total,
-		List:     list,
-		Current:  params.Current,
-		PageSize: params.PageSize,
-	}, nil
+// ListPictureHashes 查询最近上传的、已计算感知哈希的图片（按 ID 倒序）
+func (r *pictureRepo) ListPictureHashes(ctx context.Context, limit int) ([]*biz.PictureHash, error) {
+	var entities []*Picture
+	err := r.data.db.WithContext(ctx).
+		Select("id, userId, pHash").
+		Where("isDelete = 0 AND pHash IS NOT NULL").
+		Order("id DESC").
+		Limit(limit).
+		Find(&entities).Error
+
+	if err != nil {
+		r.log.Errorf("查询图片感知哈希失败: %v", err)
+		return nil, err
+	}
+
+	result := make([]*biz.PictureHash, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, &biz.PictureHash{PictureID: entity.ID, UserID: entity.UserID, PHash: *entity.PHash})
+	}
+	return result, nil
 }
 
 // convertToPicture 转换实体为业务对象
 func (r *pictureRepo) convertToPicture(entity *Picture) *biz.Picture {
 	return &biz.Picture{
-		ID:           entity.ID,
-		URL:          entity.URL,
-		Name:         entity.Name,
-		Introduction: entity.Introduction,
-		Category:     entity.Category,
-		Tags:         entity.Tags,
-		PicSize:      entity.PicSize,
-		PicWidth:     entity.PicWidth,
-		PicHeight:    entity.PicHeight,
-		PicScale:     entity.PicScale,
-		PicFormat:    entity.PicFormat,
-		UserID:       entity.UserID,
-		CreateTime:   entity.CreateTime,
-		EditTime:     entity.EditTime,
-		UpdateTime:   entity.UpdateTime,
-		IsDelete:     entity.IsDelete,
+		ID:            entity.ID,
+		URL:           entity.URL,
+		Name:          entity.Name,
+		Introduction:  entity.Introduction,
+		Category:      entity.Category,
+		Tags:          entity.Tags,
+		PicSize:       entity.PicSize,
+		PicWidth:      entity.PicWidth,
+		PicHeight:     entity.PicHeight,
+		PicScale:      entity.PicScale,
+		PicFormat:     entity.PicFormat,
+		PHash:         entity.PHash,
+		PicColor:      entity.PicColor,
+		PicPalette:    entity.PicPalette,
+		ColorLab:      columnsToLab(entity.ColorL, entity.ColorA, entity.ColorB),
+		PicMetadata:   entity.PicMetadata,
+		UserID:        entity.UserID,
+		SpaceID:       columnToSpaceID(entity.SpaceID),
+		ReviewStatus:  biz.ReviewStatus(entity.ReviewStatus),
+		ReviewMessage: entity.ReviewMessage,
+		ReviewerID:    entity.ReviewerID,
+		ReviewTime:    entity.ReviewTime,
+		LikeCount:     entity.LikeCount,
+		FavoriteCount: entity.FavoriteCount,
+		ViewCount:     entity.ViewCount,
+		CommentCount:  entity.CommentCount,
+		CreateTime:    entity.CreateTime,
+		EditTime:      entity.EditTime,
+		UpdateTime:    entity.UpdateTime,
+		IsDelete:      entity.IsDelete,
 	}
 }
 
 // convertToEntity 转换业务对象为实体
 func (r *pictureRepo) convertToEntity(picture *biz.Picture) *Picture {
+	colorL, colorA, colorB := labToColumns(picture.ColorLab)
 	return &Picture{
-		ID:           picture.ID,
-		URL:          picture.URL,
-		Name:         picture.Name,
-		Introduction: picture.Introduction,
-		Category:     picture.Category,
-		Tags:         picture.Tags,
-		PicSize:      picture.PicSize,
-		PicWidth:     picture.PicWidth,
-		PicHeight:    picture.PicHeight,
-		PicScale:     picture.PicScale,
-		PicFormat:    picture.PicFormat,
-		UserID:       picture.UserID,
-		CreateTime:   picture.CreateTime,
-		EditTime:     picture.EditTime,
-		UpdateTime:   picture.UpdateTime,
-		IsDelete:     picture.IsDelete,
+		ID:            picture.ID,
+		URL:           picture.URL,
+		Name:          picture.Name,
+		Introduction:  picture.Introduction,
+		Category:      picture.Category,
+		Tags:          picture.Tags,
+		PicSize:       picture.PicSize,
+		PicWidth:      picture.PicWidth,
+		PicHeight:     picture.PicHeight,
+		PicScale:      picture.PicScale,
+		PicFormat:     picture.PicFormat,
+		PHash:         picture.PHash,
+		PicColor:      picture.PicColor,
+		PicPalette:    picture.PicPalette,
+		ColorL:        colorL,
+		ColorA:        colorA,
+		ColorB:        colorB,
+		PicMetadata:   picture.PicMetadata,
+		UserID:        picture.UserID,
+		SpaceID:       spaceIDToColumn(picture.SpaceID),
+		ReviewStatus:  int32(picture.ReviewStatus),
+		ReviewMessage: picture.ReviewMessage,
+		ReviewerID:    picture.ReviewerID,
+		ReviewTime:    picture.ReviewTime,
+		LikeCount:     picture.LikeCount,
+		FavoriteCount: picture.FavoriteCount,
+		ViewCount:     picture.ViewCount,
+		CommentCount:  picture.CommentCount,
+		CreateTime:    picture.CreateTime,
+		EditTime:      picture.EditTime,
+		UpdateTime:    picture.UpdateTime,
+		IsDelete:      picture.IsDelete,
+	}
+}
+
+// labToColumns CIELAB 坐标转数据库列值，为空时三列均为 NULL
+func labToColumns(lab *biz.LabColor) (l, a, b *float64) {
+	if lab == nil {
+		return nil, nil, nil
+	}
+	return &lab.L, &lab.A, &lab.B
+}
+
+// columnsToLab 数据库列值转 CIELAB 坐标，任一列为空时返回 nil
+func columnsToLab(l, a, b *float64) *biz.LabColor {
+	if l == nil || a == nil || b == nil {
+		return nil
+	}
+	return &biz.LabColor{L: *l, A: *a, B: *b}
+}
+
+// spaceIDToColumn 空间 ID 转数据库列值，公共图库（0）为 NULL
+func spaceIDToColumn(spaceID int64) *int64 {
+	if spaceID <= 0 {
+		return nil
+	}
+	return &spaceID
+}
+
+// columnToSpaceID 数据库列值转空间 ID，NULL 为公共图库（0）
+func columnToSpaceID(spaceID *int64) int64 {
+	if spaceID == nil {
+		return 0
 	}
+	return *spaceID
 }
 
 // tagsToJSON 标签数组转 JSON 字符串
@@ -6,22 +6,39 @@ import (
 
 // Picture 图片实体
 type Picture struct {
-	ID           int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
-	URL          string    `gorm:"column:url;type:varchar(512);not null" json:"url"`
-	Name         string    `gorm:"column:name;type:varchar(128);not null" json:"name"`
-	Introduction string    `gorm:"column:introduction;type:varchar(512)" json:"introduction"`
-	Category     string    `gorm:"column:category;type:varchar(64)" json:"category"`
-	Tags         string    `gorm:"column:tags;type:varchar(512)" json:"tags"` // JSON 数组
-	PicSize      int64     `gorm:"column:picSize" json:"picSize"`
-	PicWidth     int32     `gorm:"column:picWidth" json:"picWidth"`
-	PicHeight    int32     `gorm:"column:picHeight" json:"picHeight"`
-	PicScale     float64   `gorm:"column:picScale" json:"picScale"`
-	PicFormat    string    `gorm:"column:picFormat;type:varchar(32)" json:"picFormat"`
-	UserID       int64     `gorm:"column:userId;not null" json:"userId"`
-	CreateTime   time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
-	EditTime     time.Time `gorm:"column:editTime;autoCreateTime" json:"editTime"`
-	UpdateTime   time.Time `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
-	IsDelete     int8      `gorm:"column:isDelete;default:0" json:"isDelete"`
+	ID           int64    `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	URL          string   `gorm:"column:url;type:varchar(512);not null;index:idx_url" json:"url"`
+	Name         string   `gorm:"column:name;type:varchar(128);not null;index:ft_picture_search,class:FULLTEXT,option:WITH PARSER ngram" json:"name"`
+	Introduction string   `gorm:"column:introduction;type:varchar(512);index:ft_picture_search,class:FULLTEXT,option:WITH PARSER ngram" json:"introduction"`
+	Category     string   `gorm:"column:category;type:varchar(64);index:ft_picture_search,class:FULLTEXT,option:WITH PARSER ngram" json:"category"`
+	Tags         string   `gorm:"column:tags;type:varchar(512);index:ft_picture_search,class:FULLTEXT,option:WITH PARSER ngram" json:"tags"` // JSON 数组
+	PicSize      int64    `gorm:"column:picSize" json:"picSize"`
+	PicWidth     int32    `gorm:"column:picWidth" json:"picWidth"`
+	PicHeight    int32    `gorm:"column:picHeight" json:"picHeight"`
+	PicScale     float64  `gorm:"column:picScale" json:"picScale"`
+	PicFormat    string   `gorm:"column:picFormat;type:varchar(32)" json:"picFormat"`
+	PHash        *uint64  `gorm:"column:pHash" json:"pHash"`                             // 感知哈希（dHash），无法解码的图片为空
+	PicColor     string   `gorm:"column:picColor;type:varchar(16)" json:"picColor"`      // 主色调（#RRGGBB）
+	PicPalette   string   `gorm:"column:picPalette;type:varchar(128)" json:"picPalette"` // 调色板（JSON 数组）
+	ColorL       *float64 `gorm:"column:colorL;index:idx_colorL" json:"colorL"`          // 主色调 CIELAB 坐标
+	ColorA       *float64 `gorm:"column:colorA" json:"colorA"`
+	ColorB       *float64 `gorm:"column:colorB" json:"colorB"`
+	PicMetadata  string   `gorm:"column:picMetadata;type:text" json:"picMetadata"` // 拍摄信息（JSON，来自 EXIF）
+	UserID       int64    `gorm:"column:userId;not null" json:"userId"`
+	SpaceID      *int64   `gorm:"column:spaceId;index:idx_spaceId" json:"spaceId"` // 所属空间，公共图库中的图片为空
+	// 审核状态：0-待审核; 1-通过; 2-拒绝，新图片默认审核通过
+	ReviewStatus  int32      `gorm:"column:reviewStatus;not null;default:1;index:idx_reviewStatus" json:"reviewStatus"`
+	ReviewMessage string     `gorm:"column:reviewMessage;type:varchar(512)" json:"reviewMessage"`
+	ReviewerID    int64      `gorm:"column:reviewerId" json:"reviewerId"`
+	ReviewTime    *time.Time `gorm:"column:reviewTime" json:"reviewTime"`
+	LikeCount     int64      `gorm:"column:likeCount;not null;default:0" json:"likeCount"`
+	FavoriteCount int64      `gorm:"column:favoriteCount;not null;default:0" json:"favoriteCount"`
+	ViewCount     int64      `gorm:"column:viewCount;not null;default:0" json:"viewCount"`
+	CommentCount  int64      `gorm:"column:commentCount;not null;default:0" json:"commentCount"`
+	CreateTime    time.Time  `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+	EditTime      time.Time  `gorm:"column:editTime;autoCreateTime" json:"editTime"`
+	UpdateTime    time.Time  `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
+	IsDelete      int8       `gorm:"column:isDelete;default:0" json:"isDelete"`
 }
 
 // TableName 指定表名
@@ -0,0 +1,133 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// pictureTagsTableSQL 关联图片的标签，每个标签一行（使用派生表避免与图片的列重名）
+const pictureTagsTableSQL = "JOIN (SELECT picture_tag.pictureId, tag.name AS tagName FROM picture_tag JOIN tag ON tag.id = picture_tag.tagId) AS pictureTag " +
+	"ON pictureTag.pictureId = picture.id"
+
+// facetRow 分面分组统计结果
+type facetRow struct {
+	Value string `gorm:"column:value"`
+	Count int64  `gorm:"column:count"`
+}
+
+// CountPictureFacets 统计满足查询条件的图片在各分面上的分布
+func (r *pictureRepo) CountPictureFacets(ctx context.Context, params *biz.PictureQueryParams) (*biz.PictureFacets, error) {
+	facets, err := countPictureFacets(func(p *biz.PictureQueryParams) *gorm.DB {
+		return applyPictureFilters(r.data.db.WithContext(ctx).Model(&Picture{}).Where("picture.isDelete = 0"), p)
+	}, params)
+	if err != nil {
+		r.log.Errorf("统计图片分面失败: %v", err)
+		return nil, err
+	}
+	return facets, nil
+}
+
+// countPictureFacets 在数据库中分组统计各分面，newQuery 返回应用了查询条件的图片查询
+func countPictureFacets(newQuery func(p *biz.PictureQueryParams) *gorm.DB, params *biz.PictureQueryParams) (*biz.PictureFacets, error) {
+	facets := &biz.PictureFacets{}
+	var err error
+
+	categoryQuery := newQuery(params.ForFacet(biz.FacetCategory)).Where("category <> ''")
+	if facets.Categories, err = countFacetValues(categoryQuery, "category"); err != nil {
+		return nil, err
+	}
+	tagQuery := newQuery(params.ForFacet(biz.FacetTag)).Joins(pictureTagsTableSQL)
+	if facets.Tags, err = countFacetValues(tagQuery, "pictureTag.tagName"); err != nil {
+		return nil, err
+	}
+	formatQuery := newQuery(params.ForFacet(biz.FacetFormat)).Where("picFormat <> ''")
+	if facets.Formats, err = countFacetValues(formatQuery, "picFormat"); err != nil {
+		return nil, err
+	}
+	sizeQuery := newQuery(params.ForFacet(biz.FacetSize))
+	if facets.SizeRanges, err = countFacetRanges(sizeQuery, "picSize", biz.PictureSizeRanges); err != nil {
+		return nil, err
+	}
+	scaleQuery := newQuery(params.ForFacet(biz.FacetScale)).Where("picScale > 0")
+	if facets.ScaleRanges, err = countFacetRanges(scaleQuery, "picScale", biz.PictureScaleRanges); err != nil {
+		return nil, err
+	}
+	return facets, nil
+}
+
+// countFacetValues 按字段取值分组统计图片数，返回图片数最多的 PictureFacetLimit 个取值
+func countFacetValues(query *gorm.DB, column string) ([]*biz.FacetCount, error) {
+	var rows []facetRow
+	err := query.
+		Select(column + " AS value, COUNT(DISTINCT picture.id) AS count").
+		Group(column).
+		Order("count DESC, value ASC").
+		Limit(biz.PictureFacetLimit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]*biz.FacetCount, 0, len(rows))
+	for _, row := range rows {
+		counts = append(counts, &biz.FacetCount{Value: row.Value, Count: row.Count})
+	}
+	return counts, nil
+}
+
+// countFacetRanges 按数值区间分组统计图片数，按区间顺序返回全部区间（没有图片的区间数量为 0）
+func countFacetRanges(query *gorm.DB, column string, ranges []*biz.PictureRange) ([]*biz.FacetCount, error) {
+	// 区间连续且从小到大排列，依次比较上限即可确定所属区间
+	var sb strings.Builder
+	vars := make([]interface{}, 0, len(ranges)*2)
+	sb.WriteString("CASE")
+	for _, r := range ranges[:len(ranges)-1] {
+		sb.WriteString(" WHEN " + column + " < ? THEN ?")
+		vars = append(vars, r.Max, r.Key)
+	}
+	sb.WriteString(" ELSE ? END AS value, COUNT(*) AS count")
+	vars = append(vars, ranges[len(ranges)-1].Key)
+
+	var rows []facetRow
+	if err := query.Select(sb.String(), vars...).Group("value").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	rowCounts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		rowCounts[row.Value] = row.Count
+	}
+	return rangeFacetCounts(ranges, rowCounts), nil
+}
+
+// rangeFacetCounts 按区间顺序生成分面统计结果
+func rangeFacetCounts(ranges []*biz.PictureRange, counts map[string]int64) []*biz.FacetCount {
+	result := make([]*biz.FacetCount, 0, len(ranges))
+	for _, r := range ranges {
+		result = append(result, &biz.FacetCount{Value: r.Key, Count: counts[r.Key]})
+	}
+	return result
+}
+
+// topFacetCounts 按图片数从多到少（相同时按取值）排序，返回前 PictureFacetLimit 个取值
+func topFacetCounts(counts map[string]int64) []*biz.FacetCount {
+	result := make([]*biz.FacetCount, 0, len(counts))
+	for value, count := range counts {
+		result = append(result, &biz.FacetCount{Value: value, Count: count})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Value < result[j].Value
+	})
+	if len(result) > biz.PictureFacetLimit {
+		result = result[:biz.PictureFacetLimit]
+	}
+	return result
+}
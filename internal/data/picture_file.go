@@ -0,0 +1,48 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/pkg"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type pictureFileRepo struct {
+	cosManager *pkg.COSManager // 为 nil 时（未配置 COS）不删除文件
+	log        *log.Helper
+}
+
+// NewPictureFileRepo 创建图片文件仓储
+func NewPictureFileRepo(cosManager *pkg.COSManager, logger log.Logger) biz.PictureFileRepo {
+	return &pictureFileRepo{
+		cosManager: cosManager,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// DeletePictureFile 删除存储桶中的图片文件
+func (r *pictureFileRepo) DeletePictureFile(ctx context.Context, url string) error {
+	if r.cosManager == nil {
+		r.log.Warnf("COS 未配置，跳过删除图片文件: url=%s", url)
+		return nil
+	}
+	return r.cosManager.DeleteObject(ctx, url)
+}
+
+// GetPictureFileETag 查询存储桶中图片文件当前的 ETag，文件不存在时返回空字符串
+func (r *pictureFileRepo) GetPictureFileETag(ctx context.Context, url string) (string, error) {
+	if r.cosManager == nil {
+		return "", nil
+	}
+	object, err := r.cosManager.HeadObject(ctx, url)
+	if err != nil {
+		if errors.Is(err, pkg.ErrObjectNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return object.ETag, nil
+}
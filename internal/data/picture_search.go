@@ -0,0 +1,474 @@
+package data
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+
+	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/conf"
+	"smart-collab-gallery-server/internal/pkg"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// 检索引擎
+const (
+	searchEngineMySQL  = "mysql"
+	searchEngineMemory = "memory"
+)
+
+const (
+	// pictureMatchSQL 图片字段的全文检索条件，列顺序需与 ft_picture_search 索引一致
+	pictureMatchSQL = "MATCH(name, introduction, category, tags) AGAINST (?)"
+	// highlightMaxRunes 高亮片段的最大字数
+	highlightMaxRunes = 80
+	// searchIndexBatchSize 重建内存索引时每批读取的图片数
+	searchIndexBatchSize = 1000
+)
+
+// searchFieldBoosts 内存索引中各字段的权重
+var searchFieldBoosts = map[string]float64{
+	biz.SearchFieldName:         3,
+	biz.SearchFieldTags:         2,
+	biz.SearchFieldCategory:     2,
+	biz.SearchFieldUserName:     1.5,
+	biz.SearchFieldIntroduction: 1,
+}
+
+// pictureSearchDoc 参与检索的图片字段
+type pictureSearchDoc struct {
+	ID           int64    `gorm:"column:id"`
+	Name         string   `gorm:"column:name"`
+	Introduction string   `gorm:"column:introduction"`
+	Category     string   `gorm:"column:category"`
+	Tags         string   `gorm:"column:tags"`
+	UserID       int64    `gorm:"column:userId"`
+	SpaceID      *int64   `gorm:"column:spaceId"`
+	ReviewStatus int32    `gorm:"column:reviewStatus"`
+	UserName     string   `gorm:"column:userName"`
+	ColorL       *float64 `gorm:"column:colorL"`
+	ColorA       *float64 `gorm:"column:colorA"`
+	ColorB       *float64 `gorm:"column:colorB"`
+	PicFormat    string   `gorm:"column:picFormat"`
+	PicSize      int64    `gorm:"column:picSize"`
+	PicScale     float64  `gorm:"column:picScale"`
+	Score        float64  `gorm:"column:score"`
+	tagList      []string
+}
+
+// fields 按字段名返回参与检索的文本
+func (d *pictureSearchDoc) fields() map[string]string {
+	return map[string]string{
+		biz.SearchFieldName:         d.Name,
+		biz.SearchFieldIntroduction: d.Introduction,
+		biz.SearchFieldCategory:     d.Category,
+		biz.SearchFieldTags:         strings.Join(d.tagList, " "),
+		biz.SearchFieldUserName:     d.UserName,
+	}
+}
+
+// toHit 生成检索结果，只保留命中的字段的高亮片段
+func (d *pictureSearchDoc) toHit(text string, score float64) *biz.PictureSearchHit {
+	highlights := make(map[string]string)
+	for field, value := range d.fields() {
+		if snippet := pkg.Highlight(value, text, highlightMaxRunes); snippet != "" {
+			highlights[field] = snippet
+		}
+	}
+	return &biz.PictureSearchHit{
+		PictureID:  d.ID,
+		Score:      math.Round(score*10000) / 10000,
+		Highlights: highlights,
+	}
+}
+
+// NewPictureSearcher 按配置创建图片检索引擎，默认使用 MySQL FULLTEXT 索引
+func NewPictureSearcher(data *Data, bc *conf.Bootstrap, logger log.Logger) (biz.PictureSearcher, error) {
+	helper := log.NewHelper(logger)
+	switch engine := bc.GetSearch().GetEngine(); engine {
+	case searchEngineMemory:
+		searcher := &memoryPictureSearcher{
+			data:  data,
+			log:   helper,
+			index: pkg.NewInvertedIndex(),
+			docs:  make(map[int64]*pictureSearchDoc),
+		}
+		if err := searcher.rebuild(context.Background()); err != nil {
+			helper.Errorf("构建图片检索索引失败: %v", err)
+			return nil, err
+		}
+		return searcher, nil
+	case "", searchEngineMySQL:
+		return &mysqlPictureSearcher{data: data, log: helper}, nil
+	default:
+		helper.Warnf("未知的检索引擎 '%s'，使用 %s", engine, searchEngineMySQL)
+		return &mysqlPictureSearcher{data: data, log: helper}, nil
+	}
+}
+
+// mysqlPictureSearcher 基于 MySQL FULLTEXT 索引（ngram 分词）的检索引擎，索引由 MySQL 自动维护
+type mysqlPictureSearcher struct {
+	data *Data
+	log  *log.Helper
+}
+
+// Search 按相关度检索图片，作者昵称命中的图片同样返回
+func (s *mysqlPictureSearcher) Search(ctx context.Context, params *biz.PictureQueryParams) (*biz.PictureSearchResult, error) {
+	text := params.SearchText
+	query := s.matchQuery(ctx, params)
+
+	var total int64
+	if !params.CursorMode || params.WithTotal {
+		if err := query.Count(&total).Error; err != nil {
+			s.log.Errorf("统计检索结果失败: %v", err)
+			return nil, err
+		}
+	}
+
+	query = query.
+		Select("id, name, introduction, category, tags, userId, "+pictureMatchSQL+" AS score", text).
+		Order("score DESC, id DESC")
+	// 按相关度排序时游标分页按偏移量定位，多查询一条用于判断是否还有下一页
+	offset := (params.Current - 1) * params.PageSize
+	if params.CursorMode {
+		offset = cursorOffset(params.After)
+		query = query.Offset(int(offset)).Limit(int(params.PageSize) + 1)
+	} else if params.Current > 0 && params.PageSize > 0 {
+		query = query.Offset(int(offset)).Limit(int(params.PageSize))
+	}
+	var docs []*pictureSearchDoc
+	if err := query.Find(&docs).Error; err != nil {
+		s.log.Errorf("检索图片失败: %v", err)
+		return nil, err
+	}
+	var nextCursor string
+	if params.CursorMode {
+		hasMore := int64(len(docs)) > params.PageSize
+		docs = docs[:min(int64(len(docs)), params.PageSize)]
+		nextCursor = offsetNextCursor(pictureCursorParams(params), offset, int64(len(docs)), hasMore)
+	}
+
+	// 查询作者昵称用于高亮
+	userIDs := make([]int64, 0, len(docs))
+	for _, doc := range docs {
+		userIDs = append(userIDs, doc.UserID)
+	}
+	var users []User
+	if len(userIDs) > 0 {
+		if err := s.data.DB(ctx).Select("id, userName").Where("id IN ?", userIDs).Find(&users).Error; err != nil {
+			s.log.Errorf("查询图片作者失败: %v", err)
+			return nil, err
+		}
+	}
+	userNames := make(map[int64]string, len(users))
+	for _, user := range users {
+		userNames[user.ID] = user.UserName
+	}
+
+	hits := make([]*biz.PictureSearchHit, 0, len(docs))
+	for _, doc := range docs {
+		doc.UserName = userNames[doc.UserID]
+		doc.tagList = tagsFromJSON(doc.Tags)
+		hits = append(hits, doc.toHit(text, doc.Score))
+	}
+	return &biz.PictureSearchResult{Total: total, Hits: hits, NextCursor: nextCursor}, nil
+}
+
+// CountFacets 统计检索结果在各分面上的分布
+func (s *mysqlPictureSearcher) CountFacets(ctx context.Context, params *biz.PictureQueryParams) (*biz.PictureFacets, error) {
+	facets, err := countPictureFacets(func(p *biz.PictureQueryParams) *gorm.DB {
+		return s.matchQuery(ctx, p)
+	}, params)
+	if err != nil {
+		s.log.Errorf("统计检索结果分面失败: %v", err)
+		return nil, err
+	}
+	return facets, nil
+}
+
+// matchQuery 构建匹配搜索词（图片字段或作者昵称）并应用其他查询条件的图片查询
+func (s *mysqlPictureSearcher) matchQuery(ctx context.Context, params *biz.PictureQueryParams) *gorm.DB {
+	matchedUsers := s.data.DB(ctx).Model(&User{}).
+		Select("id").
+		Where("isDelete = 0 AND MATCH(userName) AGAINST (?)", params.SearchText)
+	query := s.data.DB(ctx).Model(&Picture{}).
+		Where("picture.isDelete = 0").
+		Where("("+pictureMatchSQL+" OR userId IN (?))", params.SearchText, matchedUsers)
+	return applyPictureFilters(query, params)
+}
+
+// IndexPicture FULLTEXT 索引随数据写入自动更新
+func (s *mysqlPictureSearcher) IndexPicture(ctx context.Context, id int64) error {
+	return nil
+}
+
+// RemovePicture 逻辑删除的图片在检索时过滤
+func (s *mysqlPictureSearcher) RemovePicture(ctx context.Context, id int64) error {
+	return nil
+}
+
+// IndexUserPictures 检索时实时匹配作者昵称，无需更新
+func (s *mysqlPictureSearcher) IndexUserPictures(ctx context.Context, userID int64) error {
+	return nil
+}
+
+// memoryPictureSearcher 基于进程内倒排索引的检索引擎，启动时从数据库构建，图片增删改时同步更新
+// 索引只保存在当前进程中，多实例部署时各实例的索引无法同步，应使用 MySQL 引擎
+type memoryPictureSearcher struct {
+	data  *Data
+	log   *log.Helper
+	mu    sync.RWMutex
+	index *pkg.InvertedIndex
+	docs  map[int64]*pictureSearchDoc
+}
+
+// Search 按相关度检索图片，在内存中应用其他过滤条件
+func (s *memoryPictureSearcher) Search(ctx context.Context, params *biz.PictureQueryParams) (*biz.PictureSearchResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []*biz.PictureSearchHit
+	for _, match := range s.index.Search(params.SearchText) {
+		doc := s.docs[match.ID]
+		if doc == nil || !doc.matches(params) {
+			continue
+		}
+		matched = append(matched, &biz.PictureSearchHit{PictureID: doc.ID, Score: match.Score})
+	}
+
+	total := int64(len(matched))
+	var nextCursor string
+	if params.CursorMode {
+		start := min(cursorOffset(params.After), total)
+		end := min(start+params.PageSize, total)
+		matched = matched[start:end]
+		nextCursor = offsetNextCursor(pictureCursorParams(params), start, end-start, end < total)
+	} else if params.Current > 0 && params.PageSize > 0 {
+		start := min((params.Current-1)*params.PageSize, total)
+		matched = matched[start:min(start+params.PageSize, total)]
+	}
+	hits := make([]*biz.PictureSearchHit, 0, len(matched))
+	for _, hit := range matched {
+		hits = append(hits, s.docs[hit.PictureID].toHit(params.SearchText, hit.Score))
+	}
+	return &biz.PictureSearchResult{Total: total, Hits: hits, NextCursor: nextCursor}, nil
+}
+
+// CountFacets 在内存中统计检索结果在各分面上的分布
+func (s *memoryPictureSearcher) CountFacets(ctx context.Context, params *biz.PictureQueryParams) (*biz.PictureFacets, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	categoryParams := params.ForFacet(biz.FacetCategory)
+	tagParams := params.ForFacet(biz.FacetTag)
+	formatParams := params.ForFacet(biz.FacetFormat)
+	sizeParams := params.ForFacet(biz.FacetSize)
+	scaleParams := params.ForFacet(biz.FacetScale)
+
+	categories := make(map[string]int64)
+	tags := make(map[string]int64)
+	formats := make(map[string]int64)
+	sizes := make(map[string]int64)
+	scales := make(map[string]int64)
+	for _, match := range s.index.Search(params.SearchText) {
+		doc := s.docs[match.ID]
+		if doc == nil {
+			continue
+		}
+		if doc.Category != "" && doc.matches(categoryParams) {
+			categories[doc.Category]++
+		}
+		if doc.matches(tagParams) {
+			for _, tag := range slices.Compact(slices.Sorted(slices.Values(doc.tagList))) {
+				tags[tag]++
+			}
+		}
+		if doc.PicFormat != "" && doc.matches(formatParams) {
+			formats[doc.PicFormat]++
+		}
+		if doc.matches(sizeParams) {
+			if r := findRange(biz.PictureSizeRanges, float64(doc.PicSize)); r != nil {
+				sizes[r.Key]++
+			}
+		}
+		if doc.PicScale > 0 && doc.matches(scaleParams) {
+			if r := findRange(biz.PictureScaleRanges, doc.PicScale); r != nil {
+				scales[r.Key]++
+			}
+		}
+	}
+
+	return &biz.PictureFacets{
+		Categories:  topFacetCounts(categories),
+		Tags:        topFacetCounts(tags),
+		Formats:     topFacetCounts(formats),
+		SizeRanges:  rangeFacetCounts(biz.PictureSizeRanges, sizes),
+		ScaleRanges: rangeFacetCounts(biz.PictureScaleRanges, scales),
+	}, nil
+}
+
+// findRange 查找数值所属的区间
+func findRange(ranges []*biz.PictureRange, v float64) *biz.PictureRange {
+	for _, r := range ranges {
+		if r.Contains(v) {
+			return r
+		}
+	}
+	return nil
+}
+
+// matches 是否满足名称、简介、分类、用户、空间、审核状态、标签、颜色等过滤条件，与 applyPictureFilters 保持一致
+func (d *pictureSearchDoc) matches(params *biz.PictureQueryParams) bool {
+	if params.Name != "" && !strings.Contains(strings.ToLower(d.Name), strings.ToLower(params.Name)) {
+		return false
+	}
+	if params.Introduction != "" && !strings.Contains(strings.ToLower(d.Introduction), strings.ToLower(params.Introduction)) {
+		return false
+	}
+	if params.Category != "" && d.Category != params.Category {
+		return false
+	}
+	if params.UserID != nil && d.UserID != *params.UserID {
+		return false
+	}
+	if params.SpaceID > 0 && columnToSpaceID(d.SpaceID) != params.SpaceID {
+		return false
+	}
+	if params.ReviewStatus != nil && d.ReviewStatus != int32(*params.ReviewStatus) {
+		return false
+	}
+	if params.PassedOnly && d.ReviewStatus != int32(biz.ReviewStatusPass) && d.UserID != params.ViewerID {
+		return false
+	}
+	for _, tag := range params.Tags {
+		if !slices.Contains(d.tagList, tag) {
+			return false
+		}
+	}
+	if params.Color != nil {
+		lab := columnsToLab(d.ColorL, d.ColorA, d.ColorB)
+		if lab == nil {
+			return false
+		}
+		dl, da, db := lab.L-params.Color.L, lab.A-params.Color.A, lab.B-params.Color.B
+		if dl*dl+da*da+db*db > params.MaxColorDistance*params.MaxColorDistance {
+			return false
+		}
+	}
+	if params.PicFormat != "" && d.PicFormat != params.PicFormat {
+		return false
+	}
+	if params.SizeRange != nil && !params.SizeRange.Contains(float64(d.PicSize)) {
+		return false
+	}
+	if params.ScaleRange != nil && (d.PicScale <= 0 || !params.ScaleRange.Contains(d.PicScale)) {
+		return false
+	}
+	return true
+}
+
+// IndexPicture 从数据库读取图片并更新索引，图片不存在或已删除时删除索引
+func (s *memoryPictureSearcher) IndexPicture(ctx context.Context, id int64) error {
+	docs, err := s.loadDocs(ctx, s.data.DB(ctx).Where("picture.id = ?", id))
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(docs) == 0 {
+		s.remove(id)
+		return nil
+	}
+	s.add(docs[0])
+	return nil
+}
+
+// RemovePicture 删除图片的索引
+func (s *memoryPictureSearcher) RemovePicture(ctx context.Context, id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remove(id)
+	return nil
+}
+
+// IndexUserPictures 重新索引用户的所有图片
+func (s *memoryPictureSearcher) IndexUserPictures(ctx context.Context, userID int64) error {
+	docs, err := s.loadDocs(ctx, s.data.DB(ctx).Where("picture.userId = ?", userID))
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, doc := range docs {
+		s.add(doc)
+	}
+	return nil
+}
+
+// rebuild 分批读取所有未删除的图片构建索引
+func (s *memoryPictureSearcher) rebuild(ctx context.Context) error {
+	var lastID int64
+	for {
+		docs, err := s.loadDocs(ctx, s.data.DB(ctx).
+			Where("picture.id > ?", lastID).
+			Order("picture.id").
+			Limit(searchIndexBatchSize))
+		if err != nil {
+			return err
+		}
+
+		s.mu.Lock()
+		for _, doc := range docs {
+			s.add(doc)
+		}
+		s.mu.Unlock()
+
+		if len(docs) < searchIndexBatchSize {
+			break
+		}
+		lastID = docs[len(docs)-1].ID
+	}
+	s.log.Infof("图片检索索引构建完成: count=%d", s.index.Len())
+	return nil
+}
+
+// loadDocs 查询满足条件的未删除图片及其作者昵称
+func (s *memoryPictureSearcher) loadDocs(ctx context.Context, query *gorm.DB) ([]*pictureSearchDoc, error) {
+	var docs []*pictureSearchDoc
+	err := query.Model(&Picture{}).
+		Select("picture.id, picture.name, picture.introduction, picture.category, picture.tags, picture.userId, picture.spaceId, picture.reviewStatus, " +
+			"picture.colorL, picture.colorA, picture.colorB, picture.picFormat, picture.picSize, picture.picScale, `user`.userName").
+		Joins("LEFT JOIN `user` ON `user`.id = picture.userId").
+		Where("picture.isDelete = 0").
+		Find(&docs).Error
+	if err != nil {
+		s.log.Errorf("查询图片检索信息失败: %v", err)
+		return nil, err
+	}
+	for _, doc := range docs {
+		doc.tagList = tagsFromJSON(doc.Tags)
+	}
+	return docs, nil
+}
+
+// add 新增或替换图片的索引，调用方需持有写锁
+func (s *memoryPictureSearcher) add(doc *pictureSearchDoc) {
+	fields := make([]pkg.SearchField, 0, len(searchFieldBoosts))
+	for field, text := range doc.fields() {
+		fields = append(fields, pkg.SearchField{Text: text, Boost: searchFieldBoosts[field]})
+	}
+	s.index.Index(doc.ID, fields)
+	s.docs[doc.ID] = doc
+}
+
+// remove 删除图片的索引，调用方需持有写锁
+func (s *memoryPictureSearcher) remove(id int64) {
+	s.index.Remove(id)
+	delete(s.docs, id)
+}
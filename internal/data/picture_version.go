@@ -0,0 +1,310 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// pictureSnapshot 历史版本中保存的图片信息
+type pictureSnapshot struct {
+	URL          string   `json:"url"`
+	Name         string   `json:"name"`
+	Introduction string   `json:"introduction"`
+	Category     string   `json:"category"`
+	Tags         string   `json:"tags"`
+	PicSize      int64    `json:"picSize"`
+	PicWidth     int32    `json:"picWidth"`
+	PicHeight    int32    `json:"picHeight"`
+	PicScale     float64  `json:"picScale"`
+	PicFormat    string   `json:"picFormat"`
+	PHash        *uint64  `json:"pHash,omitempty"`
+	PicColor     string   `json:"picColor,omitempty"`
+	PicPalette   string   `json:"picPalette,omitempty"`
+	ColorL       *float64 `json:"colorL,omitempty"`
+	ColorA       *float64 `json:"colorA,omitempty"`
+	ColorB       *float64 `json:"colorB,omitempty"`
+	PicMetadata  string   `json:"picMetadata,omitempty"`
+}
+
+// pictureChange 历史版本中保存的字段修改
+type pictureChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+type pictureVersionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewPictureVersionRepo 创建图片历史版本仓储
+func NewPictureVersionRepo(data *Data, logger log.Logger) biz.PictureVersionRepo {
+	return &pictureVersionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateVersion 创建历史版本，版本号为该图片当前最大版本号加一
+func (r *pictureVersionRepo) CreateVersion(ctx context.Context, version *biz.PictureVersion) error {
+	entity, err := r.convertToEntity(version)
+	if err != nil {
+		r.log.Errorf("序列化图片历史版本失败: %v", err)
+		return err
+	}
+
+	db := r.data.DB(ctx)
+	if err := db.Model(&PictureVersion{}).
+		Where("pictureId = ?", version.PictureID).
+		Select("COALESCE(MAX(version), 0) + 1").
+		Scan(&entity.Version).Error; err != nil {
+		r.log.Errorf("查询图片最大版本号失败: %v", err)
+		return err
+	}
+	if err := db.Create(entity).Error; err != nil {
+		r.log.Errorf("创建图片历史版本失败: %v", err)
+		return err
+	}
+
+	version.ID = entity.ID
+	version.Version = entity.Version
+	version.CreateTime = entity.CreateTime
+	return nil
+}
+
+// GetVersionByID 根据 ID 查询历史版本
+func (r *pictureVersionRepo) GetVersionByID(ctx context.Context, id int64) (*biz.PictureVersion, error) {
+	var entity PictureVersion
+	err := r.data.DB(ctx).Where("id = ?", id).First(&entity).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("查询图片历史版本失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToVersion(&entity), nil
+}
+
+// ListVersions 按版本号倒序分页查询图片的历史版本
+func (r *pictureVersionRepo) ListVersions(ctx context.Context, pictureID, current, pageSize int64) ([]*biz.PictureVersion, int64, error) {
+	query := r.data.DB(ctx).Model(&PictureVersion{}).Where("pictureId = ?", pictureID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("统计图片历史版本失败: %v", err)
+		return nil, 0, err
+	}
+
+	var entities []PictureVersion
+	if err := query.Order("version DESC").
+		Offset(int((current - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&entities).Error; err != nil {
+		r.log.Errorf("查询图片历史版本失败: %v", err)
+		return nil, 0, err
+	}
+
+	list := make([]*biz.PictureVersion, 0, len(entities))
+	for i := range entities {
+		list = append(list, r.convertToVersion(&entities[i]))
+	}
+	return list, total, nil
+}
+
+// PruneVersions 删除超出保留数量或保留时间的历史版本，返回被删除版本引用的图片地址（去重）
+func (r *pictureVersionRepo) PruneVersions(ctx context.Context, pictureID int64, keep int, before time.Time) ([]string, error) {
+	db := r.data.DB(ctx)
+	var urls []string
+
+	if !before.IsZero() {
+		pruned, err := r.deleteVersions(ctx, "pictureId = ? AND createTime < ?", pictureID, before)
+		urls = append(urls, pruned...)
+		if err != nil {
+			r.log.Errorf("清理过期图片历史版本失败: %v", err)
+			return uniqueStrings(urls), err
+		}
+	}
+
+	if keep > 0 {
+		// 保留最新的 keep 个版本，删除第 keep+1 个及更早的版本
+		var versions []int64
+		if err := db.Model(&PictureVersion{}).
+			Where("pictureId = ?", pictureID).
+			Order("version DESC").
+			Offset(keep).
+			Limit(1).
+			Pluck("version", &versions).Error; err != nil {
+			r.log.Errorf("查询图片历史版本失败: %v", err)
+			return uniqueStrings(urls), err
+		}
+		if len(versions) > 0 {
+			pruned, err := r.deleteVersions(ctx, "pictureId = ? AND version <= ?", pictureID, versions[0])
+			urls = append(urls, pruned...)
+			if err != nil {
+				r.log.Errorf("清理超出数量的图片历史版本失败: %v", err)
+				return uniqueStrings(urls), err
+			}
+		}
+	}
+
+	return uniqueStrings(urls), nil
+}
+
+// ListReferencedURLs 返回 urls 中仍被图片（包括已删除的图片）或历史版本引用的地址
+func (r *pictureVersionRepo) ListReferencedURLs(ctx context.Context, urls []string) ([]string, error) {
+	if len(urls) == 0 {
+		return nil, nil
+	}
+
+	db := r.data.DB(ctx)
+	var pictureURLs []string
+	if err := db.Model(&Picture{}).Where("url IN ?", urls).Distinct().Pluck("url", &pictureURLs).Error; err != nil {
+		r.log.Errorf("查询图片地址引用失败: %v", err)
+		return nil, err
+	}
+	var versionURLs []string
+	if err := db.Model(&PictureVersion{}).Where("url IN ?", urls).Distinct().Pluck("url", &versionURLs).Error; err != nil {
+		r.log.Errorf("查询历史版本地址引用失败: %v", err)
+		return nil, err
+	}
+	return uniqueStrings(append(pictureURLs, versionURLs...)), nil
+}
+
+// deleteVersions 删除匹配条件的历史版本，返回被删除版本引用的图片地址
+func (r *pictureVersionRepo) deleteVersions(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	db := r.data.DB(ctx)
+	var entities []PictureVersion
+	if err := db.Select("id", "url").Where(query, args...).Find(&entities).Error; err != nil {
+		return nil, err
+	}
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(entities))
+	urls := make([]string, 0, len(entities))
+	for _, entity := range entities {
+		ids = append(ids, entity.ID)
+		if entity.URL != "" {
+			urls = append(urls, entity.URL)
+		}
+	}
+	if err := db.Where("id IN ?", ids).Delete(&PictureVersion{}).Error; err != nil {
+		return nil, err
+	}
+	return urls, nil
+}
+
+// uniqueStrings 去重并保持原有顺序
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+	return result
+}
+
+// convertToEntity 转换业务对象为实体
+func (r *pictureVersionRepo) convertToEntity(version *biz.PictureVersion) (*PictureVersion, error) {
+	picture := version.Snapshot
+	snapshot := pictureSnapshot{
+		URL:          picture.URL,
+		Name:         picture.Name,
+		Introduction: picture.Introduction,
+		Category:     picture.Category,
+		Tags:         picture.Tags,
+		PicSize:      picture.PicSize,
+		PicWidth:     picture.PicWidth,
+		PicHeight:    picture.PicHeight,
+		PicScale:     picture.PicScale,
+		PicFormat:    picture.PicFormat,
+		PHash:        picture.PHash,
+		PicColor:     picture.PicColor,
+		PicPalette:   picture.PicPalette,
+		PicMetadata:  picture.PicMetadata,
+	}
+	snapshot.ColorL, snapshot.ColorA, snapshot.ColorB = labToColumns(picture.ColorLab)
+	snapshotBytes, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]pictureChange, 0, len(version.Changes))
+	for _, change := range version.Changes {
+		changes = append(changes, pictureChange{Field: change.Field, Old: change.Old, New: change.New})
+	}
+	changesBytes, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PictureVersion{
+		PictureID:  version.PictureID,
+		Operation:  string(version.Operation),
+		OperatorID: version.OperatorID,
+		URL:        picture.URL,
+		Snapshot:   string(snapshotBytes),
+		Changes:    string(changesBytes),
+	}, nil
+}
+
+// convertToVersion 转换实体为业务对象
+func (r *pictureVersionRepo) convertToVersion(entity *PictureVersion) *biz.PictureVersion {
+	var snapshot pictureSnapshot
+	if err := json.Unmarshal([]byte(entity.Snapshot), &snapshot); err != nil {
+		r.log.Warnf("解析图片历史版本失败: id=%d, err=%v", entity.ID, err)
+		snapshot.URL = entity.URL
+	}
+	var changes []pictureChange
+	if entity.Changes != "" {
+		if err := json.Unmarshal([]byte(entity.Changes), &changes); err != nil {
+			r.log.Warnf("解析图片历史版本修改字段失败: id=%d, err=%v", entity.ID, err)
+		}
+	}
+
+	version := &biz.PictureVersion{
+		ID:         entity.ID,
+		PictureID:  entity.PictureID,
+		Version:    entity.Version,
+		Operation:  biz.PictureVersionOperation(entity.Operation),
+		OperatorID: entity.OperatorID,
+		Snapshot: &biz.Picture{
+			ID:           entity.PictureID,
+			URL:          snapshot.URL,
+			Name:         snapshot.Name,
+			Introduction: snapshot.Introduction,
+			Category:     snapshot.Category,
+			Tags:         snapshot.Tags,
+			PicSize:      snapshot.PicSize,
+			PicWidth:     snapshot.PicWidth,
+			PicHeight:    snapshot.PicHeight,
+			PicScale:     snapshot.PicScale,
+			PicFormat:    snapshot.PicFormat,
+			PHash:        snapshot.PHash,
+			PicColor:     snapshot.PicColor,
+			PicPalette:   snapshot.PicPalette,
+			ColorLab:     columnsToLab(snapshot.ColorL, snapshot.ColorA, snapshot.ColorB),
+			PicMetadata:  snapshot.PicMetadata,
+		},
+		Changes:    make([]*biz.PictureChange, 0, len(changes)),
+		CreateTime: entity.CreateTime,
+	}
+	for _, change := range changes {
+		version.Changes = append(version.Changes, &biz.PictureChange{Field: change.Field, Old: change.Old, New: change.New})
+	}
+	return version
+}
@@ -0,0 +1,23 @@
+package data
+
+import (
+	"time"
+)
+
+// PictureVersion 图片历史版本实体，创建后不再修改
+type PictureVersion struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	PictureID  int64     `gorm:"column:pictureId;not null;uniqueIndex:uk_pictureId_version" json:"pictureId"`
+	Version    int64     `gorm:"column:version;not null;uniqueIndex:uk_pictureId_version" json:"version"`
+	Operation  string    `gorm:"column:operation;type:varchar(32);not null" json:"operation"`
+	OperatorID int64     `gorm:"column:operatorId;not null" json:"operatorId"`
+	URL        string    `gorm:"column:url;type:varchar(512);index:idx_url" json:"url"` // 修改前的图片地址
+	Snapshot   string    `gorm:"column:snapshot;type:text" json:"snapshot"`             // 修改前的图片信息（JSON）
+	Changes    string    `gorm:"column:changes;type:text" json:"changes"`               // 修改的字段（JSON 数组）
+	CreateTime time.Time `gorm:"column:createTime;autoCreateTime;index:idx_createTime" json:"createTime"`
+}
+
+// TableName 指定表名
+func (PictureVersion) TableName() string {
+	return "picture_version"
+}
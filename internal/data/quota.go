@@ -0,0 +1,116 @@
+package data
+
+import (
+	"context"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type quotaRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewQuotaRepo 创建配额仓储
+func NewQuotaRepo(data *Data, logger log.Logger) biz.QuotaRepo {
+	return &quotaRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// GetUserQuota 查询用户用量，尚未初始化时返回 nil
+func (r *quotaRepo) GetUserQuota(ctx context.Context, userID int64) (*biz.UserQuota, error) {
+	var entity UserQuota
+	err := r.data.DB(ctx).Where("userId = ?", userID).First(&entity).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("查询用户存储用量失败: %v", err)
+		return nil, err
+	}
+
+	return &biz.UserQuota{
+		UserID:          entity.UserID,
+		UsedBytes:       entity.UsedBytes,
+		PictureCount:    entity.PictureCount,
+		MaxBytes:        entity.MaxBytes,
+		MaxPictures:     entity.MaxPictures,
+		MaxDailyUploads: entity.MaxDailyUploads,
+	}, nil
+}
+
+// InitUserQuota 根据用户现有图片初始化用量，并发初始化时只有第一条生效
+func (r *quotaRepo) InitUserQuota(ctx context.Context, userID int64) error {
+	err := r.data.DB(ctx).Exec(
+		"INSERT IGNORE INTO user_quota (userId, usedBytes, pictureCount, createTime, updateTime) "+
+			"SELECT ?, COALESCE(SUM(picSize), 0), COUNT(*), NOW(), NOW() FROM picture WHERE userId = ? AND isDelete = 0",
+		userID, userID).Error
+
+	if err != nil {
+		r.log.Errorf("初始化用户存储用量失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// IncrUsage 增量更新用户用量，结果不会小于 0
+func (r *quotaRepo) IncrUsage(ctx context.Context, userID, bytesDelta, pictureDelta int64) error {
+	err := r.data.DB(ctx).
+		Model(&UserQuota{}).
+		Where("userId = ?", userID).
+		Updates(map[string]interface{}{
+			"usedBytes":    gorm.Expr("GREATEST(usedBytes + ?, 0)", bytesDelta),
+			"pictureCount": gorm.Expr("GREATEST(pictureCount + ?, 0)", pictureDelta),
+		}).Error
+
+	if err != nil {
+		r.log.Errorf("更新用户存储用量失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// IncrUsageWithinLimit 增量更新用户用量，增量为正的项更新后不能超过对应的限制，超出时不更新并返回 false
+func (r *quotaRepo) IncrUsageWithinLimit(ctx context.Context, userID, bytesDelta, pictureDelta int64, limit biz.QuotaLimit) (bool, error) {
+	query := r.data.DB(ctx).Model(&UserQuota{}).Where("userId = ?", userID)
+	if bytesDelta > 0 && limit.MaxBytes > 0 {
+		query = query.Where("usedBytes + ? <= ?", bytesDelta, limit.MaxBytes)
+	}
+	if pictureDelta > 0 && limit.MaxPictures > 0 {
+		query = query.Where("pictureCount + ? <= ?", pictureDelta, limit.MaxPictures)
+	}
+
+	result := query.Updates(map[string]interface{}{
+		"usedBytes":    gorm.Expr("GREATEST(usedBytes + ?, 0)", bytesDelta),
+		"pictureCount": gorm.Expr("GREATEST(pictureCount + ?, 0)", pictureDelta),
+	})
+	if result.Error != nil {
+		r.log.Errorf("更新用户存储用量失败: %v", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// SetQuotaOverride 设置管理员单独指定的配额，nil 表示恢复默认配额
+func (r *quotaRepo) SetQuotaOverride(ctx context.Context, userID int64, maxBytes, maxPictures, maxDailyUploads *int64) error {
+	err := r.data.DB(ctx).
+		Model(&UserQuota{}).
+		Where("userId = ?", userID).
+		Updates(map[string]interface{}{
+			"maxBytes":        maxBytes,
+			"maxPictures":     maxPictures,
+			"maxDailyUploads": maxDailyUploads,
+		}).Error
+
+	if err != nil {
+		r.log.Errorf("设置用户配额失败: %v", err)
+		return err
+	}
+	return nil
+}
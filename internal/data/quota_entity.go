@@ -0,0 +1,22 @@
+package data
+
+import (
+	"time"
+)
+
+// UserQuota 用户存储用量及配额实体
+type UserQuota struct {
+	UserID          int64     `gorm:"column:userId;primaryKey;autoIncrement:false" json:"userId"`
+	UsedBytes       int64     `gorm:"column:usedBytes;not null;default:0" json:"usedBytes"`
+	PictureCount    int64     `gorm:"column:pictureCount;not null;default:0" json:"pictureCount"`
+	MaxBytes        *int64    `gorm:"column:maxBytes" json:"maxBytes"`
+	MaxPictures     *int64    `gorm:"column:maxPictures" json:"maxPictures"`
+	MaxDailyUploads *int64    `gorm:"column:maxDailyUploads" json:"maxDailyUploads"`
+	CreateTime      time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+	UpdateTime      time.Time `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
+}
+
+// TableName 指定表名
+func (UserQuota) TableName() string {
+	return "user_quota"
+}
@@ -0,0 +1,260 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// sharePasswordFailureKeyPrefix 分享链接密码错误次数的 Redis key 前缀
+const sharePasswordFailureKeyPrefix = "share:password_failure:"
+
+type shareRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewShareRepo 创建分享链接仓储
+func NewShareRepo(data *Data, logger log.Logger) biz.ShareRepo {
+	return &shareRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateShareLink 创建分享链接
+func (r *shareRepo) CreateShareLink(ctx context.Context, link *biz.ShareLink) (*biz.ShareLink, error) {
+	entity := &ShareLink{
+		UserID:       link.UserID,
+		Token:        link.Token,
+		TargetType:   string(link.TargetType),
+		TargetID:     link.TargetID,
+		PasswordHash: link.PasswordHash,
+		MaxViews:     link.MaxViews,
+		ExpireTime:   link.ExpireTime,
+	}
+	if err := r.data.DB(ctx).Create(entity).Error; err != nil {
+		r.log.Errorf("创建分享链接失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToShareLink(entity), nil
+}
+
+// GetShareLinkByID 根据 ID 查询分享链接
+func (r *shareRepo) GetShareLinkByID(ctx context.Context, id int64) (*biz.ShareLink, error) {
+	return r.getShareLink(ctx, "id = ?", id)
+}
+
+// GetShareLinkByToken 根据 token 查询分享链接
+func (r *shareRepo) GetShareLinkByToken(ctx context.Context, token string) (*biz.ShareLink, error) {
+	return r.getShareLink(ctx, "token = ?", token)
+}
+
+// getShareLink 按条件查询单个分享链接，不存在时返回 nil
+func (r *shareRepo) getShareLink(ctx context.Context, query string, args ...interface{}) (*biz.ShareLink, error) {
+	var entity ShareLink
+	err := r.data.DB(ctx).Where(query, args...).First(&entity).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("查询分享链接失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToShareLink(&entity), nil
+}
+
+// RevokeShareLink 撤销分享链接
+func (r *shareRepo) RevokeShareLink(ctx context.Context, id int64) error {
+	err := r.data.DB(ctx).
+		Model(&ShareLink{}).
+		Where("id = ?", id).
+		Update("isRevoked", 1).Error
+
+	if err != nil {
+		r.log.Errorf("撤销分享链接失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ListShareLinksByUser 分页查询用户创建的分享链接（按创建时间倒序）
+func (r *shareRepo) ListShareLinksByUser(ctx context.Context, userID int64, targetType biz.ShareTargetType, targetID, current, pageSize int64) ([]*biz.ShareLink, int64, error) {
+	var total int64
+	var entities []ShareLink
+
+	query := r.data.DB(ctx).Model(&ShareLink{}).Where("userId = ?", userID)
+	if targetType != "" {
+		query = query.Where("targetType = ?", string(targetType))
+	}
+	if targetID > 0 {
+		query = query.Where("targetId = ?", targetID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("统计分享链接总数失败: %v", err)
+		return nil, 0, err
+	}
+
+	offset := (current - 1) * pageSize
+	if err := query.Order("id DESC").
+		Offset(int(offset)).
+		Limit(int(pageSize)).
+		Find(&entities).Error; err != nil {
+		r.log.Errorf("查询分享链接列表失败: %v", err)
+		return nil, 0, err
+	}
+
+	links := make([]*biz.ShareLink, 0, len(entities))
+	for i := range entities {
+		links = append(links, r.convertToShareLink(&entities[i]))
+	}
+	return links, total, nil
+}
+
+// IncrViewCount 未超过最大访问次数时访问次数加一，返回是否成功
+func (r *shareRepo) IncrViewCount(ctx context.Context, id int64) (bool, error) {
+	result := r.data.DB(ctx).
+		Model(&ShareLink{}).
+		Where("id = ? AND (maxViews = 0 OR viewCount < maxViews)", id).
+		UpdateColumn("viewCount", gorm.Expr("viewCount + 1"))
+
+	if result.Error != nil {
+		r.log.Errorf("累加分享链接访问次数失败: %v", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// CreateAccessLog 记录一次访问
+func (r *shareRepo) CreateAccessLog(ctx context.Context, accessLog *biz.ShareAccessLog) error {
+	entity := &ShareAccessLog{
+		ShareLinkID: accessLog.ShareLinkID,
+		IP:          truncateString(accessLog.IP, 64),
+		UserAgent:   truncateString(accessLog.UserAgent, 512),
+		Result:      string(accessLog.Result),
+	}
+	if err := r.data.DB(ctx).Create(entity).Error; err != nil {
+		r.log.Errorf("记录分享链接访问失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetShareLinkStats 统计分享链接的访问记录
+func (r *shareRepo) GetShareLinkStats(ctx context.Context, id int64, since time.Time) (*biz.ShareLinkStats, error) {
+	var summary struct {
+		UniqueVisitorCount int64      `gorm:"column:uniqueVisitorCount"`
+		DeniedCount        int64      `gorm:"column:deniedCount"`
+		LastAccessTime     *time.Time `gorm:"column:lastAccessTime"`
+	}
+	success := string(biz.ShareAccessSuccess)
+	err := r.data.DB(ctx).
+		Model(&ShareAccessLog{}).
+		Select("COUNT(DISTINCT CASE WHEN result = ? THEN ip END) AS uniqueVisitorCount, "+
+			"COALESCE(SUM(CASE WHEN result <> ? THEN 1 ELSE 0 END), 0) AS deniedCount, "+
+			"MAX(CASE WHEN result = ? THEN createTime END) AS lastAccessTime", success, success, success).
+		Where("shareLinkId = ?", id).
+		Scan(&summary).Error
+	if err != nil {
+		r.log.Errorf("统计分享链接访问失败: %v", err)
+		return nil, err
+	}
+
+	var rows []struct {
+		Date  string `gorm:"column:date"`
+		Count int64  `gorm:"column:count"`
+	}
+	err = r.data.DB(ctx).
+		Model(&ShareAccessLog{}).
+		Select("DATE_FORMAT(createTime, '%Y-%m-%d') AS date, COUNT(*) AS count").
+		Where("shareLinkId = ? AND result = ? AND createTime >= ?", id, success, since).
+		Group("date").
+		Scan(&rows).Error
+	if err != nil {
+		r.log.Errorf("统计分享链接每日访问失败: %v", err)
+		return nil, err
+	}
+
+	stats := &biz.ShareLinkStats{
+		UniqueVisitorCount: summary.UniqueVisitorCount,
+		DeniedCount:        summary.DeniedCount,
+		LastAccessTime:     summary.LastAccessTime,
+		Daily:              make([]*biz.ShareDailyStat, 0, len(rows)),
+	}
+	for _, row := range rows {
+		stats.Daily = append(stats.Daily, &biz.ShareDailyStat{Date: row.Date, Count: row.Count})
+	}
+	return stats, nil
+}
+
+// GetPasswordFailures 查询 IP 在统计窗口内对分享链接输错密码的次数，ip 为空时查询该链接所有 IP 的合计次数
+func (r *shareRepo) GetPasswordFailures(ctx context.Context, token, ip string) (int64, error) {
+	count, err := r.data.rdb.Get(ctx, r.getPasswordFailureKey(token, ip)).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// IncrPasswordFailures 累加 IP 输错密码的次数，ip 为空时累加该链接的合计次数，首次累加时设置统计窗口
+func (r *shareRepo) IncrPasswordFailures(ctx context.Context, token, ip string, window time.Duration) (int64, error) {
+	key := r.getPasswordFailureKey(token, ip)
+	count, err := r.data.rdb.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if count == 1 {
+		if err := r.data.rdb.Expire(ctx, key, window).Err(); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// getPasswordFailureKey 获取密码错误次数的 Redis key，ip 为空时为链接维度的合计次数
+func (r *shareRepo) getPasswordFailureKey(token, ip string) string {
+	if ip == "" {
+		return sharePasswordFailureKeyPrefix + token
+	}
+	return fmt.Sprintf("%s%s:%s", sharePasswordFailureKeyPrefix, token, ip)
+}
+
+// convertToShareLink 转换实体为业务对象
+func (r *shareRepo) convertToShareLink(entity *ShareLink) *biz.ShareLink {
+	return &biz.ShareLink{
+		ID:           entity.ID,
+		UserID:       entity.UserID,
+		Token:        entity.Token,
+		TargetType:   biz.ShareTargetType(entity.TargetType),
+		TargetID:     entity.TargetID,
+		PasswordHash: entity.PasswordHash,
+		MaxViews:     entity.MaxViews,
+		ViewCount:    entity.ViewCount,
+		IsRevoked:    entity.IsRevoked == 1,
+		ExpireTime:   entity.ExpireTime,
+		CreateTime:   entity.CreateTime,
+		UpdateTime:   entity.UpdateTime,
+	}
+}
+
+// truncateString 按字符截断字符串，避免超出列长度
+func truncateString(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	return string(runes[:maxLen])
+}
@@ -0,0 +1,41 @@
+package data
+
+import (
+	"time"
+)
+
+// ShareLink 分享链接实体
+type ShareLink struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	UserID       int64     `gorm:"column:userId;not null;index:idx_userId" json:"userId"`
+	Token        string    `gorm:"column:token;type:varchar(64);not null;uniqueIndex:uk_token" json:"token"`
+	TargetType   string    `gorm:"column:targetType;type:varchar(16);not null;index:idx_target" json:"targetType"`
+	TargetID     int64     `gorm:"column:targetId;not null;index:idx_target" json:"targetId"`
+	PasswordHash string    `gorm:"column:passwordHash;type:varchar(64)" json:"-"`
+	MaxViews     int64     `gorm:"column:maxViews;not null;default:0" json:"maxViews"`
+	ViewCount    int64     `gorm:"column:viewCount;not null;default:0" json:"viewCount"`
+	IsRevoked    int8      `gorm:"column:isRevoked;not null;default:0" json:"isRevoked"`
+	ExpireTime   time.Time `gorm:"column:expireTime;not null" json:"expireTime"`
+	CreateTime   time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+	UpdateTime   time.Time `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
+}
+
+// TableName 指定表名
+func (ShareLink) TableName() string {
+	return "share_link"
+}
+
+// ShareAccessLog 分享链接访问记录实体
+type ShareAccessLog struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	ShareLinkID int64     `gorm:"column:shareLinkId;not null;index:idx_shareLinkId_createTime" json:"shareLinkId"`
+	IP          string    `gorm:"column:ip;type:varchar(64)" json:"ip"`
+	UserAgent   string    `gorm:"column:userAgent;type:varchar(512)" json:"userAgent"`
+	Result      string    `gorm:"column:result;type:varchar(32);not null" json:"result"`
+	CreateTime  time.Time `gorm:"column:createTime;autoCreateTime;index:idx_shareLinkId_createTime" json:"createTime"`
+}
+
+// TableName 指定表名
+func (ShareAccessLog) TableName() string {
+	return "share_access_log"
+}
@@ -0,0 +1,194 @@
+package data
+
+import (
+	"context"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type spaceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSpaceRepo 创建空间仓储
+func NewSpaceRepo(data *Data, logger log.Logger) biz.SpaceRepo {
+	return &spaceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateSpace 创建空间
+func (r *spaceRepo) CreateSpace(ctx context.Context, space *biz.Space) (*biz.Space, error) {
+	entity := r.convertToEntity(space)
+	if err := r.data.DB(ctx).Create(entity).Error; err != nil {
+		r.log.Errorf("创建空间失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToSpace(entity), nil
+}
+
+// GetSpaceByID 根据 ID 查询空间
+func (r *spaceRepo) GetSpaceByID(ctx context.Context, id int64) (*biz.Space, error) {
+	var entity Space
+	err := r.data.DB(ctx).
+		Where("id = ? AND isDelete = 0", id).
+		First(&entity).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("查询空间失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToSpace(&entity), nil
+}
+
+// UpdateSpace 更新空间信息
+func (r *spaceRepo) UpdateSpace(ctx context.Context, space *biz.Space) error {
+	err := r.data.DB(ctx).
+		Model(&Space{}).
+		Where("id = ? AND isDelete = 0", space.ID).
+		Updates(map[string]interface{}{
+			"spaceName":       space.SpaceName,
+			"duplicateMode":   string(space.DuplicateMode),
+			"maxSize":         space.MaxSize,
+			"maxCount":        space.MaxCount,
+			"versionMaxCount": space.VersionMaxCount,
+			"versionMaxDays":  space.VersionMaxDays,
+			"editTime":        space.EditTime,
+		}).Error
+
+	if err != nil {
+		r.log.Errorf("更新空间失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteSpace 删除空间（逻辑删除），空间中的图片移回公共图库
+func (r *spaceRepo) DeleteSpace(ctx context.Context, id int64) error {
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&Space{}).
+			Where("id = ? AND isDelete = 0", id).
+			Update("isDelete", 1).Error; err != nil {
+			return err
+		}
+		return tx.Model(&Picture{}).
+			Where("spaceId = ?", id).
+			Update("spaceId", nil).Error
+	})
+
+	if err != nil {
+		r.log.Errorf("删除空间失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ListSpaceByPage 分页查询空间（按创建时间倒序）
+func (r *spaceRepo) ListSpaceByPage(ctx context.Context, params *biz.SpaceQueryParams) ([]*biz.Space, int64, error) {
+	var total int64
+	var entities []Space
+
+	query := r.data.DB(ctx).Model(&Space{}).Where("isDelete = 0 AND userId = ?", params.UserID)
+	if params.SpaceName != "" {
+		query = query.Where("spaceName LIKE ?", "%"+params.SpaceName+"%")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("统计空间总数失败: %v", err)
+		return nil, 0, err
+	}
+
+	offset := (params.Current - 1) * params.PageSize
+	if err := query.Order("createTime DESC, id DESC").
+		Offset(int(offset)).
+		Limit(int(params.PageSize)).
+		Find(&entities).Error; err != nil {
+		r.log.Errorf("查询空间列表失败: %v", err)
+		return nil, 0, err
+	}
+
+	spaces := make([]*biz.Space, 0, len(entities))
+	for i := range entities {
+		spaces = append(spaces, r.convertToSpace(&entities[i]))
+	}
+	return spaces, total, nil
+}
+
+// CountUserSpaces 统计用户创建的未删除空间数
+func (r *spaceRepo) CountUserSpaces(ctx context.Context, userID int64) (int64, error) {
+	var count int64
+	err := r.data.DB(ctx).
+		Model(&Space{}).
+		Where("userId = ? AND isDelete = 0", userID).
+		Count(&count).Error
+
+	if err != nil {
+		r.log.Errorf("统计用户空间数失败: %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// IncrSpaceUsage 增量更新空间用量，增量为正的项更新后不能超过空间的上限，超出或空间已删除时不更新并返回 false
+func (r *spaceRepo) IncrSpaceUsage(ctx context.Context, id, sizeDelta, countDelta int64) (bool, error) {
+	query := r.data.DB(ctx).Model(&Space{}).Where("id = ? AND isDelete = 0", id)
+	if sizeDelta > 0 {
+		query = query.Where("(maxSize = 0 OR totalSize + ? <= maxSize)", sizeDelta)
+	}
+	if countDelta > 0 {
+		query = query.Where("(maxCount = 0 OR totalCount + ? <= maxCount)", countDelta)
+	}
+
+	result := query.Updates(map[string]interface{}{
+		"totalSize":  gorm.Expr("GREATEST(totalSize + ?, 0)", sizeDelta),
+		"totalCount": gorm.Expr("GREATEST(totalCount + ?, 0)", countDelta),
+	})
+	if result.Error != nil {
+		r.log.Errorf("更新空间用量失败: %v", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// convertToSpace 转换实体为业务对象
+func (r *spaceRepo) convertToSpace(entity *Space) *biz.Space {
+	return &biz.Space{
+		ID:              entity.ID,
+		UserID:          entity.UserID,
+		SpaceName:       entity.SpaceName,
+		DuplicateMode:   biz.DuplicateMode(entity.DuplicateMode),
+		MaxSize:         entity.MaxSize,
+		MaxCount:        entity.MaxCount,
+		TotalSize:       entity.TotalSize,
+		TotalCount:      entity.TotalCount,
+		VersionMaxCount: entity.VersionMaxCount,
+		VersionMaxDays:  entity.VersionMaxDays,
+		CreateTime:      entity.CreateTime,
+		EditTime:        entity.EditTime,
+		UpdateTime:      entity.UpdateTime,
+	}
+}
+
+// convertToEntity 转换业务对象为实体
+func (r *spaceRepo) convertToEntity(space *biz.Space) *Space {
+	return &Space{
+		ID:              space.ID,
+		UserID:          space.UserID,
+		SpaceName:       space.SpaceName,
+		DuplicateMode:   string(space.DuplicateMode),
+		MaxSize:         space.MaxSize,
+		MaxCount:        space.MaxCount,
+		VersionMaxCount: space.VersionMaxCount,
+		VersionMaxDays:  space.VersionMaxDays,
+	}
+}
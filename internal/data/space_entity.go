@@ -0,0 +1,29 @@
+package data
+
+import (
+	"time"
+)
+
+// Space 空间实体
+type Space struct {
+	ID            int64  `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	UserID        int64  `gorm:"column:userId;not null;index:idx_userId" json:"userId"`
+	SpaceName     string `gorm:"column:spaceName;type:varchar(128);not null" json:"spaceName"`
+	DuplicateMode string `gorm:"column:duplicateMode;type:varchar(16);not null;default:''" json:"duplicateMode"` // 为空时使用全局查重配置
+	MaxSize       int64  `gorm:"column:maxSize;not null;default:0" json:"maxSize"`                               // 0 表示不限
+	MaxCount      int64  `gorm:"column:maxCount;not null;default:0" json:"maxCount"`                             // 0 表示不限
+	TotalSize     int64  `gorm:"column:totalSize;not null;default:0" json:"totalSize"`
+	TotalCount    int64  `gorm:"column:totalCount;not null;default:0" json:"totalCount"`
+	// 历史版本保留策略，0 使用全局配置，负数表示不限制
+	VersionMaxCount int32     `gorm:"column:versionMaxCount;not null;default:0" json:"versionMaxCount"`
+	VersionMaxDays  int32     `gorm:"column:versionMaxDays;not null;default:0" json:"versionMaxDays"`
+	CreateTime      time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+	EditTime        time.Time `gorm:"column:editTime;autoCreateTime" json:"editTime"`
+	UpdateTime      time.Time `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
+	IsDelete        int8      `gorm:"column:isDelete;default:0" json:"isDelete"`
+}
+
+// TableName 指定表名
+func (Space) TableName() string {
+	return "space"
+}
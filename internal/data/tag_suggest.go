@@ -0,0 +1,275 @@
+package data
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+
+	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 标签建议排序权重的默认值
+const (
+	defaultTagSuggestGlobalWeight       = 1
+	defaultTagSuggestUserWeight         = 1.5
+	defaultTagSuggestCooccurrenceWeight = 2
+)
+
+// tagSuggestKey 可按前缀匹配的标签名称或别名
+type tagSuggestKey struct {
+	key   string // 小写的名称或别名
+	tagID int64
+}
+
+// tagSuggestStat 标签及其全站使用次数
+type tagSuggestStat struct {
+	name  string
+	count int64
+}
+
+// tagSuggestIndex 标签建议索引，构建后只读，刷新时整体替换
+type tagSuggestIndex struct {
+	keys     []tagSuggestKey           // 按 key 排序，用于前缀查找
+	byKey    map[string]int64          // 小写的名称或别名 -> 标签 ID，用于解析已选标签
+	tags     map[int64]*tagSuggestStat // 标签 ID -> 标签
+	userTags map[int64]map[int64]int64 // 用户 ID -> 标签 ID -> 使用次数
+	cooccur  map[int64]map[int64]int64 // 标签 ID -> 同一图片上的其他标签 ID -> 次数
+	maxCount int64                     // 使用次数最多的标签的次数
+}
+
+// tagSuggester 进程内的标签建议索引，由 TagSuggestRefreshServer 定时从图片数据刷新
+type tagSuggester struct {
+	data               *Data
+	log                *log.Helper
+	globalWeight       float64
+	userWeight         float64
+	cooccurrenceWeight float64
+
+	mu    sync.RWMutex
+	index *tagSuggestIndex
+}
+
+// NewTagSuggester 创建标签建议索引，索引在首次刷新前为空
+func NewTagSuggester(data *Data, bc *conf.Bootstrap, logger log.Logger) biz.TagSuggester {
+	c := bc.GetTagSuggest()
+	s := &tagSuggester{
+		data:               data,
+		log:                log.NewHelper(logger),
+		globalWeight:       c.GetGlobalWeight(),
+		userWeight:         c.GetUserWeight(),
+		cooccurrenceWeight: c.GetCooccurrenceWeight(),
+		index:              &tagSuggestIndex{},
+	}
+	if s.globalWeight <= 0 {
+		s.globalWeight = defaultTagSuggestGlobalWeight
+	}
+	if s.userWeight <= 0 {
+		s.userWeight = defaultTagSuggestUserWeight
+	}
+	if s.cooccurrenceWeight <= 0 {
+		s.cooccurrenceWeight = defaultTagSuggestCooccurrenceWeight
+	}
+	return s
+}
+
+// Suggest 按前缀匹配标签名称和别名，前缀为空时只返回有使用记录的标签
+// 得分 = 全站权重 × 全站使用次数（对数归一化）+ 用户权重 × 用户使用次数（对数归一化）+ 共现权重 × 与已选标签同时出现的最大比例
+func (s *tagSuggester) Suggest(ctx context.Context, params *biz.TagSuggestParams) ([]*biz.TagSuggestion, error) {
+	s.mu.RLock()
+	idx := s.index
+	s.mu.RUnlock()
+
+	selected := make(map[int64]bool, len(params.Selected))
+	for _, name := range params.Selected {
+		if id, ok := idx.byKey[biz.TermKey(name)]; ok {
+			selected[id] = true
+		}
+	}
+
+	candidates := make(map[int64]bool)
+	if prefix := biz.TermKey(params.Prefix); prefix != "" {
+		i, _ := slices.BinarySearchFunc(idx.keys, prefix, func(k tagSuggestKey, target string) int {
+			return cmp.Compare(k.key, target)
+		})
+		for ; i < len(idx.keys) && strings.HasPrefix(idx.keys[i].key, prefix); i++ {
+			candidates[idx.keys[i].tagID] = true
+		}
+	} else {
+		for id, tag := range idx.tags {
+			if tag.count > 0 {
+				candidates[id] = true
+			}
+		}
+	}
+
+	userTags := idx.userTags[params.UserID]
+	var userMax int64
+	for _, count := range userTags {
+		userMax = max(userMax, count)
+	}
+
+	suggestions := make([]*biz.TagSuggestion, 0, len(candidates))
+	for id := range candidates {
+		tag := idx.tags[id]
+		if tag == nil || selected[id] {
+			continue
+		}
+		userCount := userTags[id]
+		var score float64
+		if idx.maxCount > 0 {
+			score += s.globalWeight * math.Log1p(float64(tag.count)) / math.Log1p(float64(idx.maxCount))
+		}
+		if userMax > 0 {
+			score += s.userWeight * math.Log1p(float64(userCount)) / math.Log1p(float64(userMax))
+		}
+		var cooccurrence float64
+		for selectedID := range selected {
+			if total := idx.tags[selectedID].count; total > 0 {
+				cooccurrence = max(cooccurrence, float64(idx.cooccur[selectedID][id])/float64(total))
+			}
+		}
+		score += s.cooccurrenceWeight * cooccurrence
+
+		suggestions = append(suggestions, &biz.TagSuggestion{
+			Name:         tag.name,
+			PictureCount: tag.count,
+			UserCount:    userCount,
+			Score:        math.Round(score*10000) / 10000,
+		})
+	}
+
+	slices.SortFunc(suggestions, func(a, b *biz.TagSuggestion) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(b.PictureCount, a.PictureCount),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	if len(suggestions) > params.Limit {
+		suggestions = suggestions[:params.Limit]
+	}
+	return suggestions, nil
+}
+
+// Refresh 从标签、别名和图片标签关联重建索引
+func (s *tagSuggester) Refresh(ctx context.Context) error {
+	idx := &tagSuggestIndex{
+		byKey:    make(map[string]int64),
+		tags:     make(map[int64]*tagSuggestStat),
+		userTags: make(map[int64]map[int64]int64),
+		cooccur:  make(map[int64]map[int64]int64),
+	}
+
+	var tags []Tag
+	if err := s.data.DB(ctx).Select("id, name").Find(&tags).Error; err != nil {
+		s.log.Errorf("查询标签失败: %v", err)
+		return err
+	}
+	for _, tag := range tags {
+		idx.tags[tag.ID] = &tagSuggestStat{name: tag.Name}
+		idx.byKey[biz.TermKey(tag.Name)] = tag.ID
+	}
+	var aliases []TaxonomyAlias
+	if err := s.data.DB(ctx).Select("alias, targetId").
+		Where("kind = ?", string(biz.TaxonomyTag)).
+		Find(&aliases).Error; err != nil {
+		s.log.Errorf("查询标签别名失败: %v", err)
+		return err
+	}
+	for _, alias := range aliases {
+		key := biz.TermKey(alias.Alias)
+		// 名称优先于别名
+		if _, ok := idx.byKey[key]; !ok && idx.tags[alias.TargetID] != nil {
+			idx.byKey[key] = alias.TargetID
+		}
+	}
+	idx.keys = make([]tagSuggestKey, 0, len(idx.byKey))
+	for key, id := range idx.byKey {
+		idx.keys = append(idx.keys, tagSuggestKey{key: key, tagID: id})
+	}
+	slices.SortFunc(idx.keys, func(a, b tagSuggestKey) int {
+		return cmp.Compare(a.key, b.key)
+	})
+
+	// 分批读取未删除的图片及其标签，统计使用次数和共现
+	var lastID int64
+	for {
+		var pictures []Picture
+		if err := s.data.DB(ctx).Select("id, userId").
+			Where("id > ? AND isDelete = 0", lastID).
+			Order("id").
+			Limit(searchIndexBatchSize).
+			Find(&pictures).Error; err != nil {
+			s.log.Errorf("查询图片失败: %v", err)
+			return err
+		}
+		if len(pictures) == 0 {
+			break
+		}
+
+		pictureIDs := make([]int64, 0, len(pictures))
+		owners := make(map[int64]int64, len(pictures))
+		for _, picture := range pictures {
+			pictureIDs = append(pictureIDs, picture.ID)
+			owners[picture.ID] = picture.UserID
+		}
+		var rows []PictureTag
+		if err := s.data.DB(ctx).Select("pictureId, tagId").
+			Where("pictureId IN ?", pictureIDs).
+			Find(&rows).Error; err != nil {
+			s.log.Errorf("查询图片标签失败: %v", err)
+			return err
+		}
+		pictureTags := make(map[int64][]int64, len(pictures))
+		for _, row := range rows {
+			if idx.tags[row.TagID] != nil {
+				pictureTags[row.PictureID] = append(pictureTags[row.PictureID], row.TagID)
+			}
+		}
+		for pictureID, tagIDs := range pictureTags {
+			idx.addPicture(owners[pictureID], tagIDs)
+		}
+
+		if len(pictures) < searchIndexBatchSize {
+			break
+		}
+		lastID = pictures[len(pictures)-1].ID
+	}
+
+	s.mu.Lock()
+	s.index = idx
+	s.mu.Unlock()
+	s.log.Infof("标签建议索引刷新完成: tags=%d, keys=%d, users=%d", len(idx.tags), len(idx.keys), len(idx.userTags))
+	return nil
+}
+
+// addPicture 累计一张图片的标签使用次数和共现次数
+func (idx *tagSuggestIndex) addPicture(userID int64, tagIDs []int64) {
+	userTags := idx.userTags[userID]
+	if userTags == nil {
+		userTags = make(map[int64]int64)
+		idx.userTags[userID] = userTags
+	}
+	for _, id := range tagIDs {
+		tag := idx.tags[id]
+		tag.count++
+		idx.maxCount = max(idx.maxCount, tag.count)
+		userTags[id]++
+
+		for _, other := range tagIDs {
+			if other == id {
+				continue
+			}
+			if idx.cooccur[id] == nil {
+				idx.cooccur[id] = make(map[int64]int64)
+			}
+			idx.cooccur[id][other]++
+		}
+	}
+}
@@ -0,0 +1,614 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// defaultCategories 首次启动时预置的分类（按展示顺序）
+var defaultCategories = []string{"模板", "电商", "表情包", "素材", "海报"}
+
+// pictureTagsJSONTableSQL 将图片 tags 字段中的 JSON 数组展开为行（MySQL 8.0 JSON_TABLE），用于迁移已有标签
+const pictureTagsJSONTableSQL = "JSON_TABLE(IF(JSON_VALID(picture.tags), picture.tags, '[]'), '$[*]' " +
+	"COLUMNS (position FOR ORDINALITY, tagName VARCHAR(64) PATH '$')) AS jt"
+
+// termSortFields 标签、分类允许排序的字段
+var termSortFields = map[string]string{
+	"pictureCount": "pictureCount",
+	"name":         "name",
+	"createTime":   "createTime",
+	"sortOrder":    "sortOrder",
+}
+
+// pictureTagsSyncBatchSize 同步图片 tags 字段时每批处理的图片数
+const pictureTagsSyncBatchSize = 500
+
+type taxonomyRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTaxonomyRepo 创建标签、分类仓储
+func NewTaxonomyRepo(data *Data, logger log.Logger) biz.TaxonomyRepo {
+	return &taxonomyRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// termRow 标签、分类查询结果
+type termRow struct {
+	ID           int64     `gorm:"column:id"`
+	Name         string    `gorm:"column:name"`
+	SortOrder    int64     `gorm:"column:sortOrder"`
+	PictureCount int64     `gorm:"column:pictureCount"`
+	CreateTime   time.Time `gorm:"column:createTime"`
+	UpdateTime   time.Time `gorm:"column:updateTime"`
+}
+
+// toTerm 转换为业务对象
+func (row *termRow) toTerm(kind biz.TaxonomyKind) *biz.TaxonomyTerm {
+	return &biz.TaxonomyTerm{
+		ID:           row.ID,
+		Kind:         kind,
+		Name:         row.Name,
+		SortOrder:    row.SortOrder,
+		PictureCount: row.PictureCount,
+		CreateTime:   row.CreateTime,
+		UpdateTime:   row.UpdateTime,
+	}
+}
+
+// termTable 标签或分类的表名
+func termTable(kind biz.TaxonomyKind) string {
+	if kind == biz.TaxonomyCategory {
+		return "category"
+	}
+	return "tag"
+}
+
+// termModel 标签或分类的实体，用于更新和删除
+func termModel(kind biz.TaxonomyKind) interface{} {
+	if kind == biz.TaxonomyCategory {
+		return &Category{}
+	}
+	return &Tag{}
+}
+
+// termColumnsSQL 查询标签或分类的字段（标签没有展示顺序，固定为 0）
+func termColumnsSQL(kind biz.TaxonomyKind) string {
+	if kind == biz.TaxonomyCategory {
+		return "category.id, category.name, category.sortOrder, category.createTime, category.updateTime"
+	}
+	return "tag.id, tag.name, 0 AS sortOrder, tag.createTime, tag.updateTime"
+}
+
+// termPictureCountSQL 统计使用标签或分类的未删除图片数
+func termPictureCountSQL(kind biz.TaxonomyKind) string {
+	if kind == biz.TaxonomyCategory {
+		return "(SELECT COUNT(*) FROM picture WHERE picture.category = category.name AND picture.isDelete = 0) AS pictureCount"
+	}
+	return "(SELECT COUNT(*) FROM picture_tag JOIN picture ON picture.id = picture_tag.pictureId " +
+		"WHERE picture_tag.tagId = tag.id AND picture.isDelete = 0) AS pictureCount"
+}
+
+// CreateTerm 创建标签或分类
+func (r *taxonomyRepo) CreateTerm(ctx context.Context, term *biz.TaxonomyTerm) (*biz.TaxonomyTerm, error) {
+	row := &termRow{Name: term.Name, SortOrder: term.SortOrder}
+	if term.Kind == biz.TaxonomyCategory {
+		entity := &Category{Name: term.Name, SortOrder: term.SortOrder}
+		if err := r.data.DB(ctx).Create(entity).Error; err != nil {
+			r.log.Errorf("创建分类失败: %v", err)
+			return nil, err
+		}
+		row.ID, row.CreateTime, row.UpdateTime = entity.ID, entity.CreateTime, entity.UpdateTime
+	} else {
+		entity := &Tag{Name: term.Name}
+		if err := r.data.DB(ctx).Create(entity).Error; err != nil {
+			r.log.Errorf("创建标签失败: %v", err)
+			return nil, err
+		}
+		row.ID, row.CreateTime, row.UpdateTime = entity.ID, entity.CreateTime, entity.UpdateTime
+	}
+	return row.toTerm(term.Kind), nil
+}
+
+// EnsureTags 创建不存在的标签，已存在（包括并发创建）的忽略
+func (r *taxonomyRepo) EnsureTags(ctx context.Context, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	entities := make([]Tag, 0, len(names))
+	for _, name := range names {
+		entities = append(entities, Tag{Name: name})
+	}
+	if err := r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&entities).Error; err != nil {
+		r.log.Errorf("创建标签失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetTermByID 查询标签或分类（包含别名和使用次数），不存在时返回 nil
+func (r *taxonomyRepo) GetTermByID(ctx context.Context, kind biz.TaxonomyKind, id int64) (*biz.TaxonomyTerm, error) {
+	table := termTable(kind)
+	var row termRow
+	err := r.data.DB(ctx).
+		Table(table).
+		Select(termColumnsSQL(kind)+", "+termPictureCountSQL(kind)).
+		Where(table+".id = ?", id).
+		Take(&row).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("查询%s失败: %v", table, err)
+		return nil, err
+	}
+
+	term := row.toTerm(kind)
+	if err := r.fillAliases(ctx, kind, []*biz.TaxonomyTerm{term}); err != nil {
+		return nil, err
+	}
+	return term, nil
+}
+
+// resolvedTermRow 按名称或别名查询的结果
+type resolvedTermRow struct {
+	termRow
+	Requested string `gorm:"column:requested"` // 查询时使用的名称或别名
+}
+
+// ResolveTerms 按名称或别名查询标签或分类，返回 TermKey(名称或别名) -> 标签或分类，未找到的不返回
+// 每个名称单独按数据库的排序规则比较（与唯一索引一致），名称匹配优先于别名匹配
+func (r *taxonomyRepo) ResolveTerms(ctx context.Context, kind biz.TaxonomyKind, names []string) (map[string]*biz.TaxonomyTerm, error) {
+	result := make(map[string]*biz.TaxonomyTerm, len(names))
+	if len(names) == 0 {
+		return result, nil
+	}
+
+	table := termTable(kind)
+	columns := termColumnsSQL(kind)
+	parts := make([]string, 0, len(names)*2)
+	vars := make([]interface{}, 0, len(names)*5)
+	for _, name := range names {
+		parts = append(parts,
+			"SELECT ? AS requested, 0 AS priority, "+columns+" FROM "+table+" WHERE "+table+".name = ?",
+			"SELECT ? AS requested, 1 AS priority, "+columns+" FROM taxonomy_alias JOIN "+table+" ON "+table+".id = taxonomy_alias.targetId "+
+				"WHERE taxonomy_alias.kind = ? AND taxonomy_alias.alias = ?")
+		vars = append(vars, name, name, name, string(kind), name)
+	}
+
+	var rows []resolvedTermRow
+	sql := "SELECT * FROM (" + strings.Join(parts, " UNION ALL ") + ") AS resolved ORDER BY priority ASC"
+	if err := r.data.DB(ctx).Raw(sql, vars...).Scan(&rows).Error; err != nil {
+		r.log.Errorf("查询%s失败: %v", table, err)
+		return nil, err
+	}
+
+	for i := range rows {
+		key := biz.TermKey(rows[i].Requested)
+		if _, ok := result[key]; !ok {
+			result[key] = rows[i].toTerm(kind)
+		}
+	}
+	return result, nil
+}
+
+// ListTerms 分页查询标签或分类（包含别名和使用次数）
+func (r *taxonomyRepo) ListTerms(ctx context.Context, params *biz.TermQueryParams) ([]*biz.TaxonomyTerm, int64, error) {
+	kind := params.Kind
+	table := termTable(kind)
+	query := r.data.DB(ctx).Table(table)
+	if params.SearchText != "" {
+		like := "%" + params.SearchText + "%"
+		query = query.Where(table+".name LIKE ? OR "+table+".id IN (SELECT targetId FROM taxonomy_alias WHERE kind = ? AND alias LIKE ?)",
+			like, string(kind), like)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("统计%s数量失败: %v", table, err)
+		return nil, 0, err
+	}
+
+	// 默认标签按使用次数从多到少，分类按展示顺序
+	field, ok := termSortFields[params.SortField]
+	desc := params.SortOrder != "ascend"
+	if !ok {
+		field, desc = "sortOrder", false
+		if kind == biz.TaxonomyTag {
+			field, desc = "pictureCount", true
+		}
+	}
+	order := field + " ASC"
+	if desc {
+		order = field + " DESC"
+	}
+
+	var rows []termRow
+	err := query.
+		Select(termColumnsSQL(kind) + ", " + termPictureCountSQL(kind)).
+		Order(order + ", " + table + ".id ASC").
+		Offset(int((params.Current - 1) * params.PageSize)).
+		Limit(int(params.PageSize)).
+		Find(&rows).Error
+	if err != nil {
+		r.log.Errorf("查询%s列表失败: %v", table, err)
+		return nil, 0, err
+	}
+
+	terms := make([]*biz.TaxonomyTerm, 0, len(rows))
+	for i := range rows {
+		terms = append(terms, rows[i].toTerm(kind))
+	}
+	if err := r.fillAliases(ctx, kind, terms); err != nil {
+		return nil, 0, err
+	}
+	return terms, total, nil
+}
+
+// ListTopTags 按使用次数从多到少查询标签，不包含未使用的标签
+func (r *taxonomyRepo) ListTopTags(ctx context.Context, limit int) ([]*biz.TaxonomyTerm, error) {
+	var rows []termRow
+	err := r.data.DB(ctx).
+		Table("picture_tag").
+		Select("tag.id, tag.name, tag.createTime, tag.updateTime, COUNT(*) AS pictureCount").
+		Joins("JOIN picture ON picture.id = picture_tag.pictureId AND picture.isDelete = 0").
+		Joins("JOIN tag ON tag.id = picture_tag.tagId").
+		Group("tag.id").
+		Order("pictureCount DESC, tag.id ASC").
+		Limit(limit).
+		Find(&rows).Error
+
+	if err != nil {
+		r.log.Errorf("查询热门标签失败: %v", err)
+		return nil, err
+	}
+
+	terms := make([]*biz.TaxonomyTerm, 0, len(rows))
+	for i := range rows {
+		terms = append(terms, rows[i].toTerm(biz.TaxonomyTag))
+	}
+	return terms, nil
+}
+
+// ListCategories 按展示顺序查询全部分类（包含使用次数）
+func (r *taxonomyRepo) ListCategories(ctx context.Context) ([]*biz.TaxonomyTerm, error) {
+	var rows []termRow
+	err := r.data.DB(ctx).
+		Table("category").
+		Select(termColumnsSQL(biz.TaxonomyCategory) + ", " + termPictureCountSQL(biz.TaxonomyCategory)).
+		Order("category.sortOrder ASC, category.id ASC").
+		Find(&rows).Error
+
+	if err != nil {
+		r.log.Errorf("查询分类失败: %v", err)
+		return nil, err
+	}
+
+	terms := make([]*biz.TaxonomyTerm, 0, len(rows))
+	for i := range rows {
+		terms = append(terms, rows[i].toTerm(biz.TaxonomyCategory))
+	}
+	return terms, nil
+}
+
+// UpdateTerm 更新名称和展示顺序，名称变化时同步更新已使用的图片，返回受影响的图片 ID
+func (r *taxonomyRepo) UpdateTerm(ctx context.Context, term *biz.TaxonomyTerm, oldName string) ([]int64, error) {
+	var affected []int64
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		updates := map[string]interface{}{"name": term.Name}
+		if term.Kind == biz.TaxonomyCategory {
+			updates["sortOrder"] = term.SortOrder
+		}
+		if err := tx.Model(termModel(term.Kind)).Where("id = ?", term.ID).Updates(updates).Error; err != nil {
+			return err
+		}
+		// 改为自身的别名时删除该别名
+		if err := tx.Where("kind = ? AND targetId = ? AND alias = ?", string(term.Kind), term.ID, term.Name).
+			Delete(&TaxonomyAlias{}).Error; err != nil {
+			return err
+		}
+		if term.Name == oldName {
+			return nil
+		}
+
+		var err error
+		if term.Kind == biz.TaxonomyCategory {
+			affected, err = replacePictureCategories(tx, []string{oldName}, term.Name)
+			return err
+		}
+		if affected, err = tagPictureIDs(tx, []int64{term.ID}); err != nil {
+			return err
+		}
+		return syncPictureTags(tx, affected)
+	})
+
+	if err != nil {
+		r.log.Errorf("更新%s失败: %v", termTable(term.Kind), err)
+		return nil, err
+	}
+	return affected, nil
+}
+
+// DeleteTerm 删除标签或分类及其别名，并从已使用的图片上移除，返回受影响的图片 ID
+func (r *taxonomyRepo) DeleteTerm(ctx context.Context, term *biz.TaxonomyTerm) ([]int64, error) {
+	var affected []int64
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if term.Kind == biz.TaxonomyCategory {
+			if affected, err = replacePictureCategories(tx, []string{term.Name}, ""); err != nil {
+				return err
+			}
+		} else {
+			if affected, err = tagPictureIDs(tx, []int64{term.ID}); err != nil {
+				return err
+			}
+			if err := tx.Where("tagId = ?", term.ID).Delete(&PictureTag{}).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("id = ?", term.ID).Delete(termModel(term.Kind)).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("kind = ? AND targetId = ?", string(term.Kind), term.ID).Delete(&TaxonomyAlias{}).Error; err != nil {
+			return err
+		}
+		if term.Kind == biz.TaxonomyTag {
+			return syncPictureTags(tx, affected)
+		}
+		return nil
+	})
+
+	if err != nil {
+		r.log.Errorf("删除%s失败: %v", termTable(term.Kind), err)
+		return nil, err
+	}
+	return affected, nil
+}
+
+// MergeTerms 将 sources 合并到 target，sources 的名称及别名转为 target 的别名，返回受影响的图片 ID
+func (r *taxonomyRepo) MergeTerms(ctx context.Context, sources []*biz.TaxonomyTerm, target *biz.TaxonomyTerm) ([]int64, error) {
+	kind := target.Kind
+	sourceIDs := make([]int64, 0, len(sources))
+	sourceNames := make([]string, 0, len(sources))
+	for _, source := range sources {
+		sourceIDs = append(sourceIDs, source.ID)
+		sourceNames = append(sourceNames, source.Name)
+	}
+
+	var affected []int64
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if kind == biz.TaxonomyCategory {
+			if affected, err = replacePictureCategories(tx, sourceNames, target.Name); err != nil {
+				return err
+			}
+		} else {
+			if affected, err = tagPictureIDs(tx, sourceIDs); err != nil {
+				return err
+			}
+			// 图片已有目标标签时保留原位置，否则目标标签占用被合并标签的位置
+			if err := tx.Exec("INSERT IGNORE INTO picture_tag (pictureId, tagId, position, createTime) "+
+				"SELECT pictureId, ?, MIN(position), NOW() FROM picture_tag WHERE tagId IN ? GROUP BY pictureId",
+				target.ID, sourceIDs).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("tagId IN ?", sourceIDs).Delete(&PictureTag{}).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("id IN ?", sourceIDs).Delete(termModel(kind)).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&TaxonomyAlias{}).
+			Where("kind = ? AND targetId IN ?", string(kind), sourceIDs).
+			Update("targetId", target.ID).Error; err != nil {
+			return err
+		}
+		aliases := make([]TaxonomyAlias, 0, len(sourceNames))
+		for _, name := range sourceNames {
+			aliases = append(aliases, TaxonomyAlias{Kind: string(kind), Alias: name, TargetID: target.ID})
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&aliases).Error; err != nil {
+			return err
+		}
+
+		if kind == biz.TaxonomyTag {
+			return syncPictureTags(tx, affected)
+		}
+		return nil
+	})
+
+	if err != nil {
+		r.log.Errorf("合并%s失败: %v", termTable(kind), err)
+		return nil, err
+	}
+	return affected, nil
+}
+
+// AddAlias 添加别名
+func (r *taxonomyRepo) AddAlias(ctx context.Context, kind biz.TaxonomyKind, termID int64, alias string) error {
+	entity := &TaxonomyAlias{Kind: string(kind), Alias: alias, TargetID: termID}
+	if err := r.data.DB(ctx).Create(entity).Error; err != nil {
+		r.log.Errorf("添加别名失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteAlias 删除别名，别名不存在时返回 false
+func (r *taxonomyRepo) DeleteAlias(ctx context.Context, kind biz.TaxonomyKind, termID int64, alias string) (bool, error) {
+	result := r.data.DB(ctx).
+		Where("kind = ? AND targetId = ? AND alias = ?", string(kind), termID, alias).
+		Delete(&TaxonomyAlias{})
+
+	if result.Error != nil {
+		r.log.Errorf("删除别名失败: %v", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// SetPictureTags 按顺序设置图片的标签，替换原有标签
+func (r *taxonomyRepo) SetPictureTags(ctx context.Context, pictureID int64, tagIDs []int64) error {
+	err := r.data.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("pictureId = ?", pictureID).Delete(&PictureTag{}).Error; err != nil {
+			return err
+		}
+		if len(tagIDs) == 0 {
+			return nil
+		}
+
+		entities := make([]PictureTag, 0, len(tagIDs))
+		for i, tagID := range tagIDs {
+			entities = append(entities, PictureTag{PictureID: pictureID, TagID: tagID, Position: int64(i + 1)})
+		}
+		return tx.Create(&entities).Error
+	})
+
+	if err != nil {
+		r.log.Errorf("保存图片标签失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// fillAliases 批量查询并填充别名
+func (r *taxonomyRepo) fillAliases(ctx context.Context, kind biz.TaxonomyKind, terms []*biz.TaxonomyTerm) error {
+	if len(terms) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(terms))
+	for _, term := range terms {
+		ids = append(ids, term.ID)
+	}
+	var aliases []TaxonomyAlias
+	if err := r.data.DB(ctx).
+		Where("kind = ? AND targetId IN ?", string(kind), ids).
+		Order("id ASC").
+		Find(&aliases).Error; err != nil {
+		r.log.Errorf("查询别名失败: %v", err)
+		return err
+	}
+
+	aliasMap := make(map[int64][]string, len(terms))
+	for _, alias := range aliases {
+		aliasMap[alias.TargetID] = append(aliasMap[alias.TargetID], alias.Alias)
+	}
+	for _, term := range terms {
+		term.Aliases = aliasMap[term.ID]
+	}
+	return nil
+}
+
+// tagPictureIDs 查询使用了指定标签的图片 ID（包括已删除的图片）
+func tagPictureIDs(tx *gorm.DB, tagIDs []int64) ([]int64, error) {
+	var ids []int64
+	err := tx.Model(&PictureTag{}).Where("tagId IN ?", tagIDs).Distinct().Pluck("pictureId", &ids).Error
+	return ids, err
+}
+
+// replacePictureCategories 将使用指定分类的图片改为新分类（为空表示清除分类），返回受影响的图片 ID
+func replacePictureCategories(tx *gorm.DB, oldNames []string, newName string) ([]int64, error) {
+	var ids []int64
+	if err := tx.Model(&Picture{}).Where("category IN ?", oldNames).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	err := tx.Model(&Picture{}).Where("category IN ?", oldNames).UpdateColumn("category", newName).Error
+	return ids, err
+}
+
+// syncPictureTags 按 picture_tag 关联重新生成图片的 tags 字段（JSON 数组，按标签顺序）
+func syncPictureTags(tx *gorm.DB, pictureIDs []int64) error {
+	for start := 0; start < len(pictureIDs); start += pictureTagsSyncBatchSize {
+		batch := pictureIDs[start:min(start+pictureTagsSyncBatchSize, len(pictureIDs))]
+
+		var rows []struct {
+			PictureID int64  `gorm:"column:pictureId"`
+			Name      string `gorm:"column:name"`
+		}
+		if err := tx.Table("picture_tag").
+			Select("picture_tag.pictureId, tag.name").
+			Joins("JOIN tag ON tag.id = picture_tag.tagId").
+			Where("picture_tag.pictureId IN ?", batch).
+			Order("picture_tag.pictureId ASC, picture_tag.position ASC, picture_tag.id ASC").
+			Find(&rows).Error; err != nil {
+			return err
+		}
+
+		tagNames := make(map[int64][]string, len(batch))
+		for _, row := range rows {
+			tagNames[row.PictureID] = append(tagNames[row.PictureID], row.Name)
+		}
+		for _, id := range batch {
+			tags := ""
+			if names := tagNames[id]; len(names) > 0 {
+				tagsBytes, err := json.Marshal(names)
+				if err != nil {
+					return err
+				}
+				tags = string(tagsBytes)
+			}
+			if err := tx.Model(&Picture{}).Where("id = ?", id).UpdateColumn("tags", tags).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// initTaxonomy 首次启动（标签表和分类表均为空）时预置默认分类，并从图片已有的 category、tags 字段迁移分类和标签
+func initTaxonomy(db *gorm.DB) error {
+	var tagCount, categoryCount int64
+	if err := db.Model(&Tag{}).Count(&tagCount).Error; err != nil {
+		return err
+	}
+	if err := db.Model(&Category{}).Count(&categoryCount).Error; err != nil {
+		return err
+	}
+	if tagCount > 0 || categoryCount > 0 {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		categories := make([]Category, 0, len(defaultCategories))
+		for i, name := range defaultCategories {
+			categories = append(categories, Category{Name: name, SortOrder: int64(i + 1)})
+		}
+		if err := tx.Create(&categories).Error; err != nil {
+			return err
+		}
+
+		// 已有图片使用的分类排在预置分类之后
+		if err := tx.Exec("INSERT IGNORE INTO category (name, sortOrder, createTime, updateTime) "+
+			"SELECT DISTINCT category, ?, NOW(), NOW() FROM picture WHERE category IS NOT NULL AND category <> ''",
+			len(defaultCategories)+1).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("INSERT IGNORE INTO tag (name, createTime, updateTime) " +
+			"SELECT DISTINCT jt.tagName, NOW(), NOW() FROM picture JOIN " + pictureTagsJSONTableSQL + " WHERE jt.tagName <> ''").Error; err != nil {
+			return err
+		}
+		return tx.Exec("INSERT IGNORE INTO picture_tag (pictureId, tagId, position, createTime) " +
+			"SELECT picture.id, tag.id, MIN(jt.position), NOW() FROM picture JOIN " + pictureTagsJSONTableSQL +
+			" JOIN tag ON tag.name = jt.tagName COLLATE utf8mb4_unicode_ci GROUP BY picture.id, tag.id").Error
+	})
+}
@@ -0,0 +1,60 @@
+package data
+
+import (
+	"time"
+)
+
+// Tag 标签实体
+type Tag struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Name       string    `gorm:"column:name;type:varchar(64);not null;uniqueIndex:uk_name" json:"name"`
+	CreateTime time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+	UpdateTime time.Time `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
+}
+
+// TableName 指定表名
+func (Tag) TableName() string {
+	return "tag"
+}
+
+// Category 分类实体
+type Category struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Name       string    `gorm:"column:name;type:varchar(64);not null;uniqueIndex:uk_name" json:"name"`
+	SortOrder  int64     `gorm:"column:sortOrder;not null;default:0" json:"sortOrder"`
+	CreateTime time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+	UpdateTime time.Time `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
+}
+
+// TableName 指定表名
+func (Category) TableName() string {
+	return "category"
+}
+
+// TaxonomyAlias 标签、分类别名实体
+type TaxonomyAlias struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Kind       string    `gorm:"column:kind;type:varchar(16);not null;uniqueIndex:uk_kind_alias;index:idx_kind_targetId" json:"kind"`
+	Alias      string    `gorm:"column:alias;type:varchar(64);not null;uniqueIndex:uk_kind_alias" json:"alias"`
+	TargetID   int64     `gorm:"column:targetId;not null;index:idx_kind_targetId" json:"targetId"`
+	CreateTime time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+}
+
+// TableName 指定表名
+func (TaxonomyAlias) TableName() string {
+	return "taxonomy_alias"
+}
+
+// PictureTag 图片标签关联实体
+type PictureTag struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	PictureID  int64     `gorm:"column:pictureId;not null;uniqueIndex:uk_pictureId_tagId" json:"pictureId"`
+	TagID      int64     `gorm:"column:tagId;not null;uniqueIndex:uk_pictureId_tagId;index:idx_tagId" json:"tagId"`
+	Position   int64     `gorm:"column:position;not null;default:0" json:"position"`
+	CreateTime time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+}
+
+// TableName 指定表名
+func (PictureTag) TableName() string {
+	return "picture_tag"
+}
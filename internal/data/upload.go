@@ -0,0 +1,165 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type uploadRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewUploadRepo 创建上传记录仓储
+func NewUploadRepo(data *Data, logger log.Logger) biz.UploadRepo {
+	return &uploadRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateUpload 创建上传记录
+func (r *uploadRepo) CreateUpload(ctx context.Context, upload *biz.Upload) (*biz.Upload, error) {
+	entity := &Upload{
+		UserID:      upload.UserID,
+		BucketKey:   upload.BucketKey,
+		FileKey:     upload.FileKey,
+		URL:         upload.URL,
+		UploadID:    upload.UploadID,
+		FileSize:    upload.FileSize,
+		ContentType: upload.ContentType,
+		Status:      string(upload.Status),
+		ExpireTime:  upload.ExpireTime,
+	}
+	if err := r.data.DB(ctx).Create(entity).Error; err != nil {
+		r.log.Errorf("创建上传记录失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToUpload(entity), nil
+}
+
+// GetUploadByID 根据 ID 查询上传记录
+func (r *uploadRepo) GetUploadByID(ctx context.Context, id int64) (*biz.Upload, error) {
+	return r.getUpload(ctx, "id = ?", id)
+}
+
+// GetUploadByMultipartID 根据 COS 分片上传 ID 查询上传记录
+func (r *uploadRepo) GetUploadByMultipartID(ctx context.Context, uploadID string) (*biz.Upload, error) {
+	return r.getUpload(ctx, "uploadId = ?", uploadID)
+}
+
+// GetCompletedUploadByURL 查询用户已完成的上传记录
+func (r *uploadRepo) GetCompletedUploadByURL(ctx context.Context, userID int64, url string) (*biz.Upload, error) {
+	return r.getUpload(ctx, "userId = ? AND status = ? AND url = ?", userID, string(biz.UploadCompleted), url)
+}
+
+// getUpload 按条件查询单个上传记录，不存在时返回 nil
+func (r *uploadRepo) getUpload(ctx context.Context, query string, args ...interface{}) (*biz.Upload, error) {
+	var entity Upload
+	err := r.data.DB(ctx).Where(query, args...).Order("id DESC").First(&entity).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("查询上传记录失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToUpload(&entity), nil
+}
+
+// UpdateUploadStatus 仅当上传处于等待状态时更新状态、文件大小和 ETag
+func (r *uploadRepo) UpdateUploadStatus(ctx context.Context, id int64, status biz.UploadStatus, fileSize int64, etag string) (bool, error) {
+	updates := map[string]interface{}{
+		"status":   string(status),
+		"fileSize": fileSize,
+	}
+	if status == biz.UploadCompleted {
+		updates["etag"] = etag
+		updates["completeTime"] = time.Now()
+	}
+
+	result := r.data.DB(ctx).
+		Model(&Upload{}).
+		Where("id = ? AND status = ?", id, string(biz.UploadPending)).
+		Updates(updates)
+
+	if result.Error != nil {
+		r.log.Errorf("更新上传记录状态失败: %v", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ConsumeUpload 仅当上传处于已完成状态时标记为已被图片使用，返回是否标记成功
+func (r *uploadRepo) ConsumeUpload(ctx context.Context, id int64) (bool, error) {
+	result := r.data.DB(ctx).
+		Model(&Upload{}).
+		Where("id = ? AND status = ?", id, string(biz.UploadCompleted)).
+		Update("status", string(biz.UploadConsumed))
+
+	if result.Error != nil {
+		r.log.Errorf("标记上传已使用失败: %v", result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// UpdateUploadFile 服务端改写已完成上传的文件后，更新记录中的文件大小和 ETag
+func (r *uploadRepo) UpdateUploadFile(ctx context.Context, id int64, fileSize int64, etag string) error {
+	err := r.data.DB(ctx).
+		Model(&Upload{}).
+		Where("id = ? AND status = ?", id, string(biz.UploadCompleted)).
+		Updates(map[string]interface{}{
+			"fileSize": fileSize,
+			"etag":     etag,
+		}).Error
+
+	if err != nil {
+		r.log.Errorf("更新上传记录失败: %v", err)
+		return err
+	}
+	return nil
+}
+
+// CountUploadsSince 统计用户在指定时间之后发起的上传次数
+func (r *uploadRepo) CountUploadsSince(ctx context.Context, userID int64, since time.Time) (int64, error) {
+	var count int64
+	err := r.data.DB(ctx).
+		Model(&Upload{}).
+		Where("userId = ? AND createTime >= ?", userID, since).
+		Count(&count).Error
+
+	if err != nil {
+		r.log.Errorf("统计上传次数失败: %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// convertToUpload 转换实体为业务对象
+func (r *uploadRepo) convertToUpload(entity *Upload) *biz.Upload {
+	return &biz.Upload{
+		ID:           entity.ID,
+		UserID:       entity.UserID,
+		BucketKey:    entity.BucketKey,
+		FileKey:      entity.FileKey,
+		URL:          entity.URL,
+		UploadID:     entity.UploadID,
+		FileSize:     entity.FileSize,
+		ContentType:  entity.ContentType,
+		ETag:         entity.ETag,
+		Status:       biz.UploadStatus(entity.Status),
+		ExpireTime:   entity.ExpireTime,
+		CompleteTime: entity.CompleteTime,
+		CreateTime:   entity.CreateTime,
+		UpdateTime:   entity.UpdateTime,
+	}
+}
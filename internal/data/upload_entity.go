@@ -0,0 +1,28 @@
+package data
+
+import (
+	"time"
+)
+
+// Upload 上传记录实体
+type Upload struct {
+	ID           int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	UserID       int64      `gorm:"column:userId;not null;index:idx_userId_status;index:idx_userId_createTime" json:"userId"`
+	BucketKey    string     `gorm:"column:bucketKey;type:varchar(64);not null" json:"bucketKey"`
+	FileKey      string     `gorm:"column:fileKey;type:varchar(512);not null" json:"fileKey"`
+	URL          string     `gorm:"column:url;type:varchar(1024);not null" json:"url"`
+	UploadID     string     `gorm:"column:uploadId;type:varchar(128);index:idx_uploadId" json:"uploadId"`
+	FileSize     int64      `gorm:"column:fileSize;not null;default:0" json:"fileSize"`
+	ContentType  string     `gorm:"column:contentType;type:varchar(128)" json:"contentType"`
+	ETag         string     `gorm:"column:etag;type:varchar(128)" json:"etag"`
+	Status       string     `gorm:"column:status;type:varchar(16);not null;index:idx_userId_status" json:"status"`
+	ExpireTime   time.Time  `gorm:"column:expireTime;not null" json:"expireTime"`
+	CompleteTime *time.Time `gorm:"column:completeTime" json:"completeTime"`
+	CreateTime   time.Time  `gorm:"column:createTime;autoCreateTime;index:idx_userId_createTime" json:"createTime"`
+	UpdateTime   time.Time  `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
+}
+
+// TableName 指定表名
+func (Upload) TableName() string {
+	return "upload"
+}
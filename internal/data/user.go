@@ -31,9 +31,14 @@ func (r *userRepo) CreateUser(ctx context.Context, user *biz.User) (*biz.User, e
 		UserPassword: user.UserPassword,
 		UserName:     user.UserName,
 		UserRole:     user.UserRole,
+		ShareCode:    shareCodeToColumn(user.ShareCode),
+		InviteUser:   user.InviteUser,
 	}
 
 	if err := r.data.db.WithContext(ctx).Create(userEntity).Error; err != nil {
+		if isDuplicateKeyError(err, "uk_shareCode") {
+			return nil, biz.ErrShareCodeConflict
+		}
 		r.log.Errorf("创建用户失败: %v", err)
 		return nil, err
 	}
@@ -102,6 +107,29 @@ func (r *userRepo) GetUserByID(ctx context.Context, id int64) (*biz.User, error)
 	return r.convertToUser(&userEntity), nil
 }
 
+// ListUserByIDs 根据 ID 批量查询用户
+func (r *userRepo) ListUserByIDs(ctx context.Context, ids []int64) ([]*biz.User, error) {
+	if len(ids) == 0 {
+		return []*biz.User{}, nil
+	}
+
+	var userEntities []User
+	err := r.data.db.WithContext(ctx).
+		Where("id IN ? AND isDelete = 0", ids).
+		Find(&userEntities).Error
+
+	if err != nil {
+		r.log.Errorf("批量查询用户失败: %v", err)
+		return nil, err
+	}
+
+	users := make([]*biz.User, 0, len(userEntities))
+	for i := range userEntities {
+		users = append(users, r.convertToUser(&userEntities[i]))
+	}
+	return users, nil
+}
+
 // convertToUser 将数据库实体转换为业务对象
 func (r *userRepo) convertToUser(userEntity *User) *biz.User {
 	return &biz.User{
@@ -117,8 +145,12 @@ func (r *userRepo) convertToUser(userEntity *User) *biz.User {
 		UserAddress:         userEntity.UserAddress,
 		UserTags:            userEntity.UserTags,
 		UserRole:            userEntity.UserRole,
+		VipCode:             userEntity.VipCode,
 		VipNumber:           userEntity.VipNumber,
 		VipExpireTime:       userEntity.VipExpireTime,
+		ShareCode:           columnToShareCode(userEntity.ShareCode),
+		KeepPhotoMetadata:   userEntity.KeepPhotoMetadata,
+		InviteUser:          userEntity.InviteUser,
 		CreateTime:          userEntity.CreateTime,
 		UpdateTime:          userEntity.UpdateTime,
 	}
@@ -191,7 +223,18 @@ func (r *userRepo) DeleteUser(ctx context.Context, id int64) error {
 	return nil
 }
 
-// ListUserByPage 分页查询用户
+// userSortFields 允许排序的字段
+var userSortFields = map[string]sortColumn[User]{
+	"id":          {column: "id", kind: biz.CursorValueInt, value: func(u *User) any { return u.ID }},
+	"createTime":  {column: "createTime", kind: biz.CursorValueTime, value: func(u *User) any { return u.CreateTime }},
+	"updateTime":  {column: "updateTime", kind: biz.CursorValueTime, value: func(u *User) any { return u.UpdateTime }},
+	"editTime":    {column: "editTime", kind: biz.CursorValueTime, value: func(u *User) any { return u.EditTime }},
+	"userAccount": {column: "userAccount", kind: biz.CursorValueString, value: func(u *User) any { return u.UserAccount }},
+	"userName":    {column: "userName", expr: "COALESCE(userName, '')", kind: biz.CursorValueString, value: func(u *User) any { return u.UserName }},
+	"userRole":    {column: "userRole", kind: biz.CursorValueString, value: func(u *User) any { return u.UserRole }},
+}
+
+// ListUserByPage 分页查询用户，支持偏移量分页和游标分页
 func (r *userRepo) ListUserByPage(ctx context.Context, params *biz.UserQueryParams) (*biz.UserPage, error) {
 	var userEntities []User
 	var total int64
@@ -216,30 +259,53 @@ func (r *userRepo) ListUserByPage(ctx context.Context, params *biz.UserQueryPara
 		query = query.Where("userRole = ?", params.UserRole)
 	}
 
-	// 统计总数
-	if err := query.Count(&total).Error; err != nil {
-		r.log.Errorf("查询用户总数失败: %v", err)
-		return nil, err
+	// 统计总数（游标分页时按需统计）
+	if !params.CursorMode || params.WithTotal {
+		if err := query.Count(&total).Error; err != nil {
+			r.log.Errorf("查询用户总数失败: %v", err)
+			return nil, err
+		}
+	}
+
+	// 排序（只允许按白名单中的字段排序，防止 SQL 注入），未指定时按创建时间倒序
+	sortCol, ok := userSortFields[params.SortField]
+	desc := params.SortOrder == "descend"
+	if !ok {
+		sortCol, desc = userSortFields["createTime"], true
 	}
 
-	// 排序
-	if params.SortField != "" {
+	var nextCursor string
+	if params.CursorMode {
+		// 游标分页按 (排序字段, id) 定位
+		keysetQuery, err := applyKeyset(query, sortCol, desc, params.After, params.PageSize)
+		if err != nil {
+			return nil, err
+		}
+		if err := keysetQuery.Find(&userEntities).Error; err != nil {
+			r.log.Errorf("分页查询用户失败: %v", err)
+			return nil, err
+		}
+		userEntities, nextCursor = keysetNextPage(userEntities, sortCol, func(u *User) int64 { return u.ID }, cursorParams{
+			sortField: params.SortField,
+			sortOrder: params.SortOrder,
+			pageSize:  params.PageSize,
+		})
+	} else {
 		order := "ASC"
-		if params.SortOrder == "descend" {
+		if desc {
 			order = "DESC"
 		}
-		query = query.Order(params.SortField + " " + order)
-	} else {
-		query = query.Order("createTime DESC")
-	}
+		// 追加 id 保证排序字段相同时顺序稳定，翻页不会重复或遗漏
+		query = query.Order(sortCol.column + " " + order + ", id " + order)
 
-	// 分页查询
-	offset := (params.Current - 1) * params.PageSize
-	err := query.Offset(int(offset)).Limit(int(params.PageSize)).Find(&userEntities).Error
+		// 分页查询
+		offset := (params.Current - 1) * params.PageSize
+		err := query.Offset(int(offset)).Limit(int(params.PageSize)).Find(&userEntities).Error
 
-	if err != nil {
-		r.log.Errorf("分页查询用户失败: %v", err)
-		return nil, err
+		if err != nil {
+			r.log.Errorf("分页查询用户失败: %v", err)
+			return nil, err
+		}
 	}
 
 	// 转换为业务对象
@@ -249,10 +315,11 @@ func (r *userRepo) ListUserByPage(ctx context.Context, params *biz.UserQueryPara
 	}
 
 	return &biz.UserPage{
-		Total:    total,
-		List:     users,
-		Current:  params.Current,
-		PageSize: params.PageSize,
+		Total:      total,
+		List:       users,
+		Current:    params.Current,
+		PageSize:   params.PageSize,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -304,6 +371,160 @@ func (r *userRepo) SendEmailVerificationCode(ctx context.Context, email, code st
 	return nil
 }
 
+// GetUserByShareCode 根据分享码查询用户
+func (r *userRepo) GetUserByShareCode(ctx context.Context, shareCode string) (*biz.User, error) {
+	var userEntity User
+	err := r.data.db.WithContext(ctx).
+		Where("shareCode = ? AND isDelete = 0", shareCode).
+		First(&userEntity).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("根据分享码查询用户失败: %v", err)
+		return nil, err
+	}
+
+	return r.convertToUser(&userEntity), nil
+}
+
+// UpdateUserShareCode 更新用户分享码
+func (r *userRepo) UpdateUserShareCode(ctx context.Context, userID int64, shareCode string) error {
+	err := r.data.db.WithContext(ctx).
+		Model(&User{}).
+		Where("id = ? AND isDelete = 0", userID).
+		Update("shareCode", shareCode).Error
+
+	if err != nil {
+		if isDuplicateKeyError(err, "uk_shareCode") {
+			return biz.ErrShareCodeConflict
+		}
+		r.log.Errorf("更新分享码失败: userID=%d, err=%v", userID, err)
+		return err
+	}
+
+	return nil
+}
+
+// UpdatePrivacySettings 更新用户隐私设置
+func (r *userRepo) UpdatePrivacySettings(ctx context.Context, userID int64, keepPhotoMetadata bool) error {
+	err := r.data.db.WithContext(ctx).
+		Model(&User{}).
+		Where("id = ? AND isDelete = 0", userID).
+		Update("keepPhotoMetadata", keepPhotoMetadata).Error
+
+	if err != nil {
+		r.log.Errorf("更新隐私设置失败: userID=%d, err=%v", userID, err)
+		return err
+	}
+
+	return nil
+}
+
+// ListUserByInviter 分页查询某个用户邀请的用户
+func (r *userRepo) ListUserByInviter(ctx context.Context, inviterID, current, pageSize int64) (*biz.UserPage, error) {
+	var userEntities []User
+	var total int64
+
+	query := r.data.db.WithContext(ctx).Model(&User{}).
+		Where("inviteUser = ? AND isDelete = 0", inviterID)
+
+	if err := query.Count(&total).Error; err != nil {
+		r.log.Errorf("查询邀请用户总数失败: %v", err)
+		return nil, err
+	}
+
+	offset := (current - 1) * pageSize
+	err := query.Order("createTime DESC").Offset(int(offset)).Limit(int(pageSize)).Find(&userEntities).Error
+	if err != nil {
+		r.log.Errorf("分页查询邀请用户失败: %v", err)
+		return nil, err
+	}
+
+	users := make([]*biz.User, 0, len(userEntities))
+	for i := range userEntities {
+		users = append(users, r.convertToUser(&userEntities[i]))
+	}
+
+	return &biz.UserPage{
+		Total:    total,
+		List:     users,
+		Current:  current,
+		PageSize: pageSize,
+	}, nil
+}
+
+// GetInviteStatistics 查询邀请统计
+func (r *userRepo) GetInviteStatistics(ctx context.Context, topN int) (*biz.InviteStatistics, error) {
+	var summary struct {
+		TotalInvitees int64
+		TotalInviters int64
+	}
+	err := r.data.db.WithContext(ctx).
+		Model(&User{}).
+		Select("COUNT(*) AS total_invitees, COUNT(DISTINCT inviteUser) AS total_inviters").
+		Where("inviteUser > 0 AND isDelete = 0").
+		Scan(&summary).Error
+	if err != nil {
+		r.log.Errorf("查询邀请总数失败: %v", err)
+		return nil, err
+	}
+
+	// 按邀请人数倒序取前 N 名
+	var rows []struct {
+		InviteUser   int64
+		InviteeCount int64
+	}
+	err = r.data.db.WithContext(ctx).
+		Model(&User{}).
+		Select("inviteUser AS invite_user, COUNT(*) AS invitee_count").
+		Where("inviteUser > 0 AND isDelete = 0").
+		Group("inviteUser").
+		Order("invitee_count DESC").
+		Limit(topN).
+		Scan(&rows).Error
+	if err != nil {
+		r.log.Errorf("查询邀请排行失败: %v", err)
+		return nil, err
+	}
+
+	inviterIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		inviterIDs = append(inviterIDs, row.InviteUser)
+	}
+
+	userMap := make(map[int64]*biz.User, len(inviterIDs))
+	if len(inviterIDs) > 0 {
+		var userEntities []User
+		if err := r.data.db.WithContext(ctx).Where("id IN ?", inviterIDs).Find(&userEntities).Error; err != nil {
+			r.log.Errorf("查询邀请人信息失败: %v", err)
+			return nil, err
+		}
+		for i := range userEntities {
+			userMap[userEntities[i].ID] = r.convertToUser(&userEntities[i])
+		}
+	}
+
+	topInviters := make([]*biz.InviterStat, 0, len(rows))
+	for _, row := range rows {
+		user, ok := userMap[row.InviteUser]
+		if !ok {
+			user = &biz.User{ID: row.InviteUser}
+		}
+		topInviters = append(topInviters, &biz.InviterStat{
+			User:         user,
+			InviteeCount: row.InviteeCount,
+		})
+	}
+
+	return &biz.InviteStatistics{
+		TotalInvitees: summary.TotalInvitees,
+		TotalInviters: summary.TotalInviters,
+		TopInviters:   topInviters,
+	}, nil
+}
+
 // getEmailVerifyKey 获取邮箱验证码的 Redis key
 func (r *userRepo) getEmailVerifyKey(userID int64) string {
 	return fmt.Sprintf("email_verify:%d", userID)
@@ -318,3 +539,19 @@ func splitTwo(s, sep string) []string {
 	}
 	return []string{s}
 }
+
+// shareCodeToColumn 分享码转数据库列值，未生成分享码（空字符串）时为 NULL
+func shareCodeToColumn(shareCode string) *string {
+	if shareCode == "" {
+		return nil
+	}
+	return &shareCode
+}
+
+// columnToShareCode 数据库列值转分享码，NULL 为空字符串
+func columnToShareCode(shareCode *string) string {
+	if shareCode == nil {
+		return ""
+	}
+	return *shareCode
+}
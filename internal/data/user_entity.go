@@ -9,7 +9,7 @@ type User struct {
 	ID                  int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
 	UserAccount         string     `gorm:"column:userAccount;type:varchar(256);not null;uniqueIndex:uk_userAccount" json:"userAccount"`
 	UserPassword        string     `gorm:"column:userPassword;type:varchar(512);not null" json:"-"`
-	UserName            string     `gorm:"column:userName;type:varchar(256);index:idx_userName" json:"userName"`
+	UserName            string     `gorm:"column:userName;type:varchar(256);index:idx_userName;index:ft_userName,class:FULLTEXT,option:WITH PARSER ngram" json:"userName"`
 	UserAvatar          string     `gorm:"column:userAvatar;type:varchar(1024)" json:"userAvatar"`
 	UserBackgroundImage string     `gorm:"column:userBackgroundImage;type:varchar(1024)" json:"userBackgroundImage"`
 	UserProfile         string     `gorm:"column:userProfile;type:varchar(512)" json:"userProfile"`
@@ -21,8 +21,9 @@ type User struct {
 	VipExpireTime       *time.Time `gorm:"column:vipExpireTime" json:"vipExpireTime"`
 	VipCode             string     `gorm:"column:vipCode;type:varchar(128)" json:"vipCode"`
 	VipNumber           int64      `gorm:"column:vipNumber" json:"vipNumber"`
-	ShareCode           string     `gorm:"column:shareCode;type:varchar(20)" json:"shareCode"`
-	InviteUser          int64      `gorm:"column:inviteUser" json:"inviteUser"`
+	ShareCode           *string    `gorm:"column:shareCode;type:varchar(20);uniqueIndex:uk_shareCode" json:"shareCode"` // 未生成分享码时为 NULL，不受唯一约束限制
+	InviteUser          int64      `gorm:"column:inviteUser;index:idx_inviteUser" json:"inviteUser"`
+	KeepPhotoMetadata   bool       `gorm:"column:keepPhotoMetadata;not null;default:false" json:"keepPhotoMetadata"` // 上传图片时是否保留位置等敏感元数据
 	CreateTime          time.Time  `gorm:"column:createTime;autoCreateTime" json:"createTime"`
 	UpdateTime          time.Time  `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
 	EditTime            time.Time  `gorm:"column:editTime;autoCreateTime" json:"editTime"`
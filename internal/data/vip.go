@@ -0,0 +1,211 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// vipNumberKey 会员编号计数器的 Redis key
+const vipNumberKey = "vip:number"
+
+type vipRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewVipRepo 创建会员仓储
+func NewVipRepo(data *Data, logger log.Logger) biz.VipRepo {
+	return &vipRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// BatchCreateVipCodes 批量创建兑换码
+func (r *vipRepo) BatchCreateVipCodes(ctx context.Context, codes []*biz.VipCode) error {
+	entities := make([]*VipCode, 0, len(codes))
+	for _, code := range codes {
+		entities = append(entities, &VipCode{
+			Code:       code.Code,
+			VipDays:    code.VipDays,
+			MaxUses:    code.MaxUses,
+			ExpireTime: code.ExpireTime,
+			CreateUser: code.CreateUser,
+		})
+	}
+
+	if err := r.data.DB(ctx).CreateInBatches(entities, 100).Error; err != nil {
+		r.log.Errorf("批量创建兑换码失败: %v", err)
+		return err
+	}
+
+	for i, entity := range entities {
+		codes[i].ID = entity.ID
+		codes[i].CreateTime = entity.CreateTime
+	}
+	return nil
+}
+
+// GetVipCodeForUpdate 根据兑换码查询并加行锁
+func (r *vipRepo) GetVipCodeForUpdate(ctx context.Context, code string) (*biz.VipCode, error) {
+	var entity VipCode
+	err := r.data.DB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("code = ? AND isDelete = 0", code).
+		First(&entity).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("查询兑换码失败: %v", err)
+		return nil, err
+	}
+
+	return &biz.VipCode{
+		ID:         entity.ID,
+		Code:       entity.Code,
+		VipDays:    entity.VipDays,
+		MaxUses:    entity.MaxUses,
+		UsedCount:  entity.UsedCount,
+		ExpireTime: entity.ExpireTime,
+		CreateUser: entity.CreateUser,
+		CreateTime: entity.CreateTime,
+	}, nil
+}
+
+// IncrVipCodeUsedCount 兑换码使用次数 +1，条件更新保证不会超过最大使用次数
+func (r *vipRepo) IncrVipCodeUsedCount(ctx context.Context, id int64) (bool, error) {
+	result := r.data.DB(ctx).
+		Model(&VipCode{}).
+		Where("id = ? AND usedCount < maxUses AND isDelete = 0", id).
+		Update("usedCount", gorm.Expr("usedCount + 1"))
+
+	if result.Error != nil {
+		r.log.Errorf("更新兑换码使用次数失败: %v", result.Error)
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// HasRedeemed 查询用户是否兑换过该兑换码
+func (r *vipRepo) HasRedeemed(ctx context.Context, codeID, userID int64) (bool, error) {
+	var count int64
+	err := r.data.DB(ctx).
+		Model(&VipRedeemRecord{}).
+		Where("codeId = ? AND userId = ?", codeID, userID).
+		Count(&count).Error
+
+	if err != nil {
+		r.log.Errorf("查询兑换记录失败: %v", err)
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// CreateRedeemRecord 记录兑换记录
+func (r *vipRepo) CreateRedeemRecord(ctx context.Context, codeID, userID int64) error {
+	record := &VipRedeemRecord{
+		CodeID: codeID,
+		UserID: userID,
+	}
+
+	if err := r.data.DB(ctx).Create(record).Error; err != nil {
+		r.log.Errorf("保存兑换记录失败: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetUserForUpdate 查询用户并加行锁
+func (r *vipRepo) GetUserForUpdate(ctx context.Context, userID int64) (*biz.User, error) {
+	var userEntity User
+	err := r.data.DB(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND isDelete = 0", userID).
+		First(&userEntity).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		r.log.Errorf("查询用户失败: %v", err)
+		return nil, err
+	}
+
+	return &biz.User{
+		ID:            userEntity.ID,
+		UserAccount:   userEntity.UserAccount,
+		UserName:      userEntity.UserName,
+		UserRole:      userEntity.UserRole,
+		VipCode:       userEntity.VipCode,
+		VipNumber:     userEntity.VipNumber,
+		VipExpireTime: userEntity.VipExpireTime,
+		CreateTime:    userEntity.CreateTime,
+		UpdateTime:    userEntity.UpdateTime,
+	}, nil
+}
+
+// UpdateUserVip 更新用户会员信息
+func (r *vipRepo) UpdateUserVip(ctx context.Context, userID int64, vipCode string, vipNumber int64, vipExpireTime time.Time) error {
+	err := r.data.DB(ctx).
+		Model(&User{}).
+		Where("id = ? AND isDelete = 0", userID).
+		Updates(map[string]interface{}{
+			"vipCode":       vipCode,
+			"vipNumber":     vipNumber,
+			"vipExpireTime": vipExpireTime,
+		}).Error
+
+	if err != nil {
+		r.log.Errorf("更新用户会员信息失败: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// NextVipNumber 分配下一个会员编号
+// 使用 Redis INCR 保证多副本下编号全局递增，计数器不存在时用数据库中的最大编号初始化
+func (r *vipRepo) NextVipNumber(ctx context.Context) (int64, error) {
+	exists, err := r.data.rdb.Exists(ctx, vipNumberKey).Result()
+	if err != nil {
+		r.log.Errorf("查询会员编号计数器失败: %v", err)
+		return 0, err
+	}
+
+	if exists == 0 {
+		var maxNumber int64
+		err := r.data.DB(ctx).
+			Model(&User{}).
+			Select("COALESCE(MAX(vipNumber), 0)").
+			Scan(&maxNumber).Error
+		if err != nil {
+			r.log.Errorf("查询最大会员编号失败: %v", err)
+			return 0, err
+		}
+
+		// 多个副本同时初始化时只有一个能写入成功
+		if err := r.data.rdb.SetNX(ctx, vipNumberKey, maxNumber, 0).Err(); err != nil {
+			r.log.Errorf("初始化会员编号计数器失败: %v", err)
+			return 0, err
+		}
+	}
+
+	number, err := r.data.rdb.Incr(ctx, vipNumberKey).Result()
+	if err != nil {
+		r.log.Errorf("分配会员编号失败: %v", err)
+		return 0, err
+	}
+
+	return number, nil
+}
@@ -0,0 +1,37 @@
+package data
+
+import (
+	"time"
+)
+
+// VipCode 会员兑换码实体
+type VipCode struct {
+	ID         int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Code       string     `gorm:"column:code;type:varchar(128);not null;uniqueIndex:uk_code" json:"code"`
+	VipDays    int32      `gorm:"column:vipDays;not null" json:"vipDays"`
+	MaxUses    int32      `gorm:"column:maxUses;not null;default:1" json:"maxUses"`
+	UsedCount  int32      `gorm:"column:usedCount;not null;default:0" json:"usedCount"`
+	ExpireTime *time.Time `gorm:"column:expireTime" json:"expireTime"`
+	CreateUser int64      `gorm:"column:createUser;not null" json:"createUser"`
+	CreateTime time.Time  `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+	UpdateTime time.Time  `gorm:"column:updateTime;autoUpdateTime" json:"updateTime"`
+	IsDelete   int8       `gorm:"column:isDelete;not null;default:0" json:"-"`
+}
+
+// TableName 指定表名
+func (VipCode) TableName() string {
+	return "vip_code"
+}
+
+// VipRedeemRecord 会员兑换记录实体
+type VipRedeemRecord struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	CodeID     int64     `gorm:"column:codeId;not null;uniqueIndex:uk_codeId_userId" json:"codeId"`
+	UserID     int64     `gorm:"column:userId;not null;uniqueIndex:uk_codeId_userId;index:idx_userId" json:"userId"`
+	CreateTime time.Time `gorm:"column:createTime;autoCreateTime" json:"createTime"`
+}
+
+// TableName 指定表名
+func (VipRedeemRecord) TableName() string {
+	return "vip_redeem_record"
+}
@@ -28,6 +28,11 @@ const (
 func JWTAuth(jwtManager *pkg.JWTManager) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			// 已通过 URL 令牌认证（见 WithUser），不再要求 Authorization 请求头
+			if GetUserIDFromContext(ctx) != 0 {
+				return handler(ctx, req)
+			}
+
 			// 从 HTTP Header 中获取 Token
 			if tr, ok := transport.FromServerContext(ctx); ok {
 				tokenString := tr.RequestHeader().Get(AuthorizationKey)
@@ -59,6 +64,12 @@ func JWTAuth(jwtManager *pkg.JWTManager) middleware.Middleware {
 	}
 }
 
+// WithUser 将已通过其他方式（如 URL 令牌）认证的用户信息存入上下文，JWTAuth 对该上下文直接放行
+func WithUser(ctx context.Context, userID int64, userRole string) context.Context {
+	ctx = context.WithValue(ctx, UserIDKey, userID)
+	return context.WithValue(ctx, UserRoleKey, userRole)
+}
+
 // GetUserIDFromContext 从上下文中获取用户 ID
 func GetUserIDFromContext(ctx context.Context) int64 {
 	if userID, ok := ctx.Value(UserIDKey).(int64); ok {
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"context"
+	nethttp "net/http"
+)
+
+// connContextKey 上下文中存储连接上下文的 key
+type connContextKey struct{}
+
+// ConnContext HTTP 过滤器，在服务超时控制之前保存请求的原始上下文，客户端断开连接时该上下文被取消
+// SSE 等长连接不能依赖受服务超时限制的请求上下文，通过 ConnDone 感知客户端断开
+func ConnContext(next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), connContextKey{}, r.Context())))
+	})
+}
+
+// ConnDone 返回客户端断开连接时关闭的 channel，请求未经过 ConnContext 时返回 nil（永不关闭）
+func ConnDone(ctx context.Context) <-chan struct{} {
+	if connCtx, ok := ctx.Value(connContextKey{}).(context.Context); ok {
+		return connCtx.Done()
+	}
+	return nil
+}
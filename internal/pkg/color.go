@@ -0,0 +1,147 @@
+package pkg
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	paletteSamples     = 65536 // 提取调色板时最多采样的像素数
+	paletteMinDistance = 12.0  // 调色板中两种颜色的最小色差，更接近的颜色视为同一种
+)
+
+// ErrInvalidColor 颜色格式错误
+var ErrInvalidColor = errors.New("invalid hex color")
+
+// ExtractPalette 提取图片中占比最高的 n 种颜色（按占比从高到低），第一个为主色调
+// 像素按 RGB 各 4 位量化到 4096 个格子统计，取格子内像素的平均色，半透明像素不参与统计
+func ExtractPalette(img image.Image, n int) []color.RGBA {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= 0 || height <= 0 || n <= 0 {
+		return nil
+	}
+
+	step := 1
+	for (width/step)*(height/step) > paletteSamples {
+		step++
+	}
+
+	type bin struct {
+		r, g, b, count uint64
+	}
+	bins := make(map[uint32]*bin)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += step {
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if c.A < 128 {
+				continue
+			}
+			key := uint32(c.R>>4)<<8 | uint32(c.G>>4)<<4 | uint32(c.B>>4)
+			item, ok := bins[key]
+			if !ok {
+				item = &bin{}
+				bins[key] = item
+			}
+			item.r += uint64(c.R)
+			item.g += uint64(c.G)
+			item.b += uint64(c.B)
+			item.count++
+		}
+	}
+
+	sorted := make([]*bin, 0, len(bins))
+	for _, item := range bins {
+		sorted = append(sorted, item)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].count > sorted[j].count })
+
+	palette := make([]color.RGBA, 0, n)
+	for _, item := range sorted {
+		c := color.RGBA{
+			R: uint8(item.r / item.count),
+			G: uint8(item.g / item.count),
+			B: uint8(item.b / item.count),
+			A: 255,
+		}
+		distinct := true
+		for _, picked := range palette {
+			if ColorDistance(c, picked) < paletteMinDistance {
+				distinct = false
+				break
+			}
+		}
+		if distinct {
+			palette = append(palette, c)
+			if len(palette) == n {
+				break
+			}
+		}
+	}
+	return palette
+}
+
+// HexColor 颜色转十六进制字符串（#RRGGBB）
+func HexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+}
+
+// ParseHexColor 解析十六进制颜色，支持 #RRGGBB、#RGB，# 可省略
+func ParseHexColor(s string) (color.RGBA, error) {
+	hex := strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return color.RGBA{}, fmt.Errorf("%w: '%s'", ErrInvalidColor, s)
+	}
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("%w: '%s'", ErrInvalidColor, s)
+	}
+	return color.RGBA{R: uint8(value >> 16), G: uint8(value >> 8), B: uint8(value), A: 255}, nil
+}
+
+// RGBToLab sRGB 颜色转 CIELAB（D65 白点），Lab 空间中的欧氏距离接近人眼感知的色差
+func RGBToLab(c color.RGBA) (l, a, b float64) {
+	r := srgbToLinear(c.R)
+	g := srgbToLinear(c.G)
+	bl := srgbToLinear(c.B)
+
+	x := (0.4124*r + 0.3576*g + 0.1805*bl) / 0.95047
+	y := 0.2126*r + 0.7152*g + 0.0722*bl
+	z := (0.0193*r + 0.1192*g + 0.9505*bl) / 1.08883
+
+	fx, fy, fz := labF(x), labF(y), labF(z)
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+// ColorDistance 两种颜色的感知色差（CIE76 ΔE）
+func ColorDistance(c1, c2 color.RGBA) float64 {
+	l1, a1, b1 := RGBToLab(c1)
+	l2, a2, b2 := RGBToLab(c2)
+	return math.Sqrt((l1-l2)*(l1-l2) + (a1-a2)*(a1-a2) + (b1-b2)*(b1-b2))
+}
+
+// srgbToLinear sRGB 分量转线性亮度（0-1）
+func srgbToLinear(v uint8) float64 {
+	c := float64(v) / 255
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// labF CIELAB 转换中的非线性函数
+func labF(t float64) float64 {
+	if t > 216.0/24389.0 {
+		return math.Cbrt(t)
+	}
+	return (24389.0/27.0*t + 16) / 116
+}
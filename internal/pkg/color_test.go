@@ -0,0 +1,143 @@
+package pkg
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestRGBToLab(t *testing.T) {
+	// 参考值来自 CIE 标准换算（D65 白点）
+	tests := []struct {
+		name                string
+		c                   color.RGBA
+		wantL, wantA, wantB float64
+	}{
+		{name: "黑色", c: color.RGBA{A: 255}, wantL: 0, wantA: 0, wantB: 0},
+		{name: "白色", c: color.RGBA{R: 255, G: 255, B: 255, A: 255}, wantL: 100, wantA: 0, wantB: 0},
+		{name: "中灰", c: color.RGBA{R: 128, G: 128, B: 128, A: 255}, wantL: 53.59, wantA: 0, wantB: 0},
+		{name: "红色", c: color.RGBA{R: 255, A: 255}, wantL: 53.24, wantA: 80.09, wantB: 67.20},
+		{name: "绿色", c: color.RGBA{G: 255, A: 255}, wantL: 87.73, wantA: -86.18, wantB: 83.18},
+		{name: "蓝色", c: color.RGBA{B: 255, A: 255}, wantL: 32.30, wantA: 79.19, wantB: -107.86},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, a, b := RGBToLab(tt.c)
+			if math.Abs(l-tt.wantL) > 0.1 || math.Abs(a-tt.wantA) > 0.1 || math.Abs(b-tt.wantB) > 0.1 {
+				t.Errorf("RGBToLab(%v) = (%.2f, %.2f, %.2f), want (%.2f, %.2f, %.2f)", tt.c, l, a, b, tt.wantL, tt.wantA, tt.wantB)
+			}
+		})
+	}
+}
+
+func TestColorDistance(t *testing.T) {
+	tests := []struct {
+		name    string
+		c1, c2  color.RGBA
+		wantMin float64
+		wantMax float64
+	}{
+		{name: "相同颜色", c1: color.RGBA{R: 10, G: 20, B: 30, A: 255}, c2: color.RGBA{R: 10, G: 20, B: 30, A: 255}, wantMin: 0, wantMax: 0},
+		{name: "黑白", c1: color.RGBA{A: 255}, c2: color.RGBA{R: 255, G: 255, B: 255, A: 255}, wantMin: 99.9, wantMax: 100.1},
+		{name: "相近的红色", c1: color.RGBA{R: 255, A: 255}, c2: color.RGBA{R: 250, G: 5, B: 5, A: 255}, wantMin: 0, wantMax: paletteMinDistance},
+		{name: "红绿", c1: color.RGBA{R: 255, A: 255}, c2: color.RGBA{G: 255, A: 255}, wantMin: 170, wantMax: 171},
+		{name: "忽略透明度", c1: color.RGBA{R: 255, A: 255}, c2: color.RGBA{R: 255}, wantMin: 0, wantMax: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := ColorDistance(tt.c1, tt.c2)
+			if d < tt.wantMin || d > tt.wantMax {
+				t.Errorf("ColorDistance() = %.2f, want [%.2f, %.2f]", d, tt.wantMin, tt.wantMax)
+			}
+			if reverse := ColorDistance(tt.c2, tt.c1); reverse != d {
+				t.Errorf("ColorDistance() not symmetric: %.4f != %.4f", d, reverse)
+			}
+		})
+	}
+}
+
+func TestParseHexColor(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    color.RGBA
+		wantErr bool
+	}{
+		{name: "带井号", s: "#FF8000", want: color.RGBA{R: 255, G: 128, A: 255}},
+		{name: "不带井号", s: "ff8000", want: color.RGBA{R: 255, G: 128, A: 255}},
+		{name: "简写", s: "#f80", want: color.RGBA{R: 255, G: 136, A: 255}},
+		{name: "首尾空白", s: " #000000 ", want: color.RGBA{A: 255}},
+		{name: "长度错误", s: "#FFFF", wantErr: true},
+		{name: "非十六进制", s: "#GG0000", wantErr: true},
+		{name: "带符号", s: "+12345", wantErr: true},
+		{name: "空字符串", s: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseHexColor(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseHexColor(%q) err = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrInvalidColor) {
+					t.Errorf("ParseHexColor(%q) err = %v, want ErrInvalidColor", tt.s, err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseHexColor(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+			if hex, _ := ParseHexColor(HexColor(got)); hex != got {
+				t.Errorf("HexColor round trip = %v, want %v", hex, got)
+			}
+		})
+	}
+}
+
+func TestExtractPalette(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+
+	// 左侧 3/4 为红色，右侧 1/4 为蓝色
+	img := image.NewRGBA(image.Rect(0, 0, 40, 10))
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 40; x++ {
+			if x < 30 {
+				img.Set(x, y, red)
+			} else {
+				img.Set(x, y, blue)
+			}
+		}
+	}
+
+	tests := []struct {
+		name string
+		img  image.Image
+		n    int
+		want []color.RGBA
+	}{
+		{name: "按占比排序", img: img, n: 5, want: []color.RGBA{red, blue}},
+		{name: "只取主色调", img: img, n: 1, want: []color.RGBA{red}},
+		{name: "数量为 0", img: img, n: 0, want: nil},
+		{name: "透明图片", img: image.NewRGBA(image.Rect(0, 0, 4, 4)), n: 3, want: []color.RGBA{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractPalette(tt.img, tt.n)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ExtractPalette() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("ExtractPalette()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
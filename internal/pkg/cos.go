@@ -2,10 +2,12 @@ package pkg
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,6 +17,26 @@ import (
 	"github.com/tencentyun/cos-go-sdk-v5"
 )
 
+var (
+	// ErrFileTypeNotSupported 文件扩展名不被存储桶允许
+	ErrFileTypeNotSupported = errors.New("file type not supported")
+	// ErrFileTooLarge 文件大小超过存储桶限制
+	ErrFileTooLarge = errors.New("file too large")
+	// ErrObjectNotFound 存储桶中不存在该文件
+	ErrObjectNotFound = errors.New("object not found")
+	// ErrObjectModified 文件在校验过程中被改写（ETag 与预期不一致）
+	ErrObjectModified = errors.New("object modified")
+)
+
+// maxPresignedExpire 上传预签名 URL 的最长有效期，上传完成后客户端仍可在有效期内用同一地址改写文件，因此不允许配置得过长
+const maxPresignedExpire = 15 * time.Minute
+
+// ObjectInfo 存储桶中对象的元信息
+type ObjectInfo struct {
+	Size int64  // 文件大小（字节）
+	ETag string // 文件内容的 ETag（不含引号），内容改变后随之改变
+}
+
 // BucketConfig 单个存储桶配置
 type BucketConfig struct {
 	Name              string        // 存储桶名称
@@ -23,15 +45,18 @@ type BucketConfig struct {
 	AllowedExtensions []string      // 允许的文件扩展名
 	MaxSize           int64         // 最大文件大小（字节）
 	PresignedExpire   time.Duration // 预签名 URL 过期时间
+	IsPrivate         bool          // 是否为私有读存储桶
+	DownloadExpire    time.Duration // 下载签名 URL 过期时间
 }
 
 // COSManager 腾讯云 COS 管理器（支持多存储桶）
 type COSManager struct {
-	secretID      string
-	secretKey     string
-	buckets       map[string]*BucketConfig // 多存储桶配置
-	defaultBucket string                   // 默认存储桶 key
-	log           *log.Helper
+	secretID        string
+	secretKey       string
+	buckets         map[string]*BucketConfig // 多存储桶配置
+	defaultBucket   string                   // 默认存储桶 key
+	multipartExpire time.Duration            // 分片上传有效期
+	log             *log.Helper
 }
 
 // NewCOSManager 创建 COS 管理器
@@ -64,6 +89,15 @@ func NewCOSManager(c *conf.Cos, logger log.Logger) (*COSManager, error) {
 		if bucket.PresignedExpire != nil {
 			presignedExpire = bucket.PresignedExpire.AsDuration()
 		}
+		if presignedExpire > maxPresignedExpire {
+			helper.Warnf("存储桶 %s 的预签名过期时间 %v 超过上限，使用 %v", key, presignedExpire, maxPresignedExpire)
+			presignedExpire = maxPresignedExpire
+		}
+		// 设置下载签名过期时间，默认 10 分钟
+		downloadExpire := 10 * time.Minute
+		if bucket.DownloadExpire != nil {
+			downloadExpire = bucket.DownloadExpire.AsDuration()
+		}
 
 		buckets[key] = &BucketConfig{
 			Name:              bucket.BucketName,
@@ -72,9 +106,11 @@ func NewCOSManager(c *conf.Cos, logger log.Logger) (*COSManager, error) {
 			AllowedExtensions: bucket.AllowedExtensions,
 			MaxSize:           bucket.MaxSize,
 			PresignedExpire:   presignedExpire,
+			IsPrivate:         bucket.IsPrivate,
+			DownloadExpire:    downloadExpire,
 		}
-		helper.Infof("加载存储桶配置: key=%s, bucket=%s, region=%s, expire=%v",
-			key, bucket.BucketName, bucket.Region, presignedExpire)
+		helper.Infof("加载存储桶配置: key=%s, bucket=%s, region=%s, expire=%v, private=%v",
+			key, bucket.BucketName, bucket.Region, presignedExpire, bucket.IsPrivate)
 	}
 
 	// 验证默认存储桶
@@ -94,12 +130,19 @@ func NewCOSManager(c *conf.Cos, logger log.Logger) (*COSManager, error) {
 		}
 	}
 
+	// 设置分片上传有效期，默认 24 小时
+	multipartExpire := 24 * time.Hour
+	if c.MultipartExpire != nil {
+		multipartExpire = c.MultipartExpire.AsDuration()
+	}
+
 	manager := &COSManager{
-		secretID:      c.SecretId,
-		secretKey:     c.SecretKey,
-		buckets:       buckets,
-		defaultBucket: defaultBucket,
-		log:           helper,
+		secretID:        c.SecretId,
+		secretKey:       c.SecretKey,
+		buckets:         buckets,
+		defaultBucket:   defaultBucket,
+		multipartExpire: multipartExpire,
+		log:             helper,
 	}
 
 	helper.Infof("COS Manager 初始化成功，共 %d 个存储桶，默认: %s", len(buckets), defaultBucket)
@@ -131,43 +174,23 @@ type UploadOptions struct {
 	FileName    string // 原始文件名（必填）
 	ContentType string // 文件 MIME 类型（可选）
 	BucketKey   string // 存储桶 key（如 image/video/document，可选）
-	FileSize    int64  // 文件大小（字节，用于校验，可选）
+	FileSize    int64  // 文件大小（字节，必填，用于校验存储桶大小限制并绑定到签名）
 }
 
 // GetUploadPresignedURL 获取上传预签名 URL
 func (m *COSManager) GetUploadPresignedURL(ctx context.Context, opts *UploadOptions) (*PresignedURLResult, error) {
-	// 确定使用的存储桶
-	bucketKey := opts.BucketKey
-	if bucketKey == "" {
-		bucketKey = m.defaultBucket
+	if opts.FileSize <= 0 {
+		return nil, fmt.Errorf("file size must be positive")
 	}
 
-	bucketConfig, ok := m.buckets[bucketKey]
-	if !ok {
-		m.log.Errorf("存储桶 '%s' 不存在", bucketKey)
-		return nil, fmt.Errorf("bucket '%s' not found", bucketKey)
-	}
-
-	// 校验文件扩展名
-	if len(bucketConfig.AllowedExtensions) > 0 {
-		ext := strings.ToLower(path.Ext(opts.FileName))
-		allowed := false
-		for _, allowedExt := range bucketConfig.AllowedExtensions {
-			if ext == strings.ToLower(allowedExt) {
-				allowed = true
-				break
-			}
-		}
-		if !allowed {
-			m.log.Warnf("文件扩展名 '%s' 不被存储桶 '%s' 允许", ext, bucketKey)
-			return nil, fmt.Errorf("file extension '%s' not allowed for bucket '%s'", ext, bucketKey)
-		}
+	bucketKey, bucketConfig, err := m.resolveUploadBucket(opts)
+	if err != nil {
+		return nil, err
 	}
 
-	// 校验文件大小
-	if bucketConfig.MaxSize > 0 && opts.FileSize > bucketConfig.MaxSize {
-		m.log.Warnf("文件大小 %d 超过存储桶 '%s' 限制 %d", opts.FileSize, bucketKey, bucketConfig.MaxSize)
-		return nil, fmt.Errorf("file size %d exceeds limit %d for bucket '%s'", opts.FileSize, bucketConfig.MaxSize, bucketKey)
+	contentType, err := resolveContentType(opts.FileName, opts.ContentType)
+	if err != nil {
+		return nil, err
 	}
 
 	// 构建 Bucket URL
@@ -201,9 +224,10 @@ func (m *COSManager) GetUploadPresignedURL(ctx context.Context, opts *UploadOpti
 		Header: &http.Header{},
 	}
 
-	// 如果提供了 Content-Type，添加到签名中
-	if opts.ContentType != "" {
-		signOpt.Header.Set("Content-Type", opts.ContentType)
+	// 将文件大小和 Content-Type 加入签名，客户端上传的内容长度和类型必须与声明一致
+	signOpt.Header.Set("Content-Length", strconv.FormatInt(opts.FileSize, 10))
+	if contentType != "" {
+		signOpt.Header.Set("Content-Type", contentType)
 	}
 
 	// 获取预签名 URL
@@ -228,13 +252,14 @@ func (m *COSManager) GetUploadPresignedURL(ctx context.Context, opts *UploadOpti
 	accessURL := fmt.Sprintf("%s/%s", bucketURL, fileKey)
 
 	result := &PresignedURLResult{
-		UploadURL:  presignedURL.String(),
-		FileKey:    fileKey,
-		AccessURL:  accessURL,
-		ExpireTime: expireTime,
-		BucketKey:  bucketKey,
-		BucketName: bucketConfig.Name,
-		Region:     bucketConfig.Region,
+		UploadURL:   presignedURL.String(),
+		FileKey:     fileKey,
+		AccessURL:   accessURL,
+		ExpireTime:  expireTime,
+		ContentType: contentType,
+		BucketKey:   bucketKey,
+		BucketName:  bucketConfig.Name,
+		Region:      bucketConfig.Region,
 	}
 
 	m.log.Infof("生成预签名 URL 成功: bucketKey=%s, bucket=%s, region=%s, fileKey=%s",
@@ -242,6 +267,150 @@ func (m *COSManager) GetUploadPresignedURL(ctx context.Context, opts *UploadOpti
 	return result, nil
 }
 
+// resolveUploadBucket 确定上传使用的存储桶，并校验文件扩展名和文件大小
+func (m *COSManager) resolveUploadBucket(opts *UploadOptions) (string, *BucketConfig, error) {
+	// 确定使用的存储桶
+	bucketKey := opts.BucketKey
+	if bucketKey == "" {
+		bucketKey = m.defaultBucket
+	}
+
+	bucketConfig, ok := m.buckets[bucketKey]
+	if !ok {
+		m.log.Errorf("存储桶 '%s' 不存在", bucketKey)
+		return "", nil, fmt.Errorf("bucket '%s' not found", bucketKey)
+	}
+
+	// 校验文件扩展名
+	if len(bucketConfig.AllowedExtensions) > 0 {
+		ext := strings.ToLower(path.Ext(opts.FileName))
+		allowed := false
+		for _, allowedExt := range bucketConfig.AllowedExtensions {
+			if ext == strings.ToLower(allowedExt) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			m.log.Warnf("文件扩展名 '%s' 不被存储桶 '%s' 允许", ext, bucketKey)
+			return "", nil, fmt.Errorf("%w: file extension '%s' not allowed for bucket '%s'", ErrFileTypeNotSupported, ext, bucketKey)
+		}
+	}
+
+	// 校验文件大小
+	if bucketConfig.MaxSize > 0 && opts.FileSize > bucketConfig.MaxSize {
+		m.log.Warnf("文件大小 %d 超过存储桶 '%s' 限制 %d", opts.FileSize, bucketKey, bucketConfig.MaxSize)
+		return "", nil, fmt.Errorf("%w: file size %d exceeds limit %d for bucket '%s'", ErrFileTooLarge, opts.FileSize, bucketConfig.MaxSize, bucketKey)
+	}
+
+	return bucketKey, bucketConfig, nil
+}
+
+// newBucketClient 为存储桶创建临时 COS 客户端
+func (m *COSManager) newBucketClient(bucketConfig *BucketConfig) (*cos.Client, string, error) {
+	bucketURL := fmt.Sprintf("https://%s.cos.%s.myqcloud.com", bucketConfig.Name, bucketConfig.Region)
+	u, err := url.Parse(bucketURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid bucket url: %w", err)
+	}
+
+	client := cos.NewClient(&cos.BaseURL{BucketURL: u}, &http.Client{
+		Transport: &cos.AuthorizationTransport{
+			SecretID:  m.secretID,
+			SecretKey: m.secretKey,
+		},
+	})
+	return client, bucketURL, nil
+}
+
+// GetDownloadPresignedURL 为存储桶中对象的访问 URL 生成预签名下载（GET）URL
+// expire 小于等于 0 时使用存储桶配置的下载签名过期时间，访问 URL 不属于任何已配置的存储桶时原样返回
+func (m *COSManager) GetDownloadPresignedURL(ctx context.Context, accessURL string, expire time.Duration) (string, error) {
+	u, err := url.Parse(accessURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid access url: %w", err)
+	}
+
+	bucketConfig := m.findBucketByHost(u.Host)
+	if bucketConfig == nil {
+		return accessURL, nil
+	}
+	if expire <= 0 {
+		expire = bucketConfig.DownloadExpire
+	}
+
+	bucketURL, err := url.Parse(fmt.Sprintf("https://%s", u.Host))
+	if err != nil {
+		return "", fmt.Errorf("invalid bucket url: %w", err)
+	}
+	client := cos.NewClient(&cos.BaseURL{BucketURL: bucketURL}, &http.Client{
+		Transport: &cos.AuthorizationTransport{
+			SecretID:  m.secretID,
+			SecretKey: m.secretKey,
+		},
+	})
+
+	fileKey := strings.TrimPrefix(u.Path, "/")
+	presignedURL, err := client.Object.GetPresignedURL(ctx, http.MethodGet, fileKey, m.secretID, m.secretKey, expire, nil)
+	if err != nil {
+		m.log.Errorf("生成下载预签名 URL 失败: bucket=%s, fileKey=%s, err=%v", bucketConfig.Name, fileKey, err)
+		return "", fmt.Errorf("failed to generate presigned url: %w", err)
+	}
+	return presignedURL.String(), nil
+}
+
+// SignAccessURL 私有存储桶中对象的访问 URL 替换为短期有效的下载签名 URL，其他 URL 原样返回
+func (m *COSManager) SignAccessURL(ctx context.Context, accessURL string) (string, error) {
+	u, err := url.Parse(accessURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid access url: %w", err)
+	}
+
+	bucketConfig := m.findBucketByHost(u.Host)
+	if bucketConfig == nil || !bucketConfig.IsPrivate {
+		return accessURL, nil
+	}
+	return m.GetDownloadPresignedURL(ctx, accessURL, bucketConfig.DownloadExpire)
+}
+
+// HeadObject 查询存储桶中对象的大小和 ETag，对象不存在时返回 ErrObjectNotFound
+func (m *COSManager) HeadObject(ctx context.Context, accessURL string) (*ObjectInfo, error) {
+	u, err := url.Parse(accessURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid access url: %w", err)
+	}
+	bucketConfig := m.findBucketByHost(u.Host)
+	if bucketConfig == nil {
+		return nil, fmt.Errorf("access url '%s' does not belong to any bucket", accessURL)
+	}
+
+	client, _, err := m.newBucketClient(bucketConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	fileKey := strings.TrimPrefix(u.Path, "/")
+	resp, err := client.Object.Head(ctx, fileKey, nil)
+	if err != nil {
+		if cos.IsNotFoundError(err) {
+			return nil, ErrObjectNotFound
+		}
+		m.log.Errorf("查询文件信息失败: bucket=%s, fileKey=%s, err=%v", bucketConfig.Name, fileKey, err)
+		return nil, fmt.Errorf("failed to head object: %w", err)
+	}
+	return &ObjectInfo{Size: resp.ContentLength, ETag: strings.Trim(resp.Header.Get("ETag"), `"`)}, nil
+}
+
+// findBucketByHost 根据访问域名匹配存储桶配置，未匹配时返回 nil
+func (m *COSManager) findBucketByHost(host string) *BucketConfig {
+	for _, config := range m.buckets {
+		if host == fmt.Sprintf("%s.cos.%s.myqcloud.com", config.Name, config.Region) {
+			return config
+		}
+	}
+	return nil
+}
+
 // DetectBucketKeyByFileName 根据文件名自动检测应使用的存储桶 key
 func (m *COSManager) DetectBucketKeyByFileName(fileName string) string {
 	ext := strings.ToLower(path.Ext(fileName))
@@ -291,11 +460,12 @@ func cleanFileName(name string) string {
 
 // PresignedURLResult 预签名 URL 结果
 type PresignedURLResult struct {
-	UploadURL  string // 预签名上传 URL
-	FileKey    string // 文件 key（路径）
-	AccessURL  string // 访问 URL
-	ExpireTime int64  // 过期时间戳
-	BucketKey  string // 存储桶 key（如 image/video/document）
-	BucketName string // 实际使用的存储桶名称
-	Region     string // 实际使用的地域
+	UploadURL   string // 预签名上传 URL
+	FileKey     string // 文件 key（路径）
+	AccessURL   string // 访问 URL
+	ExpireTime  int64  // 过期时间戳
+	ContentType string // 上传时必须携带的 Content-Type，为空时不限制
+	BucketKey   string // 存储桶 key（如 image/video/document）
+	BucketName  string // 实际使用的存储桶名称
+	Region      string // 实际使用的地域
 }
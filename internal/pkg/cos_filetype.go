@@ -0,0 +1,144 @@
+package pkg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/tencentyun/cos-go-sdk-v5"
+)
+
+// sniffLength 检测文件类型时读取的文件头长度
+const sniffLength = 512
+
+// fileTypeRule 文件扩展名对应的类型规则
+type fileTypeRule struct {
+	contentType string   // 上传时签名的 Content-Type
+	sniffed     []string // 文件头检测允许的类型
+}
+
+// fileTypeRules 已知扩展名的类型规则，未列出的扩展名不做类型绑定和文件头检测
+var fileTypeRules = map[string]fileTypeRule{
+	".jpg":  {contentType: "image/jpeg", sniffed: []string{"image/jpeg"}},
+	".jpeg": {contentType: "image/jpeg", sniffed: []string{"image/jpeg"}},
+	".png":  {contentType: "image/png", sniffed: []string{"image/png"}},
+	".gif":  {contentType: "image/gif", sniffed: []string{"image/gif"}},
+	".webp": {contentType: "image/webp", sniffed: []string{"image/webp"}},
+	".bmp":  {contentType: "image/bmp", sniffed: []string{"image/bmp"}},
+	".mp4":  {contentType: "video/mp4", sniffed: []string{"video/mp4", "video/quicktime"}},
+	".mov":  {contentType: "video/quicktime", sniffed: []string{"video/quicktime", "video/mp4"}},
+	".avi":  {contentType: "video/x-msvideo", sniffed: []string{"video/avi"}},
+	".mkv":  {contentType: "video/x-matroska", sniffed: []string{"video/x-matroska", "video/webm"}},
+	".webm": {contentType: "video/webm", sniffed: []string{"video/webm"}},
+	".pdf":  {contentType: "application/pdf", sniffed: []string{"application/pdf"}},
+	".doc":  {contentType: "application/msword", sniffed: []string{"application/x-ole-storage"}},
+	".xls":  {contentType: "application/vnd.ms-excel", sniffed: []string{"application/x-ole-storage"}},
+	".docx": {contentType: "application/vnd.openxmlformats-officedocument.wordprocessingml.document", sniffed: []string{"application/zip"}},
+	".xlsx": {contentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", sniffed: []string{"application/zip"}},
+}
+
+// resolveContentType 确定上传时签名的 Content-Type
+// 已知扩展名只允许对应的类型（客户端未传时自动补全），未知扩展名使用客户端传入的类型
+func resolveContentType(fileName, contentType string) (string, error) {
+	rule, ok := fileTypeRules[strings.ToLower(path.Ext(fileName))]
+	if !ok {
+		return contentType, nil
+	}
+	if contentType == "" {
+		return rule.contentType, nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.EqualFold(mediaType, rule.contentType) {
+		return "", fmt.Errorf("%w: content type '%s' does not match file '%s'", ErrFileTypeNotSupported, contentType, fileName)
+	}
+	return rule.contentType, nil
+}
+
+// VerifyUploadedObject 读取已上传对象的文件头，检测实际类型是否与扩展名一致
+// 不一致时删除对象并返回 ErrFileTypeNotSupported，访问 URL 不属于任何已配置的存储桶时不做检测
+// etag 不为空时只读取该版本的内容，对象已被改写时返回 ErrObjectModified
+func (m *COSManager) VerifyUploadedObject(ctx context.Context, accessURL, etag string) error {
+	u, err := url.Parse(accessURL)
+	if err != nil {
+		return fmt.Errorf("invalid access url: %w", err)
+	}
+	bucketConfig := m.findBucketByHost(u.Host)
+	if bucketConfig == nil {
+		return nil
+	}
+
+	fileKey := strings.TrimPrefix(u.Path, "/")
+	rule, ok := fileTypeRules[strings.ToLower(path.Ext(fileKey))]
+	if !ok {
+		return nil
+	}
+
+	client, _, err := m.newBucketClient(bucketConfig)
+	if err != nil {
+		return err
+	}
+
+	getOpt := &cos.ObjectGetOptions{Range: fmt.Sprintf("bytes=0-%d", sniffLength-1)}
+	if etag != "" {
+		getOpt.XOptionHeader = &http.Header{}
+		getOpt.XOptionHeader.Set("If-Match", `"`+etag+`"`)
+	}
+	resp, err := client.Object.Get(ctx, fileKey, getOpt)
+	if err != nil {
+		if cos.IsNotFoundError(err) {
+			return ErrObjectNotFound
+		}
+		if cosErr, ok := cos.IsCOSError(err); ok && cosErr.Response != nil && cosErr.Response.StatusCode == http.StatusPreconditionFailed {
+			return ErrObjectModified
+		}
+		m.log.Errorf("读取文件头失败: bucket=%s, fileKey=%s, err=%v", bucketConfig.Name, fileKey, err)
+		return fmt.Errorf("failed to read object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	head, err := io.ReadAll(io.LimitReader(resp.Body, sniffLength))
+	if err != nil {
+		return fmt.Errorf("failed to read object: %w", err)
+	}
+
+	detected := sniffContentType(head)
+	for _, allowed := range rule.sniffed {
+		if detected == allowed {
+			return nil
+		}
+	}
+
+	m.log.Warnf("文件内容与扩展名不符，删除文件: bucket=%s, fileKey=%s, detected=%s", bucketConfig.Name, fileKey, detected)
+	if _, err := client.Object.Delete(ctx, fileKey); err != nil {
+		m.log.Errorf("删除伪装文件失败: bucket=%s, fileKey=%s, err=%v", bucketConfig.Name, fileKey, err)
+	}
+	return fmt.Errorf("%w: detected content type '%s' for '%s'", ErrFileTypeNotSupported, detected, fileKey)
+}
+
+// sniffContentType 根据文件头检测文件类型，补充标准库无法识别的视频容器和 Office 文档格式
+func sniffContentType(head []byte) string {
+	switch {
+	case len(head) >= 12 && string(head[4:8]) == "ftyp":
+		if string(head[8:12]) == "qt  " {
+			return "video/quicktime"
+		}
+		return "video/mp4"
+	case bytes.HasPrefix(head, []byte{0x1A, 0x45, 0xDF, 0xA3}):
+		if bytes.Contains(head, []byte("webm")) {
+			return "video/webm"
+		}
+		return "video/x-matroska"
+	case bytes.HasPrefix(head, []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}):
+		return "application/x-ole-storage"
+	}
+
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	return contentType
+}
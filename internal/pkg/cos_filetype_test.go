@@ -0,0 +1,76 @@
+package pkg
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSniffContentType(t *testing.T) {
+	tests := []struct {
+		name string
+		head []byte
+		want string
+	}{
+		{name: "JPEG", head: []byte("\xFF\xD8\xFF\xE0\x00\x10JFIF\x00"), want: "image/jpeg"},
+		{name: "PNG", head: []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR"), want: "image/png"},
+		{name: "GIF", head: []byte("GIF89a\x01\x00\x01\x00"), want: "image/gif"},
+		{name: "WebP", head: []byte("RIFF\x24\x00\x00\x00WEBPVP8 "), want: "image/webp"},
+		{name: "BMP", head: []byte("BM\x36\x00\x00\x00\x00\x00"), want: "image/bmp"},
+		{name: "MP4", head: []byte("\x00\x00\x00\x20ftypisom\x00\x00\x02\x00"), want: "video/mp4"},
+		{name: "MOV", head: []byte("\x00\x00\x00\x14ftypqt  \x00\x00\x02\x00"), want: "video/quicktime"},
+		{name: "AVI", head: []byte("RIFF\x24\x00\x00\x00AVI LIST"), want: "video/avi"},
+		{name: "MKV", head: []byte("\x1A\x45\xDF\xA3\x9F\x42\x86\x81\x01\x42\x82\x88matroska"), want: "video/x-matroska"},
+		{name: "WebM", head: []byte("\x1A\x45\xDF\xA3\x9F\x42\x86\x81\x01\x42\x82\x84webm"), want: "video/webm"},
+		{name: "PDF", head: []byte("%PDF-1.7\n"), want: "application/pdf"},
+		{name: "DOC/XLS", head: []byte("\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1\x00\x00"), want: "application/x-ole-storage"},
+		{name: "DOCX/XLSX", head: []byte("PK\x03\x04\x14\x00\x06\x00"), want: "application/zip"},
+		{name: "HTML 伪装", head: []byte("<!DOCTYPE html><script>alert(1)</script>"), want: "text/html"},
+		{name: "文本", head: []byte("hello world"), want: "text/plain"},
+		{name: "ftyp 文件头不完整", head: []byte("\x00\x00\x00\x20ftyp"), want: "application/octet-stream"},
+		{name: "空文件", head: nil, want: "text/plain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sniffContentType(tt.head); got != tt.want {
+				t.Errorf("sniffContentType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveContentType(t *testing.T) {
+	tests := []struct {
+		name        string
+		fileName    string
+		contentType string
+		want        string
+		wantErr     bool
+	}{
+		{name: "自动补全类型", fileName: "photo.JPG", want: "image/jpeg"},
+		{name: "类型一致", fileName: "photo.png", contentType: "image/png", want: "image/png"},
+		{name: "忽略大小写和参数", fileName: "a.webp", contentType: "Image/WebP; charset=binary", want: "image/webp"},
+		{name: "类型与扩展名不符", fileName: "photo.jpg", contentType: "text/html", wantErr: true},
+		{name: "类型格式错误", fileName: "photo.jpg", contentType: ";;", wantErr: true},
+		{name: "未知扩展名使用客户端类型", fileName: "notes.txt", contentType: "text/plain", want: "text/plain"},
+		{name: "没有扩展名", fileName: "README", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveContentType(tt.fileName, tt.contentType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveContentType() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrFileTypeNotSupported) {
+					t.Errorf("resolveContentType() err = %v, want ErrFileTypeNotSupported", err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("resolveContentType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
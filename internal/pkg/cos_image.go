@@ -0,0 +1,323 @@
+package pkg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // 注册 GIF 解码器
+	_ "image/jpeg" // 注册 JPEG 解码器
+	_ "image/png"  // 注册 PNG 解码器
+	"io"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/tencentyun/cos-go-sdk-v5"
+)
+
+const (
+	maxImageBytes  = 50 << 20   // 读取图片内容的最大字节数
+	maxImagePixels = 50_000_000 // 允许解码的最大像素数，避免超大图片耗尽内存
+)
+
+// ErrImageTooLarge 图片文件或像素数超过解码限制
+var ErrImageTooLarge = errors.New("image too large to decode")
+
+// ReadObject 读取存储桶中对象的完整内容，超过 maxBytes 时返回 ErrImageTooLarge
+func (m *COSManager) ReadObject(ctx context.Context, accessURL string, maxBytes int64) ([]byte, error) {
+	u, err := url.Parse(accessURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid access url: %w", err)
+	}
+	bucketConfig := m.findBucketByHost(u.Host)
+	if bucketConfig == nil {
+		return nil, fmt.Errorf("access url '%s' does not belong to any bucket", accessURL)
+	}
+
+	client, _, err := m.newBucketClient(bucketConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	fileKey := strings.TrimPrefix(u.Path, "/")
+	resp, err := client.Object.Get(ctx, fileKey, nil)
+	if err != nil {
+		if cos.IsNotFoundError(err) {
+			return nil, ErrObjectNotFound
+		}
+		m.log.Errorf("读取文件失败: bucket=%s, fileKey=%s, err=%v", bucketConfig.Name, fileKey, err)
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, ErrImageTooLarge
+	}
+	return data, nil
+}
+
+// WriteObject 覆盖写入存储桶中已有的对象，返回写入后文件的 ETag（不含引号）
+func (m *COSManager) WriteObject(ctx context.Context, accessURL string, data []byte, contentType string) (string, error) {
+	u, err := url.Parse(accessURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid access url: %w", err)
+	}
+	bucketConfig := m.findBucketByHost(u.Host)
+	if bucketConfig == nil {
+		return "", fmt.Errorf("access url '%s' does not belong to any bucket", accessURL)
+	}
+
+	client, _, err := m.newBucketClient(bucketConfig)
+	if err != nil {
+		return "", err
+	}
+
+	fileKey := strings.TrimPrefix(u.Path, "/")
+	resp, err := client.Object.Put(ctx, fileKey, bytes.NewReader(data), &cos.ObjectPutOptions{
+		ObjectPutHeaderOptions: &cos.ObjectPutHeaderOptions{
+			ContentType:   contentType,
+			ContentLength: int64(len(data)),
+		},
+	})
+	if err != nil {
+		m.log.Errorf("写入文件失败: bucket=%s, fileKey=%s, err=%v", bucketConfig.Name, fileKey, err)
+		return "", fmt.Errorf("failed to write object: %w", err)
+	}
+	return strings.Trim(resp.Header.Get("ETag"), `"`), nil
+}
+
+// ImageFile 从存储桶读取并解码的图片
+type ImageFile struct {
+	Data   []byte      // 文件原始内容
+	Image  image.Image // 解码后的图片
+	Format string      // 图片格式（jpeg、png、gif）
+}
+
+// ReadImage 读取并解码存储桶中的图片，仅支持标准库可解码的 JPEG、PNG、GIF 格式
+// 其他格式返回 image.ErrFormat
+func (m *COSManager) ReadImage(ctx context.Context, accessURL string) (*ImageFile, error) {
+	data, err := m.ReadObject(ctx, accessURL, maxImageBytes)
+	if err != nil {
+		return nil, err
+	}
+	img, format, err := DecodeImage(data)
+	if err != nil {
+		return nil, err
+	}
+	return &ImageFile{Data: data, Image: img, Format: format}, nil
+}
+
+// DecodeImage 解码图片，先读取尺寸，像素数超过限制时不解码
+func DecodeImage(data []byte) (image.Image, string, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+	if int64(config.Width)*int64(config.Height) > maxImagePixels {
+		return nil, "", ErrImageTooLarge
+	}
+
+	return image.Decode(bytes.NewReader(data))
+}
+
+// imageFormatExtensions 图片格式对应的文件扩展名（按优先级排列）
+var imageFormatExtensions = map[string][]string{
+	"jpeg": {".jpg", ".jpeg"},
+	"png":  {".png"},
+	"gif":  {".gif"},
+	"webp": {".webp"},
+}
+
+// ImageExtensionFor 返回在源文件所在存储桶中保存该格式图片使用的扩展名
+// 与源文件格式相同时沿用源文件的扩展名，存储桶不允许该格式时返回 ErrFileTypeNotSupported
+func (m *COSManager) ImageExtensionFor(sourceURL, format string) (string, error) {
+	bucketConfig, fileKey, err := m.parseBucketURL(sourceURL)
+	if err != nil {
+		return "", err
+	}
+
+	candidates := imageFormatExtensions[format]
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("%w: unknown image format '%s'", ErrFileTypeNotSupported, format)
+	}
+	sourceExt := strings.ToLower(path.Ext(fileKey))
+	if slices.Contains(candidates, sourceExt) {
+		candidates = append([]string{sourceExt}, candidates...)
+	}
+	for _, ext := range candidates {
+		if bucketAllowsExtension(bucketConfig, ext) {
+			return ext, nil
+		}
+	}
+	return "", fmt.Errorf("%w: format '%s' not allowed for bucket '%s'", ErrFileTypeNotSupported, format, bucketConfig.Name)
+}
+
+// SaveDerivedObject 将由源文件生成的新文件保存到源文件所在存储桶的同一目录，返回新文件的访问地址
+// 新文件名为源文件名加 suffix 和扩展名 ext，扩展名和文件大小需满足存储桶的限制
+func (m *COSManager) SaveDerivedObject(ctx context.Context, sourceURL, suffix, ext string, data []byte) (string, error) {
+	_, fileKey, err := m.parseBucketURL(sourceURL)
+	if err != nil {
+		return "", err
+	}
+
+	base := strings.TrimSuffix(path.Base(fileKey), path.Ext(fileKey))
+	newKey := base + "_" + suffix + ext
+	if dir := path.Dir(fileKey); dir != "." {
+		newKey = dir + "/" + newKey
+	}
+	return m.SaveSiblingObject(ctx, sourceURL, newKey, data)
+}
+
+// SaveSiblingObject 将文件保存到源文件所在存储桶的指定 key，返回新文件的访问地址
+// 扩展名和文件大小需满足存储桶的限制，不满足时返回 ErrFileTypeNotSupported 或 ErrFileTooLarge
+func (m *COSManager) SaveSiblingObject(ctx context.Context, sourceURL, fileKey string, data []byte) (string, error) {
+	bucketConfig, _, err := m.parseBucketURL(sourceURL)
+	if err != nil {
+		return "", err
+	}
+	fileKey = strings.TrimPrefix(fileKey, "/")
+	ext := strings.ToLower(path.Ext(fileKey))
+	if !bucketAllowsExtension(bucketConfig, ext) {
+		return "", fmt.Errorf("%w: file extension '%s' not allowed for bucket '%s'", ErrFileTypeNotSupported, ext, bucketConfig.Name)
+	}
+	if bucketConfig.MaxSize > 0 && int64(len(data)) > bucketConfig.MaxSize {
+		return "", fmt.Errorf("%w: file size %d exceeds limit %d for bucket '%s'", ErrFileTooLarge, len(data), bucketConfig.MaxSize, bucketConfig.Name)
+	}
+	accessURL := fmt.Sprintf("https://%s.cos.%s.myqcloud.com/%s", bucketConfig.Name, bucketConfig.Region, fileKey)
+
+	contentType := fileTypeRules[ext].contentType
+	if _, err := m.WriteObject(ctx, accessURL, data, contentType); err != nil {
+		return "", err
+	}
+	m.log.Infof("保存文件成功: bucket=%s, fileKey=%s, size=%d", bucketConfig.Name, fileKey, len(data))
+	return accessURL, nil
+}
+
+// DeleteObject 删除访问地址对应的对象，对象不存在时视为成功
+func (m *COSManager) DeleteObject(ctx context.Context, accessURL string) error {
+	bucketConfig, fileKey, err := m.parseBucketURL(accessURL)
+	if err != nil {
+		return err
+	}
+	client, _, err := m.newBucketClient(bucketConfig)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Object.Delete(ctx, fileKey); err != nil {
+		m.log.Errorf("删除文件失败: bucket=%s, fileKey=%s, err=%v", bucketConfig.Name, fileKey, err)
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	m.log.Infof("删除文件成功: bucket=%s, fileKey=%s", bucketConfig.Name, fileKey)
+	return nil
+}
+
+// DeleteObjectsByPrefix 删除所有已配置存储桶中 key 以 prefix 开头的对象，返回删除的数量
+func (m *COSManager) DeleteObjectsByPrefix(ctx context.Context, prefix string) (int, error) {
+	prefix = strings.TrimPrefix(prefix, "/")
+	if prefix == "" {
+		return 0, fmt.Errorf("delete prefix is empty")
+	}
+
+	deleted := 0
+	seen := make(map[string]struct{}, len(m.buckets))
+	for _, bucketConfig := range m.buckets {
+		// 多个 key 可能指向同一个存储桶
+		if _, ok := seen[bucketConfig.Name]; ok {
+			continue
+		}
+		seen[bucketConfig.Name] = struct{}{}
+
+		n, err := m.deleteBucketObjects(ctx, bucketConfig, prefix)
+		deleted += n
+		if err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// deleteBucketObjects 分页列出存储桶中 key 以 prefix 开头的对象并批量删除
+func (m *COSManager) deleteBucketObjects(ctx context.Context, bucketConfig *BucketConfig, prefix string) (int, error) {
+	client, _, err := m.newBucketClient(bucketConfig)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	marker := ""
+	for {
+		result, _, err := client.Bucket.Get(ctx, &cos.BucketGetOptions{Prefix: prefix, Marker: marker, MaxKeys: 1000})
+		if err != nil {
+			m.log.Errorf("列出文件失败: bucket=%s, prefix=%s, err=%v", bucketConfig.Name, prefix, err)
+			return deleted, fmt.Errorf("failed to list objects: %w", err)
+		}
+		if len(result.Contents) == 0 {
+			return deleted, nil
+		}
+
+		objects := make([]cos.Object, 0, len(result.Contents))
+		for _, object := range result.Contents {
+			objects = append(objects, cos.Object{Key: object.Key})
+		}
+		res, _, err := client.Object.DeleteMulti(ctx, &cos.ObjectDeleteMultiOptions{Quiet: true, Objects: objects})
+		if err != nil {
+			m.log.Errorf("删除文件失败: bucket=%s, prefix=%s, err=%v", bucketConfig.Name, prefix, err)
+			return deleted, fmt.Errorf("failed to delete objects: %w", err)
+		}
+		deleted += len(objects) - len(res.Errors)
+		if len(res.Errors) > 0 {
+			return deleted, fmt.Errorf("failed to delete object '%s': %s", res.Errors[0].Key, res.Errors[0].Message)
+		}
+
+		if !result.IsTruncated {
+			return deleted, nil
+		}
+		marker = result.NextMarker
+		if marker == "" {
+			marker = objects[len(objects)-1].Key
+		}
+	}
+}
+
+// SiblingObjectURL 返回源文件所在存储桶中指定 key 的访问地址
+func (m *COSManager) SiblingObjectURL(sourceURL, fileKey string) (string, error) {
+	bucketConfig, _, err := m.parseBucketURL(sourceURL)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://%s.cos.%s.myqcloud.com/%s", bucketConfig.Name, bucketConfig.Region, strings.TrimPrefix(fileKey, "/")), nil
+}
+
+// parseBucketURL 解析访问地址对应的存储桶和文件 key
+func (m *COSManager) parseBucketURL(accessURL string) (*BucketConfig, string, error) {
+	u, err := url.Parse(accessURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid access url: %w", err)
+	}
+	bucketConfig := m.findBucketByHost(u.Host)
+	if bucketConfig == nil {
+		return nil, "", fmt.Errorf("access url '%s' does not belong to any bucket", accessURL)
+	}
+	return bucketConfig, strings.TrimPrefix(u.Path, "/"), nil
+}
+
+// bucketAllowsExtension 存储桶是否允许该扩展名，未配置允许的扩展名时不限制
+func bucketAllowsExtension(bucketConfig *BucketConfig, ext string) bool {
+	if len(bucketConfig.AllowedExtensions) == 0 {
+		return true
+	}
+	for _, allowedExt := range bucketConfig.AllowedExtensions {
+		if strings.EqualFold(ext, allowedExt) {
+			return true
+		}
+	}
+	return false
+}
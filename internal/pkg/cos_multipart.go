@@ -0,0 +1,383 @@
+package pkg
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tencentyun/cos-go-sdk-v5"
+)
+
+const (
+	// multipartMinPartSize 建议的最小分片大小，COS 要求除最后一个分片外每个分片不小于 1MB
+	multipartMinPartSize = 8 * 1024 * 1024
+	// MultipartMaxPartNumber COS 单次分片上传的最大分片数量
+	MultipartMaxPartNumber = 10000
+)
+
+var (
+	// ErrUploadTokenInvalid 上传凭证无效、已过期或不属于当前用户
+	ErrUploadTokenInvalid = errors.New("upload token invalid")
+	// ErrUploadNotFound 分片上传不存在（已完成、已取消或已被清理）
+	ErrUploadNotFound = errors.New("multipart upload not found")
+)
+
+// MultipartUpload 分片上传信息，序列化后签名作为上传凭证下发给客户端
+type MultipartUpload struct {
+	UserID    int64  `json:"u"` // 发起上传的用户 ID
+	BucketKey string `json:"b"` // 存储桶 key
+	FileKey   string `json:"k"` // 文件 key（路径）
+	UploadID  string `json:"i"` // COS 分片上传 ID
+	FileSize  int64  `json:"s"` // 初始化时声明的文件大小（字节）
+	ExpireAt  int64  `json:"e"` // 上传凭证过期时间戳
+}
+
+// MultipartUploadResult 初始化分片上传结果
+type MultipartUploadResult struct {
+	UploadToken string // 上传凭证
+	UploadID    string // COS 分片上传 ID
+	FileKey     string // 文件 key（路径）
+	AccessURL   string // 访问 URL
+	PartSize    int64  // 建议的分片大小（字节）
+	PartCount   int    // 按建议分片大小计算的分片数量
+	ExpireTime  int64  // 上传凭证过期时间戳
+	ContentType string // 文件 MIME 类型
+	BucketKey   string // 存储桶 key
+	Region      string // 地域
+}
+
+// PresignedPart 分片上传预签名 URL
+type PresignedPart struct {
+	PartNumber int    // 分片编号
+	UploadURL  string // 预签名上传 URL
+}
+
+// UploadedPart 已上传的分片
+type UploadedPart struct {
+	PartNumber int    // 分片编号
+	ETag       string // 分片 ETag
+	Size       int64  // 分片大小（字节）
+}
+
+// CompletedUpload 完成分片上传结果
+type CompletedUpload struct {
+	FileKey   string // 文件 key（路径）
+	AccessURL string // 访问 URL
+	FileSize  int64  // 文件大小（字节）
+	ETag      string // 合并后文件的 ETag（不含引号）
+}
+
+// InitiateMultipartUpload 初始化分片上传，校验文件扩展名和声明的文件大小，返回签名的上传凭证
+func (m *COSManager) InitiateMultipartUpload(ctx context.Context, userID int64, opts *UploadOptions) (*MultipartUploadResult, error) {
+	if opts.FileSize <= 0 {
+		return nil, fmt.Errorf("file size must be positive")
+	}
+
+	bucketKey, bucketConfig, err := m.resolveUploadBucket(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType, err := resolveContentType(opts.FileName, opts.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	client, bucketURL, err := m.newBucketClient(bucketConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	fileKey := generateFileKey(opts.FileName, bucketConfig.UploadDir)
+
+	initOpt := &cos.InitiateMultipartUploadOptions{}
+	if contentType != "" {
+		initOpt.ObjectPutHeaderOptions = &cos.ObjectPutHeaderOptions{ContentType: contentType}
+	}
+	result, _, err := client.Object.InitiateMultipartUpload(ctx, fileKey, initOpt)
+	if err != nil {
+		m.log.Errorf("初始化分片上传失败: bucket=%s, fileKey=%s, err=%v", bucketConfig.Name, fileKey, err)
+		return nil, fmt.Errorf("failed to initiate multipart upload: %w", err)
+	}
+
+	// 分片数量不能超过上限，文件越大分片越大
+	partSize := int64(multipartMinPartSize)
+	if minSize := (opts.FileSize + MultipartMaxPartNumber - 1) / MultipartMaxPartNumber; minSize > partSize {
+		partSize = minSize
+	}
+	partCount := int((opts.FileSize + partSize - 1) / partSize)
+
+	upload := &MultipartUpload{
+		UserID:    userID,
+		BucketKey: bucketKey,
+		FileKey:   fileKey,
+		UploadID:  result.UploadID,
+		FileSize:  opts.FileSize,
+		ExpireAt:  time.Now().Add(m.multipartExpire).Unix(),
+	}
+	token, err := m.signMultipartUpload(upload)
+	if err != nil {
+		return nil, err
+	}
+
+	m.log.Infof("初始化分片上传成功: bucketKey=%s, fileKey=%s, uploadId=%s, fileSize=%d",
+		bucketKey, fileKey, result.UploadID, opts.FileSize)
+	return &MultipartUploadResult{
+		UploadToken: token,
+		UploadID:    result.UploadID,
+		FileKey:     fileKey,
+		AccessURL:   fmt.Sprintf("%s/%s", bucketURL, fileKey),
+		PartSize:    partSize,
+		PartCount:   partCount,
+		ExpireTime:  upload.ExpireAt,
+		ContentType: contentType,
+		BucketKey:   bucketKey,
+		Region:      bucketConfig.Region,
+	}, nil
+}
+
+// ParseMultipartUploadToken 校验上传凭证签名和有效期，并确认属于指定用户
+func (m *COSManager) ParseMultipartUploadToken(token string, userID int64) (*MultipartUpload, error) {
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrUploadTokenInvalid
+	}
+
+	expected := m.multipartSignature(payload)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return nil, ErrUploadTokenInvalid
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, ErrUploadTokenInvalid
+	}
+	var upload MultipartUpload
+	if err := json.Unmarshal(data, &upload); err != nil {
+		return nil, ErrUploadTokenInvalid
+	}
+
+	if upload.UserID != userID || time.Now().Unix() > upload.ExpireAt {
+		return nil, ErrUploadTokenInvalid
+	}
+	if _, ok := m.buckets[upload.BucketKey]; !ok {
+		return nil, ErrUploadTokenInvalid
+	}
+	return &upload, nil
+}
+
+// PresignUploadParts 为指定分片生成预签名上传（PUT）URL
+func (m *COSManager) PresignUploadParts(ctx context.Context, upload *MultipartUpload, partNumbers []int) ([]*PresignedPart, int64, error) {
+	bucketConfig := m.buckets[upload.BucketKey]
+	client, _, err := m.newBucketClient(bucketConfig)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	expire := bucketConfig.PresignedExpire
+	parts := make([]*PresignedPart, 0, len(partNumbers))
+	for _, partNumber := range partNumbers {
+		signOpt := &cos.PresignedURLOptions{
+			Query: &url.Values{
+				"partNumber": []string{strconv.Itoa(partNumber)},
+				"uploadId":   []string{upload.UploadID},
+			},
+			Header: &http.Header{},
+		}
+		presignedURL, err := client.Object.GetPresignedURL(ctx, http.MethodPut, upload.FileKey, m.secretID, m.secretKey, expire, signOpt)
+		if err != nil {
+			m.log.Errorf("生成分片预签名 URL 失败: fileKey=%s, partNumber=%d, err=%v", upload.FileKey, partNumber, err)
+			return nil, 0, fmt.Errorf("failed to generate presigned url: %w", err)
+		}
+		parts = append(parts, &PresignedPart{
+			PartNumber: partNumber,
+			UploadURL:  presignedURL.String(),
+		})
+	}
+	return parts, time.Now().Add(expire).Unix(), nil
+}
+
+// ListUploadedParts 查询已上传的全部分片，按分片编号升序
+func (m *COSManager) ListUploadedParts(ctx context.Context, upload *MultipartUpload) ([]*UploadedPart, error) {
+	client, _, err := m.newBucketClient(m.buckets[upload.BucketKey])
+	if err != nil {
+		return nil, err
+	}
+
+	parts := make([]*UploadedPart, 0)
+	opt := &cos.ObjectListPartsOptions{MaxParts: "1000"}
+	for {
+		result, _, err := client.Object.ListParts(ctx, upload.FileKey, upload.UploadID, opt)
+		if err != nil {
+			if cos.IsNotFoundError(err) {
+				return nil, ErrUploadNotFound
+			}
+			m.log.Errorf("查询已上传分片失败: fileKey=%s, uploadId=%s, err=%v", upload.FileKey, upload.UploadID, err)
+			return nil, fmt.Errorf("failed to list parts: %w", err)
+		}
+
+		for _, part := range result.Parts {
+			parts = append(parts, &UploadedPart{
+				PartNumber: part.PartNumber,
+				ETag:       part.ETag,
+				Size:       part.Size,
+			})
+		}
+		if !result.IsTruncated || result.NextPartNumberMarker == "" {
+			break
+		}
+		opt.PartNumberMarker = result.NextPartNumberMarker
+	}
+
+	sort.Slice(parts, func(i, j int) bool { return parts[i].PartNumber < parts[j].PartNumber })
+	return parts, nil
+}
+
+// CompleteMultipartUpload 按已上传的全部分片合并文件
+// 合并后的大小超过存储桶限制时取消上传并返回 ErrFileTooLarge，文件内容与扩展名不符时删除文件并返回 ErrFileTypeNotSupported
+func (m *COSManager) CompleteMultipartUpload(ctx context.Context, upload *MultipartUpload) (*CompletedUpload, error) {
+	bucketConfig := m.buckets[upload.BucketKey]
+	client, bucketURL, err := m.newBucketClient(bucketConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := m.ListUploadedParts(ctx, upload)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("no parts uploaded")
+	}
+
+	var fileSize int64
+	completeOpt := &cos.CompleteMultipartUploadOptions{Parts: make([]cos.Object, 0, len(parts))}
+	for _, part := range parts {
+		fileSize += part.Size
+		completeOpt.Parts = append(completeOpt.Parts, cos.Object{PartNumber: part.PartNumber, ETag: part.ETag})
+	}
+
+	// 分片上传绕过了初始化时的大小校验，合并前按实际大小再校验一次
+	if bucketConfig.MaxSize > 0 && fileSize > bucketConfig.MaxSize {
+		m.log.Warnf("分片上传文件大小 %d 超过存储桶 '%s' 限制 %d，取消上传: fileKey=%s",
+			fileSize, upload.BucketKey, bucketConfig.MaxSize, upload.FileKey)
+		if _, err := client.Object.AbortMultipartUpload(ctx, upload.FileKey, upload.UploadID); err != nil {
+			m.log.Errorf("取消分片上传失败: fileKey=%s, uploadId=%s, err=%v", upload.FileKey, upload.UploadID, err)
+		}
+		return nil, fmt.Errorf("%w: file size %d exceeds limit %d for bucket '%s'", ErrFileTooLarge, fileSize, bucketConfig.MaxSize, upload.BucketKey)
+	}
+
+	completeResult, _, err := client.Object.CompleteMultipartUpload(ctx, upload.FileKey, upload.UploadID, completeOpt)
+	if err != nil {
+		if cos.IsNotFoundError(err) {
+			return nil, ErrUploadNotFound
+		}
+		m.log.Errorf("完成分片上传失败: fileKey=%s, uploadId=%s, err=%v", upload.FileKey, upload.UploadID, err)
+		return nil, fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+
+	// 检测合并后文件的实际类型，伪装文件会被删除
+	accessURL := fmt.Sprintf("%s/%s", bucketURL, upload.FileKey)
+	etag := strings.Trim(completeResult.ETag, `"`)
+	if err := m.VerifyUploadedObject(ctx, accessURL, etag); err != nil {
+		return nil, err
+	}
+
+	m.log.Infof("完成分片上传: bucketKey=%s, fileKey=%s, parts=%d, fileSize=%d",
+		upload.BucketKey, upload.FileKey, len(parts), fileSize)
+	return &CompletedUpload{
+		FileKey:   upload.FileKey,
+		AccessURL: accessURL,
+		FileSize:  fileSize,
+		ETag:      etag,
+	}, nil
+}
+
+// AbortMultipartUpload 取消分片上传，释放已上传的分片
+func (m *COSManager) AbortMultipartUpload(ctx context.Context, upload *MultipartUpload) error {
+	client, _, err := m.newBucketClient(m.buckets[upload.BucketKey])
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Object.AbortMultipartUpload(ctx, upload.FileKey, upload.UploadID); err != nil {
+		if cos.IsNotFoundError(err) {
+			return ErrUploadNotFound
+		}
+		m.log.Errorf("取消分片上传失败: fileKey=%s, uploadId=%s, err=%v", upload.FileKey, upload.UploadID, err)
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+	return nil
+}
+
+// AbortStaleMultipartUploads 取消各存储桶上传目录中超过有效期仍未完成的分片上传，返回取消的数量
+func (m *COSManager) AbortStaleMultipartUploads(ctx context.Context) (int, error) {
+	deadline := time.Now().Add(-m.multipartExpire)
+	aborted := 0
+
+	for key, bucketConfig := range m.buckets {
+		client, _, err := m.newBucketClient(bucketConfig)
+		if err != nil {
+			return aborted, err
+		}
+
+		prefix := bucketConfig.UploadDir
+		if prefix != "" && !strings.HasSuffix(prefix, "/") {
+			prefix += "/"
+		}
+		opt := &cos.ListMultipartUploadsOptions{Prefix: prefix, MaxUploads: 1000}
+		for {
+			result, _, err := client.Bucket.ListMultipartUploads(ctx, opt)
+			if err != nil {
+				m.log.Errorf("查询进行中的分片上传失败: bucketKey=%s, err=%v", key, err)
+				return aborted, fmt.Errorf("failed to list multipart uploads: %w", err)
+			}
+
+			for _, upload := range result.Uploads {
+				initiated, err := time.Parse(time.RFC3339, upload.Initiated)
+				if err != nil || initiated.After(deadline) {
+					continue
+				}
+				if _, err := client.Object.AbortMultipartUpload(ctx, upload.Key, upload.UploadID); err != nil && !cos.IsNotFoundError(err) {
+					m.log.Errorf("取消过期分片上传失败: bucketKey=%s, fileKey=%s, uploadId=%s, err=%v", key, upload.Key, upload.UploadID, err)
+					continue
+				}
+				aborted++
+			}
+
+			if !result.IsTruncated {
+				break
+			}
+			opt.KeyMarker = result.NextKeyMarker
+			opt.UploadIDMarker = result.NextUploadIDMarker
+		}
+	}
+	return aborted, nil
+}
+
+// signMultipartUpload 序列化分片上传信息并用 SecretKey 签名，生成上传凭证
+func (m *COSManager) signMultipartUpload(upload *MultipartUpload) (string, error) {
+	data, err := json.Marshal(upload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal upload token: %w", err)
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + m.multipartSignature(payload), nil
+}
+
+// multipartSignature 计算上传凭证的 HMAC-SHA256 签名
+func (m *COSManager) multipartSignature(payload string) string {
+	mac := hmac.New(sha256.New, []byte(m.secretKey))
+	mac.Write([]byte("multipart:" + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
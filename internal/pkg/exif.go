@@ -0,0 +1,483 @@
+package pkg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EXIF 标签
+const (
+	tagMake             = 0x010F
+	tagModel            = 0x0110
+	tagOrientation      = 0x0112
+	tagDateTime         = 0x0132
+	tagExifIFD          = 0x8769
+	tagGPSIFD           = 0x8825
+	tagExposureTime     = 0x829A
+	tagFNumber          = 0x829D
+	tagISO              = 0x8827
+	tagDateTimeOriginal = 0x9003
+	tagFocalLength      = 0x920A
+	tagMakerNote        = 0x927C
+	tagCameraOwnerName  = 0xA430
+	tagBodySerialNumber = 0xA431
+	tagLensModel        = 0xA434
+	tagLensSerialNumber = 0xA435
+
+	tagGPSLatitudeRef  = 0x0001
+	tagGPSLatitude     = 0x0002
+	tagGPSLongitudeRef = 0x0003
+	tagGPSLongitude    = 0x0004
+	tagGPSAltitudeRef  = 0x0005
+	tagGPSAltitude     = 0x0006
+)
+
+// sensitiveExifTags 清除隐私信息时抹去的标签（GPS 信息整体清除）
+// 厂商私有数据中可能包含序列号和位置，一并清除
+var sensitiveExifTags = map[uint16]bool{
+	tagMakerNote:        true,
+	tagCameraOwnerName:  true,
+	tagBodySerialNumber: true,
+	tagLensSerialNumber: true,
+}
+
+var (
+	exifHeader         = []byte("Exif\x00\x00")
+	xmpHeader          = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	xmpExtensionHeader = []byte("http://ns.adobe.com/xmp/extension/\x00")
+)
+
+// errInvalidExif EXIF 数据格式错误
+var errInvalidExif = errors.New("invalid exif data")
+
+// ExifData 从图片中解析出的 EXIF 信息
+type ExifData struct {
+	Make         string
+	Model        string
+	LensModel    string
+	ShootTime    *time.Time // 拍摄时间（无时区信息，按服务器时区解析）
+	Orientation  int        // 方向（1-8），未设置时为 0
+	FNumber      float64    // 光圈值
+	ExposureTime string     // 曝光时间（如 1/125）
+	ISO          int
+	FocalLength  float64 // 焦距（毫米）
+	GPS          *GPSLocation
+	Sensitive    bool // 是否包含位置、序列号等敏感信息（包括 XMP 元数据）
+}
+
+// GPSLocation GPS 位置
+type GPSLocation struct {
+	Latitude  float64
+	Longitude float64
+	Altitude  *float64 // 海拔（米），未设置时为 nil
+}
+
+// SwapsDimensions 方向为 5-8 时图片需要旋转 90 度显示，宽高互换
+func (e *ExifData) SwapsDimensions() bool {
+	return e != nil && e.Orientation >= 5 && e.Orientation <= 8
+}
+
+// ParseExif 解析 JPEG 图片中的 EXIF 信息，非 JPEG 或没有 EXIF 时返回 nil
+func ParseExif(data []byte) *ExifData {
+	var result *ExifData
+	hasXMP := false
+	walkJPEGSegments(data, func(marker byte, start, end int) {
+		payload := data[start:end]
+		switch {
+		case marker == 0xE1 && bytes.HasPrefix(payload, exifHeader) && result == nil:
+			t, err := newTIFFReader(payload[len(exifHeader):])
+			if err == nil {
+				result = t.parse()
+			}
+		case marker == 0xE1 && (bytes.HasPrefix(payload, xmpHeader) || bytes.HasPrefix(payload, xmpExtensionHeader)):
+			hasXMP = true
+		}
+	})
+
+	if hasXMP {
+		if result == nil {
+			result = &ExifData{}
+		}
+		result.Sensitive = true
+	}
+	return result
+}
+
+// StripSensitiveMetadata 原地抹去 JPEG 图片中的 GPS 信息、序列号等敏感标签和 XMP 元数据，返回处理后的副本
+// 只覆盖数据不移动位置，文件大小不变，拍摄参数和方向等其他标签保持可读
+func StripSensitiveMetadata(data []byte) ([]byte, bool) {
+	stripped := make([]byte, len(data))
+	copy(stripped, data)
+
+	changed := false
+	walkJPEGSegments(stripped, func(marker byte, start, end int) {
+		payload := stripped[start:end]
+		switch {
+		case marker == 0xE1 && bytes.HasPrefix(payload, exifHeader):
+			t, err := newTIFFReader(payload[len(exifHeader):])
+			if err == nil && t.strip() {
+				changed = true
+			}
+		case marker == 0xE1 && (bytes.HasPrefix(payload, xmpHeader) || bytes.HasPrefix(payload, xmpExtensionHeader)):
+			// XMP 中可能包含位置信息，整个段改为内容为空的注释段
+			stripped[start-3] = 0xFE
+			clear(payload)
+			changed = true
+		}
+	})
+	return stripped, changed
+}
+
+// walkJPEGSegments 遍历 JPEG 图像数据开始（SOS）之前的所有段，start、end 为段内容（不含长度）的位置
+func walkJPEGSegments(data []byte, fn func(marker byte, start, end int)) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return
+		}
+		marker := data[pos+1]
+		if marker == 0xFF {
+			// 填充字节
+			pos++
+			continue
+		}
+		if marker == 0xD9 || marker == 0xDA {
+			return
+		}
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			pos += 2
+			continue
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos+2 : pos+4]))
+		if length < 2 || pos+2+length > len(data) {
+			return
+		}
+		fn(marker, pos+4, pos+2+length)
+		pos += 2 + length
+	}
+}
+
+// tiffReader TIFF 结构（EXIF 数据）读取器，偏移量均相对于 TIFF 头
+type tiffReader struct {
+	b     []byte
+	order binary.ByteOrder
+}
+
+// tiffEntry IFD 条目
+type tiffEntry struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	value int // 值的位置
+	size  int // 值的字节数
+}
+
+// tiffTypeSizes TIFF 数据类型的字节数
+var tiffTypeSizes = map[uint16]int{1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8}
+
+// newTIFFReader 校验 TIFF 头并创建读取器
+func newTIFFReader(b []byte) (*tiffReader, error) {
+	if len(b) < 8 {
+		return nil, errInvalidExif
+	}
+	t := &tiffReader{b: b}
+	switch string(b[:2]) {
+	case "II":
+		t.order = binary.LittleEndian
+	case "MM":
+		t.order = binary.BigEndian
+	default:
+		return nil, errInvalidExif
+	}
+	if t.order.Uint16(b[2:4]) != 42 {
+		return nil, errInvalidExif
+	}
+	return t, nil
+}
+
+// ifd 读取 IFD 的全部条目，返回条目表的位置，格式错误的条目会被跳过
+func (t *tiffReader) ifd(offset uint32) ([]tiffEntry, int, error) {
+	pos := int(offset)
+	if offset == 0 || pos+2 > len(t.b) || pos < 0 {
+		return nil, 0, errInvalidExif
+	}
+	count := int(t.order.Uint16(t.b[pos : pos+2]))
+	if pos+2+count*12 > len(t.b) {
+		return nil, 0, errInvalidExif
+	}
+
+	entries := make([]tiffEntry, 0, count)
+	for i := 0; i < count; i++ {
+		p := pos + 2 + i*12
+		entry := tiffEntry{
+			tag:   t.order.Uint16(t.b[p : p+2]),
+			typ:   t.order.Uint16(t.b[p+2 : p+4]),
+			count: t.order.Uint32(t.b[p+4 : p+8]),
+		}
+		typeSize, ok := tiffTypeSizes[entry.typ]
+		if !ok || entry.count > uint32(len(t.b)) {
+			continue
+		}
+		entry.size = typeSize * int(entry.count)
+		entry.value = p + 8
+		if entry.size > 4 {
+			entry.value = int(t.order.Uint32(t.b[p+8 : p+12]))
+		}
+		if entry.value < 0 || entry.value+entry.size > len(t.b) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, pos, nil
+}
+
+// parse 解析 IFD0、Exif IFD 和 GPS IFD 中的常用标签
+func (t *tiffReader) parse() *ExifData {
+	result := &ExifData{}
+	ifd0, _, err := t.ifd(t.order.Uint32(t.b[4:8]))
+	if err != nil {
+		return nil
+	}
+
+	var dateTime string
+	for _, e := range ifd0 {
+		switch e.tag {
+		case tagMake:
+			result.Make = t.string(e)
+		case tagModel:
+			result.Model = t.string(e)
+		case tagOrientation:
+			result.Orientation = int(t.uint(e))
+		case tagDateTime:
+			dateTime = t.string(e)
+		case tagExifIFD:
+			t.parseExifIFD(uint32(t.uint(e)), result)
+		case tagGPSIFD:
+			result.GPS = t.parseGPSIFD(uint32(t.uint(e)))
+			// 已清除的 GPS IFD 条目数为 0
+			if entries, _, err := t.ifd(uint32(t.uint(e))); err == nil && len(entries) > 0 {
+				result.Sensitive = true
+			}
+		}
+	}
+
+	// 没有拍摄时间时使用文件修改时间
+	if result.ShootTime == nil && dateTime != "" {
+		result.ShootTime = parseExifTime(dateTime)
+	}
+	return result
+}
+
+// parseExifIFD 解析 Exif IFD 中的拍摄参数
+func (t *tiffReader) parseExifIFD(offset uint32, result *ExifData) {
+	entries, _, err := t.ifd(offset)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		switch e.tag {
+		case tagDateTimeOriginal:
+			result.ShootTime = parseExifTime(t.string(e))
+		case tagExposureTime:
+			if v := t.rational(e); v > 0 {
+				result.ExposureTime = formatExposureTime(v)
+			}
+		case tagFNumber:
+			result.FNumber = math.Round(t.rational(e)*10) / 10
+		case tagISO:
+			result.ISO = int(t.uint(e))
+		case tagFocalLength:
+			result.FocalLength = math.Round(t.rational(e)*10) / 10
+		case tagLensModel:
+			result.LensModel = t.string(e)
+		}
+		if sensitiveExifTags[e.tag] && !t.blank(e) {
+			result.Sensitive = true
+		}
+	}
+}
+
+// parseGPSIFD 解析 GPS IFD 中的经纬度和海拔，没有经纬度时返回 nil
+func (t *tiffReader) parseGPSIFD(offset uint32) *GPSLocation {
+	entries, _, err := t.ifd(offset)
+	if err != nil {
+		return nil
+	}
+
+	var latRef, lonRef string
+	var lat, lon []float64
+	var alt *float64
+	var altBelowSea bool
+	for _, e := range entries {
+		switch e.tag {
+		case tagGPSLatitudeRef:
+			latRef = t.string(e)
+		case tagGPSLatitude:
+			lat = t.rationals(e)
+		case tagGPSLongitudeRef:
+			lonRef = t.string(e)
+		case tagGPSLongitude:
+			lon = t.rationals(e)
+		case tagGPSAltitudeRef:
+			altBelowSea = t.uint(e) == 1
+		case tagGPSAltitude:
+			v := t.rational(e)
+			alt = &v
+		}
+	}
+	if len(lat) != 3 || len(lon) != 3 {
+		return nil
+	}
+
+	location := &GPSLocation{
+		Latitude:  lat[0] + lat[1]/60 + lat[2]/3600,
+		Longitude: lon[0] + lon[1]/60 + lon[2]/3600,
+	}
+	if latRef == "S" {
+		location.Latitude = -location.Latitude
+	}
+	if lonRef == "W" {
+		location.Longitude = -location.Longitude
+	}
+	if alt != nil {
+		if altBelowSea {
+			*alt = -*alt
+		}
+		location.Altitude = alt
+	}
+	return location
+}
+
+// strip 抹去 GPS IFD 和敏感标签的值，返回是否有修改
+func (t *tiffReader) strip() bool {
+	ifd0, _, err := t.ifd(t.order.Uint32(t.b[4:8]))
+	if err != nil {
+		return false
+	}
+
+	changed := false
+	for _, e := range ifd0 {
+		switch e.tag {
+		case tagExifIFD:
+			entries, _, err := t.ifd(uint32(t.uint(e)))
+			if err != nil {
+				continue
+			}
+			for _, sub := range entries {
+				if sensitiveExifTags[sub.tag] {
+					clear(t.b[sub.value : sub.value+sub.size])
+					changed = true
+				}
+			}
+		case tagGPSIFD:
+			entries, pos, err := t.ifd(uint32(t.uint(e)))
+			if err != nil {
+				continue
+			}
+			// 清空所有 GPS 条目的值和条目表，并将条目数置为 0
+			for _, sub := range entries {
+				clear(t.b[sub.value : sub.value+sub.size])
+			}
+			count := int(t.order.Uint16(t.b[pos : pos+2]))
+			clear(t.b[pos : pos+2+count*12])
+			changed = true
+		}
+	}
+	return changed
+}
+
+// blank 值是否已被清除（全部为 0）
+func (t *tiffReader) blank(e tiffEntry) bool {
+	for _, b := range t.b[e.value : e.value+e.size] {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// string 读取 ASCII 值
+func (t *tiffReader) string(e tiffEntry) string {
+	if e.typ != 2 {
+		return ""
+	}
+	value := t.b[e.value : e.value+e.size]
+	if i := bytes.IndexByte(value, 0); i >= 0 {
+		value = value[:i]
+	}
+	return strings.TrimSpace(string(value))
+}
+
+// uint 读取第一个整数值
+func (t *tiffReader) uint(e tiffEntry) uint32 {
+	if e.count == 0 {
+		return 0
+	}
+	switch e.typ {
+	case 1, 7:
+		return uint32(t.b[e.value])
+	case 3:
+		return uint32(t.order.Uint16(t.b[e.value : e.value+2]))
+	case 4, 9:
+		return t.order.Uint32(t.b[e.value : e.value+4])
+	}
+	return 0
+}
+
+// rational 读取第一个分数值
+func (t *tiffReader) rational(e tiffEntry) float64 {
+	values := t.rationals(e)
+	if len(values) == 0 {
+		return 0
+	}
+	return values[0]
+}
+
+// rationals 读取全部分数值
+func (t *tiffReader) rationals(e tiffEntry) []float64 {
+	if e.typ != 5 && e.typ != 10 {
+		return nil
+	}
+	values := make([]float64, 0, e.count)
+	for i := 0; i < int(e.count); i++ {
+		p := e.value + i*8
+		num, den := t.order.Uint32(t.b[p:p+4]), t.order.Uint32(t.b[p+4:p+8])
+		if den == 0 {
+			values = append(values, 0)
+			continue
+		}
+		if e.typ == 10 {
+			values = append(values, float64(int32(num))/float64(int32(den)))
+		} else {
+			values = append(values, float64(num)/float64(den))
+		}
+	}
+	return values
+}
+
+// parseExifTime 解析 EXIF 时间（2006:01:02 15:04:05）
+func parseExifTime(value string) *time.Time {
+	t, err := time.ParseInLocation("2006:01:02 15:04:05", value, time.Local)
+	if err != nil || t.Year() < 1900 {
+		return nil
+	}
+	return &t
+}
+
+// formatExposureTime 格式化曝光时间，小于 1 秒时显示为分数
+func formatExposureTime(seconds float64) string {
+	if seconds < 1 {
+		return "1/" + strconv.FormatFloat(math.Round(1/seconds), 'f', -1, 64)
+	}
+	return strconv.FormatFloat(seconds, 'f', -1, 64)
+}
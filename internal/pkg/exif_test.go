@@ -0,0 +1,314 @@
+package pkg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testTIFFEntry 测试用的 IFD 条目，data 为按字节序编码后的值
+type testTIFFEntry struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	data  []byte
+}
+
+// tiffBuilder 构造测试用的 TIFF 结构，IFD 按调用顺序追加，被引用的子 IFD 需先写入
+type tiffBuilder struct {
+	order testByteOrder
+	buf   []byte
+}
+
+// testByteOrder binary.LittleEndian 和 binary.BigEndian 同时实现的读写接口
+type testByteOrder interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+func newTIFFBuilder(order testByteOrder) *tiffBuilder {
+	b := &tiffBuilder{order: order, buf: make([]byte, 8)}
+	if order == binary.LittleEndian {
+		copy(b.buf, "II")
+	} else {
+		copy(b.buf, "MM")
+	}
+	order.PutUint16(b.buf[2:4], 42)
+	return b
+}
+
+func (b *tiffBuilder) ascii(tag uint16, s string) testTIFFEntry {
+	return testTIFFEntry{tag: tag, typ: 2, count: uint32(len(s) + 1), data: append([]byte(s), 0)}
+}
+
+func (b *tiffBuilder) byte(tag uint16, v uint8) testTIFFEntry {
+	return testTIFFEntry{tag: tag, typ: 1, count: 1, data: []byte{v}}
+}
+
+func (b *tiffBuilder) short(tag uint16, v uint16) testTIFFEntry {
+	return testTIFFEntry{tag: tag, typ: 3, count: 1, data: b.order.AppendUint16(nil, v)}
+}
+
+func (b *tiffBuilder) long(tag uint16, v uint32) testTIFFEntry {
+	return testTIFFEntry{tag: tag, typ: 4, count: 1, data: b.order.AppendUint32(nil, v)}
+}
+
+// rational 分数值，参数依次为分子、分母
+func (b *tiffBuilder) rational(tag uint16, values ...uint32) testTIFFEntry {
+	var data []byte
+	for _, v := range values {
+		data = b.order.AppendUint32(data, v)
+	}
+	return testTIFFEntry{tag: tag, typ: 5, count: uint32(len(values) / 2), data: data}
+}
+
+// ifd 追加 IFD 及其值，返回 IFD 的偏移量
+func (b *tiffBuilder) ifd(entries ...testTIFFEntry) uint32 {
+	if len(b.buf)%2 == 1 {
+		b.buf = append(b.buf, 0)
+	}
+	offset := len(b.buf)
+	dataOffset := offset + 2 + 12*len(entries) + 4
+
+	var table, data []byte
+	table = b.order.AppendUint16(table, uint16(len(entries)))
+	for _, e := range entries {
+		table = b.order.AppendUint16(table, e.tag)
+		table = b.order.AppendUint16(table, e.typ)
+		table = b.order.AppendUint32(table, e.count)
+		if len(e.data) <= 4 {
+			value := make([]byte, 4)
+			copy(value, e.data)
+			table = append(table, value...)
+			continue
+		}
+		table = b.order.AppendUint32(table, uint32(dataOffset+len(data)))
+		data = append(data, e.data...)
+	}
+	table = b.order.AppendUint32(table, 0)
+
+	b.buf = append(b.buf, table...)
+	b.buf = append(b.buf, data...)
+	return uint32(offset)
+}
+
+// bytes 以 ifd0 为第一个 IFD 输出 TIFF 数据
+func (b *tiffBuilder) bytes(ifd0 uint32) []byte {
+	b.order.PutUint32(b.buf[4:8], ifd0)
+	return b.buf
+}
+
+// testJPEG 构造只包含指定 APP1 段的 JPEG 文件头
+func testJPEG(app1 ...[]byte) []byte {
+	data := []byte{0xFF, 0xD8}
+	for _, payload := range app1 {
+		data = append(data, 0xFF, 0xE1)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(payload)+2))
+		data = append(data, payload...)
+	}
+	return append(data, 0xFF, 0xDA, 0x00, 0x02, 0x00, 0xFF, 0xD9)
+}
+
+func exifSegment(tiff []byte) []byte {
+	return append(append([]byte{}, exifHeader...), tiff...)
+}
+
+func xmpSegment() []byte {
+	return append(append([]byte{}, xmpHeader...), `<x:xmpmeta><exif:GPSLatitude>39,54N</exif:GPSLatitude></x:xmpmeta>`...)
+}
+
+// fullExif 包含拍摄参数、序列号和 GPS 信息的 EXIF 数据
+func fullExif(order testByteOrder) []byte {
+	b := newTIFFBuilder(order)
+	gps := b.ifd(
+		b.ascii(tagGPSLatitudeRef, "N"),
+		b.rational(tagGPSLatitude, 39, 1, 54, 1, 0, 1),
+		b.ascii(tagGPSLongitudeRef, "W"),
+		b.rational(tagGPSLongitude, 116, 1, 24, 1, 0, 1),
+		b.byte(tagGPSAltitudeRef, 1),
+		b.rational(tagGPSAltitude, 25, 2),
+	)
+	exif := b.ifd(
+		b.rational(tagExposureTime, 1, 125),
+		b.rational(tagFNumber, 28, 10),
+		b.short(tagISO, 200),
+		b.ascii(tagDateTimeOriginal, "2024:05:01 08:30:00"),
+		b.rational(tagFocalLength, 35, 1),
+		b.ascii(tagBodySerialNumber, "SN123456"),
+		b.ascii(tagLensModel, "EF 35mm f/1.4L"),
+	)
+	ifd0 := b.ifd(
+		b.ascii(tagMake, "Canon"),
+		b.ascii(tagModel, "EOS R5"),
+		b.short(tagOrientation, 6),
+		b.ascii(tagDateTime, "2024:06:01 00:00:00"),
+		b.long(tagExifIFD, exif),
+		b.long(tagGPSIFD, gps),
+	)
+	return b.bytes(ifd0)
+}
+
+// basicExif 不包含敏感信息的 EXIF 数据
+func basicExif(order testByteOrder) []byte {
+	b := newTIFFBuilder(order)
+	ifd0 := b.ifd(
+		b.ascii(tagMake, "FUJIFILM"),
+		b.short(tagOrientation, 1),
+		b.ascii(tagDateTime, "2023:12:31 23:59:59"),
+	)
+	return b.bytes(ifd0)
+}
+
+func TestParseExif(t *testing.T) {
+	shootTime := time.Date(2024, 5, 1, 8, 30, 0, 0, time.Local)
+	modifyTime := time.Date(2023, 12, 31, 23, 59, 59, 0, time.Local)
+	altitude := -12.5
+
+	full := &ExifData{
+		Make:         "Canon",
+		Model:        "EOS R5",
+		LensModel:    "EF 35mm f/1.4L",
+		ShootTime:    &shootTime,
+		Orientation:  6,
+		FNumber:      2.8,
+		ExposureTime: "1/125",
+		ISO:          200,
+		FocalLength:  35,
+		GPS:          &GPSLocation{Latitude: 39.9, Longitude: -116.4, Altitude: &altitude},
+		Sensitive:    true,
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want *ExifData
+	}{
+		{name: "非 JPEG", data: []byte("\x89PNG\r\n\x1a\n"), want: nil},
+		{name: "没有 EXIF", data: testJPEG(), want: nil},
+		{name: "小端字节序", data: testJPEG(exifSegment(fullExif(binary.LittleEndian))), want: full},
+		{name: "大端字节序", data: testJPEG(exifSegment(fullExif(binary.BigEndian))), want: full},
+		{
+			name: "没有拍摄时间时使用修改时间",
+			data: testJPEG(exifSegment(basicExif(binary.BigEndian))),
+			want: &ExifData{Make: "FUJIFILM", ShootTime: &modifyTime, Orientation: 1},
+		},
+		{
+			name: "XMP 元数据视为敏感信息",
+			data: testJPEG(exifSegment(basicExif(binary.LittleEndian)), xmpSegment()),
+			want: &ExifData{Make: "FUJIFILM", ShootTime: &modifyTime, Orientation: 1, Sensitive: true},
+		},
+		{name: "只有 XMP", data: testJPEG(xmpSegment()), want: &ExifData{Sensitive: true}},
+		{name: "TIFF 头错误", data: testJPEG(exifSegment([]byte("XX\x00\x2a\x00\x00\x00\x08"))), want: nil},
+		{name: "IFD 偏移量越界", data: testJPEG(exifSegment([]byte("II\x2a\x00\xff\x00\x00\x00"))), want: nil},
+		{name: "段长度越界", data: []byte{0xFF, 0xD8, 0xFF, 0xE1, 0xFF, 0xFF, 'E', 'x'}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseExif(tt.data)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseExif() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripSensitiveMetadata(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        []byte
+		wantChanged bool
+	}{
+		{name: "非 JPEG", data: []byte("GIF89a"), wantChanged: false},
+		{name: "没有敏感信息", data: testJPEG(exifSegment(basicExif(binary.LittleEndian))), wantChanged: false},
+		{name: "GPS 和序列号", data: testJPEG(exifSegment(fullExif(binary.LittleEndian))), wantChanged: true},
+		{name: "XMP 元数据", data: testJPEG(exifSegment(basicExif(binary.BigEndian)), xmpSegment()), wantChanged: true},
+		{name: "全部敏感信息", data: testJPEG(exifSegment(fullExif(binary.BigEndian)), xmpSegment()), wantChanged: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := bytes.Clone(tt.data)
+			before := ParseExif(tt.data)
+
+			stripped, changed := StripSensitiveMetadata(tt.data)
+			if changed != tt.wantChanged {
+				t.Errorf("StripSensitiveMetadata() changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if !bytes.Equal(tt.data, original) {
+				t.Errorf("StripSensitiveMetadata() modified the input")
+			}
+			if len(stripped) != len(tt.data) {
+				t.Errorf("len(stripped) = %d, want %d", len(stripped), len(tt.data))
+			}
+			if !changed {
+				if !bytes.Equal(stripped, tt.data) {
+					t.Errorf("StripSensitiveMetadata() modified data without reporting a change")
+				}
+				return
+			}
+
+			after := ParseExif(stripped)
+			if after == nil || after.Sensitive || after.GPS != nil {
+				t.Fatalf("ParseExif(stripped) = %+v, want no sensitive data", after)
+			}
+			// 拍摄参数和方向保持可读
+			want := *before
+			want.GPS = nil
+			want.Sensitive = false
+			if !reflect.DeepEqual(*after, want) {
+				t.Errorf("ParseExif(stripped) = %+v, want %+v", *after, want)
+			}
+			if bytes.Contains(stripped, []byte("SN123456")) || bytes.Contains(stripped, []byte("GPSLatitude")) {
+				t.Errorf("stripped data still contains sensitive values")
+			}
+		})
+	}
+}
+
+func TestExifDataSwapsDimensions(t *testing.T) {
+	tests := []struct {
+		name string
+		exif *ExifData
+		want bool
+	}{
+		{name: "没有 EXIF", exif: nil, want: false},
+		{name: "未设置方向", exif: &ExifData{}, want: false},
+		{name: "正常方向", exif: &ExifData{Orientation: 1}, want: false},
+		{name: "旋转 180 度", exif: &ExifData{Orientation: 3}, want: false},
+		{name: "镜像并旋转 90 度", exif: &ExifData{Orientation: 5}, want: true},
+		{name: "旋转 90 度", exif: &ExifData{Orientation: 6}, want: true},
+		{name: "旋转 270 度", exif: &ExifData{Orientation: 8}, want: true},
+		{name: "非法方向", exif: &ExifData{Orientation: 9}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.exif.SwapsDimensions(); got != tt.want {
+				t.Errorf("SwapsDimensions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatExposureTime(t *testing.T) {
+	tests := []struct {
+		seconds float64
+		want    string
+	}{
+		{seconds: 1.0 / 125, want: "1/125"},
+		{seconds: 1.0 / 3, want: "1/3"},
+		{seconds: 1, want: "1"},
+		{seconds: 2.5, want: "2.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := formatExposureTime(tt.seconds); got != tt.want {
+				t.Errorf("formatExposureTime(%v) = %q, want %q", tt.seconds, got, tt.want)
+			}
+		})
+	}
+}
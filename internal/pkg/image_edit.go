@@ -0,0 +1,491 @@
+package pkg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"math"
+
+	"github.com/HugoSmits86/nativewebp"
+)
+
+// 图片编辑操作类型
+const (
+	ImageOpCrop           = "crop"
+	ImageOpRotate         = "rotate"
+	ImageOpFlip           = "flip"
+	ImageOpResize         = "resize"
+	ImageOpTextWatermark  = "text_watermark"
+	ImageOpImageWatermark = "image_watermark"
+)
+
+// 缩略图缩放方式
+const (
+	FitContain = "contain" // 完整显示在目标尺寸内
+	FitCover   = "cover"   // 填满目标尺寸，居中裁剪超出的部分
+)
+
+// 翻转方向
+const (
+	FlipHorizontal = "horizontal"
+	FlipVertical   = "vertical"
+)
+
+const (
+	maxImageSide            = 16384 // 编辑结果的最大边长
+	jpegQuality             = 90    // 编码 JPEG 的质量
+	defaultWatermarkOpacity = 0.5
+	defaultTextScale        = 0.05 // 文字高度占图片短边的比例
+	defaultImageScale       = 0.2  // 水印图片宽度占图片宽度的比例
+	watermarkMarginRatio    = 0.02 // 水印与图片边缘的距离占图片短边的比例
+	maxWatermarkTextLength  = 64
+)
+
+// ErrInvalidImageOperation 图片编辑参数错误
+var ErrInvalidImageOperation = errors.New("invalid image operation")
+
+// ImageOperation 图片编辑操作，坐标和尺寸以上一步操作的结果为准
+type ImageOperation struct {
+	Type      string
+	Rect      image.Rectangle // crop：裁剪区域
+	Angle     int             // rotate：顺时针角度（90、180、270）
+	Direction string          // flip：翻转方向
+	Width     int             // resize：目标宽度，为 0 时按高度等比缩放
+	Height    int             // resize：目标高度，为 0 时按宽度等比缩放
+	Text      string          // text_watermark：水印文字（ASCII 可打印字符）
+	Watermark image.Image     // image_watermark：水印图片
+	Position  string          // 水印位置，为空时为右下角
+	Opacity   float64         // 水印不透明度，为 0 时使用默认值
+	Scale     float64         // 水印大小比例，为 0 时使用默认值
+	Color     string          // 文字水印颜色（#RRGGBB），为空时为白色
+}
+
+// ApplyImageOperations 按顺序执行编辑操作，返回新的图片，不修改原图
+func ApplyImageOperations(img image.Image, ops []*ImageOperation) (image.Image, error) {
+	bounds := img.Bounds()
+	result := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(result, result.Bounds(), img, bounds.Min, draw.Src)
+	for i, op := range ops {
+		var err error
+		switch op.Type {
+		case ImageOpCrop:
+			result, err = cropImage(result, op.Rect)
+		case ImageOpRotate:
+			result, err = rotateImage(result, op.Angle)
+		case ImageOpFlip:
+			result, err = flipImage(result, op.Direction)
+		case ImageOpResize:
+			result, err = resizeToFit(result, op.Width, op.Height)
+		case ImageOpTextWatermark:
+			err = drawTextWatermark(result, op)
+		case ImageOpImageWatermark:
+			err = drawImageWatermark(result, op)
+		default:
+			err = fmt.Errorf("unknown type '%s'", op.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: operation %d: %v", ErrInvalidImageOperation, i+1, err)
+		}
+	}
+	return result, nil
+}
+
+// OrientImage 按 EXIF 方向（1-8）旋转或翻转图片，使像素方向与显示方向一致
+func OrientImage(img image.Image, orientation int) image.Image {
+	src := toRGBA(img)
+	switch orientation {
+	case 2:
+		src, _ = flipImage(src, FlipHorizontal)
+	case 3:
+		src, _ = rotateImage(src, 180)
+	case 4:
+		src, _ = flipImage(src, FlipVertical)
+	case 5:
+		src, _ = rotateImage(src, 90)
+		src, _ = flipImage(src, FlipHorizontal)
+	case 6:
+		src, _ = rotateImage(src, 90)
+	case 7:
+		src, _ = rotateImage(src, 270)
+		src, _ = flipImage(src, FlipHorizontal)
+	case 8:
+		src, _ = rotateImage(src, 270)
+	}
+	return src
+}
+
+// EncodeImage 按格式（jpeg、png、gif、webp）编码图片，webp 为无损压缩
+func EncodeImage(img image.Image, format string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case "jpeg":
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	case "png":
+		err = png.Encode(&buf, img)
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+	case "webp":
+		err = nativewebp.Encode(&buf, img, nil)
+	default:
+		return nil, fmt.Errorf("%w: cannot encode format '%s'", image.ErrFormat, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ResizeImage 将图片缩放到指定尺寸，使用三角形滤波（放大时等同双线性插值，缩小时对覆盖的像素加权平均）
+func ResizeImage(img image.Image, width, height int) *image.RGBA {
+	src := toRGBA(img)
+	srcW, srcH := src.Bounds().Dx(), src.Bounds().Dy()
+	if srcW == width && srcH == height {
+		return src
+	}
+
+	// 先水平缩放到 width×srcH，再垂直缩放到 width×height，中间结果为预乘透明度的浮点值
+	xWeights := resampleWeights(srcW, width)
+	tmp := make([]float32, width*srcH*4)
+	for y := 0; y < srcH; y++ {
+		row := src.Pix[y*src.Stride:]
+		for x, weights := range xWeights {
+			var r, g, b, a float32
+			for _, w := range weights {
+				p := row[w.index*4:]
+				r += float32(p[0]) * w.weight
+				g += float32(p[1]) * w.weight
+				b += float32(p[2]) * w.weight
+				a += float32(p[3]) * w.weight
+			}
+			i := (y*width + x) * 4
+			tmp[i], tmp[i+1], tmp[i+2], tmp[i+3] = r, g, b, a
+		}
+	}
+
+	yWeights := resampleWeights(srcH, height)
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y, weights := range yWeights {
+		for x := 0; x < width; x++ {
+			var r, g, b, a float32
+			for _, w := range weights {
+				i := (w.index*width + x) * 4
+				r += tmp[i] * w.weight
+				g += tmp[i+1] * w.weight
+				b += tmp[i+2] * w.weight
+				a += tmp[i+3] * w.weight
+			}
+			alpha := clampChannel(a, 255)
+			p := dst.Pix[y*dst.Stride+x*4:]
+			p[0] = clampChannel(r, alpha)
+			p[1] = clampChannel(g, alpha)
+			p[2] = clampChannel(b, alpha)
+			p[3] = alpha
+		}
+	}
+	return dst
+}
+
+// Thumbnail 生成不超过目标尺寸的缩略图，不放大原图（原图较小时返回原尺寸）
+// 宽或高为 0 时按另一边等比缩放，此时忽略 fit
+func Thumbnail(img image.Image, width, height int, fit string) image.Image {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	if fit == FitCover && width > 0 && height > 0 {
+		// 从原图中心裁剪出目标宽高比的区域，再缩小到目标尺寸
+		cropW, cropH := srcW, max(1, int(math.Round(float64(srcW)*float64(height)/float64(width))))
+		if cropH > srcH {
+			cropW, cropH = max(1, int(math.Round(float64(srcH)*float64(width)/float64(height)))), srcH
+		}
+		x, y := (srcW-cropW)/2, (srcH-cropH)/2
+		cropped := toRGBA(toRGBA(img).SubImage(image.Rect(x, y, x+cropW, y+cropH)))
+		if cropW <= width {
+			return cropped
+		}
+		return ResizeImage(cropped, width, height)
+	}
+
+	scale := 1.0
+	if width > 0 {
+		scale = min(scale, float64(width)/float64(srcW))
+	}
+	if height > 0 {
+		scale = min(scale, float64(height)/float64(srcH))
+	}
+	if scale >= 1 {
+		return img
+	}
+	return ResizeImage(img, max(1, int(math.Round(float64(srcW)*scale))), max(1, int(math.Round(float64(srcH)*scale))))
+}
+
+// FitSize 计算缩放后的尺寸，宽或高为 0 时按原图比例计算
+func FitSize(srcW, srcH, width, height int) (int, int) {
+	switch {
+	case width > 0 && height > 0:
+		return width, height
+	case width > 0:
+		return width, max(1, int(math.Round(float64(srcH)*float64(width)/float64(srcW))))
+	case height > 0:
+		return max(1, int(math.Round(float64(srcW)*float64(height)/float64(srcH)))), height
+	default:
+		return srcW, srcH
+	}
+}
+
+// resampleWeight 缩放时源像素的权重
+type resampleWeight struct {
+	index  int
+	weight float32
+}
+
+// resampleWeights 计算每个目标像素对应的源像素及权重
+func resampleWeights(srcLen, dstLen int) [][]resampleWeight {
+	scale := float64(srcLen) / float64(dstLen)
+	radius := math.Max(1, scale)
+	result := make([][]resampleWeight, dstLen)
+	for i := range result {
+		center := (float64(i)+0.5)*scale - 0.5
+		start := int(math.Floor(center - radius + 1))
+		end := int(math.Ceil(center + radius - 1))
+		var weights []resampleWeight
+		var sum float64
+		for j := start; j <= end; j++ {
+			w := 1 - math.Abs(float64(j)-center)/radius
+			if w <= 0 {
+				continue
+			}
+			index := min(max(j, 0), srcLen-1)
+			weights = append(weights, resampleWeight{index: index, weight: float32(w)})
+			sum += w
+		}
+		if sum == 0 {
+			weights = []resampleWeight{{index: min(max(int(math.Round(center)), 0), srcLen-1), weight: 1}}
+			sum = 1
+		}
+		for k := range weights {
+			weights[k].weight /= float32(sum)
+		}
+		result[i] = weights
+	}
+	return result
+}
+
+// clampChannel 四舍五入并限制在 [0, limit] 内，预乘透明度的颜色分量不能大于透明度
+func clampChannel(v float32, limit uint8) uint8 {
+	if v <= 0 {
+		return 0
+	}
+	if v >= float32(limit) {
+		return limit
+	}
+	return uint8(v + 0.5)
+}
+
+// toRGBA 转换为左上角在原点的 RGBA 图片，已经是时直接返回
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Bounds().Min == (image.Point{}) {
+		return rgba
+	}
+	bounds := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+	return dst
+}
+
+// cropImage 裁剪图片，裁剪区域需在图片范围内
+func cropImage(src *image.RGBA, rect image.Rectangle) (*image.RGBA, error) {
+	if rect.Empty() || !rect.In(src.Bounds()) {
+		return nil, fmt.Errorf("crop rect %v out of image bounds %dx%d", rect, src.Bounds().Dx(), src.Bounds().Dy())
+	}
+	return toRGBA(src.SubImage(rect)), nil
+}
+
+// rotateImage 顺时针旋转 90、180 或 270 度
+func rotateImage(src *image.RGBA, angle int) (*image.RGBA, error) {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	switch angle {
+	case 90:
+		return transformImage(src, h, w, func(x, y int) (int, int) { return h - 1 - y, x }), nil
+	case 180:
+		return transformImage(src, w, h, func(x, y int) (int, int) { return w - 1 - x, h - 1 - y }), nil
+	case 270:
+		return transformImage(src, h, w, func(x, y int) (int, int) { return y, w - 1 - x }), nil
+	default:
+		return nil, fmt.Errorf("rotate angle must be 90, 180 or 270, got %d", angle)
+	}
+}
+
+// flipImage 水平或垂直翻转
+func flipImage(src *image.RGBA, direction string) (*image.RGBA, error) {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	switch direction {
+	case FlipHorizontal:
+		return transformImage(src, w, h, func(x, y int) (int, int) { return w - 1 - x, y }), nil
+	case FlipVertical:
+		return transformImage(src, w, h, func(x, y int) (int, int) { return x, h - 1 - y }), nil
+	default:
+		return nil, fmt.Errorf("flip direction must be horizontal or vertical, got '%s'", direction)
+	}
+}
+
+// transformImage 将源图片每个像素 (x, y) 复制到目标图片的 fn(x, y) 位置
+func transformImage(src *image.RGBA, dstW, dstH int, fn func(x, y int) (int, int)) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			dx, dy := fn(x, y)
+			copy(dst.Pix[dy*dst.Stride+dx*4:dy*dst.Stride+dx*4+4], src.Pix[y*src.Stride+x*4:y*src.Stride+x*4+4])
+		}
+	}
+	return dst
+}
+
+// resizeToFit 缩放到指定尺寸，宽或高为 0 时等比缩放
+func resizeToFit(src *image.RGBA, width, height int) (*image.RGBA, error) {
+	if width < 0 || height < 0 || (width == 0 && height == 0) {
+		return nil, fmt.Errorf("resize width or height must be positive")
+	}
+	width, height = FitSize(src.Bounds().Dx(), src.Bounds().Dy(), width, height)
+	if width > maxImageSide || height > maxImageSide || int64(width)*int64(height) > maxImagePixels {
+		return nil, fmt.Errorf("resize to %dx%d exceeds size limit", width, height)
+	}
+	return ResizeImage(src, width, height), nil
+}
+
+// drawTextWatermark 在图片上绘制文字水印
+func drawTextWatermark(dst *image.RGBA, op *ImageOperation) error {
+	if op.Text == "" || len(op.Text) > maxWatermarkTextLength {
+		return fmt.Errorf("watermark text length must be between 1 and %d", maxWatermarkTextLength)
+	}
+	textColor := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	if op.Color != "" {
+		c, err := ParseHexColor(op.Color)
+		if err != nil {
+			return err
+		}
+		textColor = c
+	}
+	opacity, err := watermarkOpacity(op.Opacity)
+	if err != nil {
+		return err
+	}
+	scale, err := watermarkScale(op.Scale, defaultTextScale)
+	if err != nil {
+		return err
+	}
+
+	bounds := dst.Bounds()
+	pixelSize := max(1, int(math.Round(float64(min(bounds.Dx(), bounds.Dy()))*scale/glyphHeight)))
+	mask, err := renderText(op.Text, pixelSize, uint8(math.Round(opacity*255)))
+	if err != nil {
+		return err
+	}
+	origin, err := watermarkOrigin(bounds, mask.Bounds().Size(), op.Position)
+	if err != nil {
+		return err
+	}
+	draw.DrawMask(dst, mask.Bounds().Add(origin), image.NewUniform(textColor), image.Point{}, mask, image.Point{}, draw.Over)
+	return nil
+}
+
+// drawImageWatermark 在图片上叠加水印图片，水印图片按比例缩放，不超过图片本身
+func drawImageWatermark(dst *image.RGBA, op *ImageOperation) error {
+	if op.Watermark == nil {
+		return fmt.Errorf("watermark image is empty")
+	}
+	opacity, err := watermarkOpacity(op.Opacity)
+	if err != nil {
+		return err
+	}
+	scale, err := watermarkScale(op.Scale, defaultImageScale)
+	if err != nil {
+		return err
+	}
+
+	bounds := dst.Bounds()
+	wmBounds := op.Watermark.Bounds()
+	width := max(1, int(math.Round(float64(bounds.Dx())*scale)))
+	width, height := FitSize(wmBounds.Dx(), wmBounds.Dy(), width, 0)
+	if height > bounds.Dy() {
+		width, height = FitSize(wmBounds.Dx(), wmBounds.Dy(), 0, bounds.Dy())
+	}
+	watermark := ResizeImage(op.Watermark, width, height)
+
+	origin, err := watermarkOrigin(bounds, watermark.Bounds().Size(), op.Position)
+	if err != nil {
+		return err
+	}
+	mask := image.NewUniform(color.Alpha{A: uint8(math.Round(opacity * 255))})
+	draw.DrawMask(dst, watermark.Bounds().Add(origin), watermark, image.Point{}, mask, image.Point{}, draw.Over)
+	return nil
+}
+
+// watermarkOpacity 校验水印不透明度，为 0 时使用默认值
+func watermarkOpacity(opacity float64) (float64, error) {
+	if opacity == 0 {
+		return defaultWatermarkOpacity, nil
+	}
+	if opacity < 0 || opacity > 1 {
+		return 0, fmt.Errorf("watermark opacity must be in (0, 1], got %g", opacity)
+	}
+	return opacity, nil
+}
+
+// watermarkScale 校验水印大小比例，为 0 时使用默认值
+func watermarkScale(scale, defaultScale float64) (float64, error) {
+	if scale == 0 {
+		return defaultScale, nil
+	}
+	if scale < 0 || scale > 1 {
+		return 0, fmt.Errorf("watermark scale must be in (0, 1], got %g", scale)
+	}
+	return scale, nil
+}
+
+// watermarkOrigin 计算水印左上角位置，水印与图片边缘保留一定距离（水印过大时贴边）
+func watermarkOrigin(bounds image.Rectangle, size image.Point, position string) (image.Point, error) {
+	margin := int(math.Round(float64(min(bounds.Dx(), bounds.Dy())) * watermarkMarginRatio))
+	place := func(align, total, length int) int {
+		switch align {
+		case -1:
+			return min(margin, max(total-length, 0))
+		case 1:
+			return max(total-length-margin, 0)
+		default:
+			return (total - length) / 2
+		}
+	}
+
+	var alignX, alignY int
+	switch position {
+	case "top_left":
+		alignX, alignY = -1, -1
+	case "top":
+		alignX, alignY = 0, -1
+	case "top_right":
+		alignX, alignY = 1, -1
+	case "left":
+		alignX, alignY = -1, 0
+	case "center":
+		alignX, alignY = 0, 0
+	case "right":
+		alignX, alignY = 1, 0
+	case "bottom_left":
+		alignX, alignY = -1, 1
+	case "bottom":
+		alignX, alignY = 0, 1
+	case "bottom_right", "":
+		alignX, alignY = 1, 1
+	default:
+		return image.Point{}, fmt.Errorf("unknown watermark position '%s'", position)
+	}
+	return image.Pt(place(alignX, bounds.Dx(), size.X), place(alignY, bounds.Dy(), size.Y)), nil
+}
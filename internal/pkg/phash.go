@@ -0,0 +1,73 @@
+package pkg
+
+import (
+	"image"
+)
+
+const (
+	dHashWidth  = 9  // 缩放后的宽度，每行相邻像素比较得到 8 位
+	dHashHeight = 8  // 缩放后的高度
+	dHashSample = 32 // 每个缩放格子在每个方向上最多采样的像素数
+)
+
+// DHash 计算图片的差异哈希（dHash）
+// 将图片缩放为 9x8 的灰度图，逐行比较相邻像素的亮度，左侧更亮记为 1，共 64 位。
+// 缩放、轻度压缩、调色后哈希基本不变，两张图片哈希的汉明距离越小越相似
+func DHash(img image.Image) uint64 {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= 0 || height <= 0 {
+		return 0
+	}
+
+	// 按格子均匀采样求平均亮度，大图不逐像素读取
+	stepX := max(1, width/(dHashWidth*dHashSample))
+	stepY := max(1, height/(dHashHeight*dHashSample))
+
+	var sums [dHashHeight][dHashWidth]uint64
+	var counts [dHashHeight][dHashWidth]uint64
+	for y := 0; y < height; y += stepY {
+		cy := y * dHashHeight / height
+		for x := 0; x < width; x += stepX {
+			cx := x * dHashWidth / width
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			sums[cy][cx] += (299*uint64(r) + 587*uint64(g) + 114*uint64(b)) / 1000
+			counts[cy][cx]++
+		}
+	}
+
+	var gray [dHashHeight][dHashWidth]uint64
+	for y := 0; y < dHashHeight; y++ {
+		for x := 0; x < dHashWidth; x++ {
+			if counts[y][x] > 0 {
+				gray[y][x] = sums[y][x] / counts[y][x]
+			}
+		}
+	}
+
+	// 图片宽或高小于格子数时部分格子没有采样点，沿用左侧或上方格子的亮度
+	for y := 0; y < dHashHeight; y++ {
+		for x := 0; x < dHashWidth; x++ {
+			if counts[y][x] > 0 {
+				continue
+			}
+			switch {
+			case x > 0:
+				gray[y][x] = gray[y][x-1]
+			case y > 0:
+				gray[y][x] = gray[y-1][x]
+			}
+		}
+	}
+
+	var hash uint64
+	for y := 0; y < dHashHeight; y++ {
+		for x := 0; x < dHashWidth-1; x++ {
+			hash <<= 1
+			if gray[y][x] > gray[y][x+1] {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
@@ -0,0 +1,81 @@
+package pkg
+
+import (
+	"image"
+	"image/color"
+	"math/bits"
+	"testing"
+)
+
+// gradientImage 生成水平灰度渐变图，reverse 为 true 时左亮右暗
+func gradientImage(width, height int, reverse bool) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := uint8(x * 255 / max(1, width-1))
+			if reverse {
+				v = 255 - v
+			}
+			img.Set(x, y, color.RGBA{R: v, G: v, B: v, A: 255})
+		}
+	}
+	return img
+}
+
+func uniformImage(width, height int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestDHash(t *testing.T) {
+	tests := []struct {
+		name string
+		img  image.Image
+		want uint64
+	}{
+		{name: "空图片", img: image.NewRGBA(image.Rect(0, 0, 0, 0)), want: 0},
+		{name: "纯色图片", img: uniformImage(64, 64, color.RGBA{R: 200, G: 100, B: 50, A: 255}), want: 0},
+		{name: "左暗右亮", img: gradientImage(90, 80, false), want: 0},
+		{name: "左亮右暗", img: gradientImage(90, 80, true), want: ^uint64(0)},
+		{name: "小于格子数的图片", img: gradientImage(3, 2, true), want: 0x2400000024000000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DHash(tt.img); got != tt.want {
+				t.Errorf("DHash() = %#016x, want %#016x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDHashSimilarity(t *testing.T) {
+	base := gradientImage(360, 320, true)
+
+	tests := []struct {
+		name        string
+		img         image.Image
+		maxDistance int
+		minDistance int
+	}{
+		{name: "缩放后哈希不变", img: gradientImage(90, 80, true), maxDistance: 0},
+		{name: "大图采样后哈希不变", img: gradientImage(4000, 3000, true), maxDistance: 0},
+		{name: "子图坐标不从 0 开始", img: gradientImage(720, 640, true).SubImage(image.Rect(360, 0, 720, 640)), maxDistance: 0},
+		{name: "亮度反转后完全不同", img: gradientImage(360, 320, false), minDistance: 64, maxDistance: 64},
+	}
+
+	want := DHash(base)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			distance := bits.OnesCount64(DHash(tt.img) ^ want)
+			if distance < tt.minDistance || distance > tt.maxDistance {
+				t.Errorf("hamming distance = %d, want [%d, %d]", distance, tt.minDistance, tt.maxDistance)
+			}
+		})
+	}
+}
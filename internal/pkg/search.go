@@ -0,0 +1,274 @@
+package pkg
+
+import (
+	"html"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	bm25K1 = 1.2  // BM25 词频饱和参数
+	bm25B  = 0.75 // BM25 文档长度归一化参数
+)
+
+// Tokenize 分词：英文和数字按单词切分，中日韩文字按相邻两个字切分（与 MySQL ngram 分词一致），单独一个字时保留单字
+// 结果统一转为小写，保留重复的词用于统计词频
+func Tokenize(text string) []string {
+	var tokens []string
+	var word, cjk []rune
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		if len(cjk) == 1 {
+			tokens = append(tokens, string(cjk))
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			tokens = append(tokens, string(cjk[i:i+2]))
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range text {
+		r = unicode.ToLower(r)
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return tokens
+}
+
+// isCJK 是否为中日韩文字
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// isWordRune 是否为组成英文单词的字符
+func isWordRune(r rune) bool {
+	return (unicode.IsLetter(r) || unicode.IsDigit(r)) && !isCJK(r)
+}
+
+// Highlight 在文本中用 <em></em> 标出与搜索词匹配的部分，文本过长时截取第一处匹配附近的 maxRunes 个字符
+// 其余内容做 HTML 转义，没有匹配时返回空字符串
+func Highlight(text, query string, maxRunes int) string {
+	terms := uniqueTokens(Tokenize(query))
+	if text == "" || len(terms) == 0 {
+		return ""
+	}
+
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		termRunes := []rune(term)
+		wholeWord := isWordRune(termRunes[0])
+		for i := 0; i+len(termRunes) <= len(lower); i++ {
+			if !runesEqual(lower[i:i+len(termRunes)], termRunes) {
+				continue
+			}
+			// 英文单词需要完整匹配，避免在 category 中标出 cat
+			end := i + len(termRunes)
+			if wholeWord && ((i > 0 && isWordRune(lower[i-1])) || (end < len(lower) && isWordRune(lower[end]))) {
+				continue
+			}
+			for j := i; j < end; j++ {
+				marked[j] = true
+			}
+			if first < 0 || i < first {
+				first = i
+			}
+		}
+	}
+	if first < 0 {
+		return ""
+	}
+
+	start, end := 0, len(runes)
+	if maxRunes > 0 && len(runes) > maxRunes {
+		start = max(0, min(first-maxRunes/4, len(runes)-maxRunes))
+		end = start + maxRunes
+	}
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString("…")
+	}
+	for i := start; i < end; {
+		j := i
+		for j < end && marked[j] == marked[i] {
+			j++
+		}
+		segment := html.EscapeString(string(runes[i:j]))
+		if marked[i] {
+			sb.WriteString("<em>" + segment + "</em>")
+		} else {
+			sb.WriteString(segment)
+		}
+		i = j
+	}
+	if end < len(runes) {
+		sb.WriteString("…")
+	}
+	return sb.String()
+}
+
+// runesEqual 比较两个字符切片是否相同
+func runesEqual(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// uniqueTokens 去除重复的词，保持原有顺序
+func uniqueTokens(tokens []string) []string {
+	seen := make(map[string]struct{}, len(tokens))
+	result := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if _, ok := seen[token]; !ok {
+			seen[token] = struct{}{}
+			result = append(result, token)
+		}
+	}
+	return result
+}
+
+// SearchField 参与检索的文档字段
+type SearchField struct {
+	Text  string
+	Boost float64 // 字段权重，命中权重高的字段得分更高
+}
+
+// SearchMatch 检索命中的文档
+type SearchMatch struct {
+	ID    int64
+	Score float64
+}
+
+// InvertedIndex 内存倒排索引，使用 BM25 计算相关度（非并发安全，由调用方加锁）
+type InvertedIndex struct {
+	postings map[string]map[int64]float64 // 词 -> 文档 ID -> 加权词频
+	docTerms map[int64][]string           // 文档包含的词，用于更新和删除
+	docLens  map[int64]int                // 文档的词数
+	totalLen int
+}
+
+// NewInvertedIndex 创建内存倒排索引
+func NewInvertedIndex() *InvertedIndex {
+	return &InvertedIndex{
+		postings: make(map[string]map[int64]float64),
+		docTerms: make(map[int64][]string),
+		docLens:  make(map[int64]int),
+	}
+}
+
+// Index 新增或替换文档的索引
+func (idx *InvertedIndex) Index(id int64, fields []SearchField) {
+	idx.Remove(id)
+
+	freqs := make(map[string]float64)
+	length := 0
+	for _, field := range fields {
+		for _, token := range Tokenize(field.Text) {
+			freqs[token] += field.Boost
+			length++
+		}
+	}
+	if length == 0 {
+		return
+	}
+
+	terms := make([]string, 0, len(freqs))
+	for term, freq := range freqs {
+		docs, ok := idx.postings[term]
+		if !ok {
+			docs = make(map[int64]float64)
+			idx.postings[term] = docs
+		}
+		docs[id] = freq
+		terms = append(terms, term)
+	}
+	idx.docTerms[id] = terms
+	idx.docLens[id] = length
+	idx.totalLen += length
+}
+
+// Remove 删除文档的索引
+func (idx *InvertedIndex) Remove(id int64) {
+	terms, ok := idx.docTerms[id]
+	if !ok {
+		return
+	}
+	for _, term := range terms {
+		docs := idx.postings[term]
+		delete(docs, id)
+		if len(docs) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	idx.totalLen -= idx.docLens[id]
+	delete(idx.docTerms, id)
+	delete(idx.docLens, id)
+}
+
+// Len 已索引的文档数
+func (idx *InvertedIndex) Len() int {
+	return len(idx.docLens)
+}
+
+// Search 检索包含任意一个搜索词的文档，按相关度从高到低排序（相同时 ID 大的在前）
+func (idx *InvertedIndex) Search(query string) []SearchMatch {
+	docCount := float64(len(idx.docLens))
+	if docCount == 0 {
+		return nil
+	}
+	avgLen := float64(idx.totalLen) / docCount
+
+	scores := make(map[int64]float64)
+	for _, term := range uniqueTokens(Tokenize(query)) {
+		docs := idx.postings[term]
+		if len(docs) == 0 {
+			continue
+		}
+		n := float64(len(docs))
+		idf := math.Log(1 + (docCount-n+0.5)/(n+0.5))
+		for id, freq := range docs {
+			norm := bm25K1 * (1 - bm25B + bm25B*float64(idx.docLens[id])/avgLen)
+			scores[id] += idf * freq * (bm25K1 + 1) / (freq + norm)
+		}
+	}
+
+	matches := make([]SearchMatch, 0, len(scores))
+	for id, score := range scores {
+		matches = append(matches, SearchMatch{ID: id, Score: score})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].ID > matches[j].ID
+	})
+	return matches
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// counterFlushInterval 图片计数刷新间隔
+const counterFlushInterval = 10 * time.Second
+
+// CounterFlushServer 定时将 Redis 中缓冲的图片计数刷新到数据库，停止时再刷新一次剩余的计数
+type CounterFlushServer struct {
+	*taskServer
+}
+
+// NewCounterFlushServer 创建图片计数刷新任务
+func NewCounterFlushServer(uc *biz.PictureUsecase, logger log.Logger) *CounterFlushServer {
+	s := newPeriodicServer("图片计数刷新任务", counterFlushInterval, false, func(ctx context.Context) {
+		_ = uc.FlushCounters(ctx)
+	}, logger)
+	s.onStop = uc.FlushCounters
+	return &CounterFlushServer{taskServer: s}
+}
@@ -3,10 +3,17 @@ package server
 import (
 	"context"
 
+	albumv1 "smart-collab-gallery-server/api/album/v1"
+	commentv1 "smart-collab-gallery-server/api/comment/v1"
 	filev1 "smart-collab-gallery-server/api/file/v1"
 	healthv1 "smart-collab-gallery-server/api/health/v1"
 	v1 "smart-collab-gallery-server/api/helloworld/v1"
+	notificationv1 "smart-collab-gallery-server/api/notification/v1"
 	picturev1 "smart-collab-gallery-server/api/picture/v1"
+	quotav1 "smart-collab-gallery-server/api/quota/v1"
+	sharev1 "smart-collab-gallery-server/api/share/v1"
+	spacev1 "smart-collab-gallery-server/api/space/v1"
+	taxonomyv1 "smart-collab-gallery-server/api/taxonomy/v1"
 	userv1 "smart-collab-gallery-server/api/user/v1"
 	"smart-collab-gallery-server/internal/conf"
 	"smart-collab-gallery-server/internal/middleware"
@@ -22,12 +29,14 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(bc *conf.Bootstrap, greeter *service.GreeterService, user *service.UserService, file *service.FileService, picture *service.PictureService, health *service.HealthService, jwtManager *pkg.JWTManager, logger log.Logger) *http.Server {
+func NewHTTPServer(bc *conf.Bootstrap, greeter *service.GreeterService, user *service.UserService, file *service.FileService, picture *service.PictureService, comment *service.CommentService, notification *service.NotificationService, album *service.AlbumService, space *service.SpaceService, share *service.ShareService, quota *service.QuotaService, taxonomy *service.TaxonomyService, health *service.HealthService, jwtManager *pkg.JWTManager, logger log.Logger) *http.Server {
 	c := bc.Server
 	var opts = []http.ServerOption{
 		// 应用统一响应格式编码器
 		http.ResponseEncoder(response.ResponseEncoder),
 		http.ErrorEncoder(response.ErrorEncoder),
+		// 保存请求的原始上下文，SSE 长连接据此感知客户端断开
+		http.Filter(middleware.ConnContext),
 		http.Middleware(
 			recovery.Recovery(),
 			middleware.MetricsServer(),
@@ -60,6 +69,18 @@ func NewHTTPServer(bc *conf.Bootstrap, greeter *service.GreeterService, user *se
 	userv1.RegisterUserHTTPServer(srv, user)
 	filev1.RegisterFileHTTPServer(srv, file)
 	picturev1.RegisterPictureHTTPServer(srv, picture)
+	commentv1.RegisterCommentHTTPServer(srv, comment)
+	notificationv1.RegisterNotificationHTTPServer(srv, notification)
+	albumv1.RegisterAlbumHTTPServer(srv, album)
+	spacev1.RegisterSpaceHTTPServer(srv, space)
+	sharev1.RegisterShareHTTPServer(srv, share)
+	quotav1.RegisterQuotaHTTPServer(srv, quota)
+	taxonomyv1.RegisterTaxonomyHTTPServer(srv, taxonomy)
+
+	// 通知实时推送（SSE）
+	srv.Route("/").GET("/api/notification/stream", notification.StreamNotifications)
+	// 图片缩放
+	srv.Route("/").GET("/img/{id}", picture.GetPictureImage)
 	return srv
 }
 
@@ -74,6 +95,8 @@ func NewWhiteListMatcher() selector.MatchFunc {
 	// 图片公开接口（不需要登录即可查看）
 	whiteList["/api.picture.v1.Picture/GetPictureById"] = struct{}{}
 	whiteList["/api.picture.v1.Picture/ListPictureByPage"] = struct{}{}
+	// 分享链接访问接口（持有分享链接的访客无需登录）
+	whiteList["/api.share.v1.Share/ResolveShareLink"] = struct{}{}
 
 	return func(ctx context.Context, operation string) bool {
 		if _, ok := whiteList[operation]; ok {
@@ -94,6 +117,26 @@ func NewAdminOnlyMatcher() selector.MatchFunc {
 	adminList["/api.user.v1.User/DeleteUser"] = struct{}{}
 	adminList["/api.user.v1.User/UpdateUser"] = struct{}{}
 	adminList["/api.user.v1.User/ListUserByPage"] = struct{}{}
+	adminList["/api.user.v1.User/GenerateVipCodes"] = struct{}{}
+	adminList["/api.user.v1.User/GetInviteStatistics"] = struct{}{}
+	// 评论审核接口需要管理员权限
+	adminList["/api.comment.v1.Comment/ReviewComment"] = struct{}{}
+	adminList["/api.comment.v1.Comment/ListCommentsByReviewStatus"] = struct{}{}
+	// 重复图片报告需要管理员权限
+	adminList["/api.picture.v1.Picture/ListDuplicateClusters"] = struct{}{}
+	// 图片审核接口需要管理员权限
+	adminList["/api.picture.v1.Picture/BatchReviewPictures"] = struct{}{}
+	// 配额管理接口需要管理员权限
+	adminList["/api.quota.v1.Quota/GetUserUsage"] = struct{}{}
+	adminList["/api.quota.v1.Quota/SetUserQuota"] = struct{}{}
+	// 标签、分类管理接口需要管理员权限
+	adminList["/api.taxonomy.v1.Taxonomy/ListTerms"] = struct{}{}
+	adminList["/api.taxonomy.v1.Taxonomy/AddTerm"] = struct{}{}
+	adminList["/api.taxonomy.v1.Taxonomy/UpdateTerm"] = struct{}{}
+	adminList["/api.taxonomy.v1.Taxonomy/DeleteTerm"] = struct{}{}
+	adminList["/api.taxonomy.v1.Taxonomy/MergeTerms"] = struct{}{}
+	adminList["/api.taxonomy.v1.Taxonomy/AddTermAlias"] = struct{}{}
+	adminList["/api.taxonomy.v1.Taxonomy/DeleteTermAlias"] = struct{}{}
 
 	return func(ctx context.Context, operation string) bool {
 		// 在管理员列表中，需要管理员权限
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"smart-collab-gallery-server/internal/pkg"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// multipartCleanupInterval 过期分片上传清理间隔
+const multipartCleanupInterval = time.Hour
+
+// MultipartCleanupServer 定时取消超过有效期仍未完成的分片上传，释放 COS 中残留的分片
+type MultipartCleanupServer struct {
+	*taskServer
+}
+
+// NewMultipartCleanupServer 创建过期分片上传清理任务，cosManager 为 nil 时（未配置 COS）不执行清理
+func NewMultipartCleanupServer(cosManager *pkg.COSManager, logger log.Logger) *MultipartCleanupServer {
+	helper := log.NewHelper(logger)
+	return &MultipartCleanupServer{
+		taskServer: newPeriodicServer("过期分片上传清理任务", multipartCleanupInterval, false, func(ctx context.Context) {
+			if cosManager == nil {
+				return
+			}
+			aborted, err := cosManager.AbortStaleMultipartUploads(ctx)
+			if err != nil {
+				helper.Errorf("清理过期分片上传失败: %v", err)
+			}
+			if aborted > 0 {
+				helper.Infof("清理过期分片上传 %d 个", aborted)
+			}
+		}, logger),
+	}
+}
@@ -0,0 +1,19 @@
+package server
+
+import (
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NotificationPushServer 订阅 Redis 中所有副本发布的通知，并推送给本副本上的 SSE 连接
+type NotificationPushServer struct {
+	*taskServer
+}
+
+// NewNotificationPushServer 创建通知推送任务
+func NewNotificationPushServer(uc *biz.NotificationUsecase, logger log.Logger) *NotificationPushServer {
+	return &NotificationPushServer{
+		taskServer: newTaskServer("通知推送任务", uc.RunPush, logger),
+	}
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultTagSuggestRefreshInterval 标签建议索引默认刷新间隔
+const defaultTagSuggestRefreshInterval = 10 * time.Minute
+
+// TagSuggestRefreshServer 启动时构建标签建议索引，之后定时从图片数据刷新
+type TagSuggestRefreshServer struct {
+	*taskServer
+}
+
+// NewTagSuggestRefreshServer 创建标签建议索引刷新任务
+func NewTagSuggestRefreshServer(uc *biz.TaxonomyUsecase, bc *conf.Bootstrap, logger log.Logger) *TagSuggestRefreshServer {
+	interval := bc.GetTagSuggest().GetRefreshInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultTagSuggestRefreshInterval
+	}
+	return &TagSuggestRefreshServer{
+		taskServer: newPeriodicServer("标签建议索引刷新任务", interval, true, func(ctx context.Context) {
+			_ = uc.RefreshTagSuggestions(ctx)
+		}, logger),
+	}
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// taskServer 后台任务，实现 kratos transport.Server 接口，随应用一起启动和停止
+type taskServer struct {
+	name    string
+	run     func(ctx context.Context) error // 执行任务，阻塞直到 ctx 被取消
+	onStop  func(ctx context.Context) error // 任务退出后执行，可为 nil
+	log     *log.Helper
+	ctx     context.Context
+	cancel  context.CancelFunc
+	started atomic.Bool // Start 是否已执行，未执行时 Stop 不等待 done
+	done    chan struct{}
+}
+
+// newTaskServer 创建后台任务，run 在 Start 中执行，Stop 时取消其 ctx
+func newTaskServer(name string, run func(ctx context.Context) error, logger log.Logger) *taskServer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &taskServer{
+		name:   name,
+		run:    run,
+		log:    log.NewHelper(logger),
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+}
+
+// newPeriodicServer 创建每隔 interval 执行一次 fn 的定时任务，runOnStart 为 true 时启动后立即执行一次
+func newPeriodicServer(name string, interval time.Duration, runOnStart bool, fn func(ctx context.Context), logger log.Logger) *taskServer {
+	return newTaskServer(name, func(ctx context.Context) error {
+		if runOnStart {
+			fn(ctx)
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				fn(ctx)
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}, logger)
+}
+
+// Start 启动任务，阻塞直到 Stop 被调用
+func (s *taskServer) Start(ctx context.Context) error {
+	s.started.Store(true)
+	defer close(s.done)
+
+	s.log.Infof("%s已启动", s.name)
+	return s.run(s.ctx)
+}
+
+// Stop 停止任务并等待其退出，Start 未执行时（如其他服务启动失败）直接返回
+func (s *taskServer) Stop(ctx context.Context) error {
+	s.cancel()
+	if !s.started.Load() {
+		return nil
+	}
+
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	s.log.Infof("%s已停止", s.name)
+	if s.onStop != nil {
+		return s.onStop(ctx)
+	}
+	return nil
+}
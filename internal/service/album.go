@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+
+	pb "smart-collab-gallery-server/api/album/v1"
+	picturepb "smart-collab-gallery-server/api/picture/v1"
+	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/middleware"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AlbumService struct {
+	pb.UnimplementedAlbumServer
+
+	uc             *biz.AlbumUsecase
+	pictureService *PictureService // 复用图片视图对象的转换
+	log            *log.Helper
+}
+
+// NewAlbumService 创建相册服务
+func NewAlbumService(uc *biz.AlbumUsecase, pictureService *PictureService, logger log.Logger) *AlbumService {
+	return &AlbumService{
+		uc:             uc,
+		pictureService: pictureService,
+		log:            log.NewHelper(logger),
+	}
+}
+
+// AddAlbum 创建相册
+func (s *AlbumService) AddAlbum(ctx context.Context, req *pb.AddAlbumRequest) (*pb.AddAlbumReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	album, err := s.uc.AddAlbum(ctx, loginUserID, req.Name, req.Description, biz.AlbumVisibility(req.Visibility), req.CoverPictureId)
+	if err != nil {
+		s.log.Errorf("创建相册失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.AddAlbumReply{
+		Album: s.convertToProtoAlbumVO(ctx, album),
+	}, nil
+}
+
+// UpdateAlbum 更新相册
+func (s *AlbumService) UpdateAlbum(ctx context.Context, req *pb.UpdateAlbumRequest) (*pb.UpdateAlbumReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	album, err := s.uc.UpdateAlbum(ctx, loginUserID, req.Id, req.Name, req.Description, biz.AlbumVisibility(req.Visibility), req.CoverPictureId)
+	if err != nil {
+		s.log.Errorf("更新相册失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdateAlbumReply{
+		Album: s.convertToProtoAlbumVO(ctx, album),
+	}, nil
+}
+
+// DeleteAlbum 删除相册
+func (s *AlbumService) DeleteAlbum(ctx context.Context, req *pb.DeleteAlbumRequest) (*pb.DeleteAlbumReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	// 检查是否是管理员
+	isAdmin := middleware.GetUserRoleFromContext(ctx) == "admin"
+
+	if err := s.uc.DeleteAlbum(ctx, loginUserID, req.Id, isAdmin); err != nil {
+		s.log.Errorf("删除相册失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.DeleteAlbumReply{
+		Success: true,
+	}, nil
+}
+
+// GetAlbum 获取相册详情
+func (s *AlbumService) GetAlbum(ctx context.Context, req *pb.GetAlbumRequest) (*pb.GetAlbumReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	isAdmin := middleware.GetUserRoleFromContext(ctx) == "admin"
+
+	album, err := s.uc.GetAlbum(ctx, loginUserID, isAdmin, req.Id, req.ShareCode)
+	if err != nil {
+		s.log.Errorf("获取相册失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.GetAlbumReply{
+		Album: s.convertToProtoAlbumVO(ctx, album),
+	}, nil
+}
+
+// ListAlbumByPage 分页查询相册
+func (s *AlbumService) ListAlbumByPage(ctx context.Context, req *pb.ListAlbumByPageRequest) (*pb.ListAlbumByPageReply, error) {
+	page, err := s.uc.ListAlbumByPage(ctx, middleware.GetUserIDFromContext(ctx), req.UserId, req.Name, req.Current, req.PageSize)
+	if err != nil {
+		s.log.Errorf("查询相册列表失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*pb.AlbumVO, 0, len(page.List))
+	for _, album := range page.List {
+		list = append(list, s.convertToProtoAlbumVO(ctx, album))
+	}
+
+	return &pb.ListAlbumByPageReply{
+		Total: page.Total,
+		List:  list,
+	}, nil
+}
+
+// AddAlbumPictures 向相册添加图片
+func (s *AlbumService) AddAlbumPictures(ctx context.Context, req *pb.AddAlbumPicturesRequest) (*pb.AddAlbumPicturesReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	count, err := s.uc.AddAlbumPictures(ctx, loginUserID, req.AlbumId, req.PictureIds)
+	if err != nil {
+		s.log.Errorf("添加相册图片失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.AddAlbumPicturesReply{
+		Count: count,
+	}, nil
+}
+
+// RemoveAlbumPictures 从相册移除图片
+func (s *AlbumService) RemoveAlbumPictures(ctx context.Context, req *pb.RemoveAlbumPicturesRequest) (*pb.RemoveAlbumPicturesReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	count, err := s.uc.RemoveAlbumPictures(ctx, loginUserID, req.AlbumId, req.PictureIds)
+	if err != nil {
+		s.log.Errorf("移除相册图片失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.RemoveAlbumPicturesReply{
+		Count: count,
+	}, nil
+}
+
+// ReorderAlbumPictures 调整相册中图片的顺序
+func (s *AlbumService) ReorderAlbumPictures(ctx context.Context, req *pb.ReorderAlbumPicturesRequest) (*pb.ReorderAlbumPicturesReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	if err := s.uc.ReorderAlbumPictures(ctx, loginUserID, req.AlbumId, req.PictureIds); err != nil {
+		s.log.Errorf("调整相册图片顺序失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.ReorderAlbumPicturesReply{
+		Success: true,
+	}, nil
+}
+
+// ListAlbumPictures 分页查询相册中的图片
+func (s *AlbumService) ListAlbumPictures(ctx context.Context, req *pb.ListAlbumPicturesRequest) (*pb.ListAlbumPicturesReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	isAdmin := middleware.GetUserRoleFromContext(ctx) == "admin"
+
+	page, err := s.uc.ListAlbumPictures(ctx, loginUserID, isAdmin, req.AlbumId, req.ShareCode, req.Current, req.PageSize)
+	if err != nil {
+		s.log.Errorf("查询相册图片失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*picturepb.PictureVO, 0, len(page.List))
+	for _, picture := range page.List {
+		list = append(list, s.pictureService.convertToProtoPictureVO(ctx, picture))
+	}
+
+	return &pb.ListAlbumPicturesReply{
+		Total: page.Total,
+		List:  list,
+	}, nil
+}
+
+// convertToProtoAlbumVO 转换业务对象为 proto 对象
+func (s *AlbumService) convertToProtoAlbumVO(ctx context.Context, vo *biz.AlbumVO) *pb.AlbumVO {
+	if vo == nil {
+		return nil
+	}
+
+	return &pb.AlbumVO{
+		Id:             vo.ID,
+		Name:           vo.Name,
+		Description:    vo.Description,
+		Visibility:     int32(vo.Visibility),
+		ShareCode:      vo.ShareCode,
+		CoverPictureId: vo.CoverPictureID,
+		CoverUrl:       s.pictureService.signURL(ctx, vo.CoverURL),
+		PictureCount:   vo.PictureCount,
+		UserId:         vo.UserID,
+		CreateTime:     timestamppb.New(vo.CreateTime),
+		EditTime:       timestamppb.New(vo.EditTime),
+		User:           s.pictureService.convertToProtoUserVO(vo.User),
+	}
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+
+	pb "smart-collab-gallery-server/api/comment/v1"
+	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/middleware"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CommentService struct {
+	pb.UnimplementedCommentServer
+
+	uc  *biz.CommentUsecase
+	log *log.Helper
+}
+
+// NewCommentService 创建评论服务
+func NewCommentService(uc *biz.CommentUsecase, logger log.Logger) *CommentService {
+	return &CommentService{
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// AddComment 发表评论或回复
+func (s *CommentService) AddComment(ctx context.Context, req *pb.AddCommentRequest) (*pb.AddCommentReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	comment, err := s.uc.AddComment(ctx, loginUserID, req.PictureId, req.ParentId, req.Content)
+	if err != nil {
+		s.log.Errorf("发表评论失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.AddCommentReply{
+		Comment: s.convertToProtoCommentVO(comment),
+	}, nil
+}
+
+// EditComment 编辑评论
+func (s *CommentService) EditComment(ctx context.Context, req *pb.EditCommentRequest) (*pb.EditCommentReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	comment, err := s.uc.EditComment(ctx, loginUserID, req.Id, req.Content)
+	if err != nil {
+		s.log.Errorf("编辑评论失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.EditCommentReply{
+		Comment: s.convertToProtoCommentVO(comment),
+	}, nil
+}
+
+// DeleteComment 删除评论
+func (s *CommentService) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	// 检查是否是管理员
+	isAdmin := middleware.GetUserRoleFromContext(ctx) == "admin"
+
+	if err := s.uc.DeleteComment(ctx, loginUserID, req.Id, isAdmin); err != nil {
+		s.log.Errorf("删除评论失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.DeleteCommentReply{
+		Success: true,
+	}, nil
+}
+
+// ListComments 游标分页查询图片的一级评论
+func (s *CommentService) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsReply, error) {
+	page, err := s.uc.ListComments(ctx, middleware.GetUserIDFromContext(ctx), req.PictureId, req.Cursor, req.PageSize)
+	if err != nil {
+		s.log.Errorf("查询评论失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.ListCommentsReply{
+		List:       s.convertToProtoCommentVOList(page.List),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
+
+// ListReplies 游标分页查询评论的回复
+func (s *CommentService) ListReplies(ctx context.Context, req *pb.ListRepliesRequest) (*pb.ListRepliesReply, error) {
+	page, err := s.uc.ListReplies(ctx, middleware.GetUserIDFromContext(ctx), req.ParentId, req.Cursor, req.PageSize)
+	if err != nil {
+		s.log.Errorf("查询回复失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.ListRepliesReply{
+		List:       s.convertToProtoCommentVOList(page.List),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
+
+// ReviewComment 审核评论（管理员）
+func (s *CommentService) ReviewComment(ctx context.Context, req *pb.ReviewCommentRequest) (*pb.ReviewCommentReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+
+	err := s.uc.ReviewComment(ctx, loginUserID, req.Id, biz.ReviewStatus(req.ReviewStatus), req.ReviewMessage)
+	if err != nil {
+		s.log.Errorf("审核评论失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.ReviewCommentReply{
+		Success: true,
+	}, nil
+}
+
+// ListCommentsByReviewStatus 按审核状态查询评论（管理员）
+func (s *CommentService) ListCommentsByReviewStatus(ctx context.Context, req *pb.ListCommentsByReviewStatusRequest) (*pb.ListCommentsByReviewStatusReply, error) {
+	page, err := s.uc.ListCommentsByReviewStatus(ctx, biz.ReviewStatus(req.ReviewStatus), req.Cursor, req.PageSize)
+	if err != nil {
+		s.log.Errorf("按审核状态查询评论失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.ListCommentsByReviewStatusReply{
+		List:       s.convertToProtoCommentVOList(page.List),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
+
+// convertToProtoCommentVOList 批量转换评论视图对象
+func (s *CommentService) convertToProtoCommentVOList(comments []*biz.CommentVO) []*pb.CommentVO {
+	list := make([]*pb.CommentVO, 0, len(comments))
+	for _, comment := range comments {
+		list = append(list, s.convertToProtoCommentVO(comment))
+	}
+	return list
+}
+
+// convertToProtoCommentVO 转换业务对象为 proto 对象
+func (s *CommentService) convertToProtoCommentVO(vo *biz.CommentVO) *pb.CommentVO {
+	if vo == nil {
+		return nil
+	}
+
+	mentions := make([]*pb.UserVO, 0, len(vo.Mentions))
+	for _, user := range vo.Mentions {
+		mentions = append(mentions, s.convertToProtoUserVO(user))
+	}
+
+	return &pb.CommentVO{
+		Id:            vo.ID,
+		PictureId:     vo.PictureID,
+		UserId:        vo.UserID,
+		ParentId:      vo.ParentID,
+		ReplyToUserId: vo.ReplyToUserID,
+		Content:       vo.Content,
+		ReviewStatus:  int32(vo.ReviewStatus),
+		ReviewMessage: vo.ReviewMessage,
+		ReplyCount:    vo.ReplyCount,
+		CreateTime:    timestamppb.New(vo.CreateTime),
+		EditTime:      timestamppb.New(vo.EditTime),
+		User:          s.convertToProtoUserVO(vo.User),
+		ReplyToUser:   s.convertToProtoUserVO(vo.ReplyToUser),
+		Mentions:      mentions,
+	}
+}
+
+// convertToProtoUserVO 转换用户对象为 proto 对象
+func (s *CommentService) convertToProtoUserVO(userVO *biz.UserVO) *pb.UserVO {
+	if userVO == nil {
+		return nil
+	}
+
+	return &pb.UserVO{
+		Id:          userVO.ID,
+		UserAccount: userVO.UserAccount,
+		UserName:    userVO.UserName,
+		UserAvatar:  userVO.UserAvatar,
+	}
+}
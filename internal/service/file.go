@@ -2,23 +2,34 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	v1 "smart-collab-gallery-server/api/file/v1"
+	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/middleware"
 	"smart-collab-gallery-server/internal/pkg"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// maxPresignPartsPerRequest 单次最多获取的分片预签名 URL 数量
+const maxPresignPartsPerRequest = 100
+
 type FileService struct {
 	v1.UnimplementedFileServer
 
 	cosManager *pkg.COSManager
+	uploadUC   *biz.UploadUsecase // 记录每次上传，用于校验图片文件的来源
+	quotaUC    *biz.QuotaUsecase  // 获取上传地址前检查存储配额
 	log        *log.Helper
 }
 
-func NewFileService(cosManager *pkg.COSManager, logger log.Logger) *FileService {
+func NewFileService(cosManager *pkg.COSManager, uploadUC *biz.UploadUsecase, quotaUC *biz.QuotaUsecase, logger log.Logger) *FileService {
 	return &FileService{
 		cosManager: cosManager,
+		uploadUC:   uploadUC,
+		quotaUC:    quotaUC,
 		log:        log.NewHelper(logger),
 	}
 }
@@ -26,10 +37,17 @@ func NewFileService(cosManager *pkg.COSManager, logger log.Logger) *FileService
 // GetUploadPresignedUrl 获取上传预签名 URL（支持多存储桶）
 // 前端可以通过 bucket_name 字段传递 bucket_key（如 image/video/document）
 // 如果不传，则根据文件扩展名自动检测存储桶
+// 文件大小和 Content-Type 会绑定到签名中，上传时必须携带与声明一致的 Content-Length 和返回的 Content-Type
+// 上传成功后需要调用 CompleteUpload 完成上传
 func (s *FileService) GetUploadPresignedUrl(ctx context.Context, req *v1.GetUploadPresignedUrlRequest) (*v1.GetUploadPresignedUrlReply, error) {
 	s.log.WithContext(ctx).Infof("获取上传预签名 URL: fileName=%s, contentType=%s, bucketKey=%s",
 		req.FileName, req.ContentType, req.BucketName)
 
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+
 	// 检查 COS Manager 是否可用
 	if s.cosManager == nil {
 		s.log.WithContext(ctx).Error("COS Manager 未初始化，请检查配置")
@@ -40,6 +58,14 @@ func (s *FileService) GetUploadPresignedUrl(ctx context.Context, req *v1.GetUplo
 	if req.FileName == "" {
 		return nil, v1.ErrorParamsError("文件名不能为空")
 	}
+	if req.FileSize <= 0 {
+		return nil, v1.ErrorParamsError("文件大小必须大于 0")
+	}
+
+	// 检查每日上传次数和剩余存储空间
+	if err := s.quotaUC.CheckUpload(ctx, loginUserID, req.FileSize); err != nil {
+		return nil, err
+	}
 
 	// 确定使用的存储桶 key
 	// 复用 BucketName 字段作为 bucket_key（如 image/video/document）
@@ -55,21 +81,361 @@ func (s *FileService) GetUploadPresignedUrl(ctx context.Context, req *v1.GetUplo
 		FileName:    req.FileName,
 		ContentType: req.ContentType,
 		BucketKey:   bucketKey,
+		FileSize:    req.FileSize,
 	}
 
 	// 获取预签名 URL
 	result, err := s.cosManager.GetUploadPresignedURL(ctx, opts)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("生成预签名 URL 失败: %v", err)
+		switch {
+		case errors.Is(err, pkg.ErrFileTypeNotSupported):
+			return nil, v1.ErrorFileTypeNotSupported("文件类型不支持")
+		case errors.Is(err, pkg.ErrFileTooLarge):
+			return nil, v1.ErrorFileTooLarge("文件大小超过限制")
+		}
 		return nil, v1.ErrorSystemError("生成上传链接失败: %s", err.Error())
 	}
 
+	// 记录上传，完成上传时校验
+	upload, err := s.uploadUC.CreateUpload(ctx, &biz.Upload{
+		UserID:      loginUserID,
+		BucketKey:   result.BucketKey,
+		FileKey:     result.FileKey,
+		URL:         result.AccessURL,
+		FileSize:    req.FileSize,
+		ContentType: result.ContentType,
+		ExpireTime:  time.Unix(result.ExpireTime, 0),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GetUploadPresignedUrlReply{
-		UploadUrl:  result.UploadURL,
-		FileKey:    result.FileKey,
-		AccessUrl:  result.AccessURL,
-		ExpireTime: result.ExpireTime,
-		BucketName: result.BucketKey, // 返回实际使用的 bucket key
-		Region:     result.Region,
+		UploadUrl:   result.UploadURL,
+		FileKey:     result.FileKey,
+		AccessUrl:   result.AccessURL,
+		ExpireTime:  result.ExpireTime,
+		BucketName:  result.BucketKey, // 返回实际使用的 bucket key
+		Region:      result.Region,
+		ContentType: result.ContentType,
+		UploadId:    upload.ID,
+	}, nil
+}
+
+// CompleteUpload 完成上传：确认文件已上传、大小与声明一致且内容与扩展名相符后标记上传完成，并记录文件的 ETag
+// 上传地址过期后不能再完成上传；过期、大小或内容未通过校验的文件会被删除，上传记录标记为未通过校验
+// 读取文件失败等临时错误不改变上传状态，可重试
+func (s *FileService) CompleteUpload(ctx context.Context, req *v1.CompleteUploadRequest) (*v1.CompleteUploadReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	if s.cosManager == nil {
+		s.log.WithContext(ctx).Error("COS Manager 未初始化，请检查配置")
+		return nil, v1.ErrorSystemError("文件上传服务暂不可用，请联系管理员配置 COS")
+	}
+
+	upload, err := s.uploadUC.GetUpload(ctx, loginUserID, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	if upload.UploadID != "" {
+		return nil, v1.ErrorParamsError("分片上传请调用完成分片上传接口")
+	}
+	if upload.Status == biz.UploadCompleted || upload.Status == biz.UploadConsumed {
+		return s.convertToCompleteUploadReply(upload), nil
+	}
+	if upload.Status == biz.UploadPending && time.Now().After(upload.ExpireTime) {
+		s.rejectUpload(ctx, upload)
+		return nil, v1.ErrorUploadNotFound("上传地址已过期，请重新上传")
+	}
+
+	object, err := s.cosManager.HeadObject(ctx, upload.URL)
+	if err != nil {
+		if errors.Is(err, pkg.ErrObjectNotFound) {
+			return nil, v1.ErrorUploadNotFound("文件尚未上传")
+		}
+		s.log.WithContext(ctx).Errorf("查询已上传文件失败: %v", err)
+		return nil, v1.ErrorSystemError("完成上传失败")
+	}
+	if object.Size != upload.FileSize {
+		s.rejectUpload(ctx, upload)
+		return nil, v1.ErrorParamsError("文件大小与声明不一致")
+	}
+
+	// 只检测 HEAD 查询到的版本，校验期间文件被改写时拒绝
+	if err := s.cosManager.VerifyUploadedObject(ctx, upload.URL, object.ETag); err != nil {
+		s.log.WithContext(ctx).Errorf("校验已上传文件失败: %v", err)
+		if errors.Is(err, pkg.ErrFileTypeNotSupported) || errors.Is(err, pkg.ErrObjectModified) {
+			s.rejectUpload(ctx, upload)
+		}
+		return nil, s.convertMultipartError(err)
+	}
+
+	upload, err = s.uploadUC.CompleteUpload(ctx, upload, object.Size, object.ETag)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToCompleteUploadReply(upload), nil
+}
+
+// InitiateMultipartUpload 初始化分片上传
+// 大文件按返回的分片大小切分，逐个分片获取预签名 URL 直传 COS，中断后可通过上传凭证查询已上传分片续传
+func (s *FileService) InitiateMultipartUpload(ctx context.Context, req *v1.InitiateMultipartUploadRequest) (*v1.InitiateMultipartUploadReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	if s.cosManager == nil {
+		s.log.WithContext(ctx).Error("COS Manager 未初始化，请检查配置")
+		return nil, v1.ErrorSystemError("文件上传服务暂不可用，请联系管理员配置 COS")
+	}
+
+	// 参数校验
+	if req.FileName == "" {
+		return nil, v1.ErrorParamsError("文件名不能为空")
+	}
+	if req.FileSize <= 0 {
+		return nil, v1.ErrorParamsError("文件大小必须大于 0")
+	}
+
+	// 检查每日上传次数和剩余存储空间
+	if err := s.quotaUC.CheckUpload(ctx, loginUserID, req.FileSize); err != nil {
+		return nil, err
+	}
+
+	bucketKey := req.BucketName
+	if bucketKey == "" {
+		bucketKey = s.cosManager.DetectBucketKeyByFileName(req.FileName)
+	}
+
+	result, err := s.cosManager.InitiateMultipartUpload(ctx, loginUserID, &pkg.UploadOptions{
+		FileName:    req.FileName,
+		ContentType: req.ContentType,
+		BucketKey:   bucketKey,
+		FileSize:    req.FileSize,
+	})
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("初始化分片上传失败: %v", err)
+		return nil, s.convertMultipartError(err)
+	}
+
+	if _, err := s.uploadUC.CreateUpload(ctx, &biz.Upload{
+		UserID:      loginUserID,
+		BucketKey:   result.BucketKey,
+		FileKey:     result.FileKey,
+		URL:         result.AccessURL,
+		UploadID:    result.UploadID,
+		FileSize:    req.FileSize,
+		ContentType: result.ContentType,
+		ExpireTime:  time.Unix(result.ExpireTime, 0),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &v1.InitiateMultipartUploadReply{
+		UploadToken: result.UploadToken,
+		UploadId:    result.UploadID,
+		FileKey:     result.FileKey,
+		AccessUrl:   result.AccessURL,
+		PartSize:    result.PartSize,
+		PartCount:   int32(result.PartCount),
+		ExpireTime:  result.ExpireTime,
+		BucketName:  result.BucketKey,
+		Region:      result.Region,
+	}, nil
+}
+
+// GetUploadPartPresignedUrls 获取分片上传预签名 URL
+func (s *FileService) GetUploadPartPresignedUrls(ctx context.Context, req *v1.GetUploadPartPresignedUrlsRequest) (*v1.GetUploadPartPresignedUrlsReply, error) {
+	upload, err := s.parseUploadToken(ctx, req.UploadToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.PartNumbers) == 0 {
+		return nil, v1.ErrorParamsError("分片编号不能为空")
+	}
+	if len(req.PartNumbers) > maxPresignPartsPerRequest {
+		return nil, v1.ErrorParamsError("单次最多获取 %d 个分片的上传地址", maxPresignPartsPerRequest)
+	}
+	partNumbers := make([]int, 0, len(req.PartNumbers))
+	for _, partNumber := range req.PartNumbers {
+		if partNumber < 1 || partNumber > pkg.MultipartMaxPartNumber {
+			return nil, v1.ErrorParamsError("分片编号必须在 1-%d 之间", pkg.MultipartMaxPartNumber)
+		}
+		partNumbers = append(partNumbers, int(partNumber))
+	}
+
+	parts, expireTime, err := s.cosManager.PresignUploadParts(ctx, upload, partNumbers)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("生成分片预签名 URL 失败: %v", err)
+		return nil, s.convertMultipartError(err)
+	}
+
+	reply := &v1.GetUploadPartPresignedUrlsReply{
+		Parts:      make([]*v1.UploadPartPresignedUrl, 0, len(parts)),
+		ExpireTime: expireTime,
+	}
+	for _, part := range parts {
+		reply.Parts = append(reply.Parts, &v1.UploadPartPresignedUrl{
+			PartNumber: int32(part.PartNumber),
+			UploadUrl:  part.UploadURL,
+		})
+	}
+	return reply, nil
+}
+
+// ListUploadedParts 查询已上传的分片，客户端据此跳过已上传的分片继续上传
+func (s *FileService) ListUploadedParts(ctx context.Context, req *v1.ListUploadedPartsRequest) (*v1.ListUploadedPartsReply, error) {
+	upload, err := s.parseUploadToken(ctx, req.UploadToken)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := s.cosManager.ListUploadedParts(ctx, upload)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("查询已上传分片失败: %v", err)
+		return nil, s.convertMultipartError(err)
+	}
+
+	reply := &v1.ListUploadedPartsReply{
+		Parts: make([]*v1.UploadedPart, 0, len(parts)),
+	}
+	for _, part := range parts {
+		reply.Parts = append(reply.Parts, &v1.UploadedPart{
+			PartNumber: int32(part.PartNumber),
+			Etag:       part.ETag,
+			Size:       part.Size,
+		})
+		reply.UploadedSize += part.Size
+	}
+	return reply, nil
+}
+
+// CompleteMultipartUpload 完成分片上传，合并后的文件超过存储桶大小限制时上传会被取消
+// 合并后的文件大小与初始化时声明的不一致（配额按声明大小检查）时删除文件，上传记录标记为未通过校验
+func (s *FileService) CompleteMultipartUpload(ctx context.Context, req *v1.CompleteMultipartUploadRequest) (*v1.CompleteMultipartUploadReply, error) {
+	upload, err := s.parseUploadToken(ctx, req.UploadToken)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.uploadUC.GetMultipartUpload(ctx, upload.UserID, upload.UploadID)
+	if err != nil {
+		return nil, err
+	}
+	if record.Status == biz.UploadCompleted || record.Status == biz.UploadConsumed {
+		return &v1.CompleteMultipartUploadReply{
+			FileKey:   record.FileKey,
+			AccessUrl: record.URL,
+			FileSize:  record.FileSize,
+		}, nil
+	}
+
+	result, err := s.cosManager.CompleteMultipartUpload(ctx, upload)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("完成分片上传失败: %v", err)
+		if errors.Is(err, pkg.ErrFileTooLarge) || errors.Is(err, pkg.ErrFileTypeNotSupported) || errors.Is(err, pkg.ErrObjectModified) {
+			s.rejectUpload(ctx, record)
+		}
+		return nil, s.convertMultipartError(err)
+	}
+	if result.FileSize != record.FileSize {
+		s.rejectUpload(ctx, record)
+		return nil, v1.ErrorParamsError("文件大小与声明不一致")
+	}
+
+	if _, err := s.uploadUC.CompleteUpload(ctx, record, result.FileSize, result.ETag); err != nil {
+		return nil, err
+	}
+
+	return &v1.CompleteMultipartUploadReply{
+		FileKey:   result.FileKey,
+		AccessUrl: result.AccessURL,
+		FileSize:  result.FileSize,
 	}, nil
 }
+
+// AbortMultipartUpload 取消分片上传
+func (s *FileService) AbortMultipartUpload(ctx context.Context, req *v1.AbortMultipartUploadRequest) (*v1.AbortMultipartUploadReply, error) {
+	upload, err := s.parseUploadToken(ctx, req.UploadToken)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.uploadUC.GetMultipartUpload(ctx, upload.UserID, upload.UploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cosManager.AbortMultipartUpload(ctx, upload); err != nil {
+		s.log.WithContext(ctx).Errorf("取消分片上传失败: %v", err)
+		return nil, s.convertMultipartError(err)
+	}
+
+	if err := s.uploadUC.CloseUpload(ctx, record, biz.UploadAborted); err != nil {
+		return nil, err
+	}
+
+	return &v1.AbortMultipartUploadReply{
+		Success: true,
+	}, nil
+}
+
+// parseUploadToken 校验登录状态和上传凭证，上传凭证只能由发起上传的用户使用
+func (s *FileService) parseUploadToken(ctx context.Context, token string) (*pkg.MultipartUpload, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, v1.ErrorUnauthorized("请先登录")
+	}
+	if s.cosManager == nil {
+		s.log.WithContext(ctx).Error("COS Manager 未初始化，请检查配置")
+		return nil, v1.ErrorSystemError("文件上传服务暂不可用，请联系管理员配置 COS")
+	}
+	if token == "" {
+		return nil, v1.ErrorParamsError("上传凭证不能为空")
+	}
+
+	upload, err := s.cosManager.ParseMultipartUploadToken(token, loginUserID)
+	if err != nil {
+		return nil, s.convertMultipartError(err)
+	}
+	return upload, nil
+}
+
+// rejectUpload 删除未通过校验的文件，并将上传记录标记为未通过校验
+func (s *FileService) rejectUpload(ctx context.Context, upload *biz.Upload) {
+	if err := s.cosManager.DeleteObject(ctx, upload.URL); err != nil {
+		s.log.WithContext(ctx).Errorf("删除未通过校验的文件失败: url=%s, err=%v", upload.URL, err)
+	}
+	_ = s.uploadUC.CloseUpload(ctx, upload, biz.UploadRejected)
+}
+
+// convertToCompleteUploadReply 转换上传记录为完成上传响应
+func (s *FileService) convertToCompleteUploadReply(upload *biz.Upload) *v1.CompleteUploadReply {
+	return &v1.CompleteUploadReply{
+		FileKey:   upload.FileKey,
+		AccessUrl: upload.URL,
+		FileSize:  upload.FileSize,
+	}
+}
+
+// convertMultipartError 转换 COS 上传错误为 API 错误
+func (s *FileService) convertMultipartError(err error) error {
+	switch {
+	case errors.Is(err, pkg.ErrUploadTokenInvalid):
+		return v1.ErrorUploadTokenInvalid("上传凭证无效或已过期")
+	case errors.Is(err, pkg.ErrUploadNotFound), errors.Is(err, pkg.ErrObjectNotFound):
+		return v1.ErrorUploadNotFound("上传不存在或已结束")
+	case errors.Is(err, pkg.ErrFileTypeNotSupported):
+		return v1.ErrorFileTypeNotSupported("文件类型不支持")
+	case errors.Is(err, pkg.ErrObjectModified):
+		return v1.ErrorParamsError("文件在校验过程中被改写，请重新上传")
+	case errors.Is(err, pkg.ErrFileTooLarge):
+		return v1.ErrorFileTooLarge("文件大小超过限制")
+	default:
+		return v1.ErrorSystemError("分片上传失败")
+	}
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	nethttp "net/http"
+	"time"
+
+	pb "smart-collab-gallery-server/api/notification/v1"
+	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/middleware"
+	"smart-collab-gallery-server/internal/pkg"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// OperationNotificationStream SSE 推送接口的操作名，用于匹配认证等中间件
+	OperationNotificationStream = "/api.notification.v1.Notification/StreamNotifications"
+	// sseHeartbeatInterval SSE 心跳间隔，用于保持连接并及时发现客户端断开
+	sseHeartbeatInterval = 15 * time.Second
+	// urlTokenPurposeNotificationStream SSE 推送令牌的用途
+	urlTokenPurposeNotificationStream = "notification_stream"
+	// streamTokenExpire SSE 推送令牌有效期，只需覆盖从获取令牌到建立连接的时间
+	streamTokenExpire = 5 * time.Minute
+)
+
+type NotificationService struct {
+	pb.UnimplementedNotificationServer
+
+	uc         *biz.NotificationUsecase
+	jwtManager *pkg.JWTManager // 用于签发和校验 SSE 推送令牌
+	log        *log.Helper
+}
+
+// NewNotificationService 创建通知服务
+func NewNotificationService(uc *biz.NotificationUsecase, jwtManager *pkg.JWTManager, logger log.Logger) *NotificationService {
+	return &NotificationService{
+		uc:         uc,
+		jwtManager: jwtManager,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// ListNotifications 游标分页查询我的通知
+func (s *NotificationService) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	page, err := s.uc.ListNotifications(ctx, loginUserID, req.Cursor, req.PageSize, req.UnreadOnly)
+	if err != nil {
+		s.log.Errorf("查询通知失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*pb.NotificationVO, 0, len(page.List))
+	for _, notification := range page.List {
+		list = append(list, s.convertToProtoNotificationVO(notification))
+	}
+
+	return &pb.ListNotificationsReply{
+		List:       list,
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}, nil
+}
+
+// MarkNotificationsRead 标记通知为已读
+func (s *NotificationService) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*pb.MarkNotificationsReadReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	count, err := s.uc.MarkRead(ctx, loginUserID, req.Ids)
+	if err != nil {
+		s.log.Errorf("标记通知已读失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.MarkNotificationsReadReply{
+		Count: count,
+	}, nil
+}
+
+// MarkAllNotificationsRead 标记全部通知为已读
+func (s *NotificationService) MarkAllNotificationsRead(ctx context.Context, req *pb.MarkAllNotificationsReadRequest) (*pb.MarkAllNotificationsReadReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	count, err := s.uc.MarkAllRead(ctx, loginUserID)
+	if err != nil {
+		s.log.Errorf("标记全部通知已读失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.MarkAllNotificationsReadReply{
+		Count: count,
+	}, nil
+}
+
+// GetUnreadCount 查询未读通知数
+func (s *NotificationService) GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.GetUnreadCountReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	count, err := s.uc.GetUnreadCount(ctx, loginUserID)
+	if err != nil {
+		s.log.Errorf("查询未读通知数失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.GetUnreadCountReply{
+		Count: count,
+	}, nil
+}
+
+// GetStreamToken 获取建立 SSE 连接用的短期令牌
+func (s *NotificationService) GetStreamToken(ctx context.Context, req *pb.GetStreamTokenRequest) (*pb.GetStreamTokenReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	token, expireTime := s.jwtManager.GenerateURLToken(urlTokenPurposeNotificationStream, loginUserID, middleware.GetUserRoleFromContext(ctx), streamTokenExpire)
+	return &pb.GetStreamTokenReply{
+		Token:      token,
+		ExpireTime: timestamppb.New(expireTime),
+	}, nil
+}
+
+// StreamNotifications 通过 Server-Sent Events 实时推送新通知
+// 经过 HTTP 服务的中间件链（JWTAuth 认证），浏览器原生 EventSource 无法设置请求头，
+// 因此也支持通过 token 查询参数传递 GetStreamToken 获取的短期令牌（不接受登录 Token）
+func (s *NotificationService) StreamNotifications(ctx http.Context) error {
+	authCtx, ok := authenticateURLToken(ctx, ctx.Request(), s.jwtManager, urlTokenPurposeNotificationStream)
+	if !ok {
+		return pb.ErrorUnauthorized("推送令牌无效或已过期")
+	}
+
+	http.SetOperation(ctx, OperationNotificationStream)
+	h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+		return nil, s.stream(c, ctx.Response())
+	})
+	_, err := h(authCtx, nil)
+	return err
+}
+
+// stream 持续向客户端写入通知和心跳，客户端断开、写入失败或服务停止（订阅被关闭）时返回
+// 注意：请求上下文受 HTTP 服务超时限制，长连接不依赖其结束时间，通过 middleware.ConnDone 感知客户端断开
+func (s *NotificationService) stream(ctx context.Context, w nethttp.ResponseWriter) error {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return pb.ErrorUnauthorized("请先登录")
+	}
+
+	flusher, ok := w.(nethttp.Flusher)
+	if !ok {
+		return pb.ErrorSystemError("当前连接不支持实时推送")
+	}
+
+	notifications, cancel, err := s.uc.Subscribe(loginUserID)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	connDone := middleware.ConnDone(ctx)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // 关闭 Nginx 缓冲
+	w.WriteHeader(nethttp.StatusOK)
+	if _, err := fmt.Fprint(w, ": connected\n\n"); err != nil {
+		return nil
+	}
+	flusher.Flush()
+
+	ticker := time.NewTicker(sseHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case notification, ok := <-notifications:
+			if !ok {
+				return nil
+			}
+			data, err := protojson.Marshal(s.convertToProtoNotificationVO(notification))
+			if err != nil {
+				s.log.Errorf("序列化通知失败: %v", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: notification\ndata: %s\n\n", notification.ID, data); err != nil {
+				return nil
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return nil
+			}
+		case <-connDone:
+			return nil
+		}
+		flusher.Flush()
+	}
+}
+
+// convertToProtoNotificationVO 转换业务对象为 proto 对象
+func (s *NotificationService) convertToProtoNotificationVO(vo *biz.NotificationVO) *pb.NotificationVO {
+	if vo == nil {
+		return nil
+	}
+
+	notification := &pb.NotificationVO{
+		Id:         vo.ID,
+		Type:       string(vo.Type),
+		UserId:     vo.UserID,
+		SenderId:   vo.SenderID,
+		PictureId:  vo.PictureID,
+		CommentId:  vo.CommentID,
+		Content:    vo.Content,
+		IsRead:     vo.IsRead,
+		CreateTime: timestamppb.New(vo.CreateTime),
+	}
+	if vo.Sender != nil {
+		notification.Sender = &pb.UserVO{
+			Id:          vo.Sender.ID,
+			UserAccount: vo.Sender.UserAccount,
+			UserName:    vo.Sender.UserName,
+			UserAvatar:  vo.Sender.UserAvatar,
+		}
+	}
+	return notification
+}
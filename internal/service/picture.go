@@ -2,28 +2,49 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"image"
+	"time"
 
 	pb "smart-collab-gallery-server/api/picture/v1"
 	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/conf"
+	"smart-collab-gallery-server/internal/middleware"
+	"smart-collab-gallery-server/internal/pkg"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
-	jwtv5 "github.com/golang-jwt/jwt/v5"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	// picturePaletteSize 调色板颜色数量
+	picturePaletteSize = 5
+	// maxImageOperations 编辑图片文件时最多的操作数
+	maxImageOperations = 20
+)
+
 type PictureService struct {
 	pb.UnimplementedPictureServer
 
-	uc  *biz.PictureUsecase
-	log *log.Helper
+	uc         *biz.PictureUsecase
+	cosManager *pkg.COSManager // 用于为私有存储桶中的图片生成签名地址，为 nil 时返回原始地址
+	jwtManager *pkg.JWTManager // 用于签发和校验图片缩放接口的短期令牌
+	imageProxy imageProxyConfig
+	renderer   *imageRenderer
+	log        *log.Helper
 }
 
 // NewPictureService 创建图片服务
-func NewPictureService(uc *biz.PictureUsecase, logger log.Logger) *PictureService {
+func NewPictureService(uc *biz.PictureUsecase, cosManager *pkg.COSManager, jwtManager *pkg.JWTManager, bc *conf.Bootstrap, logger log.Logger) *PictureService {
+	imageProxy := newImageProxyConfig(bc.GetImageProxy())
 	return &PictureService{
-		uc:  uc,
-		log: log.NewHelper(logger),
+		uc:         uc,
+		cosManager: cosManager,
+		jwtManager: jwtManager,
+		imageProxy: imageProxy,
+		renderer:   newImageRenderer(imageProxy),
+		log:        log.NewHelper(logger),
 	}
 }
 
@@ -35,19 +56,116 @@ func (s *PictureService) UploadPicture(ctx context.Context, req *pb.UploadPictur
 		return nil, pb.ErrorUnauthorized("请先登录")
 	}
 
+	// 读取图片文件提取特征，按用户隐私设置清除文件中的敏感元数据
+	features, err := s.preparePictureFile(ctx, loginUserID, req.Url)
+	if err != nil {
+		return nil, err
+	}
+
 	// 调用业务逻辑（直接传递 req）
-	result, err := s.uc.UploadPicture(ctx, req, loginUserID)
+	result, similar, err := s.uc.UploadPicture(ctx, req, loginUserID, features)
 	if err != nil {
 		s.log.Errorf("上传图片失败: %v", err)
 		return nil, err
 	}
+	// 重新上传图片文件后删除旧文件的缩放缓存
+	if req.Id > 0 && req.Url != "" {
+		s.purgeImageVariants(ctx, req.Id)
+	}
 
 	// 转换返回结果
 	return &pb.UploadPictureReply{
-		Picture: s.convertToProtoPictureVO(result),
+		Picture:         s.convertToProtoPictureVO(ctx, result),
+		SimilarPictures: s.convertToProtoSimilarPictures(ctx, similar),
 	}, nil
 }
 
+// preparePictureFile 读取图片文件提取感知哈希、主色调、尺寸和拍摄信息
+// 文件包含位置等敏感元数据且用户未选择保留时，先清除存储桶中文件的敏感元数据再保存图片，清除失败时拒绝上传
+// 地址为空、格式无法解码或读取失败时返回 nil，由业务层使用客户端传入的信息
+func (s *PictureService) preparePictureFile(ctx context.Context, userID int64, url string) (*biz.PictureFeatures, error) {
+	if url == "" || s.cosManager == nil {
+		return nil, nil
+	}
+
+	file, err := s.cosManager.ReadImage(ctx, url)
+	if err != nil {
+		s.log.Warnf("读取图片提取特征失败: url=%s, err=%v", url, err)
+		return nil, nil
+	}
+
+	exif := pkg.ParseExif(file.Data)
+	if exif != nil && exif.Sensitive {
+		strip, err := s.uc.ShouldStripMetadata(ctx, userID, url)
+		if err != nil {
+			return nil, err
+		}
+		if strip {
+			if stripped, changed := pkg.StripSensitiveMetadata(file.Data); changed {
+				etag, err := s.cosManager.WriteObject(ctx, url, stripped, "image/"+file.Format)
+				if err != nil {
+					s.log.Errorf("清除图片敏感元数据失败: url=%s, err=%v", url, err)
+					return nil, pb.ErrorSystemError("清除图片位置信息失败，请重试")
+				}
+				if err := s.uc.RecordRewrittenFile(ctx, userID, url, int64(len(stripped)), etag); err != nil {
+					return nil, err
+				}
+			}
+			exif.GPS = nil
+		}
+	}
+
+	return s.extractFeatures(file, exif), nil
+}
+
+// extractFeatures 从已解码的图片中提取特征
+func (s *PictureService) extractFeatures(file *pkg.ImageFile, exif *pkg.ExifData) *biz.PictureFeatures {
+	bounds := file.Image.Bounds()
+	features := &biz.PictureFeatures{
+		PHash:  pkg.DHash(file.Image),
+		Width:  int32(bounds.Dx()),
+		Height: int32(bounds.Dy()),
+		Format: file.Format,
+	}
+
+	// 方向为 5-8 的图片显示时需要旋转 90 度
+	if exif.SwapsDimensions() {
+		features.Width, features.Height = features.Height, features.Width
+	}
+
+	palette := pkg.ExtractPalette(file.Image, picturePaletteSize)
+	if len(palette) > 0 {
+		features.Color = pkg.HexColor(palette[0])
+		l, a, b := pkg.RGBToLab(palette[0])
+		features.ColorLab = biz.LabColor{L: l, A: a, B: b}
+		for _, c := range palette {
+			features.Palette = append(features.Palette, pkg.HexColor(c))
+		}
+	}
+
+	if exif != nil {
+		features.Metadata = &biz.PictureMetadata{
+			CameraMake:   exif.Make,
+			CameraModel:  exif.Model,
+			LensModel:    exif.LensModel,
+			ShootTime:    exif.ShootTime,
+			Orientation:  int32(exif.Orientation),
+			FNumber:      exif.FNumber,
+			ExposureTime: exif.ExposureTime,
+			ISO:          int32(exif.ISO),
+			FocalLength:  exif.FocalLength,
+		}
+		if exif.GPS != nil {
+			features.Metadata.GPS = &biz.GPSLocation{
+				Latitude:  exif.GPS.Latitude,
+				Longitude: exif.GPS.Longitude,
+				Altitude:  exif.GPS.Altitude,
+			}
+		}
+	}
+	return features
+}
+
 // GetPictureById 根据 ID 获取图片
 func (s *PictureService) GetPictureById(ctx context.Context, req *pb.GetPictureByIdRequest) (*pb.GetPictureByIdReply, error) {
 	if req.Id <= 0 {
@@ -60,12 +178,14 @@ func (s *PictureService) GetPictureById(ctx context.Context, req *pb.GetPictureB
 		return nil, err
 	}
 
-	if picture == nil {
+	loginUserID := s.getLoginUserID(ctx)
+	if picture == nil || !picture.VisibleTo(loginUserID, s.getUserRole(ctx) == "admin") {
 		return nil, pb.ErrorPictureNotFound("图片不存在")
 	}
+	s.uc.FillInteractions(ctx, loginUserID, []*biz.PictureVO{picture})
 
 	return &pb.GetPictureByIdReply{
-		Picture: s.convertToProtoPictureVO(picture),
+		Picture: s.convertToProtoPictureVO(ctx, picture),
 	}, nil
 }
 
@@ -87,8 +207,12 @@ func (s *PictureService) ListPictureByPage(ctx context.Context, req *pb.ListPict
 		Introduction: req.Introduction,
 		Category:     req.Category,
 		Tags:         req.Tags,
+		SpaceID:      req.SpaceId,
 		SortField:    req.SortField,
 		SortOrder:    req.SortOrder,
+		CursorMode:   req.CursorMode,
+		Cursor:       req.Cursor,
+		WithTotal:    req.WithTotal,
 	}
 
 	// 如果指定了用户ID，设置到查询参数
@@ -96,6 +220,8 @@ func (s *PictureService) ListPictureByPage(ctx context.Context, req *pb.ListPict
 		params.UserID = &req.UserId
 	}
 
+	s.applyReviewVisibility(ctx, params)
+
 	// 调用业务逻辑
 	page, err := s.uc.ListPictureByPage(ctx, params)
 	if err != nil {
@@ -103,15 +229,18 @@ func (s *PictureService) ListPictureByPage(ctx context.Context, req *pb.ListPict
 		return nil, err
 	}
 
+	s.uc.FillInteractions(ctx, s.getLoginUserID(ctx), page.List)
+
 	// 转换返回结果
 	list := make([]*pb.PictureVO, 0, len(page.List))
 	for _, pic := range page.List {
-		list = append(list, s.convertToProtoPictureVO(pic))
+		list = append(list, s.convertToProtoPictureVO(ctx, pic))
 	}
 
 	return &pb.ListPictureByPageReply{
-		Total: page.Total,
-		List:  list,
+		Total:      page.Total,
+		List:       list,
+		NextCursor: page.NextCursor,
 	}, nil
 }
 
@@ -137,6 +266,7 @@ func (s *PictureService) DeletePicture(ctx context.Context, req *pb.DeletePictur
 		s.log.Errorf("删除图片失败: %v", err)
 		return nil, err
 	}
+	s.purgeImageVariants(ctx, req.Id)
 
 	return &pb.DeletePictureReply{
 		Success: true,
@@ -167,72 +297,476 @@ func (s *PictureService) UpdatePicture(ctx context.Context, req *pb.UpdatePictur
 	}, nil
 }
 
-// getLoginUserID 从上下文获取登录用户 ID
-func (s *PictureService) getLoginUserID(ctx context.Context) int64 {
-	claims, ok := jwt.FromContext(ctx)
-	if !ok {
-		return 0
+// BatchEditPictures 批量修改分类和标签
+func (s *PictureService) BatchEditPictures(ctx context.Context, req *pb.BatchEditPicturesRequest) (*pb.BatchEditPicturesReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
 	}
 
-	mapClaims, ok := claims.(jwtv5.MapClaims)
-	if !ok {
-		return 0
+	results, err := s.uc.BatchEditPictures(ctx, &biz.BatchEditParams{
+		IDs:           req.Ids,
+		Category:      req.Category,
+		ClearCategory: req.ClearCategory,
+		TagMode:       biz.BatchTagMode(req.TagMode),
+		Tags:          req.Tags,
+	}, loginUserID, s.getUserRole(ctx) == "admin")
+	if err != nil {
+		s.log.Errorf("批量修改图片失败: %v", err)
+		return nil, err
 	}
 
-	userID, ok := mapClaims["user_id"].(float64)
-	if !ok {
-		return 0
+	reply := &pb.BatchEditPicturesReply{}
+	reply.SuccessCount, reply.FailureCount, reply.Results = convertToProtoBatchResults(results)
+	return reply, nil
+}
+
+// BatchRenamePictures 按模板批量重命名
+func (s *PictureService) BatchRenamePictures(ctx context.Context, req *pb.BatchRenamePicturesRequest) (*pb.BatchRenamePicturesReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
 	}
 
-	return int64(userID)
+	results, err := s.uc.BatchRenamePictures(ctx, req.Ids, req.Pattern, int64(req.StartIndex), loginUserID, s.getUserRole(ctx) == "admin")
+	if err != nil {
+		s.log.Errorf("批量重命名图片失败: %v", err)
+		return nil, err
+	}
+
+	reply := &pb.BatchRenamePicturesReply{}
+	reply.SuccessCount, reply.FailureCount, reply.Results = convertToProtoBatchResults(results)
+	return reply, nil
 }
 
-// getUserRole 从上下文获取用户角色
-func (s *PictureService) getUserRole(ctx context.Context) string {
-	claims, ok := jwt.FromContext(ctx)
-	if !ok {
-		return ""
+// BatchDeletePictures 批量删除
+func (s *PictureService) BatchDeletePictures(ctx context.Context, req *pb.BatchDeletePicturesRequest) (*pb.BatchDeletePicturesReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
 	}
 
-	mapClaims, ok := claims.(jwtv5.MapClaims)
-	if !ok {
-		return ""
+	results, err := s.uc.BatchDeletePictures(ctx, req.Ids, loginUserID, s.getUserRole(ctx) == "admin")
+	if err != nil {
+		s.log.Errorf("批量删除图片失败: %v", err)
+		return nil, err
+	}
+	deleted := make([]int64, 0, len(results))
+	for _, result := range results {
+		if result.Err == nil {
+			deleted = append(deleted, result.ID)
+		}
 	}
+	s.purgeImageVariants(ctx, deleted...)
 
-	userRole, ok := mapClaims["user_role"].(string)
-	if !ok {
-		return ""
+	reply := &pb.BatchDeletePicturesReply{}
+	reply.SuccessCount, reply.FailureCount, reply.Results = convertToProtoBatchResults(results)
+	return reply, nil
+}
+
+// BatchMovePictures 批量移动到相册
+func (s *PictureService) BatchMovePictures(ctx context.Context, req *pb.BatchMovePicturesRequest) (*pb.BatchMovePicturesReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	results, err := s.uc.BatchMovePictures(ctx, req.Ids, req.AlbumId, req.FromAlbumId, loginUserID, s.getUserRole(ctx) == "admin")
+	if err != nil {
+		s.log.Errorf("批量移动图片失败: %v", err)
+		return nil, err
+	}
+
+	reply := &pb.BatchMovePicturesReply{}
+	reply.SuccessCount, reply.FailureCount, reply.Results = convertToProtoBatchResults(results)
+	return reply, nil
+}
+
+// BatchMovePicturesToSpace 批量移动到空间
+func (s *PictureService) BatchMovePicturesToSpace(ctx context.Context, req *pb.BatchMovePicturesToSpaceRequest) (*pb.BatchMovePicturesToSpaceReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	results, err := s.uc.BatchMovePicturesToSpace(ctx, req.Ids, req.SpaceId, loginUserID, s.getUserRole(ctx) == "admin")
+	if err != nil {
+		s.log.Errorf("批量移动图片到空间失败: %v", err)
+		return nil, err
+	}
+
+	reply := &pb.BatchMovePicturesToSpaceReply{}
+	reply.SuccessCount, reply.FailureCount, reply.Results = convertToProtoBatchResults(results)
+	return reply, nil
+}
+
+// BatchReviewPictures 批量审核（管理员）
+func (s *PictureService) BatchReviewPictures(ctx context.Context, req *pb.BatchReviewPicturesRequest) (*pb.BatchReviewPicturesReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+	if s.getUserRole(ctx) != "admin" {
+		return nil, pb.ErrorPictureNoAuth("仅管理员可以审核图片")
+	}
+
+	results, err := s.uc.BatchReviewPictures(ctx, req.Ids, biz.ReviewStatus(req.ReviewStatus), req.ReviewMessage, loginUserID)
+	if err != nil {
+		s.log.Errorf("批量审核图片失败: %v", err)
+		return nil, err
+	}
+
+	reply := &pb.BatchReviewPicturesReply{}
+	reply.SuccessCount, reply.FailureCount, reply.Results = convertToProtoBatchResults(results)
+	return reply, nil
+}
+
+// ListPictureVersions 分页查询图片的历史版本（图片作者或管理员）
+func (s *PictureService) ListPictureVersions(ctx context.Context, req *pb.ListPictureVersionsRequest) (*pb.ListPictureVersionsReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	page, err := s.uc.ListPictureVersions(ctx, req.PictureId, req.Current, req.PageSize, loginUserID, s.getUserRole(ctx) == "admin")
+	if err != nil {
+		s.log.Errorf("查询图片历史版本失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*pb.PictureVersionVO, 0, len(page.List))
+	for _, version := range page.List {
+		list = append(list, s.convertToProtoPictureVersionVO(ctx, version))
 	}
+	return &pb.ListPictureVersionsReply{
+		Total: page.Total,
+		List:  list,
+	}, nil
+}
+
+// RestorePictureVersion 回滚到历史版本（图片作者或管理员）
+func (s *PictureService) RestorePictureVersion(ctx context.Context, req *pb.RestorePictureVersionRequest) (*pb.RestorePictureVersionReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	picture, err := s.uc.RestorePictureVersion(ctx, req.PictureId, req.VersionId, loginUserID, s.getUserRole(ctx) == "admin")
+	if err != nil {
+		s.log.Errorf("回滚图片历史版本失败: %v", err)
+		return nil, err
+	}
+	// 回滚可能更换图片文件，删除旧文件的缩放缓存
+	s.purgeImageVariants(ctx, req.PictureId)
+	return &pb.RestorePictureVersionReply{
+		Picture: s.convertToProtoPictureVO(ctx, picture),
+	}, nil
+}
+
+// EditPictureImage 编辑图片文件（图片作者或管理员）
+// 原图先按 EXIF 方向校正，再按顺序执行编辑操作，结果保存为原图所在存储桶中的新文件
+func (s *PictureService) EditPictureImage(ctx context.Context, req *pb.EditPictureImageRequest) (*pb.EditPictureImageReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+	if s.cosManager == nil {
+		return nil, pb.ErrorSystemError("存储服务未配置，无法编辑图片")
+	}
+	if len(req.Operations) == 0 {
+		return nil, pb.ErrorParamsError("编辑操作不能为空")
+	}
+	if len(req.Operations) > maxImageOperations {
+		return nil, pb.ErrorParamsError(fmt.Sprintf("编辑操作最多 %d 个", maxImageOperations))
+	}
+	isAdmin := s.getUserRole(ctx) == "admin"
+
+	picture, err := s.uc.GetEditablePicture(ctx, req.Id, loginUserID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	ops, err := s.convertImageOperations(ctx, req.Operations, loginUserID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	img, sourceFormat, err := s.readOrientedImage(ctx, picture.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := pkg.ApplyImageOperations(img, ops)
+	if err != nil {
+		return nil, pb.ErrorParamsError(fmt.Sprintf("图片编辑参数错误: %v", err))
+	}
+	format, ext, err := s.resolveEditedImageFormat(picture.URL, sourceFormat, req.Format)
+	if err != nil {
+		return nil, err
+	}
+	data, err := pkg.EncodeImage(edited, format)
+	if err != nil {
+		s.log.Errorf("编码编辑后的图片失败: pictureID=%d, err=%v", picture.ID, err)
+		return nil, pb.ErrorSystemError("保存编辑后的图片失败")
+	}
+
+	// 先检查存储空间再保存文件，保存后的文件与原图在同一存储桶和目录
+	if err := s.uc.CheckPictureImageSize(ctx, picture, int64(len(data))); err != nil {
+		return nil, err
+	}
+	url, err := s.cosManager.SaveDerivedObject(ctx, picture.URL, fmt.Sprintf("edit_%d", time.Now().UnixMilli()), ext, data)
+	if err != nil {
+		s.log.Errorf("保存编辑后的图片失败: pictureID=%d, err=%v", picture.ID, err)
+		if errors.Is(err, pkg.ErrFileTooLarge) {
+			return nil, pb.ErrorPictureFileTooLarge("编辑后的图片超过存储桶的文件大小限制")
+		}
+		if errors.Is(err, pkg.ErrFileTypeNotSupported) {
+			return nil, pb.ErrorPictureFormatError("图片所在存储桶不允许保存为该格式")
+		}
+		return nil, pb.ErrorSystemError("保存编辑后的图片失败")
+	}
+
+	result, err := s.uc.SaveEditedPictureImage(ctx, picture, &biz.EditedPictureImage{
+		URL:      url,
+		Size:     int64(len(data)),
+		Features: s.extractFeatures(&pkg.ImageFile{Data: data, Image: edited, Format: format}, nil),
+	}, loginUserID)
+	if err != nil {
+		return nil, err
+	}
+	s.purgeImageVariants(ctx, picture.ID)
+	return &pb.EditPictureImageReply{
+		Picture: s.convertToProtoPictureVO(ctx, result),
+	}, nil
+}
+
+// convertImageOperations 转换编辑操作，图片水印需为当前用户可以编辑的图片
+func (s *PictureService) convertImageOperations(ctx context.Context, operations []*pb.ImageOperation, userID int64, isAdmin bool) ([]*pkg.ImageOperation, error) {
+	ops := make([]*pkg.ImageOperation, 0, len(operations))
+	for _, operation := range operations {
+		if operation.Type == pkg.ImageOpCrop && (operation.Width <= 0 || operation.Height <= 0) {
+			return nil, pb.ErrorParamsError("裁剪宽高必须大于 0")
+		}
+		op := &pkg.ImageOperation{
+			Type:      operation.Type,
+			Rect:      image.Rect(int(operation.X), int(operation.Y), int(operation.X+operation.Width), int(operation.Y+operation.Height)),
+			Angle:     int(operation.Angle),
+			Direction: operation.Direction,
+			Width:     int(operation.Width),
+			Height:    int(operation.Height),
+			Text:      operation.Text,
+			Position:  operation.Position,
+			Opacity:   operation.Opacity,
+			Scale:     operation.Scale,
+			Color:     operation.Color,
+		}
+		if operation.Type == pkg.ImageOpImageWatermark {
+			if operation.WatermarkPictureId <= 0 {
+				return nil, pb.ErrorParamsError("水印图片不能为空")
+			}
+			watermark, err := s.uc.GetEditablePicture(ctx, operation.WatermarkPictureId, userID, isAdmin)
+			if err != nil {
+				return nil, err
+			}
+			if op.Watermark, _, err = s.readOrientedImage(ctx, watermark.URL); err != nil {
+				return nil, err
+			}
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// readOrientedImage 读取并解码存储桶中的图片，按 EXIF 方向校正像素方向
+func (s *PictureService) readOrientedImage(ctx context.Context, url string) (image.Image, string, error) {
+	file, err := s.cosManager.ReadImage(ctx, url)
+	if err != nil {
+		s.log.Warnf("读取图片失败: url=%s, err=%v", url, err)
+		switch {
+		case errors.Is(err, pkg.ErrImageTooLarge):
+			return nil, "", pb.ErrorPictureFileTooLarge("图片过大，无法编辑")
+		case errors.Is(err, image.ErrFormat):
+			return nil, "", pb.ErrorPictureFormatError("仅支持编辑 JPEG、PNG、GIF 格式的图片")
+		case errors.Is(err, pkg.ErrObjectNotFound):
+			return nil, "", pb.ErrorPictureNotFound("图片文件不存在")
+		default:
+			return nil, "", pb.ErrorSystemError("读取图片失败")
+		}
+	}
+
+	img := file.Image
+	if exif := pkg.ParseExif(file.Data); exif != nil {
+		img = pkg.OrientImage(img, exif.Orientation)
+	}
+	return img, file.Format, nil
+}
+
+// resolveEditedImageFormat 确定编辑结果的格式和扩展名
+// 指定格式时只使用该格式，否则与原图相同（GIF 原图只保留第一帧，优先输出为 PNG），格式需为原图所在存储桶允许的格式
+func (s *PictureService) resolveEditedImageFormat(url, sourceFormat, format string) (string, string, error) {
+	var candidates []string
+	switch format {
+	case "":
+		candidates = []string{sourceFormat}
+		if sourceFormat == "gif" {
+			candidates = []string{"png", "jpeg", "gif"}
+		}
+	case "jpeg", "jpg":
+		candidates = []string{"jpeg"}
+	case "png":
+		candidates = []string{"png"}
+	default:
+		return "", "", pb.ErrorParamsError("输出格式只支持 jpeg、png")
+	}
+
+	for _, candidate := range candidates {
+		ext, err := s.cosManager.ImageExtensionFor(url, candidate)
+		if err == nil {
+			return candidate, ext, nil
+		}
+		if !errors.Is(err, pkg.ErrFileTypeNotSupported) {
+			s.log.Errorf("查询存储桶允许的格式失败: url=%s, err=%v", url, err)
+			return "", "", pb.ErrorParamsError("图片不在已配置的存储桶中，无法编辑")
+		}
+	}
+	return "", "", pb.ErrorPictureFormatError("图片所在存储桶不允许保存为该格式")
+}
+
+// getLoginUserID 从上下文获取登录用户 ID（由 JWT 中间件设置）
+func (s *PictureService) getLoginUserID(ctx context.Context) int64 {
+	return middleware.GetUserIDFromContext(ctx)
+}
+
+// getUserRole 从上下文获取用户角色（由 JWT 中间件设置）
+func (s *PictureService) getUserRole(ctx context.Context) string {
+	return middleware.GetUserRoleFromContext(ctx)
+}
 
-	return userRole
+// applyReviewVisibility 非管理员只能查询审核通过的图片和自己的图片
+func (s *PictureService) applyReviewVisibility(ctx context.Context, params *biz.PictureQueryParams) {
+	if s.getUserRole(ctx) == "admin" {
+		return
+	}
+	params.PassedOnly = true
+	params.ViewerID = s.getLoginUserID(ctx)
 }
 
 // convertToProtoPictureVO 转换业务对象为 proto 对象
-func (s *PictureService) convertToProtoPictureVO(vo *biz.PictureVO) *pb.PictureVO {
+// 调用方需已完成访问权限校验，私有存储桶中的图片地址会被替换为短期有效的签名地址
+func (s *PictureService) convertToProtoPictureVO(ctx context.Context, vo *biz.PictureVO) *pb.PictureVO {
 	if vo == nil {
 		return nil
 	}
 
 	return &pb.PictureVO{
+		Id:            vo.ID,
+		Url:           s.signURL(ctx, vo.URL),
+		Name:          vo.Name,
+		Introduction:  vo.Introduction,
+		Category:      vo.Category,
+		Tags:          vo.Tags,
+		PicSize:       vo.PicSize,
+		PicWidth:      vo.PicWidth,
+		PicHeight:     vo.PicHeight,
+		PicScale:      vo.PicScale,
+		PicFormat:     vo.PicFormat,
+		PicColor:      vo.PicColor,
+		PicPalette:    vo.PicPalette,
+		Metadata:      convertToProtoPictureMetadata(vo.Metadata),
+		SearchScore:   vo.SearchScore,
+		Highlights:    vo.Highlights,
+		UserId:        vo.UserID,
+		SpaceId:       vo.SpaceID,
+		ReviewStatus:  int32(vo.ReviewStatus),
+		ReviewMessage: vo.ReviewMessage,
+		CreateTime:    timestamppb.New(vo.CreateTime),
+		EditTime:      timestamppb.New(vo.EditTime),
+		UpdateTime:    timestamppb.New(vo.UpdateTime),
+		User:          s.convertToProtoUserVO(vo.User),
+		LikeCount:     vo.LikeCount,
+		FavoriteCount: vo.FavoriteCount,
+		ViewCount:     vo.ViewCount,
+		CommentCount:  vo.CommentCount,
+		Liked:         vo.Liked,
+		Favorited:     vo.Favorited,
+	}
+}
+
+// convertToProtoPictureVersionVO 转换历史版本，调用方需已完成访问权限校验
+func (s *PictureService) convertToProtoPictureVersionVO(ctx context.Context, vo *biz.PictureVersionVO) *pb.PictureVersionVO {
+	snapshot := vo.Snapshot
+	changes := make([]*pb.PictureFieldChange, 0, len(vo.Changes))
+	for _, change := range vo.Changes {
+		changes = append(changes, &pb.PictureFieldChange{
+			Field:    change.Field,
+			OldValue: change.Old,
+			NewValue: change.New,
+		})
+	}
+	return &pb.PictureVersionVO{
 		Id:           vo.ID,
-		Url:          vo.URL,
-		Name:         vo.Name,
-		Introduction: vo.Introduction,
-		Category:     vo.Category,
+		PictureId:    vo.PictureID,
+		Version:      vo.Version,
+		Operation:    string(vo.Operation),
+		OperatorId:   vo.OperatorID,
+		Operator:     s.convertToProtoUserVO(vo.Operator),
+		Url:          s.signURL(ctx, snapshot.URL),
+		Name:         snapshot.Name,
+		Introduction: snapshot.Introduction,
+		Category:     snapshot.Category,
 		Tags:         vo.Tags,
-		PicSize:      vo.PicSize,
-		PicWidth:     vo.PicWidth,
-		PicHeight:    vo.PicHeight,
-		PicScale:     vo.PicScale,
-		PicFormat:    vo.PicFormat,
-		UserId:       vo.UserID,
+		PicSize:      snapshot.PicSize,
+		PicWidth:     snapshot.PicWidth,
+		PicHeight:    snapshot.PicHeight,
+		PicFormat:    snapshot.PicFormat,
+		Changes:      changes,
 		CreateTime:   timestamppb.New(vo.CreateTime),
-		EditTime:     timestamppb.New(vo.EditTime),
-		UpdateTime:   timestamppb.New(vo.UpdateTime),
-		User:         s.convertToProtoUserVO(vo.User),
 	}
 }
 
+// convertToProtoPictureMetadata 转换拍摄信息
+func convertToProtoPictureMetadata(metadata *biz.PictureMetadata) *pb.PictureMetadata {
+	if metadata == nil {
+		return nil
+	}
+
+	result := &pb.PictureMetadata{
+		CameraMake:   metadata.CameraMake,
+		CameraModel:  metadata.CameraModel,
+		LensModel:    metadata.LensModel,
+		Orientation:  metadata.Orientation,
+		FNumber:      metadata.FNumber,
+		ExposureTime: metadata.ExposureTime,
+		Iso:          metadata.ISO,
+		FocalLength:  metadata.FocalLength,
+	}
+	if metadata.ShootTime != nil {
+		result.ShootTime = timestamppb.New(*metadata.ShootTime)
+	}
+	if metadata.GPS != nil {
+		result.Gps = &pb.GPSLocation{
+			Latitude:  metadata.GPS.Latitude,
+			Longitude: metadata.GPS.Longitude,
+		}
+		if metadata.GPS.Altitude != nil {
+			result.Gps.Altitude = *metadata.GPS.Altitude
+		}
+	}
+	return result
+}
+
+// signURL 私有存储桶中的图片地址替换为短期有效的签名地址，签名失败时返回空字符串，避免返回不可访问的地址
+func (s *PictureService) signURL(ctx context.Context, rawURL string) string {
+	if rawURL == "" || s.cosManager == nil {
+		return rawURL
+	}
+
+	signed, err := s.cosManager.SignAccessURL(ctx, rawURL)
+	if err != nil {
+		s.log.Errorf("生成图片签名地址失败: %v", err)
+		return ""
+	}
+	return signed
+}
+
 // convertToProtoUserVO 转换用户对象为 proto 对象
 func (s *PictureService) convertToProtoUserVO(userVO *biz.UserVO) *pb.UserVO {
 	if userVO == nil {
@@ -251,24 +785,20 @@ func (s *PictureService) convertToProtoUserVO(userVO *biz.UserVO) *pb.UserVO {
 
 // EditPicture 编辑图片（用户版本）
 func (s *PictureService) EditPicture(ctx context.Context, req *pb.EditPictureRequest) (*pb.EditPictureReply, error) {
-	// 从上下文获取用户 ID
-	userID, err := s.getUserID(ctx)
-	if err != nil {
-		return nil, v1.ErrorPictureNoAuth("无法获取用户信息: %v", err)
+	if req.Id <= 0 {
+		return nil, pb.ErrorInvalidArgument("图片 ID 不能为空")
 	}
 
-	// 调用 biz 层编辑图片
-	pictureVO := &biz.PictureVO{
-		ID:           req.Id,
-		URL:          req.Url,
-		Name:         req.Name,
-		Introduction: req.Introduction,
-		Category:     req.Category,
-		Tags:         req.Tags,
+	// 从上下文获取用户信息
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
 	}
 
-	err = s.pictureUC.EditPicture(ctx, userID, pictureVO)
+	// 调用 biz 层编辑图片
+	err := s.uc.EditPicture(ctx, req.Id, req.Name, req.Introduction, req.Category, req.Tags, loginUserID)
 	if err != nil {
+		s.log.Errorf("编辑图片失败: %v", err)
 		return nil, err
 	}
 
@@ -279,20 +809,34 @@ func (s *PictureService) EditPicture(ctx context.Context, req *pb.EditPictureReq
 
 // GetPictureVOById 根据 ID 获取图片（脱敏版本）
 func (s *PictureService) GetPictureVOById(ctx context.Context, req *pb.GetPictureVOByIdRequest) (*pb.GetPictureVOByIdReply, error) {
-	// 调用原有的 GetPictureById 方法
-	pictureVO, err := s.pictureUC.GetPictureById(ctx, req.Id)
+	if req.Id <= 0 {
+		return nil, pb.ErrorInvalidArgument("图片 ID 不能为空")
+	}
+
+	pictureVO, err := s.uc.GetPictureByID(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
+	loginUserID := s.getLoginUserID(ctx)
+	if pictureVO == nil || !pictureVO.VisibleTo(loginUserID, s.getUserRole(ctx) == "admin") {
+		return nil, pb.ErrorPictureNotFound("图片不存在")
+	}
+
+	s.uc.RecordView(ctx, req.Id)
+	s.uc.FillInteractions(ctx, loginUserID, []*biz.PictureVO{pictureVO})
 
 	// 脱敏处理：移除敏感字段（这里用户信息保持简单，可以根据需要进一步脱敏）
 	return &pb.GetPictureVOByIdReply{
-		Picture: s.convertToProtoPictureVO(pictureVO),
+		Picture: s.convertToProtoPictureVO(ctx, pictureVO),
 	}, nil
 }
 
 // ListPictureVOByPage 分页获取图片列表（脱敏版本，最多 20 条）
 func (s *PictureService) ListPictureVOByPage(ctx context.Context, req *pb.ListPictureVOByPageRequest) (*pb.ListPictureVOByPageReply, error) {
+	if req.Current <= 0 {
+		req.Current = 1
+	}
+
 	// 限制每页最多 20 条
 	pageSize := req.PageSize
 	if pageSize <= 0 || pageSize > 20 {
@@ -305,54 +849,314 @@ func (s *PictureService) ListPictureVOByPage(ctx context.Context, req *pb.ListPi
 		PageSize:     pageSize,
 		SortField:    req.SortField,
 		SortOrder:    req.SortOrder,
-		ID:           req.Id,
 		Name:         req.Name,
 		Introduction: req.Introduction,
 		Category:     req.Category,
 		Tags:         req.Tags,
+		SpaceID:      req.SpaceId,
 		SearchText:   req.SearchText,
-		UserID:       req.UserId,
+		CursorMode:   req.CursorMode,
+		Cursor:       req.Cursor,
+		WithTotal:    req.WithTotal,
+	}
+
+	// 如果指定了用户ID，设置到查询参数
+	if req.UserId > 0 {
+		params.UserID = &req.UserId
+	}
+
+	// 按审核状态过滤，非管理员只能查询审核通过的图片和自己的图片
+	if req.FilterReview {
+		status := biz.ReviewStatus(req.ReviewStatus)
+		params.ReviewStatus = &status
+	}
+	s.applyReviewVisibility(ctx, params)
+
+	// 按颜色搜索
+	if req.PicColor != "" {
+		c, err := pkg.ParseHexColor(req.PicColor)
+		if err != nil {
+			return nil, pb.ErrorParamsError("颜色格式错误，应为 #RRGGBB")
+		}
+		l, a, b := pkg.RGBToLab(c)
+		params.Color = &biz.LabColor{L: l, A: a, B: b}
+		params.MaxColorDistance = req.MaxColorDistance
+	}
+
+	// 按分面取值过滤
+	params.PicFormat = req.PicFormat
+	if req.SizeRange != "" {
+		if params.SizeRange = biz.FindPictureRange(biz.PictureSizeRanges, req.SizeRange); params.SizeRange == nil {
+			return nil, pb.ErrorParamsError("体积区间无效")
+		}
+	}
+	if req.ScaleRange != "" {
+		if params.ScaleRange = biz.FindPictureRange(biz.PictureScaleRanges, req.ScaleRange); params.ScaleRange == nil {
+			return nil, pb.ErrorParamsError("宽高比区间无效")
+		}
 	}
 
 	// 调用原有的 ListPictureByPage 方法
-	pictures, total, err := s.pictureUC.ListPictureByPage(ctx, params)
+	page, err := s.uc.ListPictureByPage(ctx, params)
 	if err != nil {
+		s.log.Errorf("查询图片列表失败: %v", err)
 		return nil, err
 	}
 
+	var facets *pb.PictureFacets
+	if req.WithFacets {
+		result, err := s.uc.ListPictureFacets(ctx, params)
+		if err != nil {
+			return nil, pb.ErrorSystemError("统计分面失败")
+		}
+		facets = convertToProtoPictureFacets(result)
+	}
+
+	s.uc.FillInteractions(ctx, s.getLoginUserID(ctx), page.List)
+
 	// 转换为 proto 对象列表
-	pictureList := make([]*pb.PictureVO, 0, len(pictures))
-	for _, picture := range pictures {
-		pictureList = append(pictureList, s.convertToProtoPictureVO(picture))
+	list := make([]*pb.PictureVO, 0, len(page.List))
+	for _, picture := range page.List {
+		list = append(list, s.convertToProtoPictureVO(ctx, picture))
 	}
 
 	return &pb.ListPictureVOByPageReply{
-		Records: pictureList,
-		Total:   total,
+		Total:      page.Total,
+		List:       list,
+		Facets:     facets,
+		NextCursor: page.NextCursor,
 	}, nil
 }
 
-// GetPictureTagCategory 获取图片标签和分类（预设值）
+// convertToProtoPictureFacets 转换分面统计
+func convertToProtoPictureFacets(facets *biz.PictureFacets) *pb.PictureFacets {
+	convert := func(counts []*biz.FacetCount) []*pb.FacetCount {
+		result := make([]*pb.FacetCount, 0, len(counts))
+		for _, c := range counts {
+			result = append(result, &pb.FacetCount{Value: c.Value, Count: c.Count})
+		}
+		return result
+	}
+	return &pb.PictureFacets{
+		Categories:  convert(facets.Categories),
+		Tags:        convert(facets.Tags),
+		Formats:     convert(facets.Formats),
+		SizeRanges:  convert(facets.SizeRanges),
+		ScaleRanges: convert(facets.ScaleRanges),
+	}
+}
+
+// GetPictureTagCategory 获取使用次数最多的标签和全部分类
 func (s *PictureService) GetPictureTagCategory(ctx context.Context, req *pb.GetPictureTagCategoryRequest) (*pb.GetPictureTagCategoryReply, error) {
-	// 返回预设的标签和分类列表
-	return &pb.GetPictureTagCategoryReply{
-		TagList: []string{
-			"热门",
-			"搞笑",
-			"生活",
-			"高清",
-			"艺术",
-			"校园",
-			"背景",
-			"简历",
-			"创意",
-		},
-		CategoryList: []string{
-			"模板",
-			"电商",
-			"表情包",
-			"素材",
-			"海报",
-		},
+	tags, categories, err := s.uc.GetPictureTagCategory(ctx, int(req.TagLimit))
+	if err != nil {
+		s.log.Errorf("获取标签和分类失败: %v", err)
+		return nil, err
+	}
+
+	reply := &pb.GetPictureTagCategoryReply{
+		TagList:        make([]string, 0, len(tags)),
+		CategoryList:   make([]string, 0, len(categories)),
+		TagCounts:      make([]*pb.FacetCount, 0, len(tags)),
+		CategoryCounts: make([]*pb.FacetCount, 0, len(categories)),
+	}
+	for _, tag := range tags {
+		reply.TagList = append(reply.TagList, tag.Name)
+		reply.TagCounts = append(reply.TagCounts, &pb.FacetCount{Value: tag.Name, Count: tag.PictureCount})
+	}
+	for _, category := range categories {
+		reply.CategoryList = append(reply.CategoryList, category.Name)
+		reply.CategoryCounts = append(reply.CategoryCounts, &pb.FacetCount{Value: category.Name, Count: category.PictureCount})
+	}
+	return reply, nil
+}
+
+// SuggestTags 标签输入建议，结合全站使用次数、当前用户的使用次数和已选标签排序
+func (s *PictureService) SuggestTags(ctx context.Context, req *pb.SuggestTagsRequest) (*pb.SuggestTagsReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	suggestions, err := s.uc.SuggestTags(ctx, &biz.TagSuggestParams{
+		Prefix:   req.Prefix,
+		UserID:   loginUserID,
+		Selected: req.SelectedTags,
+		Limit:    int(req.Limit),
+	})
+	if err != nil {
+		s.log.Errorf("获取标签建议失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*pb.TagSuggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		list = append(list, &pb.TagSuggestion{
+			Name:         suggestion.Name,
+			PictureCount: suggestion.PictureCount,
+			UserCount:    suggestion.UserCount,
+			Score:        suggestion.Score,
+		})
+	}
+	return &pb.SuggestTagsReply{List: list}, nil
+}
+
+// GetFeed 获取关注动态
+func (s *PictureService) GetFeed(ctx context.Context, req *pb.GetFeedRequest) (*pb.GetFeedReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	feed, err := s.uc.GetFeed(ctx, loginUserID, req.Cursor, req.PageSize)
+	if err != nil {
+		s.log.Errorf("获取关注动态失败: %v", err)
+		return nil, err
+	}
+
+	s.uc.FillInteractions(ctx, loginUserID, feed.List)
+
+	list := make([]*pb.PictureVO, 0, len(feed.List))
+	for _, picture := range feed.List {
+		list = append(list, s.convertToProtoPictureVO(ctx, picture))
+	}
+
+	return &pb.GetFeedReply{
+		List:       list,
+		NextCursor: feed.NextCursor,
+		HasMore:    feed.HasMore,
 	}, nil
 }
+
+// LikePicture 点赞或取消点赞
+func (s *PictureService) LikePicture(ctx context.Context, req *pb.LikePictureRequest) (*pb.LikePictureReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	vo, err := s.uc.LikePicture(ctx, req.Id, loginUserID, req.Like)
+	if err != nil {
+		s.log.Errorf("点赞图片失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.LikePictureReply{
+		Liked:     vo.Liked,
+		LikeCount: vo.LikeCount,
+	}, nil
+}
+
+// FavoritePicture 收藏或取消收藏
+func (s *PictureService) FavoritePicture(ctx context.Context, req *pb.FavoritePictureRequest) (*pb.FavoritePictureReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	vo, err := s.uc.FavoritePicture(ctx, req.Id, loginUserID, req.Favorite)
+	if err != nil {
+		s.log.Errorf("收藏图片失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.FavoritePictureReply{
+		Favorited:     vo.Favorited,
+		FavoriteCount: vo.FavoriteCount,
+	}, nil
+}
+
+// ListMyFavoritePictures 分页查询我的收藏
+func (s *PictureService) ListMyFavoritePictures(ctx context.Context, req *pb.ListMyFavoritePicturesRequest) (*pb.ListMyFavoritePicturesReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	page, err := s.uc.ListMyFavoritePictures(ctx, loginUserID, req.Current, req.PageSize)
+	if err != nil {
+		s.log.Errorf("查询我的收藏失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*pb.PictureVO, 0, len(page.List))
+	for _, picture := range page.List {
+		list = append(list, s.convertToProtoPictureVO(ctx, picture))
+	}
+
+	return &pb.ListMyFavoritePicturesReply{
+		Total: page.Total,
+		List:  list,
+	}, nil
+}
+
+// FindSimilarPictures 查找相似图片
+func (s *PictureService) FindSimilarPictures(ctx context.Context, req *pb.FindSimilarPicturesRequest) (*pb.FindSimilarPicturesReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	similar, err := s.uc.FindSimilarPictures(ctx, req.Id, int(req.MaxDistance), int(req.Limit), loginUserID)
+	if err != nil {
+		s.log.Errorf("查找相似图片失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.FindSimilarPicturesReply{
+		List: s.convertToProtoSimilarPictures(ctx, similar),
+	}, nil
+}
+
+// ListDuplicateClusters 重复图片分组报告（管理员）
+func (s *PictureService) ListDuplicateClusters(ctx context.Context, req *pb.ListDuplicateClustersRequest) (*pb.ListDuplicateClustersReply, error) {
+	report, err := s.uc.ListDuplicateClusters(ctx, int(req.MaxDistance), int(req.ScanLimit))
+	if err != nil {
+		s.log.Errorf("查询重复图片失败: %v", err)
+		return nil, err
+	}
+
+	clusters := make([]*pb.DuplicateCluster, 0, len(report.Clusters))
+	for _, cluster := range report.Clusters {
+		pictures := make([]*pb.PictureVO, 0, len(cluster))
+		for _, picture := range cluster {
+			pictures = append(pictures, s.convertToProtoPictureVO(ctx, picture))
+		}
+		clusters = append(clusters, &pb.DuplicateCluster{Pictures: pictures})
+	}
+
+	return &pb.ListDuplicateClustersReply{
+		Clusters: clusters,
+		Scanned:  report.Scanned,
+	}, nil
+}
+
+// convertToProtoSimilarPictures 转换相似图片列表
+func (s *PictureService) convertToProtoSimilarPictures(ctx context.Context, similar []*biz.SimilarPicture) []*pb.SimilarPictureVO {
+	list := make([]*pb.SimilarPictureVO, 0, len(similar))
+	for _, item := range similar {
+		list = append(list, &pb.SimilarPictureVO{
+			Picture:  s.convertToProtoPictureVO(ctx, item.Picture),
+			Distance: int32(item.Distance),
+		})
+	}
+	return list
+}
+
+// convertToProtoBatchResults 转换批量操作结果，返回成功数、失败数和逐张结果
+func convertToProtoBatchResults(results []*biz.BatchPictureResult) (int32, int32, []*pb.BatchPictureResult) {
+	var success, failure int32
+	list := make([]*pb.BatchPictureResult, 0, len(results))
+	for _, result := range results {
+		item := &pb.BatchPictureResult{Id: result.ID, Success: result.Err == nil}
+		if result.Err != nil {
+			se := errors.FromError(result.Err)
+			item.Reason, item.Message = se.Reason, se.Message
+			failure++
+		} else {
+			success++
+		}
+		list = append(list, item)
+	}
+	return success, failure, list
+}
@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	nethttp "net/http"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "smart-collab-gallery-server/api/picture/v1"
+	"smart-collab-gallery-server/internal/conf"
+	"smart-collab-gallery-server/internal/middleware"
+	"smart-collab-gallery-server/internal/pkg"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	lru "github.com/hashicorp/golang-lru"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// OperationPictureImage 图片缩放接口的操作名，用于匹配认证等中间件
+	OperationPictureImage = "/api.picture.v1.Picture/GetPictureImage"
+	// maxVariantBytes 读取已缓存的缩放结果的最大字节数
+	maxVariantBytes = 20 << 20
+	// renderTimeout 生成一个缩放结果（含排队、读取原图和写入缓存）的最长时间，不随单个请求取消
+	renderTimeout = 30 * time.Second
+
+	// urlTokenPurposePictureImage 图片缩放接口 URL 令牌的用途
+	urlTokenPurposePictureImage = "picture_image"
+
+	defaultImageMaxAge      = 7 * 24 * time.Hour
+	defaultImageVariantDir  = "variants"
+	defaultImageTokenExpire = time.Hour
+	defaultLocalCacheSize   = 128
+)
+
+// imageSize 缩放尺寸，宽或高为 0 表示按另一边等比缩放
+type imageSize struct {
+	width  int
+	height int
+}
+
+// defaultImageSizes 未配置时允许的尺寸：头像、列表网格、详情
+var defaultImageSizes = []imageSize{{64, 64}, {128, 128}, {300, 300}, {600, 0}, {1200, 0}}
+
+// imageProxyConfig 图片缩放接口配置
+type imageProxyConfig struct {
+	sizes          map[imageSize]bool
+	maxAge         time.Duration
+	variantDir     string
+	tokenExpire    time.Duration
+	maxRenders     int
+	localCacheSize int
+}
+
+// newImageProxyConfig 读取图片缩放接口配置，未配置的项使用默认值
+func newImageProxyConfig(c *conf.ImageProxy) imageProxyConfig {
+	config := imageProxyConfig{
+		sizes:          make(map[imageSize]bool),
+		maxAge:         defaultImageMaxAge,
+		variantDir:     strings.Trim(c.GetVariantDir(), "/"),
+		tokenExpire:    defaultImageTokenExpire,
+		maxRenders:     runtime.NumCPU(),
+		localCacheSize: defaultLocalCacheSize,
+	}
+	for _, size := range c.GetSizes() {
+		if size.Width >= 0 && size.Height >= 0 && size.Width+size.Height > 0 {
+			config.sizes[imageSize{width: int(size.Width), height: int(size.Height)}] = true
+		}
+	}
+	if len(config.sizes) == 0 {
+		for _, size := range defaultImageSizes {
+			config.sizes[size] = true
+		}
+	}
+	if c.GetMaxAge() != nil && c.GetMaxAge().AsDuration() > 0 {
+		config.maxAge = c.GetMaxAge().AsDuration()
+	}
+	if config.variantDir == "" {
+		config.variantDir = defaultImageVariantDir
+	}
+	if c.GetTokenExpire() != nil && c.GetTokenExpire().AsDuration() > 0 {
+		config.tokenExpire = c.GetTokenExpire().AsDuration()
+	}
+	if c.GetMaxRenders() > 0 {
+		config.maxRenders = int(c.GetMaxRenders())
+	}
+	if c.GetLocalCacheSize() > 0 {
+		config.localCacheSize = int(c.GetLocalCacheSize())
+	}
+	return config
+}
+
+// imageRenderer 控制缩放结果的生成：限制同时解码的数量，合并相同参数的并发生成，
+// 并在本机内存中缓存无法写入存储桶的结果，避免每次请求都重新解码原图
+type imageRenderer struct {
+	slots chan struct{}
+	group singleflight.Group
+	local *lru.Cache
+}
+
+// newImageRenderer 创建缩放结果生成器
+func newImageRenderer(config imageProxyConfig) *imageRenderer {
+	// 只在容量不大于 0 时返回错误，配置已保证容量为正数
+	local, _ := lru.New(config.localCacheSize)
+	return &imageRenderer{
+		slots: make(chan struct{}, config.maxRenders),
+		local: local,
+	}
+}
+
+// imageRequest 图片缩放请求
+type imageRequest struct {
+	pictureID int64
+	size      imageSize
+	fit       string
+	format    string
+}
+
+// GetImageToken 获取访问图片缩放接口用的短期令牌
+func (s *PictureService) GetImageToken(ctx context.Context, req *pb.GetImageTokenRequest) (*pb.GetImageTokenReply, error) {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	token, expireTime := s.jwtManager.GenerateURLToken(urlTokenPurposePictureImage, loginUserID, middleware.GetUserRoleFromContext(ctx), s.imageProxy.tokenExpire)
+	return &pb.GetImageTokenReply{
+		Token:      token,
+		ExpireTime: timestamppb.New(expireTime),
+	}, nil
+}
+
+// GetPictureImage 返回缩放后的图片：GET /img/{id}?w=&h=&fit=&fmt=
+// 与 GetPictureVOById 相同需要登录，<img> 标签无法设置请求头，
+// 因此也支持通过 token 查询参数传递 GetImageToken 获取的短期令牌（不接受登录 Token）
+// 缩放结果按原图地址和参数缓存在原图所在存储桶中，响应带 ETag 和 Cache-Control，原图更换后地址变化，缓存自然失效
+func (s *PictureService) GetPictureImage(ctx http.Context) error {
+	req := ctx.Request()
+	authCtx, ok := authenticateURLToken(ctx, req, s.jwtManager, urlTokenPurposePictureImage)
+	if !ok {
+		return pb.ErrorUnauthorized("图片令牌无效或已过期")
+	}
+
+	http.SetOperation(ctx, OperationPictureImage)
+	h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+		return nil, s.serveImage(c, ctx.Response(), req, ctx.Vars().Get("id"))
+	})
+	_, err := h(authCtx, nil)
+	return err
+}
+
+// serveImage 检查权限后返回缩放结果，优先使用存储桶中已缓存的结果
+func (s *PictureService) serveImage(ctx context.Context, w nethttp.ResponseWriter, r *nethttp.Request, id string) error {
+	loginUserID := s.getLoginUserID(ctx)
+	if loginUserID == 0 {
+		return pb.ErrorUnauthorized("请先登录")
+	}
+	imageReq, err := s.parseImageRequest(id, r)
+	if err != nil {
+		return err
+	}
+	if s.cosManager == nil {
+		return pb.ErrorSystemError("存储服务未配置")
+	}
+
+	picture, err := s.uc.GetPictureByID(ctx, imageReq.pictureID)
+	if err != nil {
+		return err
+	}
+	if imageReq.format == "" {
+		imageReq.format = defaultVariantFormat(picture.PicFormat)
+	}
+
+	// ETag 由原图地址和缩放参数决定，与缓存文件名一致
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%dx%d|%s|%s", picture.URL, imageReq.size.width, imageReq.size.height, imageReq.fit, imageReq.format)))
+	digest := hex.EncodeToString(sum[:8])
+	etag := `"` + digest + `"`
+	// 出错时不设置缓存头，避免错误响应被缓存
+	setCacheHeaders := func() {
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", int64(s.imageProxy.maxAge.Seconds())))
+	}
+	if match := r.Header.Get("If-None-Match"); match != "" && (match == etag || match == "W/"+etag) {
+		setCacheHeaders()
+		w.WriteHeader(nethttp.StatusNotModified)
+		return nil
+	}
+
+	// 缩放结果缓存在原图所在存储桶，存储桶不允许该格式的扩展名时不缓存，每次重新生成
+	variantKey := ""
+	ext, err := s.cosManager.ImageExtensionFor(picture.URL, imageReq.format)
+	switch {
+	case err == nil:
+		variantKey = fmt.Sprintf("%s%s%s", s.variantPrefix(picture.ID), digest, ext)
+	case !errors.Is(err, pkg.ErrFileTypeNotSupported):
+		s.log.Warnf("图片不在已配置的存储桶中: pictureID=%d, err=%v", picture.ID, err)
+		return pb.ErrorPictureFormatError("该图片不支持缩放")
+	}
+
+	var data []byte
+	if cached, ok := s.renderer.local.Get(digest); ok {
+		data = cached.([]byte)
+	} else if variantKey != "" {
+		variantURL, err := s.cosManager.SiblingObjectURL(picture.URL, variantKey)
+		if err != nil {
+			return pb.ErrorPictureFormatError("该图片不支持缩放")
+		}
+		if data, err = s.cosManager.ReadObject(ctx, variantURL, maxVariantBytes); err != nil {
+			if !errors.Is(err, pkg.ErrObjectNotFound) {
+				s.log.Warnf("读取缩放缓存失败: url=%s, err=%v", variantURL, err)
+			}
+			data = nil
+		}
+	}
+	if data == nil {
+		if data, err = s.renderShared(ctx, digest, picture.URL, variantKey, imageReq); err != nil {
+			return err
+		}
+	}
+
+	setCacheHeaders()
+	w.Header().Set("Content-Type", "image/"+imageReq.format)
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(nethttp.StatusOK)
+	_, _ = w.Write(data)
+	return nil
+}
+
+// renderShared 生成缩放结果，相同 digest 的并发请求只生成一次并共享结果
+// 生成过程不随单个请求取消，完成后写入缓存供后续请求使用；请求先超时或断开时直接返回
+func (s *PictureService) renderShared(ctx context.Context, digest, sourceURL, variantKey string, req *imageRequest) ([]byte, error) {
+	ch := s.renderer.group.DoChan(digest, func() (interface{}, error) {
+		renderCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), renderTimeout)
+		defer cancel()
+		return s.renderVariant(renderCtx, digest, sourceURL, variantKey, req)
+	})
+	select {
+	case result := <-ch:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.([]byte), nil
+	case <-ctx.Done():
+		return nil, pb.ErrorSystemError("图片处理繁忙，请稍后重试")
+	}
+}
+
+// renderVariant 读取原图生成缩放结果，variantKey 不为空时写入原图所在存储桶缓存，写入缓存失败不影响本次返回
+// 缓存与上传的文件一样需满足存储桶的扩展名和文件大小限制，无法写入存储桶时缓存在本机内存中
+// 解码原图占用较多内存和 CPU，同时生成的数量受 max_renders 限制
+func (s *PictureService) renderVariant(ctx context.Context, digest, sourceURL, variantKey string, req *imageRequest) ([]byte, error) {
+	select {
+	case s.renderer.slots <- struct{}{}:
+		defer func() { <-s.renderer.slots }()
+	case <-ctx.Done():
+		return nil, pb.ErrorSystemError("图片处理繁忙，请稍后重试")
+	}
+
+	img, _, err := s.readOrientedImage(ctx, sourceURL)
+	if err != nil {
+		return nil, err
+	}
+	data, err := pkg.EncodeImage(pkg.Thumbnail(img, req.size.width, req.size.height, req.fit), req.format)
+	if err != nil {
+		s.log.Errorf("编码缩放结果失败: url=%s, err=%v", sourceURL, err)
+		return nil, pb.ErrorSystemError("生成缩放图片失败")
+	}
+	if variantKey != "" {
+		_, err := s.cosManager.SaveSiblingObject(ctx, sourceURL, variantKey, data)
+		if err == nil {
+			return data, nil
+		}
+		s.log.Warnf("缓存缩放结果失败: key=%s, err=%v", variantKey, err)
+	}
+	s.renderer.local.Add(digest, data)
+	return data, nil
+}
+
+// variantPrefix 图片缩放缓存在存储桶中的目录
+func (s *PictureService) variantPrefix(pictureID int64) string {
+	return fmt.Sprintf("%s/%d/", s.imageProxy.variantDir, pictureID)
+}
+
+// purgeImageVariants 在后台删除图片的全部缩放缓存，图片删除或图片文件更换后调用，失败时只记录日志
+// 缓存按原图地址区分，不删除也不会返回过期的结果，删除只为释放存储桶空间
+func (s *PictureService) purgeImageVariants(ctx context.Context, pictureIDs ...int64) {
+	if s.cosManager == nil || len(pictureIDs) == 0 {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		for _, id := range pictureIDs {
+			if _, err := s.cosManager.DeleteObjectsByPrefix(ctx, s.variantPrefix(id)); err != nil {
+				s.log.Warnf("删除图片缩放缓存失败: pictureID=%d, err=%v", id, err)
+			}
+		}
+	}()
+}
+
+// parseImageRequest 解析并校验缩放参数，尺寸需在白名单中
+func (s *PictureService) parseImageRequest(id string, r *nethttp.Request) (*imageRequest, error) {
+	pictureID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || pictureID <= 0 {
+		return nil, pb.ErrorInvalidArgument("图片 ID 不能为空")
+	}
+
+	query := r.URL.Query()
+	size := imageSize{}
+	if value := query.Get("w"); value != "" {
+		if size.width, err = strconv.Atoi(value); err != nil || size.width < 0 {
+			return nil, pb.ErrorParamsError("宽度格式错误")
+		}
+	}
+	if value := query.Get("h"); value != "" {
+		if size.height, err = strconv.Atoi(value); err != nil || size.height < 0 {
+			return nil, pb.ErrorParamsError("高度格式错误")
+		}
+	}
+	if !s.imageProxy.sizes[size] {
+		return nil, pb.ErrorParamsError(fmt.Sprintf("不支持的图片尺寸 %dx%d", size.width, size.height))
+	}
+
+	req := &imageRequest{pictureID: pictureID, size: size}
+	switch fit := query.Get("fit"); fit {
+	case "", pkg.FitContain:
+		req.fit = pkg.FitContain
+	case pkg.FitCover:
+		req.fit = pkg.FitCover
+	default:
+		return nil, pb.ErrorParamsError("fit 只支持 contain、cover")
+	}
+	// 未指定宽高中的一边时两种方式结果相同，统一后共用缓存
+	if size.width == 0 || size.height == 0 {
+		req.fit = pkg.FitContain
+	}
+	switch format := strings.ToLower(query.Get("fmt")); format {
+	case "":
+	case "jpeg", "jpg":
+		req.format = "jpeg"
+	case "png":
+		req.format = "png"
+	case "webp":
+		req.format = "webp"
+	default:
+		return nil, pb.ErrorParamsError("fmt 只支持 jpeg、png、webp")
+	}
+	return req, nil
+}
+
+// defaultVariantFormat 未指定格式时缩放结果的格式：JPEG 原图输出 JPEG，其他输出 PNG（保留透明度）
+func defaultVariantFormat(picFormat string) string {
+	switch strings.ToLower(picFormat) {
+	case "jpeg", "jpg":
+		return "jpeg"
+	default:
+		return "png"
+	}
+}
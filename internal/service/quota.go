@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+
+	pb "smart-collab-gallery-server/api/quota/v1"
+	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/middleware"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type QuotaService struct {
+	pb.UnimplementedQuotaServer
+
+	uc  *biz.QuotaUsecase
+	log *log.Helper
+}
+
+// NewQuotaService 创建存储配额服务
+func NewQuotaService(uc *biz.QuotaUsecase, logger log.Logger) *QuotaService {
+	return &QuotaService{
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// GetMyUsage 查询我的存储用量和配额
+func (s *QuotaService) GetMyUsage(ctx context.Context, req *pb.GetMyUsageRequest) (*pb.GetMyUsageReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	usage, err := s.uc.GetUsage(ctx, loginUserID)
+	if err != nil {
+		s.log.Errorf("查询存储用量失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.GetMyUsageReply{
+		Usage: convertToProtoUsageVO(usage),
+	}, nil
+}
+
+// GetUserUsage 查询指定用户的存储用量和配额（管理员）
+func (s *QuotaService) GetUserUsage(ctx context.Context, req *pb.GetUserUsageRequest) (*pb.GetUserUsageReply, error) {
+	if req.UserId <= 0 {
+		return nil, pb.ErrorParamsError("用户 ID 不能为空")
+	}
+
+	usage, err := s.uc.GetUsage(ctx, req.UserId)
+	if err != nil {
+		s.log.Errorf("查询用户存储用量失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.GetUserUsageReply{
+		Usage: convertToProtoUsageVO(usage),
+	}, nil
+}
+
+// SetUserQuota 设置指定用户的配额（管理员）
+func (s *QuotaService) SetUserQuota(ctx context.Context, req *pb.SetUserQuotaRequest) (*pb.SetUserQuotaReply, error) {
+	usage, err := s.uc.SetUserQuota(ctx, req.UserId, req.MaxBytes, req.MaxPictures, req.MaxDailyUploads)
+	if err != nil {
+		s.log.Errorf("设置用户配额失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.SetUserQuotaReply{
+		Usage: convertToProtoUsageVO(usage),
+	}, nil
+}
+
+// convertToProtoUsageVO 转换业务对象为 proto 对象
+func convertToProtoUsageVO(usage *biz.Usage) *pb.UsageVO {
+	return &pb.UsageVO{
+		UserId:          usage.UserID,
+		UsedBytes:       usage.UsedBytes,
+		MaxBytes:        usage.Limit.MaxBytes,
+		PictureCount:    usage.PictureCount,
+		MaxPictures:     usage.Limit.MaxPictures,
+		DailyUploads:    usage.DailyUploads,
+		MaxDailyUploads: usage.Limit.MaxDailyUploads,
+		Overridden:      usage.Overridden,
+	}
+}
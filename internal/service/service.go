@@ -1,7 +1,11 @@
 package service
 
 import (
+	"context"
+	nethttp "net/http"
+
 	"smart-collab-gallery-server/internal/conf"
+	"smart-collab-gallery-server/internal/middleware"
 	"smart-collab-gallery-server/internal/pkg"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -9,7 +13,7 @@ import (
 )
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewGreeterService, NewUserService, NewFileService, NewHealthService, NewPictureService, NewJWTManager, NewCOSManager)
+var ProviderSet = wire.NewSet(NewGreeterService, NewUserService, NewFileService, NewHealthService, NewPictureService, NewCommentService, NewNotificationService, NewAlbumService, NewSpaceService, NewShareService, NewQuotaService, NewTaxonomyService, NewJWTManager, NewCOSManager)
 
 // NewJWTManager 创建 JWT 管理器
 func NewJWTManager(bc *conf.Bootstrap) *pkg.JWTManager {
@@ -20,3 +24,18 @@ func NewJWTManager(bc *conf.Bootstrap) *pkg.JWTManager {
 func NewCOSManager(bc *conf.Bootstrap, logger log.Logger) (*pkg.COSManager, error) {
 	return pkg.NewCOSManager(bc.Cos, logger)
 }
+
+// authenticateURLToken 请求没有 Authorization 请求头时，使用 token 查询参数中的 URL 令牌认证，返回带有用户信息的上下文
+// 只接受 purpose 用途的短期令牌，不接受登录 Token，避免长期有效的登录 Token 出现在 URL、访问日志和 Referer 中
+// 没有 token 参数时原样返回，由 JWTAuth 按请求头认证；令牌无效时返回 false
+func authenticateURLToken(ctx context.Context, req *nethttp.Request, jwtManager *pkg.JWTManager, purpose string) (context.Context, bool) {
+	token := req.URL.Query().Get("token")
+	if req.Header.Get(middleware.AuthorizationKey) != "" || token == "" {
+		return ctx, true
+	}
+	claims, err := jwtManager.ParseURLToken(token, purpose)
+	if err != nil {
+		return ctx, false
+	}
+	return middleware.WithUser(ctx, claims.UserID, claims.UserRole), true
+}
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	picturepb "smart-collab-gallery-server/api/picture/v1"
+	pb "smart-collab-gallery-server/api/share/v1"
+	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/conf"
+	"smart-collab-gallery-server/internal/middleware"
+	"smart-collab-gallery-server/internal/pkg"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ShareService struct {
+	pb.UnimplementedShareServer
+
+	uc             *biz.ShareUsecase
+	pictureService *PictureService // 复用图片视图对象的转换
+	albumService   *AlbumService   // 复用相册视图对象的转换
+	cosManager     *pkg.COSManager // 用于生成短期有效的下载地址，为 nil 时返回原始地址
+	trustedProxies []*net.IPNet    // 可信反向代理，只有来自这些地址的请求才读取代理传递的客户端 IP
+	log            *log.Helper
+}
+
+// NewShareService 创建分享链接服务
+func NewShareService(uc *biz.ShareUsecase, pictureService *PictureService, albumService *AlbumService, cosManager *pkg.COSManager, bc *conf.Bootstrap, logger log.Logger) *ShareService {
+	helper := log.NewHelper(logger)
+	return &ShareService{
+		uc:             uc,
+		pictureService: pictureService,
+		albumService:   albumService,
+		cosManager:     cosManager,
+		trustedProxies: parseTrustedProxies(bc.GetServer().GetHttp().GetTrustedProxies(), helper),
+		log:            helper,
+	}
+}
+
+// CreateShareLink 创建分享链接
+func (s *ShareService) CreateShareLink(ctx context.Context, req *pb.CreateShareLinkRequest) (*pb.CreateShareLinkReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	link, err := s.uc.CreateShareLink(ctx, loginUserID, biz.ShareTargetType(req.TargetType), req.TargetId, req.ExpireSeconds, req.Password, req.MaxViews)
+	if err != nil {
+		s.log.Errorf("创建分享链接失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.CreateShareLinkReply{
+		ShareLink: s.convertToProtoShareLinkVO(link),
+	}, nil
+}
+
+// RevokeShareLink 撤销分享链接
+func (s *ShareService) RevokeShareLink(ctx context.Context, req *pb.RevokeShareLinkRequest) (*pb.RevokeShareLinkReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	// 检查是否是管理员
+	isAdmin := middleware.GetUserRoleFromContext(ctx) == "admin"
+
+	if err := s.uc.RevokeShareLink(ctx, loginUserID, req.Id, isAdmin); err != nil {
+		s.log.Errorf("撤销分享链接失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.RevokeShareLinkReply{
+		Success: true,
+	}, nil
+}
+
+// ListMyShareLinks 分页查询我创建的分享链接
+func (s *ShareService) ListMyShareLinks(ctx context.Context, req *pb.ListMyShareLinksRequest) (*pb.ListMyShareLinksReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	page, err := s.uc.ListMyShareLinks(ctx, loginUserID, biz.ShareTargetType(req.TargetType), req.TargetId, req.Current, req.PageSize)
+	if err != nil {
+		s.log.Errorf("查询分享链接失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*pb.ShareLinkVO, 0, len(page.List))
+	for _, link := range page.List {
+		list = append(list, s.convertToProtoShareLinkVO(link))
+	}
+
+	return &pb.ListMyShareLinksReply{
+		Total: page.Total,
+		List:  list,
+	}, nil
+}
+
+// GetShareLinkStats 查询分享链接的访问统计
+func (s *ShareService) GetShareLinkStats(ctx context.Context, req *pb.GetShareLinkStatsRequest) (*pb.GetShareLinkStatsReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	// 检查是否是管理员
+	isAdmin := middleware.GetUserRoleFromContext(ctx) == "admin"
+
+	stats, err := s.uc.GetShareLinkStats(ctx, loginUserID, req.Id, isAdmin, req.Days)
+	if err != nil {
+		s.log.Errorf("查询分享链接访问统计失败: %v", err)
+		return nil, err
+	}
+
+	daily := make([]*pb.ShareDailyStat, 0, len(stats.Daily))
+	for _, d := range stats.Daily {
+		daily = append(daily, &pb.ShareDailyStat{
+			Date:  d.Date,
+			Count: d.Count,
+		})
+	}
+
+	reply := &pb.GetShareLinkStatsReply{
+		ViewCount:          stats.ViewCount,
+		UniqueVisitorCount: stats.UniqueVisitorCount,
+		DeniedCount:        stats.DeniedCount,
+		Daily:              daily,
+	}
+	if stats.LastAccessTime != nil {
+		reply.LastAccessTime = timestamppb.New(*stats.LastAccessTime)
+	}
+	return reply, nil
+}
+
+// ResolveShareLink 访问分享链接（无需登录）
+func (s *ShareService) ResolveShareLink(ctx context.Context, req *pb.ResolveShareLinkRequest) (*pb.ResolveShareLinkReply, error) {
+	ip, userAgent := s.clientInfoFromContext(ctx)
+
+	content, err := s.uc.ResolveShareLink(ctx, req.Token, req.Password, ip, userAgent, req.Current, req.PageSize)
+	if err != nil {
+		s.log.Errorf("访问分享链接失败: %v", err)
+		return nil, err
+	}
+
+	reply := &pb.ResolveShareLinkReply{
+		TargetType:    string(content.TargetType),
+		ExpireTime:    timestamppb.New(content.ExpireTime),
+		UrlExpireTime: timestamppb.New(time.Now().Add(content.URLExpire)),
+	}
+	if content.Picture != nil {
+		reply.Picture = s.pictureService.convertToProtoPictureVO(ctx, content.Picture)
+		reply.Picture.Url = s.signURL(ctx, content.Picture.URL, content.URLExpire)
+		reply.Picture.User = publicShareUser(reply.Picture.User)
+	}
+	if content.Album != nil {
+		reply.Album = s.albumService.convertToProtoAlbumVO(ctx, content.Album)
+		reply.Album.CoverUrl = s.signURL(ctx, content.Album.CoverURL, content.URLExpire)
+		reply.Album.User = publicShareUser(reply.Album.User)
+	}
+	if content.Pictures != nil {
+		reply.Total = content.Pictures.Total
+		reply.Pictures = make([]*picturepb.PictureVO, 0, len(content.Pictures.List))
+		for _, picture := range content.Pictures.List {
+			vo := s.pictureService.convertToProtoPictureVO(ctx, picture)
+			vo.Url = s.signURL(ctx, picture.URL, content.URLExpire)
+			vo.User = publicShareUser(vo.User)
+			reply.Pictures = append(reply.Pictures, vo)
+		}
+	}
+	return reply, nil
+}
+
+// publicShareUser 分享链接无需登录即可访问，创建者信息只保留公开的用户名和头像，不返回账号、角色等信息
+func publicShareUser(user *picturepb.UserVO) *picturepb.UserVO {
+	if user == nil {
+		return nil
+	}
+	return &picturepb.UserVO{
+		UserName:   user.UserName,
+		UserAvatar: user.UserAvatar,
+	}
+}
+
+// signURL 生成短期有效的下载地址，COS 未配置或签名失败时返回空字符串，避免泄露永久地址
+func (s *ShareService) signURL(ctx context.Context, rawURL string, expire time.Duration) string {
+	if rawURL == "" || s.cosManager == nil {
+		return rawURL
+	}
+
+	signed, err := s.cosManager.GetDownloadPresignedURL(ctx, rawURL, expire)
+	if err != nil {
+		s.log.Errorf("生成下载地址失败: %v", err)
+		return ""
+	}
+	return signed
+}
+
+// convertToProtoShareLinkVO 转换业务对象为 proto 对象
+func (s *ShareService) convertToProtoShareLinkVO(vo *biz.ShareLinkVO) *pb.ShareLinkVO {
+	if vo == nil {
+		return nil
+	}
+
+	return &pb.ShareLinkVO{
+		Id:          vo.ID,
+		Token:       vo.Token,
+		TargetType:  string(vo.TargetType),
+		TargetId:    vo.TargetID,
+		HasPassword: vo.HasPassword,
+		MaxViews:    vo.MaxViews,
+		ViewCount:   vo.ViewCount,
+		Revoked:     vo.IsRevoked,
+		ExpireTime:  timestamppb.New(vo.ExpireTime),
+		CreateTime:  timestamppb.New(vo.CreateTime),
+		UserId:      vo.UserID,
+	}
+}
+
+// clientInfoFromContext 从 HTTP 请求中获取客户端 IP 和 User-Agent
+// 只有直连地址是可信反向代理时才读取 X-Forwarded-For / X-Real-IP，否则客户端可以随意伪造 IP
+func (s *ShareService) clientInfoFromContext(ctx context.Context) (string, string) {
+	req, ok := http.RequestFromServerContext(ctx)
+	if !ok {
+		return "", ""
+	}
+
+	ip := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+		ip = host
+	}
+	if !s.isTrustedProxy(ip) {
+		return ip, req.UserAgent()
+	}
+
+	// 从右往左跳过可信代理，第一个不可信的地址即为客户端 IP，最左侧的值可能是客户端伪造的
+	if forwarded := req.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if net.ParseIP(hop) == nil {
+				break
+			}
+			ip = hop
+			if !s.isTrustedProxy(hop) {
+				break
+			}
+		}
+		return ip, req.UserAgent()
+	}
+	if realIP := strings.TrimSpace(req.Header.Get("X-Real-IP")); net.ParseIP(realIP) != nil {
+		ip = realIP
+	}
+	return ip, req.UserAgent()
+}
+
+// isTrustedProxy 判断 IP 是否属于可信反向代理
+func (s *ShareService) isTrustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range s.trustedProxies {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrustedProxies 解析可信反向代理配置，支持单个 IP 和 CIDR，无效的配置项只记录日志
+func parseTrustedProxies(values []string, logger *log.Helper) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				logger.Warnf("忽略无效的可信代理配置: %s", value)
+				continue
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			} else {
+				ip = ip.To4()
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			logger.Warnf("忽略无效的可信代理配置: %s", value)
+			continue
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+
+	pb "smart-collab-gallery-server/api/space/v1"
+	"smart-collab-gallery-server/internal/biz"
+	"smart-collab-gallery-server/internal/middleware"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SpaceService struct {
+	pb.UnimplementedSpaceServer
+
+	uc  *biz.SpaceUsecase
+	log *log.Helper
+}
+
+// NewSpaceService 创建空间服务
+func NewSpaceService(uc *biz.SpaceUsecase, logger log.Logger) *SpaceService {
+	return &SpaceService{
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// AddSpace 创建空间
+func (s *SpaceService) AddSpace(ctx context.Context, req *pb.AddSpaceRequest) (*pb.AddSpaceReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	space, err := s.uc.AddSpace(ctx, loginUserID, &biz.Space{
+		SpaceName:       req.SpaceName,
+		DuplicateMode:   biz.DuplicateMode(req.DuplicateMode),
+		MaxSize:         req.MaxSize,
+		MaxCount:        req.MaxCount,
+		VersionMaxCount: req.VersionMaxCount,
+		VersionMaxDays:  req.VersionMaxDays,
+	})
+	if err != nil {
+		s.log.Errorf("创建空间失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.AddSpaceReply{
+		Space: convertToProtoSpaceVO(space),
+	}, nil
+}
+
+// UpdateSpace 更新空间
+func (s *SpaceService) UpdateSpace(ctx context.Context, req *pb.UpdateSpaceRequest) (*pb.UpdateSpaceReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	space, err := s.uc.UpdateSpace(ctx, loginUserID, &biz.Space{
+		ID:              req.Id,
+		SpaceName:       req.SpaceName,
+		DuplicateMode:   biz.DuplicateMode(req.DuplicateMode),
+		MaxSize:         req.MaxSize,
+		MaxCount:        req.MaxCount,
+		VersionMaxCount: req.VersionMaxCount,
+		VersionMaxDays:  req.VersionMaxDays,
+	})
+	if err != nil {
+		s.log.Errorf("更新空间失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.UpdateSpaceReply{
+		Space: convertToProtoSpaceVO(space),
+	}, nil
+}
+
+// DeleteSpace 删除空间
+func (s *SpaceService) DeleteSpace(ctx context.Context, req *pb.DeleteSpaceRequest) (*pb.DeleteSpaceReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+
+	// 检查是否是管理员
+	isAdmin := middleware.GetUserRoleFromContext(ctx) == "admin"
+
+	if err := s.uc.DeleteSpace(ctx, loginUserID, req.Id, isAdmin); err != nil {
+		s.log.Errorf("删除空间失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.DeleteSpaceReply{
+		Success: true,
+	}, nil
+}
+
+// GetSpace 获取空间详情
+func (s *SpaceService) GetSpace(ctx context.Context, req *pb.GetSpaceRequest) (*pb.GetSpaceReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	isAdmin := middleware.GetUserRoleFromContext(ctx) == "admin"
+
+	space, err := s.uc.GetSpace(ctx, loginUserID, isAdmin, req.Id)
+	if err != nil {
+		s.log.Errorf("获取空间失败: %v", err)
+		return nil, err
+	}
+
+	return &pb.GetSpaceReply{
+		Space: convertToProtoSpaceVO(space),
+	}, nil
+}
+
+// ListSpaceByPage 分页查询空间
+func (s *SpaceService) ListSpaceByPage(ctx context.Context, req *pb.ListSpaceByPageRequest) (*pb.ListSpaceByPageReply, error) {
+	loginUserID := middleware.GetUserIDFromContext(ctx)
+	if loginUserID == 0 {
+		return nil, pb.ErrorUnauthorized("请先登录")
+	}
+	isAdmin := middleware.GetUserRoleFromContext(ctx) == "admin"
+
+	page, err := s.uc.ListSpaceByPage(ctx, loginUserID, isAdmin, req.UserId, req.SpaceName, req.Current, req.PageSize)
+	if err != nil {
+		s.log.Errorf("查询空间列表失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*pb.SpaceVO, 0, len(page.List))
+	for _, space := range page.List {
+		list = append(list, convertToProtoSpaceVO(space))
+	}
+
+	return &pb.ListSpaceByPageReply{
+		Total: page.Total,
+		List:  list,
+	}, nil
+}
+
+// convertToProtoSpaceVO 转换业务对象为 proto 对象
+func convertToProtoSpaceVO(space *biz.Space) *pb.SpaceVO {
+	if space == nil {
+		return nil
+	}
+
+	return &pb.SpaceVO{
+		Id:              space.ID,
+		SpaceName:       space.SpaceName,
+		DuplicateMode:   string(space.DuplicateMode),
+		UserId:          space.UserID,
+		CreateTime:      timestamppb.New(space.CreateTime),
+		EditTime:        timestamppb.New(space.EditTime),
+		MaxSize:         space.MaxSize,
+		MaxCount:        space.MaxCount,
+		TotalSize:       space.TotalSize,
+		TotalCount:      space.TotalCount,
+		VersionMaxCount: space.VersionMaxCount,
+		VersionMaxDays:  space.VersionMaxDays,
+	}
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+
+	pb "smart-collab-gallery-server/api/taxonomy/v1"
+	"smart-collab-gallery-server/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TaxonomyService struct {
+	pb.UnimplementedTaxonomyServer
+
+	uc  *biz.TaxonomyUsecase
+	log *log.Helper
+}
+
+// NewTaxonomyService 创建标签、分类管理服务
+func NewTaxonomyService(uc *biz.TaxonomyUsecase, logger log.Logger) *TaxonomyService {
+	return &TaxonomyService{
+		uc:  uc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// ListTerms 分页查询标签或分类（管理员）
+func (s *TaxonomyService) ListTerms(ctx context.Context, req *pb.ListTermsRequest) (*pb.ListTermsReply, error) {
+	kind, err := parseTaxonomyKind(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.uc.ListTerms(ctx, &biz.TermQueryParams{
+		Kind:       kind,
+		SearchText: req.SearchText,
+		Current:    req.Current,
+		PageSize:   req.PageSize,
+		SortField:  req.SortField,
+		SortOrder:  req.SortOrder,
+	})
+	if err != nil {
+		s.log.Errorf("查询标签、分类列表失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*pb.TermVO, 0, len(page.List))
+	for _, term := range page.List {
+		list = append(list, convertToProtoTermVO(term))
+	}
+	return &pb.ListTermsReply{
+		Total: page.Total,
+		List:  list,
+	}, nil
+}
+
+// AddTerm 创建标签或分类（管理员）
+func (s *TaxonomyService) AddTerm(ctx context.Context, req *pb.AddTermRequest) (*pb.AddTermReply, error) {
+	kind, err := parseTaxonomyKind(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	term, err := s.uc.AddTerm(ctx, kind, req.Name, req.SortOrder)
+	if err != nil {
+		s.log.Errorf("创建标签、分类失败: %v", err)
+		return nil, err
+	}
+	return &pb.AddTermReply{
+		Term: convertToProtoTermVO(term),
+	}, nil
+}
+
+// UpdateTerm 重命名标签或分类、调整分类顺序（管理员）
+func (s *TaxonomyService) UpdateTerm(ctx context.Context, req *pb.UpdateTermRequest) (*pb.UpdateTermReply, error) {
+	kind, err := parseTaxonomyKind(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	term, affected, err := s.uc.UpdateTerm(ctx, kind, req.Id, req.Name, req.SortOrder, req.KeepAlias)
+	if err != nil {
+		s.log.Errorf("更新标签、分类失败: %v", err)
+		return nil, err
+	}
+	return &pb.UpdateTermReply{
+		Term:             convertToProtoTermVO(term),
+		AffectedPictures: affected,
+	}, nil
+}
+
+// DeleteTerm 删除标签或分类（管理员）
+func (s *TaxonomyService) DeleteTerm(ctx context.Context, req *pb.DeleteTermRequest) (*pb.DeleteTermReply, error) {
+	kind, err := parseTaxonomyKind(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.uc.DeleteTerm(ctx, kind, req.Id)
+	if err != nil {
+		s.log.Errorf("删除标签、分类失败: %v", err)
+		return nil, err
+	}
+	return &pb.DeleteTermReply{
+		AffectedPictures: affected,
+	}, nil
+}
+
+// MergeTerms 合并标签或分类（管理员）
+func (s *TaxonomyService) MergeTerms(ctx context.Context, req *pb.MergeTermsRequest) (*pb.MergeTermsReply, error) {
+	kind, err := parseTaxonomyKind(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	term, affected, err := s.uc.MergeTerms(ctx, kind, req.SourceIds, req.TargetId)
+	if err != nil {
+		s.log.Errorf("合并标签、分类失败: %v", err)
+		return nil, err
+	}
+	return &pb.MergeTermsReply{
+		Term:             convertToProtoTermVO(term),
+		AffectedPictures: affected,
+	}, nil
+}
+
+// AddTermAlias 添加别名（管理员）
+func (s *TaxonomyService) AddTermAlias(ctx context.Context, req *pb.AddTermAliasRequest) (*pb.AddTermAliasReply, error) {
+	kind, err := parseTaxonomyKind(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	term, err := s.uc.AddAlias(ctx, kind, req.Id, req.Alias)
+	if err != nil {
+		s.log.Errorf("添加别名失败: %v", err)
+		return nil, err
+	}
+	return &pb.AddTermAliasReply{
+		Term: convertToProtoTermVO(term),
+	}, nil
+}
+
+// DeleteTermAlias 删除别名（管理员）
+func (s *TaxonomyService) DeleteTermAlias(ctx context.Context, req *pb.DeleteTermAliasRequest) (*pb.DeleteTermAliasReply, error) {
+	kind, err := parseTaxonomyKind(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	term, err := s.uc.DeleteAlias(ctx, kind, req.Id, req.Alias)
+	if err != nil {
+		s.log.Errorf("删除别名失败: %v", err)
+		return nil, err
+	}
+	return &pb.DeleteTermAliasReply{
+		Term: convertToProtoTermVO(term),
+	}, nil
+}
+
+// parseTaxonomyKind 解析请求中的类型
+func parseTaxonomyKind(s string) (biz.TaxonomyKind, error) {
+	kind, ok := biz.ParseTaxonomyKind(s)
+	if !ok {
+		return "", pb.ErrorParamsError("kind 只能为 tag 或 category")
+	}
+	return kind, nil
+}
+
+// convertToProtoTermVO 转换业务对象为 proto 对象
+func convertToProtoTermVO(term *biz.TaxonomyTerm) *pb.TermVO {
+	return &pb.TermVO{
+		Id:           term.ID,
+		Kind:         string(term.Kind),
+		Name:         term.Name,
+		Aliases:      term.Aliases,
+		SortOrder:    term.SortOrder,
+		PictureCount: term.PictureCount,
+		CreateTime:   timestamppb.New(term.CreateTime),
+		UpdateTime:   timestamppb.New(term.UpdateTime),
+	}
+}
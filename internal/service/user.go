@@ -16,13 +16,17 @@ type UserService struct {
 	v1.UnimplementedUserServer
 
 	uc         *biz.UserUsecase
+	vipUC      *biz.VipUsecase
+	followUC   *biz.FollowUsecase
 	jwtManager *pkg.JWTManager
 	log        *log.Helper
 }
 
-func NewUserService(uc *biz.UserUsecase, jwtManager *pkg.JWTManager, logger log.Logger) *UserService {
+func NewUserService(uc *biz.UserUsecase, vipUC *biz.VipUsecase, followUC *biz.FollowUsecase, jwtManager *pkg.JWTManager, logger log.Logger) *UserService {
 	return &UserService{
 		uc:         uc,
+		vipUC:      vipUC,
+		followUC:   followUC,
 		jwtManager: jwtManager,
 		log:        log.NewHelper(logger),
 	}
@@ -31,7 +35,7 @@ func NewUserService(uc *biz.UserUsecase, jwtManager *pkg.JWTManager, logger log.
 func (s *UserService) Register(ctx context.Context, req *v1.RegisterRequest) (*v1.RegisterReply, error) {
 	s.log.WithContext(ctx).Infof("用户注册请求: account=%s", req.UserAccount)
 
-	userId, err := s.uc.Register(ctx, req.UserAccount, req.UserPassword, req.CheckPassword)
+	userId, err := s.uc.Register(ctx, req.UserAccount, req.UserPassword, req.CheckPassword, req.InviteCode)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("用户注册失败: %v", err)
 		return nil, err
@@ -130,6 +134,8 @@ func (s *UserService) convertToLoginUserVO(user *biz.User) *v1.LoginUserVO {
 		VipNumber:           user.VipNumber,
 		CreateTime:          user.CreateTime.Format(time.RFC3339),
 		UpdateTime:          user.UpdateTime.Format(time.RFC3339),
+		ShareCode:           user.ShareCode,
+		KeepPhotoMetadata:   user.KeepPhotoMetadata,
 	}
 
 	if user.VipExpireTime != nil {
@@ -169,6 +175,32 @@ func (s *UserService) convertToUserVO(user *biz.User) *v1.UserVO {
 	return vo
 }
 
+// fillFollowStats 填充粉丝数、关注数和当前登录用户的关注状态，查询失败时保持默认值
+func (s *UserService) fillFollowStats(ctx context.Context, voList []*v1.UserVO) {
+	if len(voList) == 0 {
+		return
+	}
+
+	userIDs := make([]int64, 0, len(voList))
+	for _, vo := range voList {
+		userIDs = append(userIDs, vo.Id)
+	}
+
+	stats, err := s.followUC.GetFollowStats(ctx, middleware.GetUserIDFromContext(ctx), userIDs)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("查询关注统计失败: %v", err)
+		return
+	}
+
+	for _, vo := range voList {
+		if stat, ok := stats[vo.Id]; ok {
+			vo.FollowerCount = stat.FollowerCount
+			vo.FollowingCount = stat.FollowingCount
+			vo.Followed = stat.Followed
+		}
+	}
+}
+
 // convertToUserVOList 将 User 列表转换为 UserVO 列表
 func (s *UserService) convertToUserVOList(users []*biz.User) []*v1.UserVO {
 	if users == nil || len(users) == 0 {
@@ -259,8 +291,11 @@ func (s *UserService) GetUserVOById(ctx context.Context, req *v1.GetUserVOByIdRe
 		return nil, err
 	}
 
+	vo := s.convertToUserVO(user)
+	s.fillFollowStats(ctx, []*v1.UserVO{vo})
+
 	return &v1.GetUserVOByIdReply{
-		User: s.convertToUserVO(user),
+		User: vo,
 	}, nil
 }
 
@@ -326,6 +361,9 @@ func (s *UserService) ListUserByPage(ctx context.Context, req *v1.ListUserByPage
 		SortOrder:   req.SortOrder,
 		Current:     req.Current,
 		PageSize:    req.PageSize,
+		CursorMode:  req.CursorMode,
+		Cursor:      req.Cursor,
+		WithTotal:   req.WithTotal,
 	}
 
 	if req.Id > 0 {
@@ -341,12 +379,14 @@ func (s *UserService) ListUserByPage(ctx context.Context, req *v1.ListUserByPage
 
 	// 转换为 VO
 	voList := s.convertToUserVOList(userPage.List)
+	s.fillFollowStats(ctx, voList)
 
 	return &v1.ListUserByPageReply{
-		Total:    userPage.Total,
-		List:     voList,
-		Current:  userPage.Current,
-		PageSize: userPage.PageSize,
+		Total:      userPage.Total,
+		List:       voList,
+		Current:    userPage.Current,
+		PageSize:   userPage.PageSize,
+		NextCursor: userPage.NextCursor,
 	}, nil
 }
 
@@ -440,3 +480,212 @@ func (s *UserService) UpdatePassword(ctx context.Context, req *v1.UpdatePassword
 		Message: message,
 	}, nil
 }
+
+// GenerateVipCodes 批量生成会员兑换码（仅管理员）
+func (s *UserService) GenerateVipCodes(ctx context.Context, req *v1.GenerateVipCodesRequest) (*v1.GenerateVipCodesReply, error) {
+	adminID := middleware.GetUserIDFromContext(ctx)
+	if adminID == 0 {
+		return nil, v1.ErrorNotLoginError("未登录")
+	}
+
+	s.log.WithContext(ctx).Infof("生成会员兑换码: adminID=%d, count=%d, vipDays=%d", adminID, req.Count, req.VipDays)
+
+	codes, err := s.vipUC.GenerateVipCodes(ctx, adminID, req.Count, req.VipDays, req.MaxUses, req.ExpireDays)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("生成会员兑换码失败: %v", err)
+		return nil, err
+	}
+
+	reply := &v1.GenerateVipCodesReply{
+		Codes: make([]string, 0, len(codes)),
+	}
+	for _, code := range codes {
+		reply.Codes = append(reply.Codes, code.Code)
+	}
+	if len(codes) > 0 && codes[0].ExpireTime != nil {
+		reply.ExpireTime = codes[0].ExpireTime.Format(time.RFC3339)
+	}
+
+	return reply, nil
+}
+
+// RedeemVipCode 兑换会员
+func (s *UserService) RedeemVipCode(ctx context.Context, req *v1.RedeemVipCodeRequest) (*v1.RedeemVipCodeReply, error) {
+	// 从上下文中获取用户 ID（由 JWT 中间件设置）
+	userID := middleware.GetUserIDFromContext(ctx)
+	if userID == 0 {
+		return nil, v1.ErrorNotLoginError("未登录")
+	}
+
+	s.log.WithContext(ctx).Infof("兑换会员: userID=%d", userID)
+
+	user, err := s.vipUC.RedeemVipCode(ctx, userID, req.VipCode)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("兑换会员失败: %v", err)
+		return nil, err
+	}
+
+	return &v1.RedeemVipCodeReply{
+		VipNumber:     user.VipNumber,
+		VipExpireTime: user.VipExpireTime.Format(time.RFC3339),
+	}, nil
+}
+
+// ListMyInvitees 分页获取我邀请的用户
+func (s *UserService) ListMyInvitees(ctx context.Context, req *v1.ListMyInviteesRequest) (*v1.ListMyInviteesReply, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	if userID == 0 {
+		return nil, v1.ErrorNotLoginError("未登录")
+	}
+
+	page, err := s.uc.ListMyInvitees(ctx, userID, req.Current, req.PageSize)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("查询邀请用户列表失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]*v1.InviteeVO, 0, len(page.List))
+	for _, user := range page.List {
+		list = append(list, &v1.InviteeVO{
+			Id:         user.ID,
+			UserName:   user.UserName,
+			UserAvatar: user.UserAvatar,
+			CreateTime: user.CreateTime.Format(time.RFC3339),
+		})
+	}
+
+	return &v1.ListMyInviteesReply{
+		Total:    page.Total,
+		List:     list,
+		Current:  page.Current,
+		PageSize: page.PageSize,
+	}, nil
+}
+
+// GetInviteStatistics 获取邀请统计（仅管理员）
+func (s *UserService) GetInviteStatistics(ctx context.Context, req *v1.GetInviteStatisticsRequest) (*v1.GetInviteStatisticsReply, error) {
+	stats, err := s.uc.GetInviteStatistics(ctx, req.TopN)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("查询邀请统计失败: %v", err)
+		return nil, err
+	}
+
+	topInviters := make([]*v1.InviterStatVO, 0, len(stats.TopInviters))
+	for _, stat := range stats.TopInviters {
+		topInviters = append(topInviters, &v1.InviterStatVO{
+			UserId:       stat.User.ID,
+			UserAccount:  stat.User.UserAccount,
+			UserName:     stat.User.UserName,
+			InviteeCount: stat.InviteeCount,
+		})
+	}
+
+	return &v1.GetInviteStatisticsReply{
+		TotalInvitees: stats.TotalInvitees,
+		TotalInviters: stats.TotalInviters,
+		TopInviters:   topInviters,
+	}, nil
+}
+
+// FollowUser 关注用户
+func (s *UserService) FollowUser(ctx context.Context, req *v1.FollowUserRequest) (*v1.FollowUserReply, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	if userID == 0 {
+		return nil, v1.ErrorNotLoginError("未登录")
+	}
+
+	s.log.WithContext(ctx).Infof("关注用户: userID=%d, followUserID=%d", userID, req.UserId)
+
+	if err := s.followUC.Follow(ctx, userID, req.UserId); err != nil {
+		s.log.WithContext(ctx).Errorf("关注用户失败: %v", err)
+		return nil, err
+	}
+
+	return &v1.FollowUserReply{
+		Success: true,
+	}, nil
+}
+
+// UnfollowUser 取消关注
+func (s *UserService) UnfollowUser(ctx context.Context, req *v1.UnfollowUserRequest) (*v1.UnfollowUserReply, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	if userID == 0 {
+		return nil, v1.ErrorNotLoginError("未登录")
+	}
+
+	s.log.WithContext(ctx).Infof("取消关注: userID=%d, followUserID=%d", userID, req.UserId)
+
+	if err := s.followUC.Unfollow(ctx, userID, req.UserId); err != nil {
+		s.log.WithContext(ctx).Errorf("取消关注失败: %v", err)
+		return nil, err
+	}
+
+	return &v1.UnfollowUserReply{
+		Success: true,
+	}, nil
+}
+
+// ListFollowers 分页获取粉丝列表
+func (s *UserService) ListFollowers(ctx context.Context, req *v1.ListFollowUsersRequest) (*v1.ListFollowUsersReply, error) {
+	userID := req.UserId
+	if userID <= 0 {
+		userID = middleware.GetUserIDFromContext(ctx)
+	}
+
+	page, err := s.followUC.ListFollowers(ctx, userID, req.Current, req.PageSize)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("查询粉丝列表失败: %v", err)
+		return nil, err
+	}
+
+	return s.convertToFollowUsersReply(ctx, page), nil
+}
+
+// ListFollowings 分页获取关注列表
+func (s *UserService) ListFollowings(ctx context.Context, req *v1.ListFollowUsersRequest) (*v1.ListFollowUsersReply, error) {
+	userID := req.UserId
+	if userID <= 0 {
+		userID = middleware.GetUserIDFromContext(ctx)
+	}
+
+	page, err := s.followUC.ListFollowings(ctx, userID, req.Current, req.PageSize)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("查询关注列表失败: %v", err)
+		return nil, err
+	}
+
+	return s.convertToFollowUsersReply(ctx, page), nil
+}
+
+// convertToFollowUsersReply 将用户分页结果转换为粉丝/关注列表响应
+func (s *UserService) convertToFollowUsersReply(ctx context.Context, page *biz.UserPage) *v1.ListFollowUsersReply {
+	voList := s.convertToUserVOList(page.List)
+	s.fillFollowStats(ctx, voList)
+
+	return &v1.ListFollowUsersReply{
+		Total:    page.Total,
+		List:     voList,
+		Current:  page.Current,
+		PageSize: page.PageSize,
+	}
+}
+
+// UpdatePrivacySettings 更新隐私设置
+func (s *UserService) UpdatePrivacySettings(ctx context.Context, req *v1.UpdatePrivacySettingsRequest) (*v1.UpdatePrivacySettingsReply, error) {
+	// 从上下文中获取用户 ID（由 JWT 中间件设置）
+	userID := middleware.GetUserIDFromContext(ctx)
+	if userID == 0 {
+		return nil, v1.ErrorNotLoginError("未登录")
+	}
+
+	s.log.WithContext(ctx).Infof("更新隐私设置: userID=%d, keepPhotoMetadata=%v", userID, req.KeepPhotoMetadata)
+
+	if err := s.uc.UpdatePrivacySettings(ctx, userID, req.KeepPhotoMetadata); err != nil {
+		s.log.WithContext(ctx).Errorf("更新隐私设置失败: %v", err)
+		return nil, err
+	}
+
+	return &v1.UpdatePrivacySettingsReply{
+		Success: true,
+	}, nil
+}